@@ -2,7 +2,9 @@ package app
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"flag"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -17,17 +19,33 @@ import (
 	"github.com/RobinThrift/stuff/boundary/apiv1"
 	"github.com/RobinThrift/stuff/boundary/htmlui"
 	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/cron"
 	"github.com/RobinThrift/stuff/internal/log"
+	"github.com/RobinThrift/stuff/internal/notify"
 	"github.com/RobinThrift/stuff/internal/server"
 	"github.com/RobinThrift/stuff/jobs"
 	"github.com/RobinThrift/stuff/storage/blobs"
 	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/postgres"
 	"github.com/RobinThrift/stuff/storage/database/sqlite"
 	"github.com/alexedwards/scs/v2"
 	"github.com/go-chi/chi/v5"
 	"github.com/stephenafamo/bob"
 )
 
+// Serve starts the web server. It is also run when stuff is started without a command.
+func Serve(args []string) error {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	return Start()
+}
+
 func Start() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -58,7 +76,7 @@ func Start() error {
 }
 
 func setup(ctx context.Context) (func(context.Context) error, func(context.Context) error, error) {
-	config, err := NewConfigFromEnv()
+	config, err := LoadConfig()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -75,75 +93,110 @@ func setup(ctx context.Context) (func(context.Context) error, func(context.Conte
 
 	slog.InfoContext(ctx, "starting stuff service", "version", stuff.Version)
 
-	db, err := sqlite.NewSQLiteDB(&sqlite.Config{
-		File:      config.Database.Path,
-		EnableWAL: config.Database.EnableWAL,
-		Timeout:   config.Database.Timeout,
-	})
+	db, database, err := openDatabase(ctx, config)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	err = sqlite.RunMigrations(ctx, db)
+	repos := newRepositories(database)
+
+	permissionCtrl := control.NewPermissionControl(database, repos.grants, repos.assets)
+	workspaceCtrl := control.NewWorkspaceControl(database, permissionCtrl, repos.workspaces)
+	userCtrl := control.NewUserCtrl(database, permissionCtrl, repos.users, repos.workspaces)
+	authCtrl := control.NewAuthController(newAuthConfig(config), database, permissionCtrl, userCtrl, repos.localAuth)
+	var oidcCtrl htmlui.OIDCAuthCtrl
+	if config.Auth.OIDC.Enabled {
+		oidcCtrl = control.NewOIDCAuthControl(control.OIDCAuthConfig{
+			AutoProvision: config.Auth.OIDC.AutoProvision,
+			AdminGroup:    config.Auth.OIDC.AdminGroup,
+			DefaultRole:   auth.Role(config.Auth.OIDC.DefaultRole),
+		}, database, userCtrl, auth.NewOIDCProvider(auth.OIDCConfig{
+			IssuerURL:        config.Auth.OIDC.IssuerURL,
+			ClientID:         config.Auth.OIDC.ClientID,
+			ClientSecret:     config.Auth.OIDC.ClientSecret,
+			RedirectURL:      baseURL.JoinPath("/auth/oidc/callback").String(),
+			Scopes:           config.Auth.OIDC.Scopes,
+			UsernameClaim:    config.Auth.OIDC.UsernameClaim,
+			DisplayNameClaim: config.Auth.OIDC.DisplayNameClaim,
+			GroupsClaim:      config.Auth.OIDC.GroupsClaim,
+		}))
+	}
+	apiTokenCtrl := control.NewAPITokenControl(database, userCtrl, repos.apiTokens)
+	tagCtrl := control.NewTagControl(control.TagControlConfig{Algorithm: config.TagAlgorithm, SequentialPerWorkspace: config.TagSequentialPerWorkspace}, database, repos.tags)
+	fileStorage, err := newFileStorage(config)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, errors.Join(db.Close(), err)
 	}
-
-	database := &database.Database{DB: bob.NewDB(db)}
-
-	userCtrl := control.NewUserCtrl(database, &sqlite.UserRepo{})
-	authCtrl := control.NewAuthController(control.AuthConfig{
-		Argon2Params: auth.Argon2Params{
-			KeyLen:  config.Auth.Local.Argon2Params.KeyLen,
-			Memory:  config.Auth.Local.Argon2Params.Memory,
-			Threads: config.Auth.Local.Argon2Params.Threads,
-			Time:    config.Auth.Local.Argon2Params.Time,
-			Version: config.Auth.Local.Argon2Params.Version,
-		},
-	}, database, userCtrl, &sqlite.LocalAuthRepo{})
-	tagCtrl := control.NewTagControl(database, config.TagAlgorithm, &sqlite.TagRepo{})
-	fileCtrl := control.NewFileControl(database, &sqlite.FileRepo{}, &blobs.LocalFS{
-		RootDir: config.FileDir,
-		TmpDir:  config.TmpDir,
-	})
+	fileCtrl := control.NewFileControl(database, permissionCtrl, repos.files, fileStorage)
 	assetCtrl := control.NewAssetControl(
 		database,
+		permissionCtrl,
 		tagCtrl,
 		fileCtrl,
-		&sqlite.AssetRepo{},
+		repos.assets,
+		repos.photos,
+		repos.checkouts,
+		repos.assetEvents,
 	)
-	categoryCtrl := control.NewCategoryCtrl(database, &sqlite.CategoryRepo{})
-	locationCtrl := control.NewLocationControl(database, &sqlite.LocationRepo{})
-	modelCtrl := control.NewModelCtrl(database, &sqlite.ModelRepo{})
-	manufacturerCtrl := control.NewManufactuerCtrl(database, &sqlite.ManufacturerRepo{})
-	supplierCtrl := control.NewSupplierCtrl(database, &sqlite.SupplierRepo{})
-	customAttrCtrl := control.NewCustomAttrCtrl(database, &sqlite.CustomAttrRepo{})
+	categoryCtrl := control.NewCategoryCtrl(database, repos.categories)
+	locationCtrl := control.NewLocationControl(database, repos.locations)
+	modelCtrl := control.NewModelCtrl(database, repos.models)
+	manufacturerCtrl := control.NewManufactuerCtrl(database, repos.manufacturers)
+	supplierCtrl := control.NewSupplierCtrl(database, repos.suppliers)
+	customAttrCtrl := control.NewCustomAttrCtrl(database, repos.customAttrs)
+	savedSearchCtrl := control.NewSavedSearchControl(database, permissionCtrl, repos.savedSearches)
 
 	importerCtrl := control.NewImporterCtrl(control.ImporterCtrlConfig{DefaultCurrency: config.DefaultCurrency}, database, assetCtrl, tagCtrl)
-	exporterCtrl := control.NewExporterCtrl(database, assetCtrl)
-	labelsCtrl := control.NewLabelController(assetCtrl)
+	exporterCtrl := control.NewExporterCtrl(database, assetCtrl, fileCtrl, savedSearchCtrl)
+	labelsCtrl := control.NewLabelController(assetCtrl, savedSearchCtrl)
+	backupConfig, err := newBackupControlConfig(config)
+	if err != nil {
+		return nil, nil, errors.Join(db.Close(), err)
+	}
+	backupCtrl := control.NewBackupControl(backupConfig, database, permissionCtrl, repos.files, fileStorage)
 
 	initJob := jobs.NewInitJob(jobs.InitJobConfig{
 		Username: "admin",
 		Password: config.Auth.Local.InitialAdminPassword,
 	}, database, authCtrl, userCtrl)
 
-	if err = initJob.Run(ctx); err != nil {
+	if err = initJob.Run(auth.SystemCtx(ctx)); err != nil {
 		return nil, nil, errors.Join(db.Close(), err)
 	}
 
+	scheduler := jobs.NewScheduler()
+	scheduler.Every("overdue", time.Duration(config.Jobs.OverdueCheckInterval), jobs.NewOverdueJob(jobs.OverdueJobConfig{
+		ReminderInterval: time.Duration(config.Jobs.OverdueReminderInterval),
+	}, assetCtrl, newNotifier(config.Notifications)))
+
+	scheduler.Once("image_variants_backfill", jobs.NewImageVariantsBackfillJob(assetCtrl))
+
+	if config.Jobs.TrashRetention > 0 {
+		scheduler.Every("trash_purge", time.Duration(config.Jobs.TrashPurgeInterval), jobs.NewTrashPurgeJob(jobs.TrashPurgeJobConfig{
+			Retention: time.Duration(config.Jobs.TrashRetention),
+		}, assetCtrl))
+	}
+
+	if backupConfig.Dir != "" {
+		scheduler.Cron("backup", backupConfig.Schedule, jobs.NewBackupJob(backupCtrl))
+	}
+
 	sm := scs.New()
-	sm.Store = sqlite.NewSQLiteSessionStore(database) //nolint:contextcheck // false positive IMO
+	sm.Store = newSessionStore(database) //nolint:contextcheck // false positive IMO
 	sm.Lifetime = 24 * time.Hour
 	sm.Cookie.HttpOnly = true
 	sm.Cookie.Persist = true
 	sm.Cookie.SameSite = http.SameSiteStrictMode
+	if config.Auth.OIDC.Enabled {
+		// the OIDC callback is a cross-site redirect, which would not include the session cookie in strict mode
+		sm.Cookie.SameSite = http.SameSiteLaxMode
+	}
 	sm.ErrorFunc = func(w http.ResponseWriter, r *http.Request, err error) {
 		slog.ErrorContext(r.Context(), "session storage error", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 
-	srv, err := server.NewServer(config.Addr, config.UseSecureCookies, sm)
+	srv, err := server.NewServer(config.Addr, config.UseSecureCookies, sm, apiTokenCtrl, workspaceCtrl)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -152,6 +205,7 @@ func setup(ctx context.Context) (func(context.Context) error, func(context.Conte
 		apiv1.NewRouter(
 			r,
 			assetCtrl,
+			fileCtrl,
 			categoryCtrl,
 			customAttrCtrl,
 			supplierCtrl,
@@ -169,7 +223,8 @@ func setup(ctx context.Context) (func(context.Context) error, func(context.Conte
 			BaseURL:          baseURL,
 			DecimalSeparator: config.DecimalSeparator,
 			DefaultCurrency:  config.DefaultCurrency,
-			AssetFilesDir:    config.FileDir,
+			AssetFiles:       fileStorage,
+			OIDCProviderName: oidcProviderName(config.Auth.OIDC),
 		},
 		authCtrl,
 		assetCtrl,
@@ -179,6 +234,12 @@ func setup(ctx context.Context) (func(context.Context) error, func(context.Conte
 		importerCtrl,
 		exporterCtrl,
 		labelsCtrl,
+		backupCtrl,
+		apiTokenCtrl,
+		permissionCtrl,
+		workspaceCtrl,
+		savedSearchCtrl,
+		oidcCtrl,
 	)
 
 	start := func(ctx context.Context) error {
@@ -188,10 +249,17 @@ func setup(ctx context.Context) (func(context.Context) error, func(context.Conte
 			}
 		}()
 
+		scheduler.Start(auth.SystemCtx(context.Background())) //nolint:contextcheck // ctx is only valid during startup
+
 		return srv.Start(ctx)
 	}
 
 	stop := func(ctx context.Context) error {
+		slog.InfoContext(ctx, "stopping background jobs")
+		if err := scheduler.Stop(ctx); err != nil {
+			slog.ErrorContext(ctx, "error stopping background jobs", "error", err)
+		}
+
 		slog.InfoContext(ctx, "closing database")
 		if err := db.Close(); err != nil {
 			return fmt.Errorf("error closing database: %w", err)
@@ -202,3 +270,152 @@ func setup(ctx context.Context) (func(context.Context) error, func(context.Conte
 
 	return start, stop, nil
 }
+
+func openDatabase(ctx context.Context, config *Config) (*sql.DB, *database.Database, error) {
+	db, database, err := connectDatabase(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = runMigrations(ctx, db, database.Dialect)
+	if err != nil {
+		return nil, nil, errors.Join(err, db.Close())
+	}
+
+	return db, database, nil
+}
+
+// connectDatabase opens the configured database without running any migrations.
+func connectDatabase(config *Config) (*sql.DB, *database.Database, error) {
+	switch config.Database.Type {
+	case "sqlite":
+		db, err := sqlite.NewSQLiteDB(&sqlite.Config{
+			File:      config.Database.Path,
+			EnableWAL: config.Database.EnableWAL,
+			Timeout:   time.Duration(config.Database.Timeout),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return db, &database.Database{DB: bob.NewDB(db), Dialect: database.DialectSQLite}, nil
+	case "postgres":
+		if config.Database.URL == "" {
+			return nil, nil, errors.New("database URL must be set when using postgres")
+		}
+
+		db, err := postgres.NewPostgresDB(&postgres.Config{URL: config.Database.URL})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return db, &database.Database{DB: bob.NewDB(db), Dialect: database.DialectPostgres}, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown database type %q, must be one of sqlite or postgres", config.Database.Type)
+	}
+}
+
+func newAuthConfig(config *Config) control.AuthConfig {
+	return control.AuthConfig{
+		Argon2Params: auth.Argon2Params{
+			KeyLen:  config.Auth.Local.Argon2Params.KeyLen,
+			Memory:  config.Auth.Local.Argon2Params.Memory,
+			Threads: config.Auth.Local.Argon2Params.Threads,
+			Time:    config.Auth.Local.Argon2Params.Time,
+			Version: config.Auth.Local.Argon2Params.Version,
+		},
+	}
+}
+
+func newBackupControlConfig(config *Config) (control.BackupControlConfig, error) {
+	backupConfig := control.BackupControlConfig{
+		TmpDir: config.TmpDir,
+		Dir:    config.Backups.Dir,
+		Retention: entities.BackupRetention{
+			Daily:   config.Backups.KeepDaily,
+			Weekly:  config.Backups.KeepWeekly,
+			Monthly: config.Backups.KeepMonthly,
+		},
+	}
+
+	if config.Backups.Dir == "" {
+		return backupConfig, nil
+	}
+
+	schedule, err := cron.Parse(config.Backups.Schedule)
+	if err != nil {
+		return backupConfig, fmt.Errorf("invalid backup schedule: %w", err)
+	}
+
+	backupConfig.Schedule = schedule
+
+	return backupConfig, nil
+}
+
+func oidcProviderName(config OIDCAuth) string {
+	if !config.Enabled {
+		return ""
+	}
+
+	return config.ProviderName
+}
+
+type fileStorage interface {
+	control.FileBlobs
+	http.Handler
+}
+
+func newFileStorage(config *Config) (fileStorage, error) {
+	switch config.FileStorage.Backend {
+	case "", "local":
+		return &blobs.LocalFS{
+			RootDir: config.FileDir,
+			TmpDir:  config.TmpDir,
+		}, nil
+	case "s3":
+		s3Config := config.FileStorage.S3
+		if s3Config.Endpoint == "" || s3Config.Bucket == "" {
+			return nil, errors.New("S3 file storage requires an endpoint and a bucket")
+		}
+
+		return &blobs.S3{
+			Endpoint:          s3Config.Endpoint,
+			Region:            s3Config.Region,
+			Bucket:            s3Config.Bucket,
+			AccessKeyID:       s3Config.AccessKeyID,
+			SecretAccessKey:   s3Config.SecretAccessKey,
+			Prefix:            s3Config.Prefix,
+			PathStyle:         s3Config.PathStyle,
+			PresignedRedirect: s3Config.PresignedRedirect,
+			PresignExpiry:     time.Duration(s3Config.PresignExpiry),
+			TmpDir:            config.TmpDir,
+			HTTPClient:        &http.Client{Timeout: 5 * time.Minute},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unknown file storage backend: %s", config.FileStorage.Backend)
+}
+
+func newNotifier(config Notifications) notify.Notifier {
+	var notifiers notify.Multi
+
+	if config.SMTP.Addr != "" {
+		notifiers = append(notifiers, notify.NewSMTP(notify.SMTPConfig{
+			Addr:     config.SMTP.Addr,
+			Username: config.SMTP.Username,
+			Password: config.SMTP.Password,
+			From:     config.SMTP.From,
+			To:       config.SMTP.To,
+		}))
+	}
+
+	if config.Webhook.URL != "" {
+		notifiers = append(notifiers, notify.NewWebhook(notify.WebhookConfig{URL: config.Webhook.URL}))
+	}
+
+	if len(notifiers) == 0 {
+		return notify.Nop{}
+	}
+
+	return notifiers
+}
@@ -0,0 +1,238 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/internal/backup"
+	"github.com/RobinThrift/stuff/storage/database/sqlite"
+	"github.com/stephenafamo/bob"
+)
+
+// Backup writes a consistent backup of the database and all stored files to a single archive.
+// It is safe to run while the server is running.
+func Backup(args []string) error {
+	flags := flag.NewFlagSet("backup", flag.ContinueOnError)
+	output := flags.String("o", "", "path of the backup archive, defaults to stuff_backup_<timestamp>.tar.gz in the current directory")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		*output = control.BackupFileName(time.Now())
+	}
+
+	config, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	ctx := auth.SystemCtx(context.Background())
+
+	db, database, err := openDatabase(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := db.Close(); err != nil {
+			slog.ErrorContext(ctx, "error closing database", "error", err)
+		}
+	}()
+
+	fileStorage, err := newFileStorage(config)
+	if err != nil {
+		return err
+	}
+
+	repos := newRepositories(database)
+	permissionCtrl := control.NewPermissionControl(database, repos.grants, repos.assets)
+	backupCtrl := control.NewBackupControl(control.BackupControlConfig{TmpDir: config.TmpDir}, database, permissionCtrl, repos.files, fileStorage)
+
+	fhandle, err := os.OpenFile(*output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := backupCtrl.Backup(ctx, fhandle)
+	if err != nil {
+		return errors.Join(err, fhandle.Close(), os.Remove(*output))
+	}
+
+	err = fhandle.Close()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("backed up database (migration version %d) and %d files to %s\n", manifest.MigrationVersion, len(manifest.Files), *output)
+
+	return nil
+}
+
+// Restore restores the database and the stored files from a backup archive created by Backup.
+// The archive is verified completely before anything is restored. The server must not be running.
+func Restore(args []string) error {
+	flags := flag.NewFlagSet("restore", flag.ContinueOnError)
+	force := flags.Bool("force", false, "overwrite an existing database")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return errors.New("usage: stuff restore [-force] <backup archive>")
+	}
+
+	config, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	if config.Database.Type == "postgres" {
+		return errors.New("restoring backups is only supported for SQLite databases, use pg_restore to restore PostgreSQL databases")
+	}
+
+	ctx := auth.SystemCtx(context.Background())
+
+	if _, err = os.Stat(config.Database.Path); err == nil && !*force {
+		return fmt.Errorf("database %s already exists, use -force to overwrite it", config.Database.Path)
+	}
+
+	tmpDir, err := os.MkdirTemp(config.TmpDir, "stuff_restore_*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	manifest, err := extractBackup(flags.Arg(0), tmpDir)
+	if err != nil {
+		return err
+	}
+
+	err = checkBackupMigrationVersion(ctx, filepath.Join(tmpDir, backup.DatabaseName), manifest)
+	if err != nil {
+		return err
+	}
+
+	err = restoreDatabaseFile(filepath.Join(tmpDir, backup.DatabaseName), config.Database.Path)
+	if err != nil {
+		return err
+	}
+
+	db, database, err := openDatabase(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := db.Close(); err != nil {
+			slog.ErrorContext(ctx, "error closing database", "error", err)
+		}
+	}()
+
+	fileStorage, err := newFileStorage(config)
+	if err != nil {
+		return err
+	}
+
+	repos := newRepositories(database)
+	permissionCtrl := control.NewPermissionControl(database, repos.grants, repos.assets)
+	backupCtrl := control.NewBackupControl(control.BackupControlConfig{TmpDir: config.TmpDir}, database, permissionCtrl, repos.files, fileStorage)
+
+	err = backupCtrl.RestoreFiles(ctx, tmpDir, manifest)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("restored database and %d files from backup created at %s\n", len(manifest.Files), manifest.CreatedAt.Format(time.RFC3339))
+
+	return nil
+}
+
+func extractBackup(archive string, dir string) (*backup.Manifest, error) {
+	fhandle, err := os.Open(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := backup.Extract(fhandle, dir)
+	if err != nil {
+		return nil, errors.Join(err, fhandle.Close())
+	}
+
+	return manifest, fhandle.Close()
+}
+
+// checkBackupMigrationVersion makes sure the database snapshot matches the manifest and isn't newer than the migrations known to this version.
+// Older snapshots are migrated when the database is opened.
+func checkBackupMigrationVersion(ctx context.Context, snapshotPath string, manifest *backup.Manifest) error {
+	latest, err := sqlite.LatestMigrationVersion()
+	if err != nil {
+		return err
+	}
+
+	if manifest.MigrationVersion > latest {
+		return fmt.Errorf("backup was created by a newer version of stuff (%s) with migration version %d, this version only supports up to %d", manifest.StuffVersion, manifest.MigrationVersion, latest)
+	}
+
+	snapshot, err := sqlite.NewSQLiteDB(&sqlite.Config{File: snapshotPath, Timeout: 500 * time.Millisecond})
+	if err != nil {
+		return err
+	}
+
+	version, err := sqlite.MigrationVersion(ctx, bob.NewDB(snapshot))
+	if err != nil {
+		return errors.Join(err, snapshot.Close())
+	}
+
+	err = snapshot.Close()
+	if err != nil {
+		return err
+	}
+
+	if version != manifest.MigrationVersion {
+		return fmt.Errorf("%w: database has migration version %d, but the manifest lists %d", backup.ErrInvalidBackup, version, manifest.MigrationVersion)
+	}
+
+	return nil
+}
+
+func restoreDatabaseFile(snapshotPath string, target string) error {
+	// stale WAL files of the old database would be applied to the restored one
+	for _, suffix := range []string{"-wal", "-shm"} {
+		err := os.Remove(target + suffix)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	src, err := os.Open(snapshotPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(dst, src)
+	if err != nil {
+		return errors.Join(fmt.Errorf("error restoring database to %s: %w", target, err), dst.Close())
+	}
+
+	return dst.Close()
+}
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/storage/database"
+)
+
+// commandEnv holds the database and the controllers shared by the CLI commands.
+// Commands run without a user session, so they must mark their context with auth.SystemCtx
+// to be allowed past the permission checks.
+type commandEnv struct {
+	config   *Config
+	db       *sql.DB
+	database *database.Database
+	repos    *repositories
+
+	perms  *control.PermissionControl
+	users  *control.UserControl
+	tags   *control.TagControl
+	files  *control.FileControl
+	assets *control.AssetControl
+
+	savedSearches *control.SavedSearchControl
+}
+
+func newCommandEnv(ctx context.Context) (*commandEnv, error) {
+	config, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	db, database, err := openDatabase(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	fileStorage, err := newFileStorage(config)
+	if err != nil {
+		return nil, errors.Join(err, db.Close())
+	}
+
+	repos := newRepositories(database)
+
+	permissionCtrl := control.NewPermissionControl(database, repos.grants, repos.assets)
+	userCtrl := control.NewUserCtrl(database, permissionCtrl, repos.users, repos.workspaces)
+	tagCtrl := control.NewTagControl(control.TagControlConfig{Algorithm: config.TagAlgorithm, SequentialPerWorkspace: config.TagSequentialPerWorkspace}, database, repos.tags)
+	fileCtrl := control.NewFileControl(database, permissionCtrl, repos.files, fileStorage)
+	assetCtrl := control.NewAssetControl(
+		database,
+		permissionCtrl,
+		tagCtrl,
+		fileCtrl,
+		repos.assets,
+		repos.photos,
+		repos.checkouts,
+		repos.assetEvents,
+	)
+	savedSearchCtrl := control.NewSavedSearchControl(database, permissionCtrl, repos.savedSearches)
+
+	return &commandEnv{
+		config:   config,
+		db:       db,
+		database: database,
+		repos:    repos,
+		perms:    permissionCtrl,
+		users:    userCtrl,
+		tags:     tagCtrl,
+		files:    fileCtrl,
+		assets:   assetCtrl,
+
+		savedSearches: savedSearchCtrl,
+	}, nil
+}
+
+func (env *commandEnv) close(ctx context.Context) {
+	if err := env.db.Close(); err != nil {
+		slog.ErrorContext(ctx, "error closing database", "error", err)
+	}
+}
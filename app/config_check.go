@@ -0,0 +1,56 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/invopop/yaml"
+)
+
+var errConfigUsage = errors.New("usage: stuff config check [-format yaml|json]")
+
+// ConfigCommand validates the configuration with `check` and prints the effective configuration, with all secrets redacted.
+// The output can be used as a config file.
+func ConfigCommand(args []string) error {
+	if len(args) == 0 || args[0] != "check" {
+		return errConfigUsage
+	}
+
+	flags := flag.NewFlagSet("config check", flag.ContinueOnError)
+	format := flags.String("format", "yaml", "output format, one of yaml or json")
+
+	err := flags.Parse(args[1:])
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 0 {
+		return errConfigUsage
+	}
+
+	config, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	var out []byte
+	switch *format {
+	case "yaml":
+		out, err = yaml.Marshal(config.redacted())
+	case "json":
+		out, err = json.MarshalIndent(config.redacted(), "", "  ")
+		out = append(out, '\n')
+	default:
+		return fmt.Errorf("unknown output format '%s'", *format)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(string(out))
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// loadEnv overrides the config with all environment variables that are set.
+func (c *Config) loadEnv() error {
+	env := &envLoader{}
+
+	env.string("STUFF_ADDRESS", &c.Addr)
+	env.string("STUFF_BASE_URL", &c.BaseURL)
+	env.bool("STUFF_USE_SECURE_COOKIES", &c.UseSecureCookies)
+
+	env.string("STUFF_DATABASE_TYPE", &c.Database.Type)
+	env.secret("STUFF_DATABASE_URL", &c.Database.URL)
+	env.string("STUFF_DATABASE_PATH", &c.Database.Path)
+	env.bool("STUFF_DATABASE_ENABLE_WAL", &c.Database.EnableWAL)
+	env.duration("STUFF_DATABASE_TIMEOUT", &c.Database.Timeout)
+
+	env.string("STUFF_FILE_DIR", &c.FileDir)
+	env.string("STUFF_FILE_STORAGE_BACKEND", &c.FileStorage.Backend)
+	env.string("STUFF_FILE_STORAGE_S3_ENDPOINT", &c.FileStorage.S3.Endpoint)
+	env.string("STUFF_FILE_STORAGE_S3_REGION", &c.FileStorage.S3.Region)
+	env.string("STUFF_FILE_STORAGE_S3_BUCKET", &c.FileStorage.S3.Bucket)
+	env.secret("STUFF_FILE_STORAGE_S3_ACCESS_KEY_ID", &c.FileStorage.S3.AccessKeyID)
+	env.secret("STUFF_FILE_STORAGE_S3_SECRET_ACCESS_KEY", &c.FileStorage.S3.SecretAccessKey)
+	env.string("STUFF_FILE_STORAGE_S3_PREFIX", &c.FileStorage.S3.Prefix)
+	env.bool("STUFF_FILE_STORAGE_S3_PATH_STYLE", &c.FileStorage.S3.PathStyle)
+	env.bool("STUFF_FILE_STORAGE_S3_PRESIGNED_REDIRECT", &c.FileStorage.S3.PresignedRedirect)
+	env.duration("STUFF_FILE_STORAGE_S3_PRESIGN_EXPIRY", &c.FileStorage.S3.PresignExpiry)
+	env.string("STUFF_TMP_DIR", &c.TmpDir)
+
+	env.string("STUFF_TAG_ALGORITHM", &c.TagAlgorithm)
+	env.bool("STUFF_TAG_SEQUENTIAL_PER_WORKSPACE", &c.TagSequentialPerWorkspace)
+
+	env.string("STUFF_DEFAULT_CURRENCY", &c.DefaultCurrency)
+	env.string("STUFF_DECIMAL_SEPARATOR", &c.DecimalSeparator)
+
+	env.secret("STUFF_AUTH_LOCAL_INITIAL_ADMIN_PASSWORD", &c.Auth.Local.InitialAdminPassword)
+	env.uint32("STUFF_AUTH_LOCAL_ARGON2_KEYLEN", &c.Auth.Local.Argon2Params.KeyLen)
+	env.uint32("STUFF_AUTH_LOCAL_ARGON2_MEMORY", &c.Auth.Local.Argon2Params.Memory)
+	env.uint8("STUFF_AUTH_LOCAL_ARGON2_THREADS", &c.Auth.Local.Argon2Params.Threads)
+	env.uint32("STUFF_AUTH_LOCAL_ARGON2_TIME", &c.Auth.Local.Argon2Params.Time)
+
+	env.bool("STUFF_AUTH_OIDC_ENABLED", &c.Auth.OIDC.Enabled)
+	env.string("STUFF_AUTH_OIDC_PROVIDER_NAME", &c.Auth.OIDC.ProviderName)
+	env.string("STUFF_AUTH_OIDC_ISSUER_URL", &c.Auth.OIDC.IssuerURL)
+	env.string("STUFF_AUTH_OIDC_CLIENT_ID", &c.Auth.OIDC.ClientID)
+	env.secret("STUFF_AUTH_OIDC_CLIENT_SECRET", &c.Auth.OIDC.ClientSecret)
+	env.list("STUFF_AUTH_OIDC_SCOPES", &c.Auth.OIDC.Scopes)
+	env.bool("STUFF_AUTH_OIDC_AUTO_PROVISION", &c.Auth.OIDC.AutoProvision)
+	env.string("STUFF_AUTH_OIDC_USERNAME_CLAIM", &c.Auth.OIDC.UsernameClaim)
+	env.string("STUFF_AUTH_OIDC_DISPLAY_NAME_CLAIM", &c.Auth.OIDC.DisplayNameClaim)
+	env.string("STUFF_AUTH_OIDC_GROUPS_CLAIM", &c.Auth.OIDC.GroupsClaim)
+	env.string("STUFF_AUTH_OIDC_ADMIN_GROUP", &c.Auth.OIDC.AdminGroup)
+	env.string("STUFF_AUTH_OIDC_DEFAULT_ROLE", &c.Auth.OIDC.DefaultRole)
+
+	env.duration("STUFF_JOBS_OVERDUE_CHECK_INTERVAL", &c.Jobs.OverdueCheckInterval)
+	env.duration("STUFF_JOBS_OVERDUE_REMINDER_INTERVAL", &c.Jobs.OverdueReminderInterval)
+	env.duration("STUFF_JOBS_TRASH_PURGE_INTERVAL", &c.Jobs.TrashPurgeInterval)
+	env.duration("STUFF_JOBS_TRASH_RETENTION", &c.Jobs.TrashRetention)
+
+	env.string("STUFF_BACKUPS_DIR", &c.Backups.Dir)
+	env.string("STUFF_BACKUPS_SCHEDULE", &c.Backups.Schedule)
+	env.int("STUFF_BACKUPS_KEEP_DAILY", &c.Backups.KeepDaily)
+	env.int("STUFF_BACKUPS_KEEP_WEEKLY", &c.Backups.KeepWeekly)
+	env.int("STUFF_BACKUPS_KEEP_MONTHLY", &c.Backups.KeepMonthly)
+
+	env.string("STUFF_NOTIFICATIONS_SMTP_ADDR", &c.Notifications.SMTP.Addr)
+	env.string("STUFF_NOTIFICATIONS_SMTP_USERNAME", &c.Notifications.SMTP.Username)
+	env.secret("STUFF_NOTIFICATIONS_SMTP_PASSWORD", &c.Notifications.SMTP.Password)
+	env.string("STUFF_NOTIFICATIONS_SMTP_FROM", &c.Notifications.SMTP.From)
+	env.list("STUFF_NOTIFICATIONS_SMTP_TO", &c.Notifications.SMTP.To)
+	env.secret("STUFF_NOTIFICATIONS_WEBHOOK_URL", &c.Notifications.Webhook.URL)
+
+	env.string("STUFF_LOG_LEVEL", &c.LogLevel)
+	env.string("STUFF_LOG_FORMAT", &c.LogFormat)
+
+	if len(env.errs) != 0 {
+		return fmt.Errorf("invalid environment variables:\n%w", errors.Join(env.errs...))
+	}
+
+	return nil
+}
+
+// envLoader sets config values from environment variables that are set and collects all invalid values.
+type envLoader struct {
+	errs []error
+}
+
+func (l *envLoader) invalid(key string, v string, format string, args ...any) {
+	l.errs = append(l.errs, fmt.Errorf("invalid value %q for %s: %s", v, key, fmt.Sprintf(format, args...)))
+}
+
+func (l *envLoader) string(key string, dest *string) {
+	if v, ok := os.LookupEnv(key); ok {
+		*dest = v
+	}
+}
+
+// secret is like string, but alternatively reads the value from the file set in <key>_FILE, e.g. a Docker or Kubernetes secret.
+func (l *envLoader) secret(key string, dest *string) {
+	file, ok := os.LookupEnv(key + "_FILE")
+	if !ok {
+		l.string(key, dest)
+		return
+	}
+
+	if _, ok := os.LookupEnv(key); ok {
+		l.errs = append(l.errs, fmt.Errorf("only one of %s and %s_FILE can be set", key, key))
+		return
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		l.errs = append(l.errs, fmt.Errorf("error reading %s_FILE: %w", key, err))
+		return
+	}
+
+	*dest = strings.TrimRight(string(data), "\r\n")
+}
+
+func (l *envLoader) bool(key string, dest *bool) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		l.invalid(key, v, "must be true or false")
+		return
+	}
+
+	*dest = b
+}
+
+func (l *envLoader) int(key string, dest *int) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		l.invalid(key, v, "must be a whole number")
+		return
+	}
+
+	*dest = i
+}
+
+func (l *envLoader) uint8(key string, dest *uint8) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+
+	i, err := strconv.ParseUint(v, 10, 8)
+	if err != nil {
+		l.invalid(key, v, "must be a whole number between 0 and 255")
+		return
+	}
+
+	*dest = uint8(i)
+}
+
+func (l *envLoader) uint32(key string, dest *uint32) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+
+	i, err := strconv.ParseUint(v, 10, 32)
+	if err != nil {
+		l.invalid(key, v, "must be a positive whole number")
+		return
+	}
+
+	*dest = uint32(i)
+}
+
+func (l *envLoader) list(key string, dest *[]string) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+
+	list := strings.Split(v, ",")
+	for i := range list {
+		list[i] = strings.TrimSpace(list[i])
+	}
+
+	*dest = list
+}
+
+func (l *envLoader) duration(key string, dest *Duration) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		l.invalid(key, v, "must be a duration like 1h30m or 500ms")
+		return
+	}
+
+	*dest = Duration(d)
+}
@@ -0,0 +1,145 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig_Defaults(t *testing.T) {
+	config, err := LoadConfig()
+	require.NoError(t, err)
+
+	assert.Equal(t, "http://localhost:8080", config.BaseURL)
+	assert.Equal(t, "sqlite", config.Database.Type)
+	assert.Equal(t, Duration(500*time.Millisecond), config.Database.Timeout)
+	assert.True(t, config.UseSecureCookies)
+}
+
+func TestLoadConfig_FileAndEnv(t *testing.T) {
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "config.yaml")
+	err := os.WriteFile(file, []byte(`
+address: ":9000"
+database:
+  path: /var/lib/stuff/stuff.db
+  timeout: 2s
+auth:
+  oidc:
+    scopes: [profile]
+jobs:
+  trashRetention: 72h
+  overdueCheckInterval: 0s
+`), 0600)
+	require.NoError(t, err)
+
+	secretFile := filepath.Join(dir, "admin_password")
+	err = os.WriteFile(secretFile, []byte("s3cr3t\n"), 0600)
+	require.NoError(t, err)
+
+	webhookFile := filepath.Join(dir, "webhook_url")
+	err = os.WriteFile(webhookFile, []byte("https://hooks.example.com/stuff?token=s3cr3t\n"), 0600)
+	require.NoError(t, err)
+
+	t.Setenv("STUFF_CONFIG_FILE", file)
+	t.Setenv("STUFF_DATABASE_TIMEOUT", "1s")
+	t.Setenv("STUFF_AUTH_LOCAL_INITIAL_ADMIN_PASSWORD_FILE", secretFile)
+	t.Setenv("STUFF_NOTIFICATIONS_WEBHOOK_URL_FILE", webhookFile)
+
+	config, err := LoadConfig()
+	require.NoError(t, err)
+
+	assert.Equal(t, ":9000", config.Addr)
+	assert.Equal(t, "http://localhost:9000", config.BaseURL)
+	assert.Equal(t, "/var/lib/stuff/stuff.db", config.Database.Path)
+	assert.Equal(t, Duration(time.Second), config.Database.Timeout)
+	assert.Equal(t, []string{"profile"}, config.Auth.OIDC.Scopes)
+	assert.Equal(t, Duration(72*time.Hour), config.Jobs.TrashRetention)
+	assert.Equal(t, Duration(0), config.Jobs.OverdueCheckInterval, "0 disables the overdue check")
+	assert.Equal(t, "https://hooks.example.com/stuff?token=s3cr3t", config.Notifications.Webhook.URL)
+	assert.Equal(t, "s3cr3t", config.Auth.Local.InitialAdminPassword)
+	assert.Equal(t, "preferred_username", config.Auth.OIDC.UsernameClaim, "keys missing from the file must keep their defaults")
+
+	assert.Equal(t, "<redacted>", config.redacted().Auth.Local.InitialAdminPassword)
+	assert.Equal(t, "<redacted>", config.redacted().Notifications.Webhook.URL)
+	assert.Equal(t, "s3cr3t", config.Auth.Local.InitialAdminPassword)
+}
+
+func TestLoadConfig_Invalid(t *testing.T) {
+	tt := []struct {
+		name string
+		env  map[string]string
+		file string
+		err  string
+	}{
+		{
+			name: "Invalid Duration",
+			env:  map[string]string{"STUFF_DATABASE_TIMEOUT": "soon"},
+			err:  `invalid value "soon" for STUFF_DATABASE_TIMEOUT`,
+		},
+		{
+			name: "Invalid Bool",
+			env:  map[string]string{"STUFF_USE_SECURE_COOKIES": "yes"},
+			err:  `invalid value "yes" for STUFF_USE_SECURE_COOKIES`,
+		},
+		{
+			name: "Secret and Secret File",
+			env:  map[string]string{"STUFF_AUTH_OIDC_CLIENT_SECRET": "a", "STUFF_AUTH_OIDC_CLIENT_SECRET_FILE": "b"},
+			err:  "only one of STUFF_AUTH_OIDC_CLIENT_SECRET and STUFF_AUTH_OIDC_CLIENT_SECRET_FILE can be set",
+		},
+		{
+			name: "Unknown Database Type",
+			env:  map[string]string{"STUFF_DATABASE_TYPE": "mysql"},
+			err:  "invalid database.type",
+		},
+		{
+			name: "Postgres Without URL",
+			env:  map[string]string{"STUFF_DATABASE_TYPE": "postgres"},
+			err:  "invalid database.url",
+		},
+		{
+			name: "Invalid Backup Schedule",
+			env:  map[string]string{"STUFF_BACKUPS_DIR": "/backups", "STUFF_BACKUPS_SCHEDULE": "daily"},
+			err:  "invalid backups.schedule",
+		},
+		{
+			name: "Negative Overdue Check Interval",
+			env:  map[string]string{"STUFF_JOBS_OVERDUE_CHECK_INTERVAL": "-1h"},
+			err:  "invalid jobs.overdueCheckInterval",
+		},
+		{
+			name: "Unknown Key in File",
+			file: `{"databse": {"path": "stuff.db"}}`,
+			err:  `unknown field "databse"`,
+		},
+		{
+			name: "Invalid Duration in File",
+			file: `{"jobs": {"trashRetention": "forever"}}`,
+			err:  `invalid duration "forever"`,
+		},
+	}
+
+	for _, tt := range tt {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			if tt.file != "" {
+				file := filepath.Join(t.TempDir(), "config.json")
+				err := os.WriteFile(file, []byte(tt.file), 0600)
+				require.NoError(t, err)
+				t.Setenv("STUFF_CONFIG_FILE", file)
+			}
+
+			_, err := LoadConfig()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
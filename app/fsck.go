@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/entities"
+)
+
+var errFsckProblemsFound = errors.New("storage check found problems")
+
+// Fsck checks the stored files for consistency with the database and prints a report.
+// With `-repair` orphaned files are deleted and files with missing or corrupted contents are marked as broken.
+func Fsck(args []string) error {
+	flags := flag.NewFlagSet("fsck", flag.ContinueOnError)
+	repair := flags.Bool("repair", false, "delete orphaned files and mark files with missing or corrupted contents as broken")
+	verifyChecksums := flags.Bool("checksums", true, "verify the checksum of every stored file")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	config, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	ctx := auth.SystemCtx(context.Background())
+
+	db, database, err := openDatabase(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := db.Close(); err != nil {
+			slog.ErrorContext(ctx, "error closing database", "error", err)
+		}
+	}()
+
+	fileStorage, err := newFileStorage(config)
+	if err != nil {
+		return err
+	}
+
+	repos := newRepositories(database)
+	permissionCtrl := control.NewPermissionControl(database, repos.grants, repos.assets)
+	fileCtrl := control.NewFileControl(database, permissionCtrl, repos.files, fileStorage)
+
+	report, err := fileCtrl.Check(ctx, control.CheckFilesCmd{
+		VerifyChecksums: *verifyChecksums,
+		Repair:          *repair,
+	})
+	if err != nil {
+		return err
+	}
+
+	printFsckReport(os.Stdout, report)
+
+	if !report.OK() && !report.Repaired {
+		return errFsckProblemsFound
+	}
+
+	return nil
+}
+
+func printFsckReport(w io.Writer, report *entities.FileCheckReport) {
+	fmt.Fprintf(w, "checked %d files and %d stored blobs in %s\n", report.NumFiles, report.NumBlobs, report.FinishedAt.Sub(report.StartedAt))
+
+	for _, file := range report.Orphans {
+		fmt.Fprintf(w, "orphaned: %s\n", file.FullPath)
+	}
+
+	for _, file := range report.Missing {
+		fmt.Fprintf(w, "missing: file %d (%s) of asset %d: %s\n", file.ID, file.Name, file.AssetID, file.FullPath)
+	}
+
+	for _, file := range report.Mismatched {
+		fmt.Fprintf(w, "checksum mismatch: file %d (%s) of asset %d: %s\n", file.ID, file.Name, file.AssetID, file.FullPath)
+	}
+
+	if !report.ChecksumsVerified {
+		fmt.Fprintln(w, "checksums were not verified")
+	}
+
+	switch {
+	case report.OK():
+		fmt.Fprintln(w, "no problems found")
+	case report.Repaired:
+		fmt.Fprintf(w, "deleted %d orphaned blobs, marked %d files as broken\n", len(report.Orphans), len(report.Missing)+len(report.Mismatched))
+	default:
+		fmt.Fprintf(w, "found %d problems, run with -repair to fix them\n", len(report.Orphans)+len(report.Missing)+len(report.Mismatched))
+	}
+}
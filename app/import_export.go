@@ -0,0 +1,113 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/control"
+)
+
+// Import imports assets from a Snipe-IT JSON export file or directly from the Snipe-IT API.
+// The imported assets are attributed to the user given with `-user`.
+func Import(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	format := flags.String("format", "snipeit_json_export", "format of the import, one of snipeit_json_export or snipeit_api")
+	username := flags.String("user", "admin", "username of the user the assets are created by")
+	ignoreDuplicates := flags.Bool("ignore-duplicates", false, "import assets even if an asset with the same tag already exists")
+	snipeITURL := flags.String("snipeit-url", "", "URL of the Snipe-IT instance, when using the snipeit_api format")
+	snipeITAPIKey := flags.String("snipeit-api-key", os.Getenv("STUFF_SNIPEIT_API_KEY"), "Snipe-IT API key, when using the snipeit_api format, defaults to $STUFF_SNIPEIT_API_KEY")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	var input io.Reader
+	switch {
+	case *format == "snipeit_api" && flags.NArg() == 0:
+	case *format != "snipeit_api" && flags.NArg() == 1:
+		fhandle, err := os.Open(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer fhandle.Close()
+		input = fhandle
+	default:
+		return errors.New("usage: stuff import [-format <format>] [-user <username>] [-ignore-duplicates] <file>")
+	}
+
+	ctx := auth.SystemCtx(context.Background())
+
+	env, err := newCommandEnv(ctx)
+	if err != nil {
+		return err
+	}
+	defer env.close(ctx)
+
+	user, err := env.users.GetByUsername(ctx, *username)
+	if err != nil {
+		return err
+	}
+
+	importerCtrl := control.NewImporterCtrl(control.ImporterCtrlConfig{DefaultCurrency: env.config.DefaultCurrency}, env.database, env.assets, env.tags)
+
+	imported, err := importerCtrl.ImportFrom(ctx, input, control.ImportCmd{
+		ImportUserID:     user.ID,
+		IgnoreDuplicates: *ignoreDuplicates,
+		Format:           *format,
+		SnipeITURL:       *snipeITURL,
+		SnipeITAPIKey:    *snipeITAPIKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("imported %d assets\n", imported)
+
+	return nil
+}
+
+// Export writes all assets, or those matching the saved search given with `-saved-search`, as JSON or CSV
+// to stdout or the file given with `-o`.
+func Export(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := flags.String("format", "json", "format of the export, one of json or csv")
+	output := flags.String("o", "", "path of the export file, defaults to stdout")
+	savedSearch := flags.Int64("saved-search", 0, "ID of a saved search selecting the assets to export")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	ctx := auth.SystemCtx(context.Background())
+
+	env, err := newCommandEnv(ctx)
+	if err != nil {
+		return err
+	}
+	defer env.close(ctx)
+
+	exporterCtrl := control.NewExporterCtrl(env.database, env.assets, env.files, env.savedSearches)
+
+	if *output == "" {
+		return exporterCtrl.Export(ctx, os.Stdout, control.ExportCmd{Format: *format, SavedSearchID: *savedSearch})
+	}
+
+	fhandle, err := os.OpenFile(*output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	err = exporterCtrl.Export(ctx, fhandle, control.ExportCmd{Format: *format, SavedSearchID: *savedSearch})
+	if err != nil {
+		return errors.Join(err, fhandle.Close(), os.Remove(*output))
+	}
+
+	return fhandle.Close()
+}
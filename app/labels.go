@@ -0,0 +1,118 @@
+package app
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/entities"
+)
+
+// labelSheetTemplates mirror the templates of the label sheet creator in the web UI.
+var labelSheetTemplates = map[string]entities.Sheet{
+	"Avery L78710-20": {
+		PageSize: entities.PageSizeA4,
+		PageLayout: entities.PageLayout{
+			Cols:         7,
+			Rows:         27,
+			MarginLeft:   8.5,
+			MarginTop:    13.3,
+			MarginRight:  8.5,
+			MarginBottom: 13.0,
+		},
+		LabelSize: entities.LabelSize{
+			FontSize:          4,
+			Height:            10,
+			Width:             25.4,
+			VerticalPadding:   1,
+			HorizontalPadding: 1,
+			VerticalSpacing:   0,
+			HorizontalSpacing: 2.5,
+		},
+	},
+}
+
+// Labels generates a PDF label sheet for the assets with the given tags, or for all assets if no tags are given.
+// With `-saved-search` only the assets matching the saved search are included.
+func Labels(args []string) error {
+	flags := flag.NewFlagSet("labels", flag.ContinueOnError)
+	output := flags.String("o", "labels.pdf", "path of the generated PDF")
+	template := flags.String("template", "Avery L78710-20", "label sheet template, one of "+strings.Join(labelSheetTemplateNames(), ", "))
+	skip := flags.Int("skip", 0, "number of labels to skip at the start of the sheet, e.g. when reusing a partially used sheet")
+	borders := flags.Bool("borders", false, "print a border around each label")
+	savedSearch := flags.Int64("saved-search", 0, "ID of a saved search selecting the assets")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	sheet, ok := labelSheetTemplates[*template]
+	if !ok {
+		return fmt.Errorf("unknown label sheet template '%s'", *template)
+	}
+
+	sheet.SkipNumLabels = *skip
+	sheet.PrintBorders = *borders
+
+	ctx := auth.SystemCtx(context.Background())
+
+	env, err := newCommandEnv(ctx)
+	if err != nil {
+		return err
+	}
+	defer env.close(ctx)
+
+	baseURL, err := url.Parse(env.config.BaseURL)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]int64, 0, flags.NArg())
+	for _, tag := range flags.Args() {
+		asset, err := env.assets.Get(ctx, control.GetAssetQuery{Tag: tag})
+		if err != nil {
+			return fmt.Errorf("error finding asset with tag '%s': %w", tag, err)
+		}
+
+		ids = append(ids, asset.ID)
+	}
+
+	labelsCtrl := control.NewLabelController(env.assets, env.savedSearches)
+
+	pdf, err := labelsCtrl.GenerateLabelSheet(ctx, control.GenerateLabelSheetQuery{
+		BaseURL:       baseURL,
+		IDs:           ids,
+		SavedSearchID: *savedSearch,
+		Sheet:         &sheet,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(*output, pdf, 0600)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("generated %d labels in %s\n", len(sheet.Labels), *output)
+
+	return nil
+}
+
+func labelSheetTemplateNames() []string {
+	names := make([]string, 0, len(labelSheetTemplates))
+	for name := range labelSheetTemplates {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"path"
+	"time"
+
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/postgres"
+	"github.com/RobinThrift/stuff/storage/database/sqlite"
+	"github.com/pressly/goose/v3"
+)
+
+var errMigrateUsage = errors.New("usage: stuff migrate up|down|status")
+
+// Migrate applies all pending migrations (`up`), reverts the last applied migration (`down`)
+// or lists all migrations and whether they have been applied (`status`).
+// The server applies pending migrations on start, so `down` should only be used while the server is stopped.
+func Migrate(args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return errMigrateUsage
+	}
+
+	config, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	db, database, err := connectDatabase(config)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := db.Close(); err != nil {
+			slog.ErrorContext(ctx, "error closing database", "error", err)
+		}
+	}()
+
+	switch flags.Arg(0) {
+	case "up":
+		return runMigrations(ctx, db, database.Dialect)
+	case "down":
+		result, err := rollbackMigration(ctx, db, database.Dialect)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("rolled back migration %s\n", path.Base(result.Source.Path))
+		return nil
+	case "status":
+		status, err := migrationStatus(ctx, db, database.Dialect)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range status {
+			appliedAt := "pending"
+			if s.State == goose.StateApplied {
+				appliedAt = s.AppliedAt.Format(time.RFC3339)
+			}
+
+			fmt.Printf("%-25s %s\n", appliedAt, path.Base(s.Source.Path))
+		}
+
+		return nil
+	default:
+		return errMigrateUsage
+	}
+}
+
+func runMigrations(ctx context.Context, db *sql.DB, dialect database.Dialect) error {
+	if dialect == database.DialectPostgres {
+		return postgres.RunMigrations(ctx, db)
+	}
+
+	return sqlite.RunMigrations(ctx, db)
+}
+
+func rollbackMigration(ctx context.Context, db *sql.DB, dialect database.Dialect) (*goose.MigrationResult, error) {
+	if dialect == database.DialectPostgres {
+		return postgres.RollbackMigration(ctx, db)
+	}
+
+	return sqlite.RollbackMigration(ctx, db)
+}
+
+func migrationStatus(ctx context.Context, db *sql.DB, dialect database.Dialect) ([]*goose.MigrationStatus, error) {
+	if dialect == database.DialectPostgres {
+		return postgres.MigrationStatus(ctx, db)
+	}
+
+	return sqlite.MigrationStatus(ctx, db)
+}
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/postgres"
+	"github.com/RobinThrift/stuff/storage/database/sqlite"
+	"github.com/alexedwards/scs/v2"
+)
+
+type repositories struct {
+	grants        control.GrantRepo
+	assets        control.AssetRepo
+	workspaces    control.WorkspaceRepo
+	users         control.UserRepo
+	localAuth     control.LocalAuthRepo
+	apiTokens     control.APITokenRepo
+	tags          control.TagRepo
+	files         control.FileRepo
+	photos        control.PhotoRepo
+	checkouts     control.CheckoutRepo
+	assetEvents   control.AssetEventRepo
+	categories    control.CategoryRepo
+	locations     control.LocationRepo
+	models        control.ModelRepo
+	manufacturers control.ManufactuerRepo
+	suppliers     control.SupplierRepo
+	customAttrs   control.CustomAttrRepo
+	savedSearches control.SavedSearchRepo
+}
+
+func newRepositories(db *database.Database) *repositories {
+	if db.Dialect == database.DialectPostgres {
+		return &repositories{
+			grants:        &postgres.GrantRepo{},
+			assets:        &postgres.AssetRepo{},
+			workspaces:    &postgres.WorkspaceRepo{},
+			users:         &postgres.UserRepo{},
+			localAuth:     &postgres.LocalAuthRepo{},
+			apiTokens:     &postgres.APITokenRepo{},
+			tags:          &postgres.TagRepo{},
+			files:         &postgres.FileRepo{},
+			photos:        &postgres.PhotoRepo{},
+			checkouts:     &postgres.CheckoutRepo{},
+			assetEvents:   &postgres.AssetEventRepo{},
+			categories:    &postgres.CategoryRepo{},
+			locations:     &postgres.LocationRepo{},
+			models:        &postgres.ModelRepo{},
+			manufacturers: &postgres.ManufacturerRepo{},
+			suppliers:     &postgres.SupplierRepo{},
+			customAttrs:   &postgres.CustomAttrRepo{},
+			savedSearches: &postgres.SavedSearchRepo{},
+		}
+	}
+
+	return &repositories{
+		grants:        &sqlite.GrantRepo{},
+		assets:        &sqlite.AssetRepo{},
+		workspaces:    &sqlite.WorkspaceRepo{},
+		users:         &sqlite.UserRepo{},
+		localAuth:     &sqlite.LocalAuthRepo{},
+		apiTokens:     &sqlite.APITokenRepo{},
+		tags:          &sqlite.TagRepo{},
+		files:         &sqlite.FileRepo{},
+		photos:        &sqlite.PhotoRepo{},
+		checkouts:     &sqlite.CheckoutRepo{},
+		assetEvents:   &sqlite.AssetEventRepo{},
+		categories:    &sqlite.CategoryRepo{},
+		locations:     &sqlite.LocationRepo{},
+		models:        &sqlite.ModelRepo{},
+		manufacturers: &sqlite.ManufacturerRepo{},
+		suppliers:     &sqlite.SupplierRepo{},
+		customAttrs:   &sqlite.CustomAttrRepo{},
+		savedSearches: &sqlite.SavedSearchRepo{},
+	}
+}
+
+func newSessionStore(db *database.Database) scs.Store {
+	if db.Dialect == database.DialectPostgres {
+		return postgres.NewPostgresSessionStore(db)
+	}
+
+	return sqlite.NewSQLiteSessionStore(db)
+}
@@ -0,0 +1,185 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/control"
+)
+
+var errUserUsage = errors.New("usage: stuff user create|reset-password|promote [flags] <username>")
+
+// User manages local users. New passwords are read from the first line of stdin, so they don't show up in the shell history.
+// Users are asked to change the password on their next login.
+func User(args []string) error {
+	if len(args) == 0 {
+		return errUserUsage
+	}
+
+	switch args[0] {
+	case "create":
+		return createUser(args[1:])
+	case "reset-password":
+		return resetUserPassword(args[1:])
+	case "promote":
+		return promoteUser(args[1:])
+	default:
+		return errUserUsage
+	}
+}
+
+func createUser(args []string) error {
+	flags := flag.NewFlagSet("user create", flag.ContinueOnError)
+	displayName := flags.String("display-name", "", "display name of the user, defaults to the username")
+	role := flags.String("role", string(auth.RoleEditor), "role of the user, one of admin, editor or viewer")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return errors.New("usage: stuff user create [-display-name <name>] [-role <role>] <username>")
+	}
+
+	username := flags.Arg(0)
+	if *displayName == "" {
+		*displayName = username
+	}
+
+	password, err := readPassword(os.Stdin)
+	if err != nil {
+		return err
+	}
+
+	ctx := auth.SystemCtx(context.Background())
+
+	env, err := newCommandEnv(ctx)
+	if err != nil {
+		return err
+	}
+	defer env.close(ctx)
+
+	authCtrl := control.NewAuthController(newAuthConfig(env.config), env.database, env.perms, env.users, env.repos.localAuth)
+
+	err = authCtrl.CreateUser(ctx, control.CreateUserCmd{
+		User: &auth.User{
+			Username:    username,
+			DisplayName: *displayName,
+			Role:        auth.Role(*role),
+		},
+		PlaintextPasswd: password,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("created user %s with role %s\n", username, *role)
+
+	return nil
+}
+
+func resetUserPassword(args []string) error {
+	flags := flag.NewFlagSet("user reset-password", flag.ContinueOnError)
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return errors.New("usage: stuff user reset-password <username>")
+	}
+
+	password, err := readPassword(os.Stdin)
+	if err != nil {
+		return err
+	}
+
+	ctx := auth.SystemCtx(context.Background())
+
+	env, err := newCommandEnv(ctx)
+	if err != nil {
+		return err
+	}
+	defer env.close(ctx)
+
+	user, err := env.users.GetByUsername(ctx, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if user.IsOIDCUser() {
+		return fmt.Errorf("user %s logs in using OIDC and has no local password", user.Username)
+	}
+
+	authCtrl := control.NewAuthController(newAuthConfig(env.config), env.database, env.perms, env.users, env.repos.localAuth)
+
+	err = authCtrl.ResetPassword(ctx, control.ResetPasswordCmd{UserID: user.ID, PlaintextPasswd: password})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("reset password of user %s\n", user.Username)
+
+	return nil
+}
+
+func promoteUser(args []string) error {
+	flags := flag.NewFlagSet("user promote", flag.ContinueOnError)
+	role := flags.String("role", string(auth.RoleAdmin), "new role of the user, one of admin, editor or viewer")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return errors.New("usage: stuff user promote [-role <role>] <username>")
+	}
+
+	ctx := auth.SystemCtx(context.Background())
+
+	env, err := newCommandEnv(ctx)
+	if err != nil {
+		return err
+	}
+	defer env.close(ctx)
+
+	user, err := env.users.GetByUsername(ctx, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	authCtrl := control.NewAuthController(newAuthConfig(env.config), env.database, env.perms, env.users, env.repos.localAuth)
+
+	err = authCtrl.SetUserRole(ctx, control.SetUserRoleCmd{UserID: user.ID, Role: auth.Role(*role)})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("changed role of user %s to %s\n", user.Username, *role)
+
+	return nil
+}
+
+func readPassword(r io.Reader) (string, error) {
+	password, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	password = strings.TrimRight(password, "\r\n")
+	if password == "" {
+		return "", control.ErrPasswordEmpty
+	}
+
+	return password, nil
+}
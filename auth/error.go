@@ -5,3 +5,4 @@ import (
 )
 
 var ErrUnauthorized = errors.New("unauthorized")
+var ErrForbidden = errors.New("forbidden")
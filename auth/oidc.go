@@ -0,0 +1,244 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// OIDCAuthRefPrefix is prepended to the subject of OIDC users to build their auth ref,
+// so they can never collide with local users, whose auth ref is their username.
+const OIDCAuthRefPrefix = "oidc:"
+
+var ErrOIDCInvalidState = errors.New("invalid OIDC login state")
+var ErrOIDCInvalidIDToken = errors.New("invalid OIDC ID token")
+
+type OIDCConfig struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+
+	UsernameClaim    string
+	DisplayNameClaim string
+	GroupsClaim      string
+}
+
+// OIDCLoginState must be kept between starting the login and handling the callback, e.g. in the session.
+type OIDCLoginState struct {
+	State        string
+	Nonce        string
+	CodeVerifier string
+}
+
+// OIDCIdentity is the user information extracted from a verified ID token.
+type OIDCIdentity struct {
+	Subject     string
+	Username    string
+	DisplayName string
+	Groups      []string
+}
+
+func (i *OIDCIdentity) AuthRef() string {
+	return OIDCAuthRefPrefix + i.Subject
+}
+
+func (i *OIDCIdentity) InGroup(group string) bool {
+	for _, g := range i.Groups {
+		if g == group {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OIDCProvider implements the authorization code flow with PKCE against an OpenID Connect issuer.
+// The issuer's discovery document is only fetched when it is first needed,
+// so Stuff can start even if the issuer is temporarily unavailable.
+type OIDCProvider struct {
+	config OIDCConfig
+
+	mu       sync.Mutex
+	oauth2   *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+func NewOIDCProvider(config OIDCConfig) *OIDCProvider {
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"profile", "email"}
+	}
+
+	if config.UsernameClaim == "" {
+		config.UsernameClaim = "preferred_username"
+	}
+
+	if config.DisplayNameClaim == "" {
+		config.DisplayNameClaim = "name"
+	}
+
+	if config.GroupsClaim == "" {
+		config.GroupsClaim = "groups"
+	}
+
+	return &OIDCProvider{config: config}
+}
+
+// BeginLogin returns the URL the user needs to be redirected to, together with the state needed to finish the login.
+func (p *OIDCProvider) BeginLogin(ctx context.Context) (*OIDCLoginState, string, error) {
+	config, _, err := p.init(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	state, err := randomString()
+	if err != nil {
+		return nil, "", err
+	}
+
+	nonce, err := randomString()
+	if err != nil {
+		return nil, "", err
+	}
+
+	loginState := &OIDCLoginState{
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: oauth2.GenerateVerifier(),
+	}
+
+	url := config.AuthCodeURL(
+		loginState.State,
+		oidc.Nonce(loginState.Nonce),
+		oauth2.S256ChallengeOption(loginState.CodeVerifier),
+	)
+
+	return loginState, url, nil
+}
+
+// FinishLogin exchanges the authorization code for an ID token and verifies it.
+func (p *OIDCProvider) FinishLogin(ctx context.Context, loginState *OIDCLoginState, state string, code string) (*OIDCIdentity, error) {
+	if loginState == nil || state == "" || loginState.State != state {
+		return nil, ErrOIDCInvalidState
+	}
+
+	config, verifier, err := p.init(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := config.Exchange(ctx, code, oauth2.VerifierOption(loginState.CodeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("error exchanging OIDC authorization code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: token response is missing the id_token", ErrOIDCInvalidIDToken)
+	}
+
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOIDCInvalidIDToken, err)
+	}
+
+	if idToken.Nonce != loginState.Nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrOIDCInvalidIDToken)
+	}
+
+	claims := map[string]any{}
+	err = idToken.Claims(&claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOIDCInvalidIDToken, err)
+	}
+
+	identity := &OIDCIdentity{
+		Subject:     idToken.Subject,
+		Username:    stringClaim(claims, p.config.UsernameClaim),
+		DisplayName: stringClaim(claims, p.config.DisplayNameClaim),
+		Groups:      listClaim(claims, p.config.GroupsClaim),
+	}
+
+	if identity.Username == "" {
+		identity.Username = stringClaim(claims, "email")
+	}
+
+	if identity.Username == "" {
+		identity.Username = identity.Subject
+	}
+
+	if identity.DisplayName == "" {
+		identity.DisplayName = identity.Username
+	}
+
+	return identity, nil
+}
+
+func (p *OIDCProvider) init(ctx context.Context) (*oauth2.Config, *oidc.IDTokenVerifier, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.oauth2 != nil {
+		return p.oauth2, p.verifier, nil
+	}
+
+	// the provider keeps the context for fetching the issuer's signing keys later on
+	provider, err := oidc.NewProvider(context.WithoutCancel(ctx), p.config.IssuerURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error discovering OIDC issuer %s: %w", p.config.IssuerURL, err)
+	}
+
+	p.oauth2 = &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  p.config.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       append([]string{oidc.ScopeOpenID}, p.config.Scopes...),
+	}
+
+	p.verifier = provider.Verifier(&oidc.Config{ClientID: p.config.ClientID})
+
+	return p.oauth2, p.verifier, nil
+}
+
+func randomString() (string, error) {
+	var b [32]byte
+
+	_, err := rand.Read(b[:])
+	if err != nil {
+		return "", fmt.Errorf("error generating random string: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b[:]), nil
+}
+
+func stringClaim(claims map[string]any, name string) string {
+	v, _ := claims[name].(string)
+	return v
+}
+
+// listClaim returns the claim as a list of strings.
+// Some issuers send a single string instead of a list when the user is only in one group.
+func listClaim(claims map[string]any, name string) []string {
+	switch v := claims[name].(type) {
+	case string:
+		return []string{v}
+	case []any:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	default:
+		return nil
+	}
+}
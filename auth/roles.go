@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"time"
+)
+
+type Role string
+
+const (
+	// RoleViewer can see all assets, but not change anything.
+	RoleViewer Role = "viewer"
+	// RoleEditor can create, edit, delete and check out assets.
+	RoleEditor Role = "editor"
+	// RoleAdmin can do everything, including managing users.
+	RoleAdmin Role = "admin"
+)
+
+var Roles = []Role{RoleViewer, RoleEditor, RoleAdmin}
+
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleViewer, RoleEditor, RoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// Includes reports whether the role has at least the permissions of other.
+func (r Role) Includes(other Role) bool {
+	return r.rank() >= other.rank()
+}
+
+func (r Role) rank() int {
+	switch r {
+	case RoleViewer:
+		return 1
+	case RoleEditor:
+		return 2
+	case RoleAdmin:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Grant gives a user a higher role for all assets in a location or category,
+// e.g. a viewer can be made an editor of the assets stored in their lab.
+// If both Location and Category are set, the asset must match both.
+type Grant struct {
+	ID       int64  `form:"-"`
+	UserID   int64  `form:"-"`
+	Role     Role   `form:"role"`
+	Location string `form:"location"`
+	Category string `form:"category"`
+
+	CreatedAt time.Time `form:"-"`
+	UpdatedAt time.Time `form:"-"`
+}
+
+func (g *Grant) Matches(location string, category string) bool {
+	if g.Location == "" && g.Category == "" {
+		return false
+	}
+
+	if g.Location != "" && g.Location != location {
+		return false
+	}
+
+	if g.Category != "" && g.Category != category {
+		return false
+	}
+
+	return true
+}
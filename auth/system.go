@@ -0,0 +1,20 @@
+package auth
+
+import (
+	"context"
+)
+
+type ctxSystemKeyType string
+
+const ctxSystemKey = ctxSystemKeyType("ctxSystemKey")
+
+// SystemCtx marks the context as being used by the system itself, like background jobs, CLI commands
+// or the initial setup. Permission checks are only skipped for contexts without a user if they are marked.
+func SystemCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxSystemKey, true)
+}
+
+func IsSystemCtx(ctx context.Context) bool {
+	isSystem, ok := ctx.Value(ctxSystemKey).(bool)
+	return ok && isSystem
+}
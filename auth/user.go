@@ -2,6 +2,7 @@ package auth
 
 import (
 	"errors"
+	"strings"
 	"time"
 )
 
@@ -12,7 +13,7 @@ type User struct {
 	Username string `form:"username"`
 
 	DisplayName string `form:"display_name"`
-	IsAdmin     bool   `form:"is_admin"`
+	Role        Role   `form:"-"`
 
 	RequiresPasswordChange bool `form:"-"`
 
@@ -24,6 +25,15 @@ type User struct {
 	UpdatedAt time.Time `form:"-"`
 }
 
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
+// IsOIDCUser reports whether the user logs in using OpenID Connect instead of a local password.
+func (u *User) IsOIDCUser() bool {
+	return strings.HasPrefix(u.AuthRef, OIDCAuthRefPrefix)
+}
+
 type UserPreferences struct {
 	SidebarClosedDesktop bool
 
@@ -34,4 +44,7 @@ type UserPreferences struct {
 	AssetListCompact bool
 
 	UserListCompact bool
+
+	// PinnedSavedSearches are the IDs of the saved searches shown in the sidebar.
+	PinnedSavedSearches []int64
 }
@@ -1,15 +1,78 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
 	"os"
 
 	"github.com/RobinThrift/stuff/app"
 )
 
+const usage = `usage: stuff [command] [flags]
+
+commands:
+  serve                              start the server (default)
+  migrate up|down|status             apply, revert or list database migrations
+  user create <username>             create a local user, reads the password from stdin
+  user reset-password <username>     reset the password of a local user, reads the password from stdin
+  user promote <username>            change the role of a user, defaults to admin
+  import <file>                      import assets from a Snipe-IT export
+  export                             export all assets as JSON or CSV
+  labels [tag...]                    generate a PDF label sheet
+  fsck                               check the stored files for consistency
+  backup                             create a backup of the database and all files
+  restore <archive>                  restore a backup
+  config check                       validate the configuration and print the effective configuration
+
+run stuff <command> -h for the flags of each command
+
+the configuration is read from the environment and the JSON or YAML file set in STUFF_CONFIG_FILE
+`
+
+type command struct {
+	run    func(args []string) error
+	errMsg string
+}
+
+var commands = map[string]command{
+	"serve":   {run: app.Serve, errMsg: "error starting stuff"},
+	"migrate": {run: app.Migrate, errMsg: "error running migrations"},
+	"user":    {run: app.User, errMsg: "error managing user"},
+	"import":  {run: app.Import, errMsg: "error importing assets"},
+	"export":  {run: app.Export, errMsg: "error exporting assets"},
+	"labels":  {run: app.Labels, errMsg: "error generating labels"},
+	"fsck":    {run: app.Fsck, errMsg: "error checking file storage"},
+	"backup":  {run: app.Backup, errMsg: "error creating backup"},
+	"restore": {run: app.Restore, errMsg: "error restoring backup"},
+	"config":  {run: app.ConfigCommand, errMsg: "error checking configuration"},
+}
+
 func main() {
-	if err := app.Start(); err != nil {
-		fmt.Println("error starting stuff", err)
+	name := "serve"
+	args := []string{}
+	if len(os.Args) > 1 {
+		name = os.Args[1]
+		args = os.Args[2:]
+	}
+
+	if name == "help" || name == "-h" || name == "-help" || name == "--help" {
+		fmt.Print(usage)
+		return
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Printf("unknown command %s\n\n%s", name, usage)
+		os.Exit(2)
+	}
+
+	if err := cmd.run(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+
+		fmt.Println(cmd.errMsg, err)
 		os.Exit(1)
 	}
 }
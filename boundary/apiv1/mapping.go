@@ -3,6 +3,7 @@ package apiv1
 
 import (
 	"fmt"
+	"html"
 	"strings"
 	"time"
 
@@ -13,13 +14,7 @@ import (
 func mapCreateAssetBodyToAsset(asset *CreateAssetJSONRequestBody) *entities.Asset {
 	purchases := make([]*entities.Purchase, 0, len(asset.Purchases))
 	for _, p := range asset.Purchases {
-		purchases = append(purchases, &entities.Purchase{
-			Supplier: valFromPtr(p.Supplier),
-			OrderNo:  valFromPtr(p.OrderNo),
-			Date:     valFromPtr(p.Date).Time,
-			Amount:   entities.MonetaryAmount(valFromPtr(p.Amount)),
-			Currency: valFromPtr(p.Currency),
-		})
+		purchases = append(purchases, mapPurchaseFromAPI(p))
 	}
 
 	customAttrs := make([]entities.CustomAttr, 0, len(asset.CustomAttrs))
@@ -74,13 +69,7 @@ func mapCreateAssetBodyToAsset(asset *CreateAssetJSONRequestBody) *entities.Asse
 func mapUpdateIntoAsset(asset *entities.Asset, update *UpdateAssetJSONRequestBody) {
 	asset.Purchases = make([]*entities.Purchase, 0, len(update.Purchases))
 	for _, p := range update.Purchases {
-		asset.Purchases = append(asset.Purchases, &entities.Purchase{
-			Supplier: valFromPtr(p.Supplier),
-			OrderNo:  valFromPtr(p.OrderNo),
-			Date:     valFromPtr(p.Date).Time,
-			Amount:   entities.MonetaryAmount(valFromPtr(p.Amount)),
-			Currency: valFromPtr(p.Currency),
-		})
+		asset.Purchases = append(asset.Purchases, mapPurchaseFromAPI(p))
 	}
 
 	asset.CustomAttrs = make([]entities.CustomAttr, 0, len(asset.CustomAttrs))
@@ -125,13 +114,7 @@ func mapUpdateIntoAsset(asset *entities.Asset, update *UpdateAssetJSONRequestBod
 func mapAssetToAPI(asset *entities.Asset) Asset {
 	purchases := make([]Purchase, 0, len(asset.Purchases))
 	for _, p := range asset.Purchases {
-		purchases = append(purchases, Purchase{
-			Supplier: ptrFromVal(p.Supplier),
-			OrderNo:  ptrFromVal(p.OrderNo),
-			Date:     timeToDate(p.Date),
-			Amount:   ptrFromVal(int(p.Amount)),
-			Currency: ptrFromVal(p.Currency),
-		})
+		purchases = append(purchases, mapPurchaseToAPI(p))
 	}
 
 	customAttrs := make([]CustomAttr, 0, len(asset.CustomAttrs))
@@ -141,34 +124,17 @@ func mapAssetToAPI(asset *entities.Asset) Asset {
 
 	parts := make([]AssetPart, 0, len(asset.Parts))
 	for _, part := range asset.Parts {
-		parts = append(parts, AssetPart{
-			Id:           int(part.ID),
-			AssetID:      int(part.AssetID),
-			Tag:          part.Tag,
-			Name:         part.Name,
-			Location:     ptrFromVal(part.Location),
-			PositionCode: ptrFromVal(part.PositionCode),
-			Notes:        ptrFromVal(part.Notes),
-			CreatedBy:    int(part.CreatedBy),
-			CreatedAt:    valFromPtr(timeToDate(part.CreatedAt)),
-			UpdatedAt:    valFromPtr(timeToDate(part.UpdatedAt)),
-		})
+		parts = append(parts, mapPartToAPI(part))
 	}
 
 	files := make([]AssetFile, 0, len(asset.Files))
 	for _, file := range asset.Files {
-		files = append(files, AssetFile{
-			AssetID:    int(file.AssetID),
-			Id:         int(file.ID),
-			Name:       file.Name,
-			Filetype:   file.Filetype,
-			PublicPath: file.PublicPath,
-			Sha256:     fmt.Sprintf("%x", file.Sha256),
-			SizeBytes:  int(file.SizeBytes),
-			CreatedBy:  int(file.CreatedBy),
-			CreatedAt:  valFromPtr(timeToDate(file.CreatedAt)),
-			UpdatedAt:  valFromPtr(timeToDate(file.UpdatedAt)),
-		})
+		files = append(files, mapFileToAPI(file))
+	}
+
+	photos := make([]AssetPhoto, 0, len(asset.Photos))
+	for _, photo := range asset.Photos {
+		photos = append(photos, mapPhotoToAPI(photo))
 	}
 
 	children := make([]Asset, 0, len(asset.Children))
@@ -195,13 +161,96 @@ func mapAssetToAPI(asset *entities.Asset) Asset {
 		PositionCode:  ptrFromVal(asset.PositionCode),
 		Purchases:     purchases,
 		Files:         files,
+		Photos:        &photos,
 		Children:      &children,
+		Snippet:       ptrFromVal(highlightSnippet(asset.Snippet)),
 		CreatedBy:     int(asset.MetaInfo.CreatedBy),
 		CreatedAt:     types.Date{Time: asset.MetaInfo.CreatedAt},
 		UpdatedAt:     types.Date{Time: asset.MetaInfo.UpdatedAt},
 	}
 }
 
+var snippetMarkers = strings.NewReplacer(entities.SnippetMatchStart, "<mark>", entities.SnippetMatchEnd, "</mark>")
+
+func highlightSnippet(snippet string) string {
+	return snippetMarkers.Replace(html.EscapeString(snippet))
+}
+
+func mapPurchaseFromAPI(p Purchase) *entities.Purchase {
+	return &entities.Purchase{
+		Supplier: valFromPtr(p.Supplier),
+		OrderNo:  valFromPtr(p.OrderNo),
+		Date:     valFromPtr(p.Date).Time,
+		Amount:   entities.MonetaryAmount(valFromPtr(p.Amount)),
+		Currency: valFromPtr(p.Currency),
+	}
+}
+
+func mapPurchaseToAPI(p *entities.Purchase) Purchase {
+	return Purchase{
+		Id:       ptrFromVal(p.ID),
+		Supplier: ptrFromVal(p.Supplier),
+		OrderNo:  ptrFromVal(p.OrderNo),
+		Date:     timeToDate(p.Date),
+		Amount:   ptrFromVal(int(p.Amount)),
+		Currency: ptrFromVal(p.Currency),
+	}
+}
+
+func mapAssetPartRequestIntoPart(part *entities.Part, req AssetPartRequest) {
+	part.Tag = valFromPtr(req.Tag)
+	part.Name = req.Name
+	part.Location = valFromPtr(req.Location)
+	part.PositionCode = valFromPtr(req.PositionCode)
+	part.Notes = valFromPtr(req.Notes)
+}
+
+func mapPartToAPI(part *entities.Part) AssetPart {
+	return AssetPart{
+		Id:           int(part.ID),
+		AssetID:      int(part.AssetID),
+		Tag:          part.Tag,
+		Name:         part.Name,
+		Location:     ptrFromVal(part.Location),
+		PositionCode: ptrFromVal(part.PositionCode),
+		Notes:        ptrFromVal(part.Notes),
+		CreatedBy:    int(part.CreatedBy),
+		CreatedAt:    valFromPtr(timeToDate(part.CreatedAt)),
+		UpdatedAt:    valFromPtr(timeToDate(part.UpdatedAt)),
+	}
+}
+
+func mapFileToAPI(file *entities.File) AssetFile {
+	return AssetFile{
+		AssetID:    int(file.AssetID),
+		Id:         int(file.ID),
+		Name:       file.Name,
+		Filetype:   file.Filetype,
+		PublicPath: file.PublicPath,
+		Sha256:     fmt.Sprintf("%x", file.Sha256),
+		SizeBytes:  int(file.SizeBytes),
+		CreatedBy:  int(file.CreatedBy),
+		CreatedAt:  valFromPtr(timeToDate(file.CreatedAt)),
+		UpdatedAt:  valFromPtr(timeToDate(file.UpdatedAt)),
+	}
+}
+
+func mapPhotoToAPI(photo *entities.Photo) AssetPhoto {
+	return AssetPhoto{
+		Id:           int(photo.ID),
+		AssetID:      int(photo.AssetID),
+		FileID:       int(photo.FileID),
+		ImageURL:     photo.ImageURL,
+		ThumbnailURL: photo.ThumbnailURL,
+		PreviewURL:   photo.PreviewURL,
+		Caption:      photo.Caption,
+		Position:     photo.Position,
+		Primary:      photo.Primary,
+		CreatedAt:    valFromPtr(timeToDate(photo.CreatedAt)),
+		UpdatedAt:    valFromPtr(timeToDate(photo.UpdatedAt)),
+	}
+}
+
 func mapTagToAPI(tag *entities.Tag) Tag {
 	return Tag{
 		Id:        int(tag.ID),
@@ -212,6 +261,79 @@ func mapTagToAPI(tag *entities.Tag) Tag {
 	}
 }
 
+func mapCheckoutToAPI(checkout *entities.Checkout) Checkout {
+	return Checkout{
+		Id:               checkout.ID,
+		AssetID:          checkout.AssetID,
+		CheckedOutTo:     checkout.CheckedOutTo,
+		CheckedOutToName: ptrFromVal(checkout.CheckedOutToName),
+		DueAt:            timeToDate(checkout.DueAt),
+		Note:             ptrFromVal(checkout.Note),
+		CheckedOutAt:     types.Date{Time: checkout.CheckedOutAt},
+		CheckedInAt:      timeToDate(checkout.CheckedInAt),
+		CheckInNote:      ptrFromVal(checkout.CheckInNote),
+		CreatedBy:        checkout.CreatedBy,
+		CreatedAt:        types.Date{Time: checkout.CreatedAt},
+		UpdatedAt:        types.Date{Time: checkout.UpdatedAt},
+	}
+}
+
+func mapAssetEventToAPI(event *entities.AssetEvent) AssetEvent {
+	changes := make([]AssetFieldChange, 0, len(event.Changes))
+	for _, c := range event.Changes {
+		changes = append(changes, AssetFieldChange{
+			Field:  c.Field,
+			Before: c.Before,
+			After:  c.After,
+		})
+	}
+
+	mapped := AssetEvent{
+		Id:        event.ID,
+		AssetID:   event.AssetID,
+		Type:      AssetEventType(event.Type),
+		Changes:   changes,
+		UserName:  ptrFromVal(event.UserName),
+		RequestID: ptrFromVal(event.RequestID),
+		CreatedAt: event.CreatedAt,
+	}
+
+	if event.UserID != 0 {
+		mapped.UserID = &event.UserID
+	}
+
+	return mapped
+}
+
+func mapFacetParamsToFilters(status, category, location, manufacturer, supplier, warranty, attr *[]string) entities.AssetFilters {
+	return entities.AssetFiltersFromValues(map[string][]string{
+		entities.FacetStatus:       valFromPtr(status),
+		entities.FacetCategory:     valFromPtr(category),
+		entities.FacetLocation:     valFromPtr(location),
+		entities.FacetManufacturer: valFromPtr(manufacturer),
+		entities.FacetSupplier:     valFromPtr(supplier),
+		entities.FacetWarranty:     valFromPtr(warranty),
+		entities.FacetCustomAttr:   valFromPtr(attr),
+	})
+}
+
+func mapFacetToAPI(facet *entities.Facet) Facet {
+	values := make([]FacetValue, 0, len(facet.Values))
+	for _, v := range facet.Values {
+		values = append(values, FacetValue{
+			Value:    v.Value,
+			Count:    v.Count,
+			Selected: v.Selected,
+		})
+	}
+
+	return Facet{
+		Name:   facet.Name,
+		Attr:   ptrFromVal(facet.Attr),
+		Values: values,
+	}
+}
+
 func ptrFromVal[T comparable](v T) *T {
 	var zero T
 	if v == zero {
@@ -237,46 +359,3 @@ func timeToDate(t time.Time) *types.Date {
 
 	return &types.Date{Time: t}
 }
-
-func decodeSearchQuery(queryPtr *string) map[string]string {
-	if queryPtr == nil {
-		return nil
-	}
-
-	queryStr := strings.TrimPrefix(*queryPtr, "*")
-
-	fields := map[string]string{}
-
-	lastWordEnd := 0
-	lastNameEnd := 0
-	name := ""
-	value := ""
-	for i := 0; i < len(queryStr)-1; i++ {
-		switch queryStr[i] {
-		case ':':
-			value = queryStr[lastNameEnd:lastWordEnd]
-			if name != "" {
-				fields[strings.ToLower(name)] = value
-			}
-			if queryStr[lastWordEnd] == ' ' {
-				lastWordEnd += 1
-			}
-			name = queryStr[lastWordEnd:i]
-			lastNameEnd = i + 1
-			if i+1 < len(queryStr) && queryStr[i+1] == ' ' {
-				lastNameEnd = i + 2
-			}
-		case ' ':
-			lastWordEnd = i
-		}
-	}
-
-	if name != "" {
-		value = queryStr[lastNameEnd:]
-		if value != "" {
-			fields[strings.ToLower(name)] = queryStr[lastNameEnd:]
-		}
-	}
-
-	return fields
-}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -12,12 +14,22 @@ import (
 	"github.com/RobinThrift/stuff/auth"
 	"github.com/RobinThrift/stuff/control"
 	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/server/session"
 	"github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/go-chi/chi/v5"
 )
 
+var errAssetPartNotFound = errors.New("asset part not found")
+var errAssetPartTagInUse = errors.New("asset already has a part with this tag")
+var errAssetPurchaseNotFound = errors.New("asset purchase not found")
+var errNoFilesUploaded = errors.New("no files uploaded")
+
+// maxRequestBodySize limits the size of all request bodies, including the multipart bodies of file uploads.
+const maxRequestBodySize = 256 << 20 // 256 MB
+
 type Router struct {
 	assets        AssetCtrl
+	files         FileCtrl
 	customAttrs   CustomAttrCtrl
 	categories    CategoryCtrl
 	suppliers     SupplierCtrl
@@ -32,9 +44,20 @@ type Router struct {
 type AssetCtrl interface {
 	Get(ctx context.Context, query control.GetAssetQuery) (*entities.Asset, error)
 	List(ctx context.Context, query control.ListAssetsQuery) (*entities.ListPage[*entities.Asset], error)
+	ListFacets(ctx context.Context, query control.ListAssetFacetsQuery) ([]*entities.Facet, error)
 	Create(ctx context.Context, cmd control.CreateAssetCmd) (*entities.Asset, error)
 	Update(ctx context.Context, cmd control.UpdateAssetCmd) (*entities.Asset, error)
 	Delete(ctx context.Context, asset *entities.Asset) error
+	ListCheckouts(ctx context.Context, query control.ListCheckoutsQuery) (*entities.ListPage[*entities.Checkout], error)
+	CheckOut(ctx context.Context, cmd control.CheckOutAssetCmd) (*entities.Checkout, error)
+	CheckIn(ctx context.Context, cmd control.CheckInAssetCmd) (*entities.Checkout, error)
+	ListEvents(ctx context.Context, query control.ListAssetEventsQuery) (*entities.ListPage[*entities.AssetEvent], error)
+}
+
+type FileCtrl interface {
+	Get(ctx context.Context, id int64) (*entities.File, error)
+	WriteFile(ctx context.Context, file *entities.File) (*entities.File, error)
+	Delete(ctx context.Context, id int64) error
 }
 
 type TagCtrl interface {
@@ -74,6 +97,7 @@ func NewRouter(
 	mux chi.Router,
 
 	assets AssetCtrl,
+	files FileCtrl,
 	categories CategoryCtrl,
 	customAttrs CustomAttrCtrl,
 	suppliers SupplierCtrl,
@@ -86,6 +110,7 @@ func NewRouter(
 ) *Router {
 	r := &Router{ //nolint: varnamelen
 		assets:        assets,
+		files:         files,
 		customAttrs:   customAttrs,
 		categories:    categories,
 		suppliers:     suppliers,
@@ -118,6 +143,27 @@ func NewRouter(
 			}
 		}
 
+		if errors.Is(err, auth.ErrForbidden) {
+			code = http.StatusForbidden
+			apiErr = Error{
+				Code:   http.StatusForbidden,
+				Title:  http.StatusText(http.StatusForbidden),
+				Detail: err.Error(),
+				Type:   "stuff/api/v1/Forbidden",
+			}
+		}
+
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			code = http.StatusRequestEntityTooLarge
+			apiErr = Error{
+				Code:   http.StatusRequestEntityTooLarge,
+				Title:  http.StatusText(http.StatusRequestEntityTooLarge),
+				Detail: err.Error(),
+				Type:   "stuff/api/v1/RequestEntityTooLarge",
+			}
+		}
+
 		w.WriteHeader(code)
 
 		b, err := json.Marshal(apiErr)
@@ -133,6 +179,8 @@ func NewRouter(
 		}
 	}
 
+	mux.Use(limitRequestBodySize)
+
 	HandlerWithOptions(NewStrictHandlerWithOptions(r, nil, StrictHTTPServerOptions{
 		RequestErrorHandlerFunc:  errorHandlerFunc,
 		ResponseErrorHandlerFunc: errorHandlerFunc,
@@ -147,15 +195,21 @@ func NewRouter(
 // (GET /v1/assets)
 func (r *Router) ListAssets(ctx context.Context, req ListAssetsRequestObject) (ListAssetsResponseObject, error) {
 	list, err := r.assets.List(ctx, control.ListAssetsQuery{
-		SearchRaw:    valFromPtr(req.Params.Query),
-		SearchFields: decodeSearchQuery(req.Params.Query),
-		Page:         valFromPtr(req.Params.Page),
-		PageSize:     valFromPtr(req.Params.PageSize),
-		OrderBy:      valFromPtr(req.Params.OrderBy),
-		OrderDir:     valFromPtr(req.Params.OrderDir),
-		AssetType:    entities.AssetType(valFromPtr(req.Params.Type)),
+		SearchRaw: valFromPtr(req.Params.Query),
+		Page:      valFromPtr(req.Params.Page),
+		PageSize:  valFromPtr(req.Params.PageSize),
+		OrderBy:   valFromPtr(req.Params.OrderBy),
+		OrderDir:  valFromPtr(req.Params.OrderDir),
+		AssetType: entities.AssetType(valFromPtr(req.Params.Type)),
+		Filters: mapFacetParamsToFilters(
+			req.Params.Status, req.Params.Category, req.Params.Location, req.Params.Manufacturer,
+			req.Params.Supplier, req.Params.Warranty, req.Params.Attr,
+		),
 	})
 	if err != nil {
+		if errors.Is(err, control.ErrInvalidSearchQuery) {
+			return ListAssets400JSONResponse(badRequestError(err)), nil
+		}
 		return nil, err
 	}
 
@@ -173,6 +227,31 @@ func (r *Router) ListAssets(ctx context.Context, req ListAssetsRequestObject) (L
 	}, nil
 }
 
+// (GET /v1/assets/facets)
+func (r *Router) ListAssetFacets(ctx context.Context, req ListAssetFacetsRequestObject) (ListAssetFacetsResponseObject, error) {
+	facets, err := r.assets.ListFacets(ctx, control.ListAssetFacetsQuery{
+		SearchRaw: valFromPtr(req.Params.Query),
+		AssetType: entities.AssetType(valFromPtr(req.Params.Type)),
+		Filters: mapFacetParamsToFilters(
+			req.Params.Status, req.Params.Category, req.Params.Location, req.Params.Manufacturer,
+			req.Params.Supplier, req.Params.Warranty, req.Params.Attr,
+		),
+	})
+	if err != nil {
+		if errors.Is(err, control.ErrInvalidSearchQuery) {
+			return ListAssetFacets400JSONResponse(badRequestError(err)), nil
+		}
+		return nil, err
+	}
+
+	mapped := make([]Facet, 0, len(facets))
+	for _, facet := range facets {
+		mapped = append(mapped, mapFacetToAPI(facet))
+	}
+
+	return ListAssetFacets200JSONResponse{Facets: mapped}, nil
+}
+
 // (POST /v1/assets)
 func (r *Router) CreateAsset(ctx context.Context, req CreateAssetRequestObject) (CreateAssetResponseObject, error) {
 	asset := mapCreateAssetBodyToAsset(req.Body)
@@ -191,6 +270,7 @@ func (r *Router) GetAsset(ctx context.Context, req GetAssetRequestObject) (GetAs
 		IncludeParts:     true,
 		IncludePurchases: true,
 		IncludeFiles:     true,
+		IncludePhotos:    true,
 		IncludeChildren:  valFromPtr(req.Params.IncludeChildren),
 	}
 
@@ -257,6 +337,385 @@ func (r *Router) DeleteAsset(ctx context.Context, req DeleteAssetRequestObject)
 	return DeleteAsset204Response{}, nil
 }
 
+// (GET /v1/assets/{tagOrID}/checkout)
+func (r *Router) ListAssetCheckouts(ctx context.Context, req ListAssetCheckoutsRequestObject) (ListAssetCheckoutsResponseObject, error) {
+	asset, err := r.getAsset(ctx, req.TagOrID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) {
+			return ListAssetCheckouts404JSONResponse(notFoundError(err)), nil
+		}
+		return nil, err
+	}
+
+	list, err := r.assets.ListCheckouts(ctx, control.ListCheckoutsQuery{
+		AssetID:  asset.ID,
+		Page:     valFromPtr(req.Params.Page),
+		PageSize: valFromPtr(req.Params.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	checkouts := make([]Checkout, 0, len(list.Items))
+	for _, checkout := range list.Items {
+		checkouts = append(checkouts, mapCheckoutToAPI(checkout))
+	}
+
+	return ListAssetCheckouts200JSONResponse{
+		Checkouts: checkouts,
+		NumPages:  list.NumPages,
+		Page:      list.Page,
+		PageSize:  list.PageSize,
+		Total:     list.Total,
+	}, nil
+}
+
+// (POST /v1/assets/{tagOrID}/checkout)
+func (r *Router) CheckOutAsset(ctx context.Context, req CheckOutAssetRequestObject) (CheckOutAssetResponseObject, error) {
+	user, ok := session.Get[*auth.User](ctx, "user")
+	if !ok {
+		return nil, auth.ErrUnauthorized
+	}
+
+	asset, err := r.getAsset(ctx, req.TagOrID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) {
+			return CheckOutAsset404JSONResponse(notFoundError(err)), nil
+		}
+		return nil, err
+	}
+
+	checkedOutTo := valFromPtr(req.Body.CheckedOutTo)
+	if checkedOutTo == 0 {
+		checkedOutTo = user.ID
+	}
+
+	checkout, err := r.assets.CheckOut(ctx, control.CheckOutAssetCmd{
+		AssetID:      asset.ID,
+		CheckedOutTo: checkedOutTo,
+		DueAt:        valFromPtr(req.Body.DueAt).Time,
+		Note:         valFromPtr(req.Body.Note),
+		CreatedBy:    user.ID,
+	})
+	if err != nil {
+		if errors.Is(err, control.ErrAssetAlreadyCheckedOut) {
+			return CheckOutAsset409JSONResponse(conflictError(err)), nil
+		}
+		return nil, err
+	}
+
+	return CheckOutAsset201JSONResponse(mapCheckoutToAPI(checkout)), nil
+}
+
+// (DELETE /v1/assets/{tagOrID}/checkout)
+func (r *Router) CheckInAsset(ctx context.Context, req CheckInAssetRequestObject) (CheckInAssetResponseObject, error) {
+	asset, err := r.getAsset(ctx, req.TagOrID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) {
+			return CheckInAsset404JSONResponse(notFoundError(err)), nil
+		}
+		return nil, err
+	}
+
+	checkout, err := r.assets.CheckIn(ctx, control.CheckInAssetCmd{
+		AssetID: asset.ID,
+		Note:    valFromPtr(req.Body.Note),
+	})
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotCheckedOut) {
+			return CheckInAsset409JSONResponse(conflictError(err)), nil
+		}
+		return nil, err
+	}
+
+	return CheckInAsset200JSONResponse(mapCheckoutToAPI(checkout)), nil
+}
+
+// (GET /v1/assets/{tagOrID}/history)
+func (r *Router) ListAssetEvents(ctx context.Context, req ListAssetEventsRequestObject) (ListAssetEventsResponseObject, error) {
+	asset, err := r.getAsset(ctx, req.TagOrID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) {
+			return ListAssetEvents404JSONResponse(notFoundError(err)), nil
+		}
+		return nil, err
+	}
+
+	list, err := r.assets.ListEvents(ctx, control.ListAssetEventsQuery{
+		AssetID:  asset.ID,
+		Page:     valFromPtr(req.Params.Page),
+		PageSize: valFromPtr(req.Params.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]AssetEvent, 0, len(list.Items))
+	for _, event := range list.Items {
+		events = append(events, mapAssetEventToAPI(event))
+	}
+
+	return ListAssetEvents200JSONResponse{
+		Events:   events,
+		NumPages: list.NumPages,
+		Page:     list.Page,
+		PageSize: list.PageSize,
+		Total:    list.Total,
+	}, nil
+}
+
+// (POST /v1/assets/{tagOrID}/parts)
+func (r *Router) CreateAssetPart(ctx context.Context, req CreateAssetPartRequestObject) (CreateAssetPartResponseObject, error) {
+	user, ok := session.Get[*auth.User](ctx, "user")
+	if !ok {
+		return nil, auth.ErrUnauthorized
+	}
+
+	asset, err := r.getAssetWithPartsAndPurchases(ctx, req.TagOrID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) {
+			return CreateAssetPart404JSONResponse(notFoundError(err)), nil
+		}
+		return nil, err
+	}
+
+	part := &entities.Part{AssetID: asset.ID, CreatedBy: user.ID}
+	mapAssetPartRequestIntoPart(part, AssetPartRequest(*req.Body))
+
+	if part.Tag == "" {
+		part.Tag = nextPartTag(asset)
+	}
+
+	if findPart(asset.Parts, part.Tag) != -1 {
+		return CreateAssetPart409JSONResponse(conflictError(fmt.Errorf("%w: %s", errAssetPartTagInUse, part.Tag))), nil
+	}
+
+	asset.Parts = append(asset.Parts, part)
+
+	updated, err := r.assets.Update(ctx, control.UpdateAssetCmd{Asset: asset})
+	if err != nil {
+		return nil, err
+	}
+
+	return CreateAssetPart201JSONResponse(mapPartToAPI(updated.Parts[findPart(updated.Parts, part.Tag)])), nil
+}
+
+// (PUT /v1/assets/{tagOrID}/parts/{partTag})
+func (r *Router) UpdateAssetPart(ctx context.Context, req UpdateAssetPartRequestObject) (UpdateAssetPartResponseObject, error) {
+	asset, err := r.getAssetWithPartsAndPurchases(ctx, req.TagOrID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) {
+			return UpdateAssetPart404JSONResponse(notFoundError(err)), nil
+		}
+		return nil, err
+	}
+
+	i := findPart(asset.Parts, req.PartTag)
+	if i == -1 {
+		return UpdateAssetPart404JSONResponse(notFoundError(fmt.Errorf("%w: %s", errAssetPartNotFound, req.PartTag))), nil
+	}
+
+	newTag := valFromPtr(req.Body.Tag)
+	if newTag != "" && newTag != req.PartTag && findPart(asset.Parts, newTag) != -1 {
+		return UpdateAssetPart409JSONResponse(conflictError(fmt.Errorf("%w: %s", errAssetPartTagInUse, newTag))), nil
+	}
+
+	part := asset.Parts[i]
+	mapAssetPartRequestIntoPart(part, AssetPartRequest(*req.Body))
+	if part.Tag == "" {
+		part.Tag = req.PartTag
+	}
+
+	updated, err := r.assets.Update(ctx, control.UpdateAssetCmd{Asset: asset})
+	if err != nil {
+		return nil, err
+	}
+
+	return UpdateAssetPart200JSONResponse(mapPartToAPI(updated.Parts[findPart(updated.Parts, part.Tag)])), nil
+}
+
+// (DELETE /v1/assets/{tagOrID}/parts/{partTag})
+func (r *Router) DeleteAssetPart(ctx context.Context, req DeleteAssetPartRequestObject) (DeleteAssetPartResponseObject, error) {
+	asset, err := r.getAssetWithPartsAndPurchases(ctx, req.TagOrID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) {
+			return DeleteAssetPart404JSONResponse(notFoundError(err)), nil
+		}
+		return nil, err
+	}
+
+	i := findPart(asset.Parts, req.PartTag)
+	if i == -1 {
+		return DeleteAssetPart404JSONResponse(notFoundError(fmt.Errorf("%w: %s", errAssetPartNotFound, req.PartTag))), nil
+	}
+
+	asset.Parts = append(asset.Parts[:i], asset.Parts[i+1:]...)
+
+	_, err = r.assets.Update(ctx, control.UpdateAssetCmd{Asset: asset})
+	if err != nil {
+		return nil, err
+	}
+
+	return DeleteAssetPart204Response{}, nil
+}
+
+// (POST /v1/assets/{tagOrID}/purchases)
+func (r *Router) CreateAssetPurchase(ctx context.Context, req CreateAssetPurchaseRequestObject) (CreateAssetPurchaseResponseObject, error) {
+	asset, err := r.getAssetWithPartsAndPurchases(ctx, req.TagOrID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) {
+			return CreateAssetPurchase404JSONResponse(notFoundError(err)), nil
+		}
+		return nil, err
+	}
+
+	purchase := mapPurchaseFromAPI(*req.Body)
+	asset.Purchases = append(asset.Purchases, purchase)
+
+	updated, err := r.assets.Update(ctx, control.UpdateAssetCmd{Asset: asset})
+	if err != nil {
+		return nil, err
+	}
+
+	return CreateAssetPurchase201JSONResponse(mapPurchaseToAPI(updated.Purchases[findPurchase(updated.Purchases, purchase.ID)])), nil
+}
+
+// (PUT /v1/assets/{tagOrID}/purchases/{purchaseID})
+func (r *Router) UpdateAssetPurchase(ctx context.Context, req UpdateAssetPurchaseRequestObject) (UpdateAssetPurchaseResponseObject, error) {
+	asset, err := r.getAssetWithPartsAndPurchases(ctx, req.TagOrID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) {
+			return UpdateAssetPurchase404JSONResponse(notFoundError(err)), nil
+		}
+		return nil, err
+	}
+
+	i := findPurchase(asset.Purchases, req.PurchaseID)
+	if i == -1 {
+		return UpdateAssetPurchase404JSONResponse(notFoundError(fmt.Errorf("%w: %d", errAssetPurchaseNotFound, req.PurchaseID))), nil
+	}
+
+	asset.Purchases[i] = mapPurchaseFromAPI(*req.Body)
+	asset.Purchases[i].ID = req.PurchaseID
+
+	updated, err := r.assets.Update(ctx, control.UpdateAssetCmd{Asset: asset})
+	if err != nil {
+		return nil, err
+	}
+
+	return UpdateAssetPurchase200JSONResponse(mapPurchaseToAPI(updated.Purchases[findPurchase(updated.Purchases, req.PurchaseID)])), nil
+}
+
+// (DELETE /v1/assets/{tagOrID}/purchases/{purchaseID})
+func (r *Router) DeleteAssetPurchase(ctx context.Context, req DeleteAssetPurchaseRequestObject) (DeleteAssetPurchaseResponseObject, error) {
+	asset, err := r.getAssetWithPartsAndPurchases(ctx, req.TagOrID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) {
+			return DeleteAssetPurchase404JSONResponse(notFoundError(err)), nil
+		}
+		return nil, err
+	}
+
+	i := findPurchase(asset.Purchases, req.PurchaseID)
+	if i == -1 {
+		return DeleteAssetPurchase404JSONResponse(notFoundError(fmt.Errorf("%w: %d", errAssetPurchaseNotFound, req.PurchaseID))), nil
+	}
+
+	asset.Purchases = append(asset.Purchases[:i], asset.Purchases[i+1:]...)
+
+	_, err = r.assets.Update(ctx, control.UpdateAssetCmd{Asset: asset})
+	if err != nil {
+		return nil, err
+	}
+
+	return DeleteAssetPurchase204Response{}, nil
+}
+
+// (POST /v1/assets/{tagOrID}/files)
+func (r *Router) UploadAssetFiles(ctx context.Context, req UploadAssetFilesRequestObject) (UploadAssetFilesResponseObject, error) {
+	user, ok := session.Get[*auth.User](ctx, "user")
+	if !ok {
+		return nil, auth.ErrUnauthorized
+	}
+
+	asset, err := r.getAsset(ctx, req.TagOrID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) {
+			return UploadAssetFiles404JSONResponse(notFoundError(err)), nil
+		}
+		return nil, err
+	}
+
+	files := []AssetFile{}
+	for {
+		part, err := req.Body.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				return nil, err
+			}
+			return UploadAssetFiles400JSONResponse(badRequestError(err)), nil
+		}
+
+		if part.FileName() == "" {
+			continue
+		}
+
+		file, err := r.files.WriteFile(ctx, &entities.File{
+			Reader:    part,
+			AssetID:   asset.ID,
+			Name:      part.FileName(),
+			Filetype:  part.Header.Get("Content-Type"),
+			CreatedBy: user.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, mapFileToAPI(file))
+	}
+
+	if len(files) == 0 {
+		return UploadAssetFiles400JSONResponse(badRequestError(errNoFilesUploaded)), nil
+	}
+
+	return UploadAssetFiles201JSONResponse{Files: files}, nil
+}
+
+// (DELETE /v1/assets/{tagOrID}/files/{fileID})
+func (r *Router) DeleteAssetFile(ctx context.Context, req DeleteAssetFileRequestObject) (DeleteAssetFileResponseObject, error) {
+	asset, err := r.getAsset(ctx, req.TagOrID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) {
+			return DeleteAssetFile404JSONResponse(notFoundError(err)), nil
+		}
+		return nil, err
+	}
+
+	file, err := r.files.Get(ctx, req.FileID)
+	if err != nil {
+		if errors.Is(err, control.ErrFileNotFound) {
+			return DeleteAssetFile404JSONResponse(notFoundError(err)), nil
+		}
+		return nil, err
+	}
+
+	if file.AssetID != asset.ID {
+		return DeleteAssetFile404JSONResponse(notFoundError(fmt.Errorf("%w: %d", control.ErrFileNotFound, req.FileID))), nil
+	}
+
+	err = r.files.Delete(ctx, file.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return DeleteAssetFile204Response{}, nil
+}
+
 // (GET /v1/categories)
 func (r *Router) ListCategories(ctx context.Context, req ListCategoriesRequestObject) (ListCategoriesResponseObject, error) {
 	categories, err := r.categories.List(ctx, control.ListCategoriesQuery{
@@ -472,7 +931,8 @@ func (r *Router) ListUsers(ctx context.Context, req ListUsersRequestObject) (Lis
 			Id:          user.ID,
 			Username:    user.Username,
 			DisplayName: user.DisplayName,
-			IsAdmin:     user.IsAdmin,
+			IsAdmin:     user.IsAdmin(),
+			Role:        UserRole(user.Role),
 			CreatedAt:   types.Date{Time: user.CreatedAt},
 			UpdatedAt:   types.Date{Time: user.UpdatedAt},
 		})
@@ -486,3 +946,94 @@ func (r *Router) ListUsers(ctx context.Context, req ListUsersRequestObject) (Lis
 		Total:    list.Total,
 	}, nil
 }
+
+func (r *Router) getAsset(ctx context.Context, tagOrID string) (*entities.Asset, error) {
+	var query control.GetAssetQuery
+	if id, err := strconv.ParseInt(tagOrID, 10, 64); err == nil {
+		query.Tag = tagOrID
+		query.ID = id
+	} else {
+		query.Tag = tagOrID
+	}
+
+	return r.assets.Get(ctx, query)
+}
+
+func (r *Router) getAssetWithPartsAndPurchases(ctx context.Context, tagOrID string) (*entities.Asset, error) {
+	query := control.GetAssetQuery{IncludeParts: true, IncludePurchases: true}
+	if id, err := strconv.ParseInt(tagOrID, 10, 64); err == nil {
+		query.Tag = tagOrID
+		query.ID = id
+	} else {
+		query.Tag = tagOrID
+	}
+
+	return r.assets.Get(ctx, query)
+}
+
+func findPart(parts []*entities.Part, tag string) int {
+	for i, part := range parts {
+		if part.Tag == tag {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// nextPartTag returns the tag for a new part of the asset, which is made up of the asset's tag and the
+// parts counter. The counter never decreases, but a tag may still have been given to a part explicitly.
+func nextPartTag(asset *entities.Asset) string {
+	asset.PartsTotalCounter = max(asset.PartsTotalCounter, len(asset.Parts))
+	for {
+		asset.PartsTotalCounter++
+		tag := fmt.Sprintf("%s-%d", asset.Tag, asset.PartsTotalCounter)
+		if findPart(asset.Parts, tag) == -1 {
+			return tag
+		}
+	}
+}
+
+func findPurchase(purchases []*entities.Purchase, id int64) int {
+	for i, purchase := range purchases {
+		if purchase.ID == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func limitRequestBodySize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodySize)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func notFoundError(err error) Error {
+	return Error{
+		Code:   http.StatusNotFound,
+		Title:  http.StatusText(http.StatusNotFound),
+		Detail: err.Error(),
+		Type:   "stuff/api/v1/NotFound",
+	}
+}
+
+func badRequestError(err error) Error {
+	return Error{
+		Code:   http.StatusBadRequest,
+		Title:  http.StatusText(http.StatusBadRequest),
+		Detail: err.Error(),
+		Type:   "stuff/api/v1/BadRequest",
+	}
+}
+
+func conflictError(err error) Error {
+	return Error{
+		Code:   http.StatusConflict,
+		Title:  http.StatusText(http.StatusConflict),
+		Detail: err.Error(),
+		Type:   "stuff/api/v1/Conflict",
+	}
+}
@@ -1,23 +1,29 @@
-//lint:file-ignore SA1029 Ignore because generated code
-//lint:file-ignore SA1019 Ignore because generated code
-//lint:file-ignore ST1005 Ignore because generated code
-//
 // Package apiv1 provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version v1.12.5-0.20230118012357-f4cf8f9a5703 DO NOT EDIT.
+//
+//lint:file-ignore SA1029 Ignore because generated code
+//lint:file-ignore SA1019 Ignore because generated code
+//lint:file-ignore ST1005 Ignore because generated code
 package apiv1
 
 import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"mime/multipart"
 	"net/http"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/go-chi/chi/v5"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
 // Defines values for AssetStatus.
 const (
 	ARCHIVED  AssetStatus = "ARCHIVED"
@@ -25,42 +31,100 @@ const (
 	INUSE     AssetStatus = "IN_USE"
 )
 
+// Defines values for AssetEventType.
+const (
+	CHECKEDIN  AssetEventType = "CHECKED_IN"
+	CHECKEDOUT AssetEventType = "CHECKED_OUT"
+	CREATED    AssetEventType = "CREATED"
+	DELETED    AssetEventType = "DELETED"
+	PURGED     AssetEventType = "PURGED"
+	RESTORED   AssetEventType = "RESTORED"
+	UPDATED    AssetEventType = "UPDATED"
+)
+
+// Defines values for UserRole.
+const (
+	Admin  UserRole = "admin"
+	Editor UserRole = "editor"
+	Viewer UserRole = "viewer"
+)
+
 // Asset defines model for Asset.
 type Asset struct {
-	Category        *string             `json:"category,omitempty"`
-	CheckedOutTo    *int                `json:"checkedOutTo,omitempty"`
-	Children        *[]Asset            `json:"children,omitempty"`
-	CreatedAt       openapi_types.Date  `json:"createdAt"`
-	CreatedBy       int                 `json:"createdBy"`
-	CustomAttrs     []CustomAttr        `json:"customAttrs"`
-	Files           []AssetFile         `json:"files"`
-	Id              int                 `json:"id"`
-	ImageURL        *string             `json:"imageURL,omitempty"`
-	Location        *string             `json:"location,omitempty"`
-	Manufacturer    *string             `json:"manufacturer,omitempty"`
-	Model           *string             `json:"model,omitempty"`
-	ModelNo         *string             `json:"modelNo,omitempty"`
-	Name            string              `json:"name"`
-	Notes           *string             `json:"notes,omitempty"`
-	ParentAssetID   *int                `json:"parentAssetID,omitempty"`
-	Parts           []AssetPart         `json:"parts"`
-	PartsTotalCount *int                `json:"partsTotalCount,omitempty"`
-	PositionCode    *string             `json:"positionCode,omitempty"`
-	Purchases       []Purchase          `json:"purchases"`
-	Quantity        *int                `json:"quantity,omitempty"`
-	QuantityUnit    *string             `json:"quantityUnit,omitempty"`
-	SerialNo        *string             `json:"serialNo,omitempty"`
-	Status          AssetStatus         `json:"status"`
-	Tag             string              `json:"tag"`
-	ThumbnailURL    *string             `json:"thumbnailURL,omitempty"`
-	Type            string              `json:"type"`
-	UpdatedAt       openapi_types.Date  `json:"updatedAt"`
-	WarrantyUntil   *openapi_types.Date `json:"warrantyUntil,omitempty"`
+	Category        *string            `json:"category,omitempty"`
+	CheckedOutTo    *int               `json:"checkedOutTo,omitempty"`
+	Children        *[]Asset           `json:"children,omitempty"`
+	CreatedAt       openapi_types.Date `json:"createdAt"`
+	CreatedBy       int                `json:"createdBy"`
+	CustomAttrs     []CustomAttr       `json:"customAttrs"`
+	Files           []AssetFile        `json:"files"`
+	Id              int                `json:"id"`
+	ImageURL        *string            `json:"imageURL,omitempty"`
+	Location        *string            `json:"location,omitempty"`
+	Manufacturer    *string            `json:"manufacturer,omitempty"`
+	Model           *string            `json:"model,omitempty"`
+	ModelNo         *string            `json:"modelNo,omitempty"`
+	Name            string             `json:"name"`
+	Notes           *string            `json:"notes,omitempty"`
+	ParentAssetID   *int               `json:"parentAssetID,omitempty"`
+	Parts           []AssetPart        `json:"parts"`
+	PartsTotalCount *int               `json:"partsTotalCount,omitempty"`
+	Photos          *[]AssetPhoto      `json:"photos,omitempty"`
+	PositionCode    *string            `json:"positionCode,omitempty"`
+	Purchases       []Purchase         `json:"purchases"`
+	Quantity        *int               `json:"quantity,omitempty"`
+	QuantityUnit    *string            `json:"quantityUnit,omitempty"`
+	SerialNo        *string            `json:"serialNo,omitempty"`
+
+	// Snippet Excerpt of the text that matched the search query, with the matching words wrapped in `<mark>` tags. Only set for search results.
+	Snippet       *string             `json:"snippet,omitempty"`
+	Status        AssetStatus         `json:"status"`
+	Tag           string              `json:"tag"`
+	ThumbnailURL  *string             `json:"thumbnailURL,omitempty"`
+	Type          string              `json:"type"`
+	UpdatedAt     openapi_types.Date  `json:"updatedAt"`
+	WarrantyUntil *openapi_types.Date `json:"warrantyUntil,omitempty"`
 }
 
 // AssetStatus defines model for Asset.Status.
 type AssetStatus string
 
+// AssetEvent defines model for AssetEvent.
+type AssetEvent struct {
+	AssetID   int64              `json:"assetID"`
+	Changes   []AssetFieldChange `json:"changes"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        int64              `json:"id"`
+	RequestID *string            `json:"requestID,omitempty"`
+	Type      AssetEventType     `json:"type"`
+	UserID    *int64             `json:"userID,omitempty"`
+	UserName  *string            `json:"userName,omitempty"`
+}
+
+// AssetEventType defines model for AssetEvent.Type.
+type AssetEventType string
+
+// AssetEventListPage defines model for AssetEventListPage.
+type AssetEventListPage struct {
+	Events   []AssetEvent `json:"events"`
+	NumPages int          `json:"numPages"`
+	Page     int          `json:"page"`
+	PageSize int          `json:"pageSize"`
+	Total    int          `json:"total"`
+}
+
+// AssetFacets defines model for AssetFacets.
+type AssetFacets struct {
+	Facets []Facet `json:"facets"`
+}
+
+// AssetFieldChange defines model for AssetFieldChange.
+type AssetFieldChange struct {
+	After  interface{} `json:"after"`
+	Before interface{} `json:"before"`
+	Field  string      `json:"field"`
+}
+
 // AssetFile defines model for AssetFile.
 type AssetFile struct {
 	AssetID    int                `json:"assetID"`
@@ -98,6 +162,21 @@ type AssetPart struct {
 	UpdatedAt    openapi_types.Date `json:"updatedAt"`
 }
 
+// AssetPhoto defines model for AssetPhoto.
+type AssetPhoto struct {
+	AssetID      int                `json:"assetID"`
+	Caption      string             `json:"caption"`
+	CreatedAt    openapi_types.Date `json:"createdAt"`
+	FileID       int                `json:"fileID"`
+	Id           int                `json:"id"`
+	ImageURL     string             `json:"imageURL"`
+	Position     int                `json:"position"`
+	PreviewURL   string             `json:"previewURL"`
+	Primary      bool               `json:"primary"`
+	ThumbnailURL string             `json:"thumbnailURL"`
+	UpdatedAt    openapi_types.Date `json:"updatedAt"`
+}
+
 // Category defines model for Category.
 type Category struct {
 	Name string `json:"name"`
@@ -112,6 +191,31 @@ type CategoryListPage struct {
 	Total      int        `json:"total"`
 }
 
+// Checkout defines model for Checkout.
+type Checkout struct {
+	AssetID          int64               `json:"assetID"`
+	CheckInNote      *string             `json:"checkInNote,omitempty"`
+	CheckedInAt      *openapi_types.Date `json:"checkedInAt,omitempty"`
+	CheckedOutAt     openapi_types.Date  `json:"checkedOutAt"`
+	CheckedOutTo     int64               `json:"checkedOutTo"`
+	CheckedOutToName *string             `json:"checkedOutToName,omitempty"`
+	CreatedAt        openapi_types.Date  `json:"createdAt"`
+	CreatedBy        int64               `json:"createdBy"`
+	DueAt            *openapi_types.Date `json:"dueAt,omitempty"`
+	Id               int64               `json:"id"`
+	Note             *string             `json:"note,omitempty"`
+	UpdatedAt        openapi_types.Date  `json:"updatedAt"`
+}
+
+// CheckoutListPage defines model for CheckoutListPage.
+type CheckoutListPage struct {
+	Checkouts []Checkout `json:"checkouts"`
+	NumPages  int        `json:"numPages"`
+	Page      int        `json:"page"`
+	PageSize  int        `json:"pageSize"`
+	Total     int        `json:"total"`
+}
+
 // CustomAttr defines model for CustomAttr.
 type CustomAttr struct {
 	Name  string `json:"name"`
@@ -135,6 +239,23 @@ type Error struct {
 	Type   string `json:"type"`
 }
 
+// Facet defines model for Facet.
+type Facet struct {
+	// Attr Name of the custom attribute, only set for the `attr` facet.
+	Attr *string `json:"attr,omitempty"`
+
+	// Name Name of the facet and the query parameter to filter by it.
+	Name   string       `json:"name"`
+	Values []FacetValue `json:"values"`
+}
+
+// FacetValue defines model for FacetValue.
+type FacetValue struct {
+	Count    int    `json:"count"`
+	Selected bool   `json:"selected"`
+	Value    string `json:"value"`
+}
+
 // Location defines model for Location.
 type Location struct {
 	Name string `json:"name"`
@@ -198,6 +319,7 @@ type Purchase struct {
 	Amount   *int                `json:"amount,omitempty"`
 	Currency *string             `json:"currency,omitempty"`
 	Date     *openapi_types.Date `json:"date,omitempty"`
+	Id       *int64              `json:"id,omitempty"`
 	OrderNo  *string             `json:"orderNo,omitempty"`
 	Supplier *string             `json:"supplier,omitempty"`
 }
@@ -241,10 +363,14 @@ type User struct {
 	DisplayName string             `json:"displayName"`
 	Id          int64              `json:"id"`
 	IsAdmin     bool               `json:"isAdmin"`
+	Role        UserRole           `json:"role"`
 	UpdatedAt   openapi_types.Date `json:"updatedAt"`
 	Username    string             `json:"username"`
 }
 
+// UserRole defines model for User.Role.
+type UserRole string
+
 // UserListPage defines model for UserListPage.
 type UserListPage struct {
 	NumPages int    `json:"numPages"`
@@ -254,6 +380,27 @@ type UserListPage struct {
 	Users    []User `json:"users"`
 }
 
+// AssetPartRequest defines model for AssetPartRequest.
+type AssetPartRequest struct {
+	Location     *string `json:"location,omitempty"`
+	Name         string  `json:"name"`
+	Notes        *string `json:"notes,omitempty"`
+	PositionCode *string `json:"positionCode,omitempty"`
+	Tag          *string `json:"tag,omitempty"`
+}
+
+// CheckInAssetRequest defines model for CheckInAssetRequest.
+type CheckInAssetRequest struct {
+	Note *string `json:"note,omitempty"`
+}
+
+// CheckOutAssetRequest defines model for CheckOutAssetRequest.
+type CheckOutAssetRequest struct {
+	CheckedOutTo *int64              `json:"checkedOutTo,omitempty"`
+	DueAt        *openapi_types.Date `json:"dueAt,omitempty"`
+	Note         *string             `json:"note,omitempty"`
+}
+
 // CreateAssetRequest defines model for CreateAssetRequest.
 type CreateAssetRequest struct {
 	Category        *string             `json:"category,omitempty"`
@@ -279,6 +426,9 @@ type CreateAssetRequest struct {
 	WarrantyUntil   *openapi_types.Date `json:"warrantyUntil,omitempty"`
 }
 
+// PurchaseRequest defines model for PurchaseRequest.
+type PurchaseRequest = Purchase
+
 // UpdateAssetRequest defines model for UpdateAssetRequest.
 type UpdateAssetRequest struct {
 	Category        *string             `json:"category,omitempty"`
@@ -311,7 +461,43 @@ type ListAssetsParams struct {
 	Type     *string `form:"type,omitempty" json:"type,omitempty"`
 	OrderBy  *string `form:"order_by,omitempty" json:"order_by,omitempty"`
 	OrderDir *string `form:"order_dir,omitempty" json:"order_dir,omitempty"`
-	Query    *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Query Search query, e.g. `laptop OR "desk lamp" status:IN_USE warranty<2025-01-01 attr.ram>=16`.
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Status Only include assets with one of the statuses.
+	Status       *[]string `form:"status,omitempty" json:"status,omitempty"`
+	Category     *[]string `form:"category,omitempty" json:"category,omitempty"`
+	Location     *[]string `form:"location,omitempty" json:"location,omitempty"`
+	Manufacturer *[]string `form:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	Supplier     *[]string `form:"supplier,omitempty" json:"supplier,omitempty"`
+
+	// Warranty Warranty state, one of `ACTIVE`, `EXPIRED` or `NONE`.
+	Warranty *[]string `form:"warranty,omitempty" json:"warranty,omitempty"`
+
+	// Attr Custom attribute values formatted as `name:value`, e.g. `attr=ram:16`.
+	Attr *[]string `form:"attr,omitempty" json:"attr,omitempty"`
+}
+
+// ListAssetFacetsParams defines parameters for ListAssetFacets.
+type ListAssetFacetsParams struct {
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// Query Search query, same as for `/v1/assets`.
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Status Only include assets with one of the statuses.
+	Status       *[]string `form:"status,omitempty" json:"status,omitempty"`
+	Category     *[]string `form:"category,omitempty" json:"category,omitempty"`
+	Location     *[]string `form:"location,omitempty" json:"location,omitempty"`
+	Manufacturer *[]string `form:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	Supplier     *[]string `form:"supplier,omitempty" json:"supplier,omitempty"`
+
+	// Warranty Warranty state, one of `ACTIVE`, `EXPIRED` or `NONE`.
+	Warranty *[]string `form:"warranty,omitempty" json:"warranty,omitempty"`
+
+	// Attr Custom attribute values formatted as `name:value`, e.g. `attr=ram:16`.
+	Attr *[]string `form:"attr,omitempty" json:"attr,omitempty"`
 }
 
 // CreateAssetJSONBody defines parameters for CreateAsset.
@@ -369,6 +555,53 @@ type UpdateAssetJSONBody struct {
 	WarrantyUntil   *openapi_types.Date `json:"warrantyUntil,omitempty"`
 }
 
+// CheckInAssetJSONBody defines parameters for CheckInAsset.
+type CheckInAssetJSONBody struct {
+	Note *string `json:"note,omitempty"`
+}
+
+// ListAssetCheckoutsParams defines parameters for ListAssetCheckouts.
+type ListAssetCheckoutsParams struct {
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+	Page     *int `form:"page,omitempty" json:"page,omitempty"`
+}
+
+// CheckOutAssetJSONBody defines parameters for CheckOutAsset.
+type CheckOutAssetJSONBody struct {
+	CheckedOutTo *int64              `json:"checkedOutTo,omitempty"`
+	DueAt        *openapi_types.Date `json:"dueAt,omitempty"`
+	Note         *string             `json:"note,omitempty"`
+}
+
+// UploadAssetFilesMultipartBody defines parameters for UploadAssetFiles.
+type UploadAssetFilesMultipartBody struct {
+	Files *[]openapi_types.File `json:"files,omitempty"`
+}
+
+// ListAssetEventsParams defines parameters for ListAssetEvents.
+type ListAssetEventsParams struct {
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+	Page     *int `form:"page,omitempty" json:"page,omitempty"`
+}
+
+// CreateAssetPartJSONBody defines parameters for CreateAssetPart.
+type CreateAssetPartJSONBody struct {
+	Location     *string `json:"location,omitempty"`
+	Name         string  `json:"name"`
+	Notes        *string `json:"notes,omitempty"`
+	PositionCode *string `json:"positionCode,omitempty"`
+	Tag          *string `json:"tag,omitempty"`
+}
+
+// UpdateAssetPartJSONBody defines parameters for UpdateAssetPart.
+type UpdateAssetPartJSONBody struct {
+	Location     *string `json:"location,omitempty"`
+	Name         string  `json:"name"`
+	Notes        *string `json:"notes,omitempty"`
+	PositionCode *string `json:"positionCode,omitempty"`
+	Tag          *string `json:"tag,omitempty"`
+}
+
 // ListCategoriesParams defines parameters for ListCategories.
 type ListCategoriesParams struct {
 	PageSize *int    `form:"page_size,omitempty" json:"page_size,omitempty"`
@@ -432,7 +665,9 @@ type ListUsersParams struct {
 	Type     *string `form:"type,omitempty" json:"type,omitempty"`
 	OrderBy  *string `form:"order_by,omitempty" json:"order_by,omitempty"`
 	OrderDir *string `form:"order_dir,omitempty" json:"order_dir,omitempty"`
-	Query    *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Query Search query, e.g. `laptop OR "desk lamp" status:IN_USE warranty<2025-01-01 attr.ram>=16`.
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
 }
 
 // CreateAssetJSONRequestBody defines body for CreateAsset for application/json ContentType.
@@ -441,6 +676,27 @@ type CreateAssetJSONRequestBody CreateAssetJSONBody
 // UpdateAssetJSONRequestBody defines body for UpdateAsset for application/json ContentType.
 type UpdateAssetJSONRequestBody UpdateAssetJSONBody
 
+// CheckInAssetJSONRequestBody defines body for CheckInAsset for application/json ContentType.
+type CheckInAssetJSONRequestBody CheckInAssetJSONBody
+
+// CheckOutAssetJSONRequestBody defines body for CheckOutAsset for application/json ContentType.
+type CheckOutAssetJSONRequestBody CheckOutAssetJSONBody
+
+// UploadAssetFilesMultipartRequestBody defines body for UploadAssetFiles for multipart/form-data ContentType.
+type UploadAssetFilesMultipartRequestBody UploadAssetFilesMultipartBody
+
+// CreateAssetPartJSONRequestBody defines body for CreateAssetPart for application/json ContentType.
+type CreateAssetPartJSONRequestBody CreateAssetPartJSONBody
+
+// UpdateAssetPartJSONRequestBody defines body for UpdateAssetPart for application/json ContentType.
+type UpdateAssetPartJSONRequestBody UpdateAssetPartJSONBody
+
+// CreateAssetPurchaseJSONRequestBody defines body for CreateAssetPurchase for application/json ContentType.
+type CreateAssetPurchaseJSONRequestBody = Purchase
+
+// UpdateAssetPurchaseJSONRequestBody defines body for UpdateAssetPurchase for application/json ContentType.
+type UpdateAssetPurchaseJSONRequestBody = Purchase
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -450,6 +706,9 @@ type ServerInterface interface {
 	// (POST /v1/assets)
 	CreateAsset(w http.ResponseWriter, r *http.Request)
 
+	// (GET /v1/assets/facets)
+	ListAssetFacets(w http.ResponseWriter, r *http.Request, params ListAssetFacetsParams)
+
 	// (DELETE /v1/assets/{tagOrID})
 	DeleteAsset(w http.ResponseWriter, r *http.Request, tagOrID string)
 
@@ -459,6 +718,42 @@ type ServerInterface interface {
 	// (PUT /v1/assets/{tagOrID})
 	UpdateAsset(w http.ResponseWriter, r *http.Request, tagOrID string)
 
+	// (DELETE /v1/assets/{tagOrID}/checkout)
+	CheckInAsset(w http.ResponseWriter, r *http.Request, tagOrID string)
+
+	// (GET /v1/assets/{tagOrID}/checkout)
+	ListAssetCheckouts(w http.ResponseWriter, r *http.Request, tagOrID string, params ListAssetCheckoutsParams)
+
+	// (POST /v1/assets/{tagOrID}/checkout)
+	CheckOutAsset(w http.ResponseWriter, r *http.Request, tagOrID string)
+
+	// (POST /v1/assets/{tagOrID}/files)
+	UploadAssetFiles(w http.ResponseWriter, r *http.Request, tagOrID string)
+
+	// (DELETE /v1/assets/{tagOrID}/files/{fileID})
+	DeleteAssetFile(w http.ResponseWriter, r *http.Request, tagOrID string, fileID int64)
+
+	// (GET /v1/assets/{tagOrID}/history)
+	ListAssetEvents(w http.ResponseWriter, r *http.Request, tagOrID string, params ListAssetEventsParams)
+
+	// (POST /v1/assets/{tagOrID}/parts)
+	CreateAssetPart(w http.ResponseWriter, r *http.Request, tagOrID string)
+
+	// (DELETE /v1/assets/{tagOrID}/parts/{partTag})
+	DeleteAssetPart(w http.ResponseWriter, r *http.Request, tagOrID string, partTag string)
+
+	// (PUT /v1/assets/{tagOrID}/parts/{partTag})
+	UpdateAssetPart(w http.ResponseWriter, r *http.Request, tagOrID string, partTag string)
+
+	// (POST /v1/assets/{tagOrID}/purchases)
+	CreateAssetPurchase(w http.ResponseWriter, r *http.Request, tagOrID string)
+
+	// (DELETE /v1/assets/{tagOrID}/purchases/{purchaseID})
+	DeleteAssetPurchase(w http.ResponseWriter, r *http.Request, tagOrID string, purchaseID int64)
+
+	// (PUT /v1/assets/{tagOrID}/purchases/{purchaseID})
+	UpdateAssetPurchase(w http.ResponseWriter, r *http.Request, tagOrID string, purchaseID int64)
+
 	// (GET /v1/categories)
 	ListCategories(w http.ResponseWriter, r *http.Request, params ListCategoriesParams)
 
@@ -502,6 +797,8 @@ func (siw *ServerInterfaceWrapper) ListAssets(w http.ResponseWriter, r *http.Req
 
 	var err error
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params ListAssetsParams
 
@@ -553,6 +850,62 @@ func (siw *ServerInterfaceWrapper) ListAssets(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "location" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "location", r.URL.Query(), &params.Location)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "location", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "manufacturer" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "manufacturer", r.URL.Query(), &params.Manufacturer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "manufacturer", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "supplier" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "supplier", r.URL.Query(), &params.Supplier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supplier", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "warranty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "warranty", r.URL.Query(), &params.Warranty)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "warranty", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "attr" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "attr", r.URL.Query(), &params.Attr)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attr", Err: err})
+		return
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListAssets(w, r, params)
 	})
@@ -568,6 +921,8 @@ func (siw *ServerInterfaceWrapper) ListAssets(w http.ResponseWriter, r *http.Req
 func (siw *ServerInterfaceWrapper) CreateAsset(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.CreateAsset(w, r)
 	})
@@ -579,6 +934,100 @@ func (siw *ServerInterfaceWrapper) CreateAsset(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// ListAssetFacets operation middleware
+func (siw *ServerInterfaceWrapper) ListAssetFacets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAssetFacetsParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "location" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "location", r.URL.Query(), &params.Location)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "location", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "manufacturer" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "manufacturer", r.URL.Query(), &params.Manufacturer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "manufacturer", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "supplier" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "supplier", r.URL.Query(), &params.Supplier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supplier", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "warranty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "warranty", r.URL.Query(), &params.Warranty)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "warranty", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "attr" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "attr", r.URL.Query(), &params.Attr)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attr", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAssetFacets(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // DeleteAsset operation middleware
 func (siw *ServerInterfaceWrapper) DeleteAsset(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -594,6 +1043,8 @@ func (siw *ServerInterfaceWrapper) DeleteAsset(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteAsset(w, r, tagOrID)
 	})
@@ -620,6 +1071,8 @@ func (siw *ServerInterfaceWrapper) GetAsset(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetAssetParams
 
@@ -657,6 +1110,8 @@ func (siw *ServerInterfaceWrapper) UpdateAsset(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateAsset(w, r, tagOrID)
 	})
@@ -668,41 +1123,25 @@ func (siw *ServerInterfaceWrapper) UpdateAsset(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListCategories operation middleware
-func (siw *ServerInterfaceWrapper) ListCategories(w http.ResponseWriter, r *http.Request) {
+// CheckInAsset operation middleware
+func (siw *ServerInterfaceWrapper) CheckInAsset(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCategoriesParams
-
-	// ------------- Optional query parameter "page_size" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "tagOrID" -------------
+	var tagOrID string
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagOrID", runtime.ParamLocationPath, chi.URLParam(r, "tagOrID"), &tagOrID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagOrID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "query" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCategories(w, r, params)
+		siw.Handler.CheckInAsset(w, r, tagOrID)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -712,14 +1151,25 @@ func (siw *ServerInterfaceWrapper) ListCategories(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListCustomAttrs operation middleware
-func (siw *ServerInterfaceWrapper) ListCustomAttrs(w http.ResponseWriter, r *http.Request) {
+// ListAssetCheckouts operation middleware
+func (siw *ServerInterfaceWrapper) ListAssetCheckouts(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "tagOrID" -------------
+	var tagOrID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagOrID", runtime.ParamLocationPath, chi.URLParam(r, "tagOrID"), &tagOrID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagOrID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCustomAttrsParams
+	var params ListAssetCheckoutsParams
 
 	// ------------- Optional query parameter "page_size" -------------
 
@@ -737,16 +1187,36 @@ func (siw *ServerInterfaceWrapper) ListCustomAttrs(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// ------------- Optional query parameter "query" -------------
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAssetCheckouts(w, r, tagOrID, params)
+	})
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CheckOutAsset operation middleware
+func (siw *ServerInterfaceWrapper) CheckOutAsset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "tagOrID" -------------
+	var tagOrID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagOrID", runtime.ParamLocationPath, chi.URLParam(r, "tagOrID"), &tagOrID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagOrID", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCustomAttrs(w, r, params)
+		siw.Handler.CheckOutAsset(w, r, tagOrID)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -756,41 +1226,62 @@ func (siw *ServerInterfaceWrapper) ListCustomAttrs(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListLocations operation middleware
-func (siw *ServerInterfaceWrapper) ListLocations(w http.ResponseWriter, r *http.Request) {
+// UploadAssetFiles operation middleware
+func (siw *ServerInterfaceWrapper) UploadAssetFiles(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListLocationsParams
-
-	// ------------- Optional query parameter "page_size" -------------
+	// ------------- Path parameter "tagOrID" -------------
+	var tagOrID string
 
-	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagOrID", runtime.ParamLocationPath, chi.URLParam(r, "tagOrID"), &tagOrID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagOrID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadAssetFiles(w, r, tagOrID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteAssetFile operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAssetFile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "tagOrID" -------------
+	var tagOrID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagOrID", runtime.ParamLocationPath, chi.URLParam(r, "tagOrID"), &tagOrID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagOrID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "query" -------------
+	// ------------- Path parameter "fileID" -------------
+	var fileID int64
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "fileID", runtime.ParamLocationPath, chi.URLParam(r, "fileID"), &fileID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fileID", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListLocations(w, r, params)
+		siw.Handler.DeleteAssetFile(w, r, tagOrID, fileID)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -800,14 +1291,25 @@ func (siw *ServerInterfaceWrapper) ListLocations(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListPositionCodes operation middleware
-func (siw *ServerInterfaceWrapper) ListPositionCodes(w http.ResponseWriter, r *http.Request) {
+// ListAssetEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListAssetEvents(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "tagOrID" -------------
+	var tagOrID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagOrID", runtime.ParamLocationPath, chi.URLParam(r, "tagOrID"), &tagOrID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagOrID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListPositionCodesParams
+	var params ListAssetEventsParams
 
 	// ------------- Optional query parameter "page_size" -------------
 
@@ -825,16 +1327,36 @@ func (siw *ServerInterfaceWrapper) ListPositionCodes(w http.ResponseWriter, r *h
 		return
 	}
 
-	// ------------- Optional query parameter "query" -------------
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAssetEvents(w, r, tagOrID, params)
+	})
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateAssetPart operation middleware
+func (siw *ServerInterfaceWrapper) CreateAssetPart(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "tagOrID" -------------
+	var tagOrID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagOrID", runtime.ParamLocationPath, chi.URLParam(r, "tagOrID"), &tagOrID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagOrID", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPositionCodes(w, r, params)
+		siw.Handler.CreateAssetPart(w, r, tagOrID)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -844,41 +1366,71 @@ func (siw *ServerInterfaceWrapper) ListPositionCodes(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListManufacturers operation middleware
-func (siw *ServerInterfaceWrapper) ListManufacturers(w http.ResponseWriter, r *http.Request) {
+// DeleteAssetPart operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAssetPart(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListManufacturersParams
+	// ------------- Path parameter "tagOrID" -------------
+	var tagOrID string
 
-	// ------------- Optional query parameter "page_size" -------------
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagOrID", runtime.ParamLocationPath, chi.URLParam(r, "tagOrID"), &tagOrID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagOrID", Err: err})
+		return
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	// ------------- Path parameter "partTag" -------------
+	var partTag string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "partTag", runtime.ParamLocationPath, chi.URLParam(r, "partTag"), &partTag)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "partTag", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAssetPart(w, r, tagOrID, partTag)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateAssetPart operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAssetPart(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "tagOrID" -------------
+	var tagOrID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagOrID", runtime.ParamLocationPath, chi.URLParam(r, "tagOrID"), &tagOrID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagOrID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "query" -------------
+	// ------------- Path parameter "partTag" -------------
+	var partTag string
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "partTag", runtime.ParamLocationPath, chi.URLParam(r, "partTag"), &partTag)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "partTag", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListManufacturers(w, r, params)
+		siw.Handler.UpdateAssetPart(w, r, tagOrID, partTag)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -888,41 +1440,99 @@ func (siw *ServerInterfaceWrapper) ListManufacturers(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListModels operation middleware
-func (siw *ServerInterfaceWrapper) ListModels(w http.ResponseWriter, r *http.Request) {
+// CreateAssetPurchase operation middleware
+func (siw *ServerInterfaceWrapper) CreateAssetPurchase(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListModelsParams
+	// ------------- Path parameter "tagOrID" -------------
+	var tagOrID string
 
-	// ------------- Optional query parameter "page_size" -------------
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagOrID", runtime.ParamLocationPath, chi.URLParam(r, "tagOrID"), &tagOrID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagOrID", Err: err})
+		return
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAssetPurchase(w, r, tagOrID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteAssetPurchase operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAssetPurchase(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "tagOrID" -------------
+	var tagOrID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagOrID", runtime.ParamLocationPath, chi.URLParam(r, "tagOrID"), &tagOrID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagOrID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "purchaseID" -------------
+	var purchaseID int64
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "purchaseID", runtime.ParamLocationPath, chi.URLParam(r, "purchaseID"), &purchaseID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "purchaseID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "query" -------------
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAssetPurchase(w, r, tagOrID, purchaseID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateAssetPurchase operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAssetPurchase(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "tagOrID" -------------
+	var tagOrID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagOrID", runtime.ParamLocationPath, chi.URLParam(r, "tagOrID"), &tagOrID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagOrID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "purchaseID" -------------
+	var purchaseID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "purchaseID", runtime.ParamLocationPath, chi.URLParam(r, "purchaseID"), &purchaseID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "purchaseID", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListModels(w, r, params)
+		siw.Handler.UpdateAssetPurchase(w, r, tagOrID, purchaseID)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -932,14 +1542,16 @@ func (siw *ServerInterfaceWrapper) ListModels(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListSuppliers operation middleware
-func (siw *ServerInterfaceWrapper) ListSuppliers(w http.ResponseWriter, r *http.Request) {
+// ListCategories operation middleware
+func (siw *ServerInterfaceWrapper) ListCategories(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListSuppliersParams
+	var params ListCategoriesParams
 
 	// ------------- Optional query parameter "page_size" -------------
 
@@ -966,7 +1578,7 @@ func (siw *ServerInterfaceWrapper) ListSuppliers(w http.ResponseWriter, r *http.
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListSuppliers(w, r, params)
+		siw.Handler.ListCategories(w, r, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -976,14 +1588,16 @@ func (siw *ServerInterfaceWrapper) ListSuppliers(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListTags operation middleware
-func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Request) {
+// ListCustomAttrs operation middleware
+func (siw *ServerInterfaceWrapper) ListCustomAttrs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTagsParams
+	var params ListCustomAttrsParams
 
 	// ------------- Optional query parameter "page_size" -------------
 
@@ -1010,7 +1624,7 @@ func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Reque
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTags(w, r, params)
+		siw.Handler.ListCustomAttrs(w, r, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1020,14 +1634,16 @@ func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListUsers operation middleware
-func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+// ListLocations operation middleware
+func (siw *ServerInterfaceWrapper) ListLocations(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListUsersParams
+	var params ListLocationsParams
 
 	// ------------- Optional query parameter "page_size" -------------
 
@@ -1045,27 +1661,49 @@ func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// ------------- Optional query parameter "type" -------------
+	// ------------- Optional query parameter "query" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "order_by" -------------
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLocations(w, r, params)
+	})
 
-	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "order_dir" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "order_dir", r.URL.Query(), &params.OrderDir)
+// ListPositionCodes operation middleware
+func (siw *ServerInterfaceWrapper) ListPositionCodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPositionCodesParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_dir", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
@@ -1078,7 +1716,7 @@ func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Requ
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListUsers(w, r, params)
+		siw.Handler.ListPositionCodes(w, r, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1088,580 +1726,1925 @@ func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
-
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// ListManufacturers operation middleware
+func (siw *ServerInterfaceWrapper) ListManufacturers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	var err error
 
-type UnmarshallingParamError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
-func (e *UnmarshallingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshalling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListManufacturersParams
 
-func (e *UnmarshallingParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Optional query parameter "page_size" -------------
 
-type RequiredParamError struct {
-	ParamName string
-}
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// ------------- Optional query parameter "page" -------------
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// ------------- Optional query parameter "query" -------------
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListManufacturers(w, r, params)
+	})
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+// ListModels operation middleware
+func (siw *ServerInterfaceWrapper) ListModels(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	var err error
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListModelsParams
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	// ------------- Optional query parameter "page_size" -------------
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	// ------------- Optional query parameter "page" -------------
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/assets", wrapper.ListAssets)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/assets", wrapper.CreateAsset)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v1/assets/{tagOrID}", wrapper.DeleteAsset)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/assets/{tagOrID}", wrapper.GetAsset)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/v1/assets/{tagOrID}", wrapper.UpdateAsset)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/categories", wrapper.ListCategories)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/custom_attrs", wrapper.ListCustomAttrs)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/locations", wrapper.ListLocations)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/locations/position_codes", wrapper.ListPositionCodes)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/manufacturers", wrapper.ListManufacturers)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/models", wrapper.ListModels)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/suppliers", wrapper.ListSuppliers)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/tags", wrapper.ListTags)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/users", wrapper.ListUsers)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListModels(w, r, params)
 	})
 
-	return r
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListAssetsRequestObject struct {
-	Params ListAssetsParams
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type ListAssetsResponseObject interface {
-	VisitListAssetsResponse(w http.ResponseWriter) error
-}
+// ListSuppliers operation middleware
+func (siw *ServerInterfaceWrapper) ListSuppliers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type ListAssets200JSONResponse AssetListPage
+	var err error
 
-func (response ListAssets200JSONResponse) VisitListAssetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSuppliersParams
 
-type CreateAssetRequestObject struct {
-	Body *CreateAssetJSONRequestBody
-}
+	// ------------- Optional query parameter "page_size" -------------
 
-type CreateAssetResponseObject interface {
-	VisitCreateAssetResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
 
-type CreateAsset201JSONResponse Asset
+	// ------------- Optional query parameter "page" -------------
 
-func (response CreateAsset201JSONResponse) VisitCreateAssetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "query" -------------
 
-type CreateAsset400JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
 
-func (response CreateAsset400JSONResponse) VisitCreateAssetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSuppliers(w, r, params)
+	})
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateAsset401JSONResponse Error
+// ListTags operation middleware
+func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateAsset401JSONResponse) VisitCreateAssetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
-type DeleteAssetRequestObject struct {
-	TagOrID string `json:"tagOrID"`
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTagsParams
 
-type DeleteAssetResponseObject interface {
-	VisitDeleteAssetResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "page_size" -------------
 
-type DeleteAsset204Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
 
-func (response DeleteAsset204Response) VisitDeleteAssetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	// ------------- Optional query parameter "page" -------------
 
-type DeleteAsset401JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-func (response DeleteAsset401JSONResponse) VisitDeleteAssetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Optional query parameter "query" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
 
-type DeleteAsset404JSONResponse Error
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTags(w, r, params)
+	})
 
-func (response DeleteAsset404JSONResponse) VisitDeleteAssetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetAssetRequestObject struct {
-	TagOrID string `json:"tagOrID"`
-	Params  GetAssetParams
-}
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetAssetResponseObject interface {
-	VisitGetAssetResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetAsset200JSONResponse Asset
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
-func (response GetAsset200JSONResponse) VisitGetAssetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "page_size" -------------
 
-type GetAsset401JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
 
-func (response GetAsset401JSONResponse) VisitGetAssetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Optional query parameter "page" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-type GetAsset404JSONResponse Error
+	// ------------- Optional query parameter "type" -------------
 
-func (response GetAsset404JSONResponse) VisitGetAssetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "order_by" -------------
 
-type UpdateAssetRequestObject struct {
-	TagOrID string `json:"tagOrID"`
-	Body    *UpdateAssetJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
 
-type UpdateAssetResponseObject interface {
-	VisitUpdateAssetResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "order_dir" -------------
 
-type UpdateAsset200JSONResponse Asset
+	err = runtime.BindQueryParameter("form", true, false, "order_dir", r.URL.Query(), &params.OrderDir)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_dir", Err: err})
+		return
+	}
 
-func (response UpdateAsset200JSONResponse) VisitUpdateAssetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "query" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
 
-type UpdateAsset400JSONResponse Error
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUsers(w, r, params)
+	})
 
-func (response UpdateAsset400JSONResponse) VisitUpdateAssetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UpdateAsset401JSONResponse Error
-
-func (response UpdateAsset401JSONResponse) VisitUpdateAssetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type UpdateAsset404JSONResponse Error
-
-func (response UpdateAsset404JSONResponse) VisitUpdateAssetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-type ListCategoriesRequestObject struct {
-	Params ListCategoriesParams
+type UnmarshallingParamError struct {
+	ParamName string
+	Err       error
 }
 
-type ListCategoriesResponseObject interface {
-	VisitListCategoriesResponse(w http.ResponseWriter) error
+func (e *UnmarshallingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshalling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
 }
 
-type ListCategories200JSONResponse CategoryListPage
+func (e *UnmarshallingParamError) Unwrap() error {
+	return e.Err
+}
 
-func (response ListCategories200JSONResponse) VisitListCategoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type RequiredParamError struct {
+	ParamName string
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
 }
 
-type ListCustomAttrsRequestObject struct {
-	Params ListCustomAttrsParams
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
 }
 
-type ListCustomAttrsResponseObject interface {
-	VisitListCustomAttrsResponse(w http.ResponseWriter) error
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
 }
 
-type ListCustomAttrs200JSONResponse CustomAttrListPage
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
 
-func (response ListCustomAttrs200JSONResponse) VisitListCustomAttrsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
 }
 
-type ListLocationsRequestObject struct {
-	Params ListLocationsParams
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
 }
 
-type ListLocationsResponseObject interface {
-	VisitListLocationsResponse(w http.ResponseWriter) error
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
 }
 
-type ListLocations200JSONResponse LocationListPage
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
 
-func (response ListLocations200JSONResponse) VisitListLocationsResponse(w http.ResponseWriter) error {
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/assets", wrapper.ListAssets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/assets", wrapper.CreateAsset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/assets/facets", wrapper.ListAssetFacets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/assets/{tagOrID}", wrapper.DeleteAsset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/assets/{tagOrID}", wrapper.GetAsset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/assets/{tagOrID}", wrapper.UpdateAsset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/assets/{tagOrID}/checkout", wrapper.CheckInAsset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/assets/{tagOrID}/checkout", wrapper.ListAssetCheckouts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/assets/{tagOrID}/checkout", wrapper.CheckOutAsset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/assets/{tagOrID}/files", wrapper.UploadAssetFiles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/assets/{tagOrID}/files/{fileID}", wrapper.DeleteAssetFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/assets/{tagOrID}/history", wrapper.ListAssetEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/assets/{tagOrID}/parts", wrapper.CreateAssetPart)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/assets/{tagOrID}/parts/{partTag}", wrapper.DeleteAssetPart)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/assets/{tagOrID}/parts/{partTag}", wrapper.UpdateAssetPart)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/assets/{tagOrID}/purchases", wrapper.CreateAssetPurchase)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/assets/{tagOrID}/purchases/{purchaseID}", wrapper.DeleteAssetPurchase)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/assets/{tagOrID}/purchases/{purchaseID}", wrapper.UpdateAssetPurchase)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/categories", wrapper.ListCategories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/custom_attrs", wrapper.ListCustomAttrs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/locations", wrapper.ListLocations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/locations/position_codes", wrapper.ListPositionCodes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/manufacturers", wrapper.ListManufacturers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/models", wrapper.ListModels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/suppliers", wrapper.ListSuppliers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/tags", wrapper.ListTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/users", wrapper.ListUsers)
+	})
+
+	return r
+}
+
+type ListAssetsRequestObject struct {
+	Params ListAssetsParams
+}
+
+type ListAssetsResponseObject interface {
+	VisitListAssetsResponse(w http.ResponseWriter) error
+}
+
+type ListAssets200JSONResponse AssetListPage
+
+func (response ListAssets200JSONResponse) VisitListAssetsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPositionCodesRequestObject struct {
-	Params ListPositionCodesParams
+type ListAssets400JSONResponse Error
+
+func (response ListAssets400JSONResponse) VisitListAssetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPositionCodesResponseObject interface {
-	VisitListPositionCodesResponse(w http.ResponseWriter) error
+type CreateAssetRequestObject struct {
+	Body *CreateAssetJSONRequestBody
 }
 
-type ListPositionCodes200JSONResponse PositionCodeListPage
+type CreateAssetResponseObject interface {
+	VisitCreateAssetResponse(w http.ResponseWriter) error
+}
 
-func (response ListPositionCodes200JSONResponse) VisitListPositionCodesResponse(w http.ResponseWriter) error {
+type CreateAsset201JSONResponse Asset
+
+func (response CreateAsset201JSONResponse) VisitCreateAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAsset400JSONResponse Error
+
+func (response CreateAsset400JSONResponse) VisitCreateAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAsset401JSONResponse Error
+
+func (response CreateAsset401JSONResponse) VisitCreateAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAsset403JSONResponse Error
+
+func (response CreateAsset403JSONResponse) VisitCreateAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssetFacetsRequestObject struct {
+	Params ListAssetFacetsParams
+}
+
+type ListAssetFacetsResponseObject interface {
+	VisitListAssetFacetsResponse(w http.ResponseWriter) error
+}
+
+type ListAssetFacets200JSONResponse AssetFacets
+
+func (response ListAssetFacets200JSONResponse) VisitListAssetFacetsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListManufacturersRequestObject struct {
-	Params ListManufacturersParams
+type ListAssetFacets400JSONResponse Error
+
+func (response ListAssetFacets400JSONResponse) VisitListAssetFacetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListManufacturersResponseObject interface {
-	VisitListManufacturersResponse(w http.ResponseWriter) error
+type DeleteAssetRequestObject struct {
+	TagOrID string `json:"tagOrID"`
 }
 
-type ListManufacturers200JSONResponse ManufacturerListPage
+type DeleteAssetResponseObject interface {
+	VisitDeleteAssetResponse(w http.ResponseWriter) error
+}
 
-func (response ListManufacturers200JSONResponse) VisitListManufacturersResponse(w http.ResponseWriter) error {
+type DeleteAsset204Response struct {
+}
+
+func (response DeleteAsset204Response) VisitDeleteAssetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAsset401JSONResponse Error
+
+func (response DeleteAsset401JSONResponse) VisitDeleteAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAsset403JSONResponse Error
+
+func (response DeleteAsset403JSONResponse) VisitDeleteAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAsset404JSONResponse Error
+
+func (response DeleteAsset404JSONResponse) VisitDeleteAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssetRequestObject struct {
+	TagOrID string `json:"tagOrID"`
+	Params  GetAssetParams
+}
+
+type GetAssetResponseObject interface {
+	VisitGetAssetResponse(w http.ResponseWriter) error
+}
+
+type GetAsset200JSONResponse Asset
+
+func (response GetAsset200JSONResponse) VisitGetAssetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListModelsRequestObject struct {
-	Params ListModelsParams
+type GetAsset401JSONResponse Error
+
+func (response GetAsset401JSONResponse) VisitGetAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListModelsResponseObject interface {
-	VisitListModelsResponse(w http.ResponseWriter) error
+type GetAsset404JSONResponse Error
+
+func (response GetAsset404JSONResponse) VisitGetAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssetRequestObject struct {
+	TagOrID string `json:"tagOrID"`
+	Body    *UpdateAssetJSONRequestBody
+}
+
+type UpdateAssetResponseObject interface {
+	VisitUpdateAssetResponse(w http.ResponseWriter) error
+}
+
+type UpdateAsset200JSONResponse Asset
+
+func (response UpdateAsset200JSONResponse) VisitUpdateAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAsset400JSONResponse Error
+
+func (response UpdateAsset400JSONResponse) VisitUpdateAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAsset401JSONResponse Error
+
+func (response UpdateAsset401JSONResponse) VisitUpdateAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAsset403JSONResponse Error
+
+func (response UpdateAsset403JSONResponse) VisitUpdateAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAsset404JSONResponse Error
+
+func (response UpdateAsset404JSONResponse) VisitUpdateAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckInAssetRequestObject struct {
+	TagOrID string `json:"tagOrID"`
+	Body    *CheckInAssetJSONRequestBody
+}
+
+type CheckInAssetResponseObject interface {
+	VisitCheckInAssetResponse(w http.ResponseWriter) error
+}
+
+type CheckInAsset200JSONResponse Checkout
+
+func (response CheckInAsset200JSONResponse) VisitCheckInAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckInAsset401JSONResponse Error
+
+func (response CheckInAsset401JSONResponse) VisitCheckInAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckInAsset403JSONResponse Error
+
+func (response CheckInAsset403JSONResponse) VisitCheckInAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckInAsset404JSONResponse Error
+
+func (response CheckInAsset404JSONResponse) VisitCheckInAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckInAsset409JSONResponse Error
+
+func (response CheckInAsset409JSONResponse) VisitCheckInAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssetCheckoutsRequestObject struct {
+	TagOrID string `json:"tagOrID"`
+	Params  ListAssetCheckoutsParams
+}
+
+type ListAssetCheckoutsResponseObject interface {
+	VisitListAssetCheckoutsResponse(w http.ResponseWriter) error
+}
+
+type ListAssetCheckouts200JSONResponse CheckoutListPage
+
+func (response ListAssetCheckouts200JSONResponse) VisitListAssetCheckoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssetCheckouts401JSONResponse Error
+
+func (response ListAssetCheckouts401JSONResponse) VisitListAssetCheckoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssetCheckouts404JSONResponse Error
+
+func (response ListAssetCheckouts404JSONResponse) VisitListAssetCheckoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckOutAssetRequestObject struct {
+	TagOrID string `json:"tagOrID"`
+	Body    *CheckOutAssetJSONRequestBody
+}
+
+type CheckOutAssetResponseObject interface {
+	VisitCheckOutAssetResponse(w http.ResponseWriter) error
+}
+
+type CheckOutAsset201JSONResponse Checkout
+
+func (response CheckOutAsset201JSONResponse) VisitCheckOutAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckOutAsset401JSONResponse Error
+
+func (response CheckOutAsset401JSONResponse) VisitCheckOutAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckOutAsset403JSONResponse Error
+
+func (response CheckOutAsset403JSONResponse) VisitCheckOutAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckOutAsset404JSONResponse Error
+
+func (response CheckOutAsset404JSONResponse) VisitCheckOutAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckOutAsset409JSONResponse Error
+
+func (response CheckOutAsset409JSONResponse) VisitCheckOutAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadAssetFilesRequestObject struct {
+	TagOrID string `json:"tagOrID"`
+	Body    *multipart.Reader
+}
+
+type UploadAssetFilesResponseObject interface {
+	VisitUploadAssetFilesResponse(w http.ResponseWriter) error
+}
+
+type UploadAssetFiles201JSONResponse struct {
+	Files []AssetFile `json:"files"`
+}
+
+func (response UploadAssetFiles201JSONResponse) VisitUploadAssetFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadAssetFiles400JSONResponse Error
+
+func (response UploadAssetFiles400JSONResponse) VisitUploadAssetFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadAssetFiles401JSONResponse Error
+
+func (response UploadAssetFiles401JSONResponse) VisitUploadAssetFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadAssetFiles403JSONResponse Error
+
+func (response UploadAssetFiles403JSONResponse) VisitUploadAssetFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadAssetFiles404JSONResponse Error
+
+func (response UploadAssetFiles404JSONResponse) VisitUploadAssetFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssetFileRequestObject struct {
+	TagOrID string `json:"tagOrID"`
+	FileID  int64  `json:"fileID"`
+}
+
+type DeleteAssetFileResponseObject interface {
+	VisitDeleteAssetFileResponse(w http.ResponseWriter) error
+}
+
+type DeleteAssetFile204Response struct {
+}
+
+func (response DeleteAssetFile204Response) VisitDeleteAssetFileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAssetFile401JSONResponse Error
+
+func (response DeleteAssetFile401JSONResponse) VisitDeleteAssetFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssetFile403JSONResponse Error
+
+func (response DeleteAssetFile403JSONResponse) VisitDeleteAssetFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssetFile404JSONResponse Error
+
+func (response DeleteAssetFile404JSONResponse) VisitDeleteAssetFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssetEventsRequestObject struct {
+	TagOrID string `json:"tagOrID"`
+	Params  ListAssetEventsParams
+}
+
+type ListAssetEventsResponseObject interface {
+	VisitListAssetEventsResponse(w http.ResponseWriter) error
+}
+
+type ListAssetEvents200JSONResponse AssetEventListPage
+
+func (response ListAssetEvents200JSONResponse) VisitListAssetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssetEvents401JSONResponse Error
+
+func (response ListAssetEvents401JSONResponse) VisitListAssetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssetEvents404JSONResponse Error
+
+func (response ListAssetEvents404JSONResponse) VisitListAssetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssetPartRequestObject struct {
+	TagOrID string `json:"tagOrID"`
+	Body    *CreateAssetPartJSONRequestBody
+}
+
+type CreateAssetPartResponseObject interface {
+	VisitCreateAssetPartResponse(w http.ResponseWriter) error
+}
+
+type CreateAssetPart201JSONResponse AssetPart
+
+func (response CreateAssetPart201JSONResponse) VisitCreateAssetPartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssetPart401JSONResponse Error
+
+func (response CreateAssetPart401JSONResponse) VisitCreateAssetPartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssetPart403JSONResponse Error
+
+func (response CreateAssetPart403JSONResponse) VisitCreateAssetPartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssetPart404JSONResponse Error
+
+func (response CreateAssetPart404JSONResponse) VisitCreateAssetPartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssetPart409JSONResponse Error
+
+func (response CreateAssetPart409JSONResponse) VisitCreateAssetPartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssetPartRequestObject struct {
+	TagOrID string `json:"tagOrID"`
+	PartTag string `json:"partTag"`
+}
+
+type DeleteAssetPartResponseObject interface {
+	VisitDeleteAssetPartResponse(w http.ResponseWriter) error
+}
+
+type DeleteAssetPart204Response struct {
+}
+
+func (response DeleteAssetPart204Response) VisitDeleteAssetPartResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAssetPart401JSONResponse Error
+
+func (response DeleteAssetPart401JSONResponse) VisitDeleteAssetPartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssetPart403JSONResponse Error
+
+func (response DeleteAssetPart403JSONResponse) VisitDeleteAssetPartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssetPart404JSONResponse Error
+
+func (response DeleteAssetPart404JSONResponse) VisitDeleteAssetPartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssetPartRequestObject struct {
+	TagOrID string `json:"tagOrID"`
+	PartTag string `json:"partTag"`
+	Body    *UpdateAssetPartJSONRequestBody
+}
+
+type UpdateAssetPartResponseObject interface {
+	VisitUpdateAssetPartResponse(w http.ResponseWriter) error
+}
+
+type UpdateAssetPart200JSONResponse AssetPart
+
+func (response UpdateAssetPart200JSONResponse) VisitUpdateAssetPartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssetPart401JSONResponse Error
+
+func (response UpdateAssetPart401JSONResponse) VisitUpdateAssetPartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssetPart403JSONResponse Error
+
+func (response UpdateAssetPart403JSONResponse) VisitUpdateAssetPartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssetPart404JSONResponse Error
+
+func (response UpdateAssetPart404JSONResponse) VisitUpdateAssetPartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssetPart409JSONResponse Error
+
+func (response UpdateAssetPart409JSONResponse) VisitUpdateAssetPartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssetPurchaseRequestObject struct {
+	TagOrID string `json:"tagOrID"`
+	Body    *CreateAssetPurchaseJSONRequestBody
+}
+
+type CreateAssetPurchaseResponseObject interface {
+	VisitCreateAssetPurchaseResponse(w http.ResponseWriter) error
+}
+
+type CreateAssetPurchase201JSONResponse Purchase
+
+func (response CreateAssetPurchase201JSONResponse) VisitCreateAssetPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssetPurchase401JSONResponse Error
+
+func (response CreateAssetPurchase401JSONResponse) VisitCreateAssetPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssetPurchase403JSONResponse Error
+
+func (response CreateAssetPurchase403JSONResponse) VisitCreateAssetPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssetPurchase404JSONResponse Error
+
+func (response CreateAssetPurchase404JSONResponse) VisitCreateAssetPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssetPurchaseRequestObject struct {
+	TagOrID    string `json:"tagOrID"`
+	PurchaseID int64  `json:"purchaseID"`
+}
+
+type DeleteAssetPurchaseResponseObject interface {
+	VisitDeleteAssetPurchaseResponse(w http.ResponseWriter) error
+}
+
+type DeleteAssetPurchase204Response struct {
+}
+
+func (response DeleteAssetPurchase204Response) VisitDeleteAssetPurchaseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAssetPurchase401JSONResponse Error
+
+func (response DeleteAssetPurchase401JSONResponse) VisitDeleteAssetPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssetPurchase403JSONResponse Error
+
+func (response DeleteAssetPurchase403JSONResponse) VisitDeleteAssetPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssetPurchase404JSONResponse Error
+
+func (response DeleteAssetPurchase404JSONResponse) VisitDeleteAssetPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssetPurchaseRequestObject struct {
+	TagOrID    string `json:"tagOrID"`
+	PurchaseID int64  `json:"purchaseID"`
+	Body       *UpdateAssetPurchaseJSONRequestBody
+}
+
+type UpdateAssetPurchaseResponseObject interface {
+	VisitUpdateAssetPurchaseResponse(w http.ResponseWriter) error
+}
+
+type UpdateAssetPurchase200JSONResponse Purchase
+
+func (response UpdateAssetPurchase200JSONResponse) VisitUpdateAssetPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssetPurchase401JSONResponse Error
+
+func (response UpdateAssetPurchase401JSONResponse) VisitUpdateAssetPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssetPurchase403JSONResponse Error
+
+func (response UpdateAssetPurchase403JSONResponse) VisitUpdateAssetPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssetPurchase404JSONResponse Error
+
+func (response UpdateAssetPurchase404JSONResponse) VisitUpdateAssetPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCategoriesRequestObject struct {
+	Params ListCategoriesParams
+}
+
+type ListCategoriesResponseObject interface {
+	VisitListCategoriesResponse(w http.ResponseWriter) error
+}
+
+type ListCategories200JSONResponse CategoryListPage
+
+func (response ListCategories200JSONResponse) VisitListCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCustomAttrsRequestObject struct {
+	Params ListCustomAttrsParams
+}
+
+type ListCustomAttrsResponseObject interface {
+	VisitListCustomAttrsResponse(w http.ResponseWriter) error
+}
+
+type ListCustomAttrs200JSONResponse CustomAttrListPage
+
+func (response ListCustomAttrs200JSONResponse) VisitListCustomAttrsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLocationsRequestObject struct {
+	Params ListLocationsParams
+}
+
+type ListLocationsResponseObject interface {
+	VisitListLocationsResponse(w http.ResponseWriter) error
+}
+
+type ListLocations200JSONResponse LocationListPage
+
+func (response ListLocations200JSONResponse) VisitListLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPositionCodesRequestObject struct {
+	Params ListPositionCodesParams
+}
+
+type ListPositionCodesResponseObject interface {
+	VisitListPositionCodesResponse(w http.ResponseWriter) error
+}
+
+type ListPositionCodes200JSONResponse PositionCodeListPage
+
+func (response ListPositionCodes200JSONResponse) VisitListPositionCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListManufacturersRequestObject struct {
+	Params ListManufacturersParams
+}
+
+type ListManufacturersResponseObject interface {
+	VisitListManufacturersResponse(w http.ResponseWriter) error
+}
+
+type ListManufacturers200JSONResponse ManufacturerListPage
+
+func (response ListManufacturers200JSONResponse) VisitListManufacturersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListModelsRequestObject struct {
+	Params ListModelsParams
+}
+
+type ListModelsResponseObject interface {
+	VisitListModelsResponse(w http.ResponseWriter) error
+}
+
+type ListModels200JSONResponse ModelListPage
+
+func (response ListModels200JSONResponse) VisitListModelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSuppliersRequestObject struct {
+	Params ListSuppliersParams
+}
+
+type ListSuppliersResponseObject interface {
+	VisitListSuppliersResponse(w http.ResponseWriter) error
+}
+
+type ListSuppliers200JSONResponse SupplierListPage
+
+func (response ListSuppliers200JSONResponse) VisitListSuppliersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTagsRequestObject struct {
+	Params ListTagsParams
+}
+
+type ListTagsResponseObject interface {
+	VisitListTagsResponse(w http.ResponseWriter) error
+}
+
+type ListTags200JSONResponse TagListPage
+
+func (response ListTags200JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsersRequestObject struct {
+	Params ListUsersParams
+}
+
+type ListUsersResponseObject interface {
+	VisitListUsersResponse(w http.ResponseWriter) error
+}
+
+type ListUsers200JSONResponse UserListPage
+
+func (response ListUsers200JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /v1/assets)
+	ListAssets(ctx context.Context, request ListAssetsRequestObject) (ListAssetsResponseObject, error)
+
+	// (POST /v1/assets)
+	CreateAsset(ctx context.Context, request CreateAssetRequestObject) (CreateAssetResponseObject, error)
+
+	// (GET /v1/assets/facets)
+	ListAssetFacets(ctx context.Context, request ListAssetFacetsRequestObject) (ListAssetFacetsResponseObject, error)
+
+	// (DELETE /v1/assets/{tagOrID})
+	DeleteAsset(ctx context.Context, request DeleteAssetRequestObject) (DeleteAssetResponseObject, error)
+
+	// (GET /v1/assets/{tagOrID})
+	GetAsset(ctx context.Context, request GetAssetRequestObject) (GetAssetResponseObject, error)
+
+	// (PUT /v1/assets/{tagOrID})
+	UpdateAsset(ctx context.Context, request UpdateAssetRequestObject) (UpdateAssetResponseObject, error)
+
+	// (DELETE /v1/assets/{tagOrID}/checkout)
+	CheckInAsset(ctx context.Context, request CheckInAssetRequestObject) (CheckInAssetResponseObject, error)
+
+	// (GET /v1/assets/{tagOrID}/checkout)
+	ListAssetCheckouts(ctx context.Context, request ListAssetCheckoutsRequestObject) (ListAssetCheckoutsResponseObject, error)
+
+	// (POST /v1/assets/{tagOrID}/checkout)
+	CheckOutAsset(ctx context.Context, request CheckOutAssetRequestObject) (CheckOutAssetResponseObject, error)
+
+	// (POST /v1/assets/{tagOrID}/files)
+	UploadAssetFiles(ctx context.Context, request UploadAssetFilesRequestObject) (UploadAssetFilesResponseObject, error)
+
+	// (DELETE /v1/assets/{tagOrID}/files/{fileID})
+	DeleteAssetFile(ctx context.Context, request DeleteAssetFileRequestObject) (DeleteAssetFileResponseObject, error)
+
+	// (GET /v1/assets/{tagOrID}/history)
+	ListAssetEvents(ctx context.Context, request ListAssetEventsRequestObject) (ListAssetEventsResponseObject, error)
+
+	// (POST /v1/assets/{tagOrID}/parts)
+	CreateAssetPart(ctx context.Context, request CreateAssetPartRequestObject) (CreateAssetPartResponseObject, error)
+
+	// (DELETE /v1/assets/{tagOrID}/parts/{partTag})
+	DeleteAssetPart(ctx context.Context, request DeleteAssetPartRequestObject) (DeleteAssetPartResponseObject, error)
+
+	// (PUT /v1/assets/{tagOrID}/parts/{partTag})
+	UpdateAssetPart(ctx context.Context, request UpdateAssetPartRequestObject) (UpdateAssetPartResponseObject, error)
+
+	// (POST /v1/assets/{tagOrID}/purchases)
+	CreateAssetPurchase(ctx context.Context, request CreateAssetPurchaseRequestObject) (CreateAssetPurchaseResponseObject, error)
+
+	// (DELETE /v1/assets/{tagOrID}/purchases/{purchaseID})
+	DeleteAssetPurchase(ctx context.Context, request DeleteAssetPurchaseRequestObject) (DeleteAssetPurchaseResponseObject, error)
+
+	// (PUT /v1/assets/{tagOrID}/purchases/{purchaseID})
+	UpdateAssetPurchase(ctx context.Context, request UpdateAssetPurchaseRequestObject) (UpdateAssetPurchaseResponseObject, error)
+
+	// (GET /v1/categories)
+	ListCategories(ctx context.Context, request ListCategoriesRequestObject) (ListCategoriesResponseObject, error)
+
+	// (GET /v1/custom_attrs)
+	ListCustomAttrs(ctx context.Context, request ListCustomAttrsRequestObject) (ListCustomAttrsResponseObject, error)
+
+	// (GET /v1/locations)
+	ListLocations(ctx context.Context, request ListLocationsRequestObject) (ListLocationsResponseObject, error)
+
+	// (GET /v1/locations/position_codes)
+	ListPositionCodes(ctx context.Context, request ListPositionCodesRequestObject) (ListPositionCodesResponseObject, error)
+
+	// (GET /v1/manufacturers)
+	ListManufacturers(ctx context.Context, request ListManufacturersRequestObject) (ListManufacturersResponseObject, error)
+
+	// (GET /v1/models)
+	ListModels(ctx context.Context, request ListModelsRequestObject) (ListModelsResponseObject, error)
+
+	// (GET /v1/suppliers)
+	ListSuppliers(ctx context.Context, request ListSuppliersRequestObject) (ListSuppliersResponseObject, error)
+
+	// (GET /v1/tags)
+	ListTags(ctx context.Context, request ListTagsRequestObject) (ListTagsResponseObject, error)
+
+	// (GET /v1/users)
+	ListUsers(ctx context.Context, request ListUsersRequestObject) (ListUsersResponseObject, error)
+}
+
+type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error)
+
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListAssets operation middleware
+func (sh *strictHandler) ListAssets(w http.ResponseWriter, r *http.Request, params ListAssetsParams) {
+	var request ListAssetsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAssets(ctx, request.(ListAssetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAssets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAssetsResponseObject); ok {
+		if err := validResponse.VisitListAssetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// CreateAsset operation middleware
+func (sh *strictHandler) CreateAsset(w http.ResponseWriter, r *http.Request) {
+	var request CreateAssetRequestObject
+
+	var body CreateAssetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAsset(ctx, request.(CreateAssetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAsset")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAssetResponseObject); ok {
+		if err := validResponse.VisitCreateAssetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// ListAssetFacets operation middleware
+func (sh *strictHandler) ListAssetFacets(w http.ResponseWriter, r *http.Request, params ListAssetFacetsParams) {
+	var request ListAssetFacetsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAssetFacets(ctx, request.(ListAssetFacetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAssetFacets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAssetFacetsResponseObject); ok {
+		if err := validResponse.VisitListAssetFacetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// DeleteAsset operation middleware
+func (sh *strictHandler) DeleteAsset(w http.ResponseWriter, r *http.Request, tagOrID string) {
+	var request DeleteAssetRequestObject
+
+	request.TagOrID = tagOrID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAsset(ctx, request.(DeleteAssetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAsset")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAssetResponseObject); ok {
+		if err := validResponse.VisitDeleteAssetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetAsset operation middleware
+func (sh *strictHandler) GetAsset(w http.ResponseWriter, r *http.Request, tagOrID string, params GetAssetParams) {
+	var request GetAssetRequestObject
+
+	request.TagOrID = tagOrID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAsset(ctx, request.(GetAssetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAsset")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAssetResponseObject); ok {
+		if err := validResponse.VisitGetAssetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// UpdateAsset operation middleware
+func (sh *strictHandler) UpdateAsset(w http.ResponseWriter, r *http.Request, tagOrID string) {
+	var request UpdateAssetRequestObject
+
+	request.TagOrID = tagOrID
+
+	var body UpdateAssetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAsset(ctx, request.(UpdateAssetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAsset")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateAssetResponseObject); ok {
+		if err := validResponse.VisitUpdateAssetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type ListModels200JSONResponse ModelListPage
-
-func (response ListModels200JSONResponse) VisitListModelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// CheckInAsset operation middleware
+func (sh *strictHandler) CheckInAsset(w http.ResponseWriter, r *http.Request, tagOrID string) {
+	var request CheckInAssetRequestObject
 
-type ListSuppliersRequestObject struct {
-	Params ListSuppliersParams
-}
+	request.TagOrID = tagOrID
 
-type ListSuppliersResponseObject interface {
-	VisitListSuppliersResponse(w http.ResponseWriter) error
-}
+	var body CheckInAssetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ListSuppliers200JSONResponse SupplierListPage
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CheckInAsset(ctx, request.(CheckInAssetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CheckInAsset")
+	}
 
-func (response ListSuppliers200JSONResponse) VisitListSuppliersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CheckInAssetResponseObject); ok {
+		if err := validResponse.VisitCheckInAssetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type ListTagsRequestObject struct {
-	Params ListTagsParams
-}
+// ListAssetCheckouts operation middleware
+func (sh *strictHandler) ListAssetCheckouts(w http.ResponseWriter, r *http.Request, tagOrID string, params ListAssetCheckoutsParams) {
+	var request ListAssetCheckoutsRequestObject
 
-type ListTagsResponseObject interface {
-	VisitListTagsResponse(w http.ResponseWriter) error
-}
+	request.TagOrID = tagOrID
+	request.Params = params
 
-type ListTags200JSONResponse TagListPage
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAssetCheckouts(ctx, request.(ListAssetCheckoutsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAssetCheckouts")
+	}
 
-func (response ListTags200JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAssetCheckoutsResponseObject); ok {
+		if err := validResponse.VisitListAssetCheckoutsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type ListUsersRequestObject struct {
-	Params ListUsersParams
-}
+// CheckOutAsset operation middleware
+func (sh *strictHandler) CheckOutAsset(w http.ResponseWriter, r *http.Request, tagOrID string) {
+	var request CheckOutAssetRequestObject
 
-type ListUsersResponseObject interface {
-	VisitListUsersResponse(w http.ResponseWriter) error
-}
+	request.TagOrID = tagOrID
 
-type ListUsers200JSONResponse UserListPage
+	var body CheckOutAssetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response ListUsers200JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CheckOutAsset(ctx, request.(CheckOutAssetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CheckOutAsset")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CheckOutAssetResponseObject); ok {
+		if err := validResponse.VisitCheckOutAssetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+// UploadAssetFiles operation middleware
+func (sh *strictHandler) UploadAssetFiles(w http.ResponseWriter, r *http.Request, tagOrID string) {
+	var request UploadAssetFilesRequestObject
 
-	// (GET /v1/assets)
-	ListAssets(ctx context.Context, request ListAssetsRequestObject) (ListAssetsResponseObject, error)
+	request.TagOrID = tagOrID
 
-	// (POST /v1/assets)
-	CreateAsset(ctx context.Context, request CreateAssetRequestObject) (CreateAssetResponseObject, error)
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
 
-	// (DELETE /v1/assets/{tagOrID})
-	DeleteAsset(ctx context.Context, request DeleteAssetRequestObject) (DeleteAssetResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadAssetFiles(ctx, request.(UploadAssetFilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadAssetFiles")
+	}
 
-	// (GET /v1/assets/{tagOrID})
-	GetAsset(ctx context.Context, request GetAssetRequestObject) (GetAssetResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (PUT /v1/assets/{tagOrID})
-	UpdateAsset(ctx context.Context, request UpdateAssetRequestObject) (UpdateAssetResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadAssetFilesResponseObject); ok {
+		if err := validResponse.VisitUploadAssetFilesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
 
-	// (GET /v1/categories)
-	ListCategories(ctx context.Context, request ListCategoriesRequestObject) (ListCategoriesResponseObject, error)
+// DeleteAssetFile operation middleware
+func (sh *strictHandler) DeleteAssetFile(w http.ResponseWriter, r *http.Request, tagOrID string, fileID int64) {
+	var request DeleteAssetFileRequestObject
 
-	// (GET /v1/custom_attrs)
-	ListCustomAttrs(ctx context.Context, request ListCustomAttrsRequestObject) (ListCustomAttrsResponseObject, error)
+	request.TagOrID = tagOrID
+	request.FileID = fileID
 
-	// (GET /v1/locations)
-	ListLocations(ctx context.Context, request ListLocationsRequestObject) (ListLocationsResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAssetFile(ctx, request.(DeleteAssetFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAssetFile")
+	}
 
-	// (GET /v1/locations/position_codes)
-	ListPositionCodes(ctx context.Context, request ListPositionCodesRequestObject) (ListPositionCodesResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /v1/manufacturers)
-	ListManufacturers(ctx context.Context, request ListManufacturersRequestObject) (ListManufacturersResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAssetFileResponseObject); ok {
+		if err := validResponse.VisitDeleteAssetFileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
 
-	// (GET /v1/models)
-	ListModels(ctx context.Context, request ListModelsRequestObject) (ListModelsResponseObject, error)
+// ListAssetEvents operation middleware
+func (sh *strictHandler) ListAssetEvents(w http.ResponseWriter, r *http.Request, tagOrID string, params ListAssetEventsParams) {
+	var request ListAssetEventsRequestObject
 
-	// (GET /v1/suppliers)
-	ListSuppliers(ctx context.Context, request ListSuppliersRequestObject) (ListSuppliersResponseObject, error)
+	request.TagOrID = tagOrID
+	request.Params = params
 
-	// (GET /v1/tags)
-	ListTags(ctx context.Context, request ListTagsRequestObject) (ListTagsResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAssetEvents(ctx, request.(ListAssetEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAssetEvents")
+	}
 
-	// (GET /v1/users)
-	ListUsers(ctx context.Context, request ListUsersRequestObject) (ListUsersResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAssetEventsResponseObject); ok {
+		if err := validResponse.VisitListAssetEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error)
+// CreateAssetPart operation middleware
+func (sh *strictHandler) CreateAssetPart(w http.ResponseWriter, r *http.Request, tagOrID string) {
+	var request CreateAssetPartRequestObject
 
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+	request.TagOrID = tagOrID
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body CreateAssetPartJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAssetPart(ctx, request.(CreateAssetPartRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAssetPart")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAssetPartResponseObject); ok {
+		if err := validResponse.VisitCreateAssetPartResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-// ListAssets operation middleware
-func (sh *strictHandler) ListAssets(w http.ResponseWriter, r *http.Request, params ListAssetsParams) {
-	var request ListAssetsRequestObject
+// DeleteAssetPart operation middleware
+func (sh *strictHandler) DeleteAssetPart(w http.ResponseWriter, r *http.Request, tagOrID string, partTag string) {
+	var request DeleteAssetPartRequestObject
 
-	request.Params = params
+	request.TagOrID = tagOrID
+	request.PartTag = partTag
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListAssets(ctx, request.(ListAssetsRequestObject))
+		return sh.ssi.DeleteAssetPart(ctx, request.(DeleteAssetPartRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListAssets")
+		handler = middleware(handler, "DeleteAssetPart")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListAssetsResponseObject); ok {
-		if err := validResponse.VisitListAssetsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteAssetPartResponseObject); ok {
+		if err := validResponse.VisitDeleteAssetPartResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1669,11 +3652,14 @@ func (sh *strictHandler) ListAssets(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// CreateAsset operation middleware
-func (sh *strictHandler) CreateAsset(w http.ResponseWriter, r *http.Request) {
-	var request CreateAssetRequestObject
+// UpdateAssetPart operation middleware
+func (sh *strictHandler) UpdateAssetPart(w http.ResponseWriter, r *http.Request, tagOrID string, partTag string) {
+	var request UpdateAssetPartRequestObject
 
-	var body CreateAssetJSONRequestBody
+	request.TagOrID = tagOrID
+	request.PartTag = partTag
+
+	var body UpdateAssetPartJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1681,18 +3667,18 @@ func (sh *strictHandler) CreateAsset(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateAsset(ctx, request.(CreateAssetRequestObject))
+		return sh.ssi.UpdateAssetPart(ctx, request.(UpdateAssetPartRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateAsset")
+		handler = middleware(handler, "UpdateAssetPart")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateAssetResponseObject); ok {
-		if err := validResponse.VisitCreateAssetResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateAssetPartResponseObject); ok {
+		if err := validResponse.VisitUpdateAssetPartResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1700,25 +3686,32 @@ func (sh *strictHandler) CreateAsset(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteAsset operation middleware
-func (sh *strictHandler) DeleteAsset(w http.ResponseWriter, r *http.Request, tagOrID string) {
-	var request DeleteAssetRequestObject
+// CreateAssetPurchase operation middleware
+func (sh *strictHandler) CreateAssetPurchase(w http.ResponseWriter, r *http.Request, tagOrID string) {
+	var request CreateAssetPurchaseRequestObject
 
 	request.TagOrID = tagOrID
 
+	var body CreateAssetPurchaseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteAsset(ctx, request.(DeleteAssetRequestObject))
+		return sh.ssi.CreateAssetPurchase(ctx, request.(CreateAssetPurchaseRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteAsset")
+		handler = middleware(handler, "CreateAssetPurchase")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteAssetResponseObject); ok {
-		if err := validResponse.VisitDeleteAssetResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateAssetPurchaseResponseObject); ok {
+		if err := validResponse.VisitCreateAssetPurchaseResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1726,26 +3719,26 @@ func (sh *strictHandler) DeleteAsset(w http.ResponseWriter, r *http.Request, tag
 	}
 }
 
-// GetAsset operation middleware
-func (sh *strictHandler) GetAsset(w http.ResponseWriter, r *http.Request, tagOrID string, params GetAssetParams) {
-	var request GetAssetRequestObject
+// DeleteAssetPurchase operation middleware
+func (sh *strictHandler) DeleteAssetPurchase(w http.ResponseWriter, r *http.Request, tagOrID string, purchaseID int64) {
+	var request DeleteAssetPurchaseRequestObject
 
 	request.TagOrID = tagOrID
-	request.Params = params
+	request.PurchaseID = purchaseID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAsset(ctx, request.(GetAssetRequestObject))
+		return sh.ssi.DeleteAssetPurchase(ctx, request.(DeleteAssetPurchaseRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAsset")
+		handler = middleware(handler, "DeleteAssetPurchase")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAssetResponseObject); ok {
-		if err := validResponse.VisitGetAssetResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteAssetPurchaseResponseObject); ok {
+		if err := validResponse.VisitDeleteAssetPurchaseResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1753,13 +3746,14 @@ func (sh *strictHandler) GetAsset(w http.ResponseWriter, r *http.Request, tagOrI
 	}
 }
 
-// UpdateAsset operation middleware
-func (sh *strictHandler) UpdateAsset(w http.ResponseWriter, r *http.Request, tagOrID string) {
-	var request UpdateAssetRequestObject
+// UpdateAssetPurchase operation middleware
+func (sh *strictHandler) UpdateAssetPurchase(w http.ResponseWriter, r *http.Request, tagOrID string, purchaseID int64) {
+	var request UpdateAssetPurchaseRequestObject
 
 	request.TagOrID = tagOrID
+	request.PurchaseID = purchaseID
 
-	var body UpdateAssetJSONRequestBody
+	var body UpdateAssetPurchaseJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1767,18 +3761,18 @@ func (sh *strictHandler) UpdateAsset(w http.ResponseWriter, r *http.Request, tag
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateAsset(ctx, request.(UpdateAssetRequestObject))
+		return sh.ssi.UpdateAssetPurchase(ctx, request.(UpdateAssetPurchaseRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateAsset")
+		handler = middleware(handler, "UpdateAssetPurchase")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateAssetResponseObject); ok {
-		if err := validResponse.VisitUpdateAssetResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateAssetPurchaseResponseObject); ok {
+		if err := validResponse.VisitUpdateAssetPurchaseResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -0,0 +1,315 @@
+package apiv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/server/session"
+	"github.com/RobinThrift/stuff/storage/blobs"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/sqlite"
+	"github.com/go-chi/chi/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_AssetParts(t *testing.T) {
+	tr := newTestRouter(t)
+	asset := tr.createAsset(t, "PARTS")
+
+	var first, second AssetPart
+
+	res := tr.do(t, http.MethodPost, "/v1/assets/PARTS/parts", `{"name": "First"}`)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+	decode(t, res, &first)
+	assert.Equal(t, "PARTS-1", first.Tag)
+	assert.Equal(t, "First", first.Name)
+	assert.Equal(t, int(asset.ID), first.AssetID)
+
+	res = tr.do(t, http.MethodPost, "/v1/assets/PARTS/parts", `{"name": "Second"}`)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+	decode(t, res, &second)
+	assert.Equal(t, "PARTS-2", second.Tag)
+
+	t.Run("Tag In Use", func(t *testing.T) {
+		res := tr.do(t, http.MethodPost, "/v1/assets/PARTS/parts", `{"name": "Dup", "tag": "PARTS-1"}`)
+		assert.Equal(t, http.StatusConflict, res.Code, res.Body.String())
+	})
+
+	t.Run("Generated Tag After Delete", func(t *testing.T) {
+		res := tr.do(t, http.MethodDelete, "/v1/assets/PARTS/parts/PARTS-1", "")
+		require.Equal(t, http.StatusNoContent, res.Code, res.Body.String())
+
+		var third AssetPart
+		res = tr.do(t, http.MethodPost, "/v1/assets/PARTS/parts", `{"name": "Third"}`)
+		require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+		decode(t, res, &third)
+		assert.Equal(t, "PARTS-3", third.Tag)
+
+		var fourth AssetPart
+		res = tr.do(t, http.MethodPost, "/v1/assets/PARTS/parts", `{"name": "Fourth"}`)
+		require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+		decode(t, res, &fourth)
+		assert.Equal(t, "PARTS-4", fourth.Tag)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		var updated AssetPart
+		res := tr.do(t, http.MethodPut, "/v1/assets/PARTS/parts/PARTS-2", `{"name": "Second Changed", "notes": "some notes"}`)
+		require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+		decode(t, res, &updated)
+		assert.Equal(t, "PARTS-2", updated.Tag)
+		assert.Equal(t, "Second Changed", updated.Name)
+		assert.Equal(t, "some notes", valFromPtr(updated.Notes))
+	})
+
+	t.Run("Rename", func(t *testing.T) {
+		var renamed AssetPart
+		res := tr.do(t, http.MethodPut, "/v1/assets/PARTS/parts/PARTS-2", `{"name": "Second", "tag": "PARTS-RENAMED"}`)
+		require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+		decode(t, res, &renamed)
+		assert.Equal(t, "PARTS-RENAMED", renamed.Tag)
+
+		res = tr.do(t, http.MethodPut, "/v1/assets/PARTS/parts/PARTS-2", `{"name": "Second"}`)
+		assert.Equal(t, http.StatusNotFound, res.Code, res.Body.String())
+	})
+
+	t.Run("Rename To Tag In Use", func(t *testing.T) {
+		res := tr.do(t, http.MethodPut, "/v1/assets/PARTS/parts/PARTS-RENAMED", `{"name": "Second", "tag": "PARTS-3"}`)
+		assert.Equal(t, http.StatusConflict, res.Code, res.Body.String())
+
+		parts := tr.getAsset(t, asset.ID).Parts
+		tags := make([]string, 0, len(parts))
+		for _, p := range parts {
+			tags = append(tags, p.Tag)
+		}
+		assert.ElementsMatch(t, []string{"PARTS-RENAMED", "PARTS-3", "PARTS-4"}, tags)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		res := tr.do(t, http.MethodDelete, "/v1/assets/PARTS/parts/PARTS-1", "")
+		assert.Equal(t, http.StatusNotFound, res.Code, res.Body.String())
+
+		res = tr.do(t, http.MethodPost, "/v1/assets/MISSING/parts", `{"name": "First"}`)
+		assert.Equal(t, http.StatusNotFound, res.Code, res.Body.String())
+	})
+}
+
+func TestRouter_AssetPurchases(t *testing.T) {
+	tr := newTestRouter(t)
+	asset := tr.createAsset(t, "PURCHASES")
+
+	var first, second Purchase
+
+	res := tr.do(t, http.MethodPost, "/v1/assets/PURCHASES/purchases", `{"supplier": "First Shop", "amount": 1000, "currency": "EUR"}`)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+	decode(t, res, &first)
+	require.NotNil(t, first.Id)
+	assert.Equal(t, "First Shop", valFromPtr(first.Supplier))
+
+	res = tr.do(t, http.MethodPost, "/v1/assets/PURCHASES/purchases", `{"supplier": "Second Shop", "orderNo": "#2"}`)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+	decode(t, res, &second)
+	require.NotNil(t, second.Id)
+	assert.NotEqual(t, *first.Id, *second.Id)
+
+	t.Run("Update Keeps IDs", func(t *testing.T) {
+		var updated Purchase
+		res := tr.do(t, http.MethodPut, fmt.Sprintf("/v1/assets/PURCHASES/purchases/%d", *first.Id), `{"supplier": "First Shop Changed"}`)
+		require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+		decode(t, res, &updated)
+		assert.Equal(t, *first.Id, valFromPtr(updated.Id))
+		assert.Equal(t, "First Shop Changed", valFromPtr(updated.Supplier))
+
+		purchases := tr.getAsset(t, asset.ID).Purchases
+		require.Len(t, purchases, 2)
+		assert.Equal(t, *first.Id, purchases[0].ID)
+		assert.Equal(t, *second.Id, purchases[1].ID)
+		assert.Equal(t, "Second Shop", purchases[1].Supplier)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		res := tr.do(t, http.MethodDelete, fmt.Sprintf("/v1/assets/PURCHASES/purchases/%d", *first.Id), "")
+		require.Equal(t, http.StatusNoContent, res.Code, res.Body.String())
+
+		purchases := tr.getAsset(t, asset.ID).Purchases
+		require.Len(t, purchases, 1)
+		assert.Equal(t, *second.Id, purchases[0].ID)
+
+		// the deleted purchase must not be confused with the one that moved into its position
+		res = tr.do(t, http.MethodPut, fmt.Sprintf("/v1/assets/PURCHASES/purchases/%d", *first.Id), `{"supplier": "Wrong"}`)
+		assert.Equal(t, http.StatusNotFound, res.Code, res.Body.String())
+
+		res = tr.do(t, http.MethodDelete, fmt.Sprintf("/v1/assets/PURCHASES/purchases/%d", *first.Id), "")
+		assert.Equal(t, http.StatusNotFound, res.Code, res.Body.String())
+
+		assert.Equal(t, "Second Shop", tr.getAsset(t, asset.ID).Purchases[0].Supplier)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		res := tr.do(t, http.MethodPost, "/v1/assets/MISSING/purchases", `{"supplier": "Shop"}`)
+		assert.Equal(t, http.StatusNotFound, res.Code, res.Body.String())
+	})
+}
+
+func TestRouter_AssetFiles(t *testing.T) {
+	tr := newTestRouter(t)
+	asset := tr.createAsset(t, "FILES")
+	tr.createAsset(t, "OTHER")
+
+	var uploaded UploadAssetFiles201JSONResponse
+	res := tr.upload(t, "/v1/assets/FILES/files", map[string]string{"manual.pdf": "manual", "invoice.pdf": "invoice"})
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+	decode(t, res, &uploaded)
+	require.Len(t, uploaded.Files, 2)
+
+	files := map[string]AssetFile{}
+	for _, f := range uploaded.Files {
+		assert.Equal(t, int(asset.ID), f.AssetID)
+		files[f.Name] = f
+	}
+	assert.Contains(t, files, "manual.pdf")
+	assert.Contains(t, files, "invoice.pdf")
+
+	t.Run("No Files", func(t *testing.T) {
+		res := tr.upload(t, "/v1/assets/FILES/files", map[string]string{})
+		assert.Equal(t, http.StatusBadRequest, res.Code, res.Body.String())
+	})
+
+	t.Run("Asset Not Found", func(t *testing.T) {
+		res := tr.upload(t, "/v1/assets/MISSING/files", map[string]string{"manual.pdf": "manual"})
+		assert.Equal(t, http.StatusNotFound, res.Code, res.Body.String())
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		manual := files["manual.pdf"]
+
+		res := tr.do(t, http.MethodDelete, fmt.Sprintf("/v1/assets/OTHER/files/%d", manual.Id), "")
+		assert.Equal(t, http.StatusNotFound, res.Code, res.Body.String())
+
+		res = tr.do(t, http.MethodDelete, fmt.Sprintf("/v1/assets/FILES/files/%d", manual.Id), "")
+		require.Equal(t, http.StatusNoContent, res.Code, res.Body.String())
+
+		res = tr.do(t, http.MethodDelete, fmt.Sprintf("/v1/assets/FILES/files/%d", manual.Id), "")
+		assert.Equal(t, http.StatusNotFound, res.Code, res.Body.String())
+
+		_, err := tr.files.Get(tr.ctx, int64(files["invoice.pdf"].Id))
+		assert.NoError(t, err)
+	})
+}
+
+type testRouter struct {
+	ctx    context.Context
+	mux    chi.Router
+	assets *control.AssetControl
+	files  *control.FileControl
+}
+
+func newTestRouter(t *testing.T) *testRouter {
+	db, err := sqlite.NewSQLiteDB(&sqlite.Config{File: ":memory:", Timeout: time.Millisecond * 500})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if err = db.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	err = sqlite.RunMigrations(ctx, db)
+	require.NoError(t, err)
+
+	database := &database.Database{DB: bob.NewDB(db)}
+
+	user := &auth.User{Username: "api_test_user", Role: auth.RoleEditor}
+	err = (&sqlite.UserRepo{}).Create(ctx, database.DB, user)
+	require.NoError(t, err)
+
+	ctx = session.CtxWithStatelessSession(ctx)
+	session.Put(ctx, "user", user)
+
+	perms := control.NewPermissionControl(database, &sqlite.GrantRepo{}, &sqlite.AssetRepo{})
+	files := control.NewFileControl(database, perms, &sqlite.FileRepo{}, &blobs.LocalFS{RootDir: t.TempDir(), TmpDir: t.TempDir()})
+	assets := control.NewAssetControl(
+		database,
+		perms,
+		control.NewTagControl(control.TagControlConfig{Algorithm: "nanoid"}, database, &sqlite.TagRepo{}),
+		files,
+		&sqlite.AssetRepo{},
+		&sqlite.PhotoRepo{},
+		&sqlite.CheckoutRepo{},
+		&sqlite.AssetEventRepo{},
+	)
+
+	mux := chi.NewRouter()
+	NewRouter(mux, assets, files, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	return &testRouter{ctx: ctx, mux: mux, assets: assets, files: files}
+}
+
+func (tr *testRouter) createAsset(t *testing.T, tag string) *entities.Asset {
+	asset, err := tr.assets.Create(tr.ctx, control.CreateAssetCmd{Asset: &entities.Asset{
+		Type:     entities.AssetTypeAsset,
+		Status:   entities.StatusInUse,
+		Tag:      tag,
+		Name:     "Test Asset " + tag,
+		Category: "Test Category",
+		MetaInfo: entities.MetaInfo{CreatedBy: 1},
+	}})
+	require.NoError(t, err)
+	return asset
+}
+
+func (tr *testRouter) getAsset(t *testing.T, id int64) *entities.Asset {
+	asset, err := tr.assets.Get(tr.ctx, control.GetAssetQuery{ID: id, IncludeParts: true, IncludePurchases: true})
+	require.NoError(t, err)
+	return asset
+}
+
+func (tr *testRouter) do(t *testing.T, method string, path string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body)).WithContext(tr.ctx)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res := httptest.NewRecorder()
+	tr.mux.ServeHTTP(res, req)
+	return res
+}
+
+func (tr *testRouter) upload(t *testing.T, path string, files map[string]string) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for name, content := range files {
+		part, err := w.CreateFormFile("files", name)
+		require.NoError(t, err)
+		_, err = part.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	req := httptest.NewRequest(http.MethodPost, path, &body).WithContext(tr.ctx)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	res := httptest.NewRecorder()
+	tr.mux.ServeHTTP(res, req)
+	return res
+}
+
+func decode(t *testing.T, res *httptest.ResponseRecorder, v any) {
+	require.NoError(t, json.NewDecoder(res.Body).Decode(v))
+}
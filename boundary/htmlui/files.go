@@ -68,6 +68,32 @@ func handleFileUpload(r *http.Request, key string) (*entities.File, error) {
 	return nil, nil
 }
 
+func handleFileUploads(r *http.Request, key string, allowlist []string) ([]*entities.File, error) {
+	err := r.ParseMultipartForm(defaultMaxMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := r.MultipartForm.File[key]
+	files := make([]*entities.File, 0, len(headers))
+
+	for _, header := range headers {
+		err = checkFileType(header, allowlist)
+		if err != nil {
+			return nil, err
+		}
+
+		uploaded, err := header.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, &entities.File{Name: header.Filename, Reader: uploaded})
+	}
+
+	return files, nil
+}
+
 var errFileTypeNotAllowed = errors.New("file type not allowed")
 
 func checkFileType(header *multipart.FileHeader, allowlist []string) error {
@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"reflect"
 	"strconv"
-	"strings"
 
 	"github.com/go-chi/chi/v5"
 )
@@ -63,44 +62,6 @@ func setField(target reflect.Value, val string) error {
 	return nil
 }
 
-func decodeSearchQuery(queryStr string) map[string]string {
-	queryStr = strings.TrimPrefix(queryStr, "*")
-	fields := map[string]string{}
-
-	lastWordEnd := 0
-	lastNameEnd := 0
-	name := ""
-	value := ""
-	for i := 0; i < len(queryStr)-1; i++ {
-		switch queryStr[i] {
-		case ':':
-			value = queryStr[lastNameEnd:lastWordEnd]
-			if name != "" {
-				fields[strings.ToLower(name)] = value
-			}
-			if queryStr[lastWordEnd] == ' ' {
-				lastWordEnd += 1
-			}
-			name = queryStr[lastWordEnd:i]
-			lastNameEnd = i + 1
-			if i+1 < len(queryStr) && queryStr[i+1] == ' ' {
-				lastNameEnd = i + 2
-			}
-		case ' ':
-			lastWordEnd = i
-		}
-	}
-
-	if name != "" {
-		value = queryStr[lastNameEnd:]
-		if value != "" {
-			fields[strings.ToLower(name)] = queryStr[lastNameEnd:]
-		}
-	}
-
-	return fields
-}
-
 func unpackTarget(target any) reflect.Value {
 	val := reflect.ValueOf(target)
 
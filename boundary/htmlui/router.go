@@ -12,43 +12,68 @@ import (
 	"github.com/RobinThrift/stuff/auth"
 	"github.com/RobinThrift/stuff/control"
 	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/backup"
 	"github.com/go-chi/chi/v5"
 
 	"github.com/go-playground/form/v4"
 )
 
 type Router struct {
-	config   Config
-	auth     AuthCtrl
-	assets   AssetCtrl
-	files    FileCtrl
-	tags     TagCtrl
-	users    UserCtrl
-	importer ImporterCtrl
-	exporter ExporterCtrl
-	labels   LabelCtrl
-	forms    *form.Decoder
+	config     Config
+	auth       AuthCtrl
+	assets     AssetCtrl
+	files      FileCtrl
+	tags       TagCtrl
+	users      UserCtrl
+	importer   ImporterCtrl
+	exporter   ExporterCtrl
+	labels     LabelCtrl
+	backups    BackupCtrl
+	tokens     APITokenCtrl
+	perms      PermissionCtrl
+	workspaces WorkspaceCtrl
+	oidc       OIDCAuthCtrl
+	forms      *form.Decoder
+
+	savedSearches SavedSearchCtrl
 }
 
 type Config struct {
 	BaseURL          *url.URL
 	DecimalSeparator string
 	DefaultCurrency  string
-	AssetFilesDir    string
+	// AssetFiles serves the uploaded files below `/assets/files/`.
+	AssetFiles       http.Handler
+	OIDCProviderName string
 }
 
 type AssetCtrl interface {
 	Get(ctx context.Context, query control.GetAssetQuery) (*entities.Asset, error)
 	List(ctx context.Context, query control.ListAssetsQuery) (*entities.ListPage[*entities.Asset], error)
+	ListFacets(ctx context.Context, query control.ListAssetFacetsQuery) ([]*entities.Facet, error)
 	Create(ctx context.Context, cmd control.CreateAssetCmd) (*entities.Asset, error)
 	Update(ctx context.Context, cmd control.UpdateAssetCmd) (*entities.Asset, error)
 	Delete(ctx context.Context, asset *entities.Asset) error
+	Restore(ctx context.Context, id int64) (*entities.Asset, error)
+	Purge(ctx context.Context, id int64) error
+	GetOpenCheckout(ctx context.Context, assetID int64) (*entities.Checkout, error)
+	ListCheckouts(ctx context.Context, query control.ListCheckoutsQuery) (*entities.ListPage[*entities.Checkout], error)
+	CheckOut(ctx context.Context, cmd control.CheckOutAssetCmd) (*entities.Checkout, error)
+	CheckIn(ctx context.Context, cmd control.CheckInAssetCmd) (*entities.Checkout, error)
+	ListEvents(ctx context.Context, query control.ListAssetEventsQuery) (*entities.ListPage[*entities.AssetEvent], error)
+	Revert(ctx context.Context, cmd control.RevertAssetCmd) (*entities.Asset, error)
+	AddPhotos(ctx context.Context, cmd control.AddPhotosCmd) ([]*entities.Photo, error)
+	UpdatePhoto(ctx context.Context, cmd control.UpdatePhotoCmd) (*entities.Photo, error)
+	ReorderPhotos(ctx context.Context, assetID int64, photoIDs []int64) error
+	SetPrimaryPhoto(ctx context.Context, assetID int64, photoID int64) error
+	DeletePhoto(ctx context.Context, assetID int64, photoID int64) error
 }
 
 type FileCtrl interface {
 	Get(ctx context.Context, id int64) (*entities.File, error)
 	WriteFile(ctx context.Context, file *entities.File) (*entities.File, error)
 	Delete(ctx context.Context, id int64) error
+	Check(ctx context.Context, cmd control.CheckFilesCmd) (*entities.FileCheckReport, error)
 }
 
 type TagCtrl interface {
@@ -62,12 +87,19 @@ type ImporterCtrl interface {
 
 type ExporterCtrl interface {
 	Export(ctx context.Context, w io.Writer, cmd control.ExportCmd) error
+	ExportFiles(ctx context.Context, w io.Writer, cmd control.ExportFilesCmd) error
+}
+
+type BackupCtrl interface {
+	Backup(ctx context.Context, w io.Writer) (*backup.Manifest, error)
+	Status(ctx context.Context) (*entities.BackupStatus, error)
 }
 
 type UserCtrl interface {
 	List(ctx context.Context, query control.ListUsersQuery) (*entities.ListPage[*auth.User], error)
 	Update(ctx context.Context, user *auth.User) error
 	Get(ctx context.Context, id int64) (*auth.User, error)
+	GetByUsername(ctx context.Context, username string) (*auth.User, error)
 	SetUserPreferences(ctx context.Context, user *auth.User) error
 }
 
@@ -76,7 +108,7 @@ type AuthCtrl interface {
 	ChangeUserCredentials(ctx context.Context, cmd control.ChangeUserCredentialsCmd) (map[string]string, error)
 	CreateUser(ctx context.Context, cmd control.CreateUserCmd) error
 	ResetPassword(ctx context.Context, cmd control.ResetPasswordCmd) error
-	ToggleAdmin(ctx context.Context, id int64) error
+	SetUserRole(ctx context.Context, cmd control.SetUserRoleCmd) error
 	DeleteUser(ctx context.Context, userID int64) error
 }
 
@@ -84,6 +116,44 @@ type LabelCtrl interface {
 	GenerateLabelSheet(ctx context.Context, query control.GenerateLabelSheetQuery) ([]byte, error)
 }
 
+type APITokenCtrl interface {
+	List(ctx context.Context, userID int64) ([]*auth.APIToken, error)
+	Create(ctx context.Context, cmd control.CreateAPITokenCmd) (*auth.APIToken, string, map[string]string, error)
+	Delete(ctx context.Context, userID int64, id int64) error
+}
+
+type PermissionCtrl interface {
+	RequireRole(ctx context.Context, role auth.Role) error
+	ListGrants(ctx context.Context, userID int64) ([]*auth.Grant, error)
+	CreateGrant(ctx context.Context, grant *auth.Grant) (map[string]string, error)
+	DeleteGrant(ctx context.Context, userID int64, id int64) error
+}
+
+type WorkspaceCtrl interface {
+	List(ctx context.Context) ([]*entities.Workspace, error)
+	Get(ctx context.Context, id int64) (*entities.Workspace, error)
+	Create(ctx context.Context, ws *entities.Workspace) (map[string]string, error)
+	Update(ctx context.Context, ws *entities.Workspace) (map[string]string, error)
+	Delete(ctx context.Context, id int64) error
+	ListMembers(ctx context.Context, id int64) ([]*auth.User, error)
+	AddMember(ctx context.Context, id int64, userID int64) error
+	RemoveMember(ctx context.Context, id int64, userID int64) error
+}
+
+type SavedSearchCtrl interface {
+	List(ctx context.Context) ([]*entities.SavedSearch, error)
+	ListPinned(ctx context.Context, ids []int64) ([]*entities.SavedSearch, error)
+	Get(ctx context.Context, id int64) (*entities.SavedSearch, error)
+	Create(ctx context.Context, cmd control.CreateSavedSearchCmd) (*entities.SavedSearch, map[string]string, error)
+	Delete(ctx context.Context, user *auth.User, id int64) error
+}
+
+type OIDCAuthCtrl interface {
+	BeginLogin(ctx context.Context) (*auth.OIDCLoginState, string, error)
+	FinishLogin(ctx context.Context, cmd control.FinishOIDCLoginCmd) (*auth.User, error)
+}
+
+// NewRouter registers all routes of the HTML UI. oidc may be nil, if OIDC login is disabled.
 func NewRouter(
 	mux chi.Router,
 	config Config,
@@ -95,37 +165,80 @@ func NewRouter(
 	importer ImporterCtrl,
 	exporter ExporterCtrl,
 	labels LabelCtrl,
+	backups BackupCtrl,
+	tokens APITokenCtrl,
+	perms PermissionCtrl,
+	workspaces WorkspaceCtrl,
+	savedSearches SavedSearchCtrl,
+	oidc OIDCAuthCtrl,
 ) *Router {
 	r := &Router{ //nolint: varnamelen
-		config:   config,
-		auth:     auth,
-		assets:   assets,
-		files:    files,
-		tags:     tags,
-		users:    users,
-		importer: importer,
-		exporter: exporter,
-		labels:   labels,
-		forms:    newDecoder(config.DecimalSeparator),
+		config:     config,
+		auth:       auth,
+		assets:     assets,
+		files:      files,
+		tags:       tags,
+		users:      users,
+		importer:   importer,
+		exporter:   exporter,
+		labels:     labels,
+		backups:    backups,
+		tokens:     tokens,
+		perms:      perms,
+		workspaces: workspaces,
+		oidc:       oidc,
+		forms:      newDecoder(config.DecimalSeparator),
+
+		savedSearches: savedSearches,
 	}
 
+	mux = mux.With(r.pinnedSavedSearchesMiddleware)
+
 	mux.Get("/login", viewRenderHandler(r.authLoginHandler))
 	mux.Post("/login", viewRenderHandler(r.authLoginSubmitHandler))
 	mux.Get("/logout", viewRenderHandler(r.authLogoutHandler))
 	mux.Get("/auth/changepassword", viewRenderHandler(r.authChangePasswordHandler))
 	mux.Post("/auth/changepassword", viewRenderHandler(r.authChangePasswordSubmitHandler))
 
-	mux.Handle("/assets/files/*", http.StripPrefix("/assets/files/", http.FileServer(http.Dir(config.AssetFilesDir))))
+	if oidc != nil {
+		mux.Get("/auth/oidc/login", viewRenderHandler(r.authOIDCLoginHandler))
+		mux.Get("/auth/oidc/callback", viewRenderHandler(r.authOIDCCallbackHandler))
+	}
+
+	mux.Handle("/assets/files/*", http.StripPrefix("/assets/files/", config.AssetFiles))
 
 	mux.Get("/", viewRenderHandler(r.assetsListHandler))
 	mux.Get("/assets", viewRenderHandler(r.assetsListHandler))
 
 	mux.Get("/tags", viewRenderHandler(r.tagsListHandler))
 
+	mux.Get("/saved-searches", viewRenderHandler(r.savedSearchesListHandler))
+	mux.Post("/saved-searches/new", viewRenderHandler(r.savedSearchesNewSubmitHandler))
+	mux.Post("/saved-searches/{id}/pin", viewRenderHandler(r.savedSearchesPinSubmitHandler))
+	mux.Post("/saved-searches/{id}/delete", viewRenderHandler(r.savedSearchesDeleteSubmitHandler))
+
+	mux.Get("/trash", viewRenderHandler(r.trashListHandler))
+	mux.Post("/trash/{id}/restore", viewRenderHandler(r.trashRestoreSubmitHandler))
+	mux.Post("/trash/{id}/purge", viewRenderHandler(r.trashPurgeSubmitHandler))
+
+	mux.Get("/storage", viewRenderHandler(r.storageCheckHandler))
+	mux.Post("/storage/check", viewRenderHandler(r.storageCheckSubmitHandler))
+
+	mux.Get("/backups", viewRenderHandler(r.backupsHandler))
+	mux.Post("/backups/download", viewRenderHandler(r.backupsDownloadSubmitHandler))
+
 	mux.Get("/assets/{id}", viewRenderHandler(r.assetsGetHandler))
+	mux.Get("/assets/{id}/history", viewRenderHandler(r.assetsHistoryHandler))
+	mux.Post("/assets/{id}/history/{eventID}/revert", viewRenderHandler(r.assetsRevertSubmitHandler))
 	mux.Post("/assets/{id}/files", viewRenderHandler(r.assetFilesNewSubmitHandler))
+	mux.Get("/assets/{id}/files.zip", viewRenderHandler(r.exportAssetFilesHandler))
 	mux.Get("/assets/{id}/files/{fileID}/delete", viewRenderHandler(r.assetFilesDeleteHandler))
 	mux.Post("/assets/{id}/files/{fileID}/delete", viewRenderHandler(r.assetFilesDeleteSubmitHandler))
+	mux.Post("/assets/{id}/photos", viewRenderHandler(r.assetPhotosNewSubmitHandler))
+	mux.Post("/assets/{id}/photos/order", viewRenderHandler(r.assetPhotosReorderSubmitHandler))
+	mux.Post("/assets/{id}/photos/{photoID}/caption", viewRenderHandler(r.assetPhotosCaptionSubmitHandler))
+	mux.Post("/assets/{id}/photos/{photoID}/primary", viewRenderHandler(r.assetPhotosPrimarySubmitHandler))
+	mux.Post("/assets/{id}/photos/{photoID}/delete", viewRenderHandler(r.assetPhotosDeleteSubmitHandler))
 
 	mux.Get("/assets/new", viewRenderHandler(r.assetsNewHandler))
 	mux.Post("/assets/new", viewRenderHandler(r.assetsNewSubmitHandler))
@@ -136,12 +249,19 @@ func NewRouter(
 	mux.Get("/assets/{id}/delete", viewRenderHandler(r.assetsDeleteHandler))
 	mux.Post("/assets/{id}/delete", viewRenderHandler(r.assetsDeleteSubmitHandler))
 
+	mux.Get("/assets/overdue", viewRenderHandler(r.assetsOverdueHandler))
+	mux.Get("/assets/{id}/checkout", viewRenderHandler(r.assetsCheckOutHandler))
+	mux.Post("/assets/{id}/checkout", viewRenderHandler(r.assetsCheckOutSubmitHandler))
+	mux.Get("/assets/{id}/checkin", viewRenderHandler(r.assetsCheckInHandler))
+	mux.Post("/assets/{id}/checkin", viewRenderHandler(r.assetsCheckInSubmitHandler))
+
 	mux.Get("/assets/import", viewRenderHandler(r.importAssetsHandler))
 	mux.Post("/assets/import", viewRenderHandler(r.importAssetsSubmitHandler))
 
 	mux.Get("/assets/export/labels", viewRenderHandler(r.labelsHandler))
 	mux.Post("/assets/export/labels", viewRenderHandler(r.labelsSubmitHandler))
 
+	mux.Post("/assets/export/files", viewRenderHandler(r.exportFilesSubmitHandler))
 	mux.Get("/assets/export/{format}", viewRenderHandler(r.exportAssetsHandler))
 
 	mux.Get("/users", viewRenderHandler(r.usersListHandler))
@@ -152,17 +272,31 @@ func NewRouter(
 	mux.Get("/users/me", viewRenderHandler(r.usersCurrentHandler))
 	mux.Post("/users/me", viewRenderHandler(r.usersCurrentSubmitHandler))
 	mux.Get("/users/me/changepassword", viewRenderHandler(r.usersCurrentInitChangePasswordHandler))
+	mux.Post("/users/me/tokens", viewRenderHandler(r.usersCurrentTokensNewSubmitHandler))
+	mux.Post("/users/me/tokens/{id}/delete", viewRenderHandler(r.usersCurrentTokensDeleteSubmitHandler))
 
 	mux.Get("/users/{id}/reset_password", viewRenderHandler(r.usersResetPasswordHandler))
 	mux.Post("/users/{id}/reset_password", viewRenderHandler(r.usersResetPasswordSubmitHandler))
 
-	mux.Get("/users/{id}/toggle_admin", viewRenderHandler(r.usersToggleAdminHandler))
+	mux.Get("/users/{id}/permissions", viewRenderHandler(r.usersPermissionsHandler))
+	mux.Post("/users/{id}/role", viewRenderHandler(r.usersSetRoleSubmitHandler))
+	mux.Post("/users/{id}/grants", viewRenderHandler(r.usersGrantsNewSubmitHandler))
+	mux.Post("/users/{id}/grants/{grantID}/delete", viewRenderHandler(r.usersGrantsDeleteSubmitHandler))
 
 	mux.Get("/users/{id}/delete", viewRenderHandler(r.usersDeleteHandler))
 	mux.Post("/users/{id}/delete", viewRenderHandler(r.usersDeleteSubmitHandler))
 
 	mux.Post("/users/settings", r.usersSettingsSubmitHandler)
 
+	mux.Get("/workspaces", viewRenderHandler(r.workspacesListHandler))
+	mux.Post("/workspaces/new", viewRenderHandler(r.workspacesNewSubmitHandler))
+	mux.Post("/workspaces/switch", viewRenderHandler(r.workspacesSwitchSubmitHandler))
+	mux.Get("/workspaces/{id}", viewRenderHandler(r.workspacesEditHandler))
+	mux.Post("/workspaces/{id}", viewRenderHandler(r.workspacesEditSubmitHandler))
+	mux.Post("/workspaces/{id}/members", viewRenderHandler(r.workspacesMembersNewSubmitHandler))
+	mux.Post("/workspaces/{id}/members/{userID}/delete", viewRenderHandler(r.workspacesMembersDeleteSubmitHandler))
+	mux.Post("/workspaces/{id}/delete", viewRenderHandler(r.workspacesDeleteSubmitHandler))
+
 	return r
 }
 
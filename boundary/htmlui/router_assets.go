@@ -27,6 +27,9 @@ type assetsListParams struct {
 	OrderBy   string `query:"order_by"`
 	OrderDir  string `query:"order_dir"`
 	AssetType string `query:"type"`
+	// SavedSearchID is only used to show the name and actions of the saved search, the list itself is always
+	// determined by the query and filters, so the saved search can be refined without changing it.
+	SavedSearchID int64 `query:"saved_search"`
 }
 
 // [GET] /assets
@@ -35,24 +38,55 @@ func (rt *Router) assetsListHandler(w http.ResponseWriter, r *http.Request, para
 		params.PageSize = 25
 	}
 
+	filters := entities.AssetFiltersFromValues(r.URL.Query())
+	page := &pages.AssetListPage{Search: params.Query, Filters: filters}
+	assetType := entities.AssetType(strings.ToUpper(params.AssetType))
+
+	if params.SavedSearchID != 0 {
+		savedSearch, err := rt.savedSearches.Get(r.Context(), params.SavedSearchID)
+		if err != nil {
+			if errors.Is(err, control.ErrSavedSearchNotFound) {
+				return views.ErrorPageErr{Err: err, Code: http.StatusNotFound}
+			}
+			return err
+		}
+
+		page.SavedSearch = savedSearch
+	}
+
 	list, err := rt.assets.List(r.Context(), control.ListAssetsQuery{
-		SearchRaw:    params.Query,
-		SearchFields: decodeSearchQuery(params.Query),
-		Page:         params.Page,
-		PageSize:     params.PageSize,
-		OrderBy:      params.OrderBy,
-		OrderDir:     params.OrderDir,
-		AssetType:    entities.AssetType(strings.ToUpper(params.AssetType)),
+		SearchRaw: params.Query,
+		Filters:   filters,
+		Page:      params.Page,
+		PageSize:  params.PageSize,
+		OrderBy:   params.OrderBy,
+		OrderDir:  params.OrderDir,
+		AssetType: assetType,
 	})
 	if err != nil {
-		return err
+		if !errors.Is(err, control.ErrInvalidSearchQuery) {
+			return err
+		}
+
+		page.SearchErr = err.Error()
+		list = &entities.ListPage[*entities.Asset]{Items: []*entities.Asset{}, PageSize: params.PageSize}
 	}
 
-	page := &pages.AssetListPage{
-		Assets: &views.Pagination[*entities.Asset]{
-			ListPage: list,
-			URL:      r.URL,
-		},
+	if page.SearchErr == "" {
+		page.Facets, err = rt.assets.ListFacets(r.Context(), control.ListAssetFacetsQuery{
+			SearchRaw: params.Query,
+			Filters:   filters,
+			AssetType: assetType,
+			MaxValues: 10,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	page.Assets = &views.Pagination[*entities.Asset]{
+		ListPage: list,
+		URL:      r.URL,
 	}
 
 	return page.Render(w, r)
@@ -70,19 +104,96 @@ func (rt *Router) assetsGetHandler(w http.ResponseWriter, r *http.Request, param
 	query.IncludePurchases = true
 	query.IncludeChildren = true
 	query.IncludeFiles = true
+	query.IncludePhotos = true
 	asset, err := rt.assets.Get(r.Context(), query)
 	if err != nil {
 		return err
 	}
 
+	checkouts, err := rt.assets.ListCheckouts(r.Context(), control.ListCheckoutsQuery{AssetID: asset.ID, PageSize: 10})
+	if err != nil {
+		return err
+	}
+
 	page := &pages.AssetViewPage{
 		Asset:            asset,
+		Checkouts:        checkouts.Items,
 		DecimalSeparator: rt.config.DecimalSeparator,
 	}
 
 	return page.Render(w, r)
 }
 
+type assetsHistoryParams struct {
+	TagOrID  string `url:"id"`
+	Page     int    `query:"page"`
+	PageSize int    `query:"page_size"`
+}
+
+// [GET] /assets/{id}/history
+func (rt *Router) assetsHistoryHandler(w http.ResponseWriter, r *http.Request, params assetsHistoryParams) error {
+	if params.PageSize == 0 {
+		params.PageSize = 25
+	}
+
+	asset, err := rt.getAsset(r.Context(), params.TagOrID)
+	if err != nil {
+		return err
+	}
+
+	events, err := rt.assets.ListEvents(r.Context(), control.ListAssetEventsQuery{
+		AssetID:  asset.ID,
+		Page:     params.Page,
+		PageSize: params.PageSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	page := &pages.AssetHistoryPage{
+		Asset: asset,
+		Events: &views.Pagination[*entities.AssetEvent]{
+			ListPage: events,
+			URL:      r.URL,
+		},
+	}
+
+	return page.Render(w, r)
+}
+
+type assetsRevertParams struct {
+	TagOrID string `url:"id"`
+	EventID int64  `url:"eventID"`
+}
+
+// [POST] /assets/{id}/history/{eventID}/revert
+func (rt *Router) assetsRevertSubmitHandler(w http.ResponseWriter, r *http.Request, params assetsRevertParams) error {
+	asset, err := rt.getAsset(r.Context(), params.TagOrID)
+	if err != nil {
+		return err
+	}
+
+	reverted, err := rt.assets.Revert(r.Context(), control.RevertAssetCmd{AssetID: asset.ID, EventID: params.EventID})
+	if err != nil {
+		if errors.Is(err, control.ErrAssetEventNotFound) {
+			return views.ErrorPageErr{Err: err, Code: http.StatusNotFound}
+		}
+
+		if errors.Is(err, control.ErrAssetEventNotRevertable) {
+			views.SetFlashMessage(r.Context(), views.FlashMessageError, err.Error())
+			http.Redirect(w, r, fmt.Sprintf("/assets/%v/history", asset.ID), http.StatusFound)
+			return nil
+		}
+
+		return err
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, fmt.Sprintf("Asset '%s' reverted", reverted.Name))
+
+	http.Redirect(w, r, fmt.Sprintf("/assets/%v", reverted.ID), http.StatusFound)
+	return nil
+}
+
 // [POST] /assets/{id}/files
 func (rt *Router) assetFilesNewSubmitHandler(w http.ResponseWriter, r *http.Request, params assetsGetParams) error {
 	user, ok := session.Get[*auth.User](r.Context(), "user")
@@ -143,6 +254,148 @@ func (rt *Router) assetFilesDeleteSubmitHandler(w http.ResponseWriter, r *http.R
 	return nil
 }
 
+type photoParams struct {
+	TagOrID string `url:"id"`
+	PhotoID int64  `url:"photoID"`
+}
+
+// [POST] /assets/{id}/photos
+func (rt *Router) assetPhotosNewSubmitHandler(w http.ResponseWriter, r *http.Request, params assetsGetParams) error {
+	user, ok := session.Get[*auth.User](r.Context(), "user")
+	if !ok {
+		return errors.New("can't find user in session")
+	}
+
+	asset, err := rt.getAsset(r.Context(), params.TagOrID)
+	if err != nil {
+		return err
+	}
+
+	images, err := handleFileUploads(r, "photos", imgAllowList)
+	if err != nil {
+		if errors.Is(err, errFileTypeNotAllowed) {
+			views.SetFlashMessage(r.Context(), views.FlashMessageError, err.Error())
+			http.Redirect(w, r, fmt.Sprintf("/assets/%d", asset.ID), http.StatusFound)
+			return nil
+		}
+		return err
+	}
+
+	for _, image := range images {
+		image.CreatedBy = user.ID
+	}
+
+	added, err := rt.assets.AddPhotos(r.Context(), control.AddPhotosCmd{AssetID: asset.ID, Images: images})
+	if err != nil {
+		return err
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, fmt.Sprintf("Added %d photo(s)", len(added)))
+
+	http.Redirect(w, r, fmt.Sprintf("/assets/%d", asset.ID), http.StatusFound)
+	return nil
+}
+
+// [POST] /assets/{id}/photos/order
+func (rt *Router) assetPhotosReorderSubmitHandler(w http.ResponseWriter, r *http.Request, params assetsGetParams) error {
+	err := r.ParseForm()
+	if err != nil {
+		return err
+	}
+
+	asset, err := rt.getAsset(r.Context(), params.TagOrID)
+	if err != nil {
+		return err
+	}
+
+	photoIDs := make([]int64, 0, len(r.PostForm["photo_ids"]))
+	for _, v := range r.PostForm["photo_ids"] {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return views.ErrorPageErr{Err: fmt.Errorf("invalid photo id %q: %w", v, err), Code: http.StatusBadRequest}
+		}
+		photoIDs = append(photoIDs, id)
+	}
+
+	err = rt.assets.ReorderPhotos(r.Context(), asset.ID, photoIDs)
+	if err != nil {
+		return err
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/assets/%d", asset.ID), http.StatusFound)
+	return nil
+}
+
+// [POST] /assets/{id}/photos/{photoID}/caption
+func (rt *Router) assetPhotosCaptionSubmitHandler(w http.ResponseWriter, r *http.Request, params photoParams) error {
+	err := r.ParseForm()
+	if err != nil {
+		return err
+	}
+
+	asset, err := rt.getAsset(r.Context(), params.TagOrID)
+	if err != nil {
+		return err
+	}
+
+	_, err = rt.assets.UpdatePhoto(r.Context(), control.UpdatePhotoCmd{
+		AssetID: asset.ID,
+		PhotoID: params.PhotoID,
+		Caption: strings.TrimSpace(r.PostForm.Get("caption")),
+	})
+	if err != nil {
+		return photoErr(err)
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, "Updated caption")
+
+	http.Redirect(w, r, fmt.Sprintf("/assets/%d", asset.ID), http.StatusFound)
+	return nil
+}
+
+// [POST] /assets/{id}/photos/{photoID}/primary
+func (rt *Router) assetPhotosPrimarySubmitHandler(w http.ResponseWriter, r *http.Request, params photoParams) error {
+	asset, err := rt.getAsset(r.Context(), params.TagOrID)
+	if err != nil {
+		return err
+	}
+
+	err = rt.assets.SetPrimaryPhoto(r.Context(), asset.ID, params.PhotoID)
+	if err != nil {
+		return photoErr(err)
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, "Updated primary photo")
+
+	http.Redirect(w, r, fmt.Sprintf("/assets/%d", asset.ID), http.StatusFound)
+	return nil
+}
+
+// [POST] /assets/{id}/photos/{photoID}/delete
+func (rt *Router) assetPhotosDeleteSubmitHandler(w http.ResponseWriter, r *http.Request, params photoParams) error {
+	asset, err := rt.getAsset(r.Context(), params.TagOrID)
+	if err != nil {
+		return err
+	}
+
+	err = rt.assets.DeletePhoto(r.Context(), asset.ID, params.PhotoID)
+	if err != nil {
+		return photoErr(err)
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, "Deleted photo")
+
+	http.Redirect(w, r, fmt.Sprintf("/assets/%d", asset.ID), http.StatusFound)
+	return nil
+}
+
+func photoErr(err error) error {
+	if errors.Is(err, control.ErrPhotoNotFound) {
+		return views.ErrorPageErr{Err: err, Code: http.StatusNotFound}
+	}
+	return err
+}
+
 // [GET] /assets/new
 func (rt *Router) assetsNewHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
 	tag, err := rt.tags.GetNext(r.Context())
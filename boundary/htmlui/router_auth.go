@@ -1,6 +1,7 @@
 package htmlui
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -11,6 +12,7 @@ import (
 )
 
 const userForPasswordChangeKey = "user_for_password_change"
+const oidcLoginStateKey = "oidc_login_state"
 
 // [GET] /login
 func (rt *Router) authLoginHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
@@ -26,7 +28,7 @@ func (rt *Router) authLoginHandler(w http.ResponseWriter, r *http.Request, param
 		return nil
 	}
 
-	page := pages.LoginPage{ValidationErrs: map[string]string{}}
+	page := pages.LoginPage{ValidationErrs: map[string]string{}, OIDCProviderName: rt.config.OIDCProviderName}
 	return page.Render(w, r)
 }
 
@@ -44,7 +46,7 @@ func (rt *Router) authLoginSubmitHandler(w http.ResponseWriter, r *http.Request,
 		return err
 	}
 
-	page := pages.LoginPage{ValidationErrs: validationErrs}
+	page := pages.LoginPage{ValidationErrs: validationErrs, OIDCProviderName: rt.config.OIDCProviderName}
 
 	if len(validationErrs) != 0 {
 		return page.Render(w, r)
@@ -57,7 +59,71 @@ func (rt *Router) authLoginSubmitHandler(w http.ResponseWriter, r *http.Request,
 	}
 
 	session.Put(r.Context(), "user", user)
-	session.Put(r.Context(), "user_is_admin", user.IsAdmin)
+	session.Put(r.Context(), "user_is_admin", user.IsAdmin())
+
+	http.Redirect(w, r, "/", http.StatusFound)
+	return nil
+}
+
+// [GET] /auth/oidc/login
+func (rt *Router) authOIDCLoginHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
+	loginState, url, err := rt.oidc.BeginLogin(r.Context())
+	if err != nil {
+		return err
+	}
+
+	session.Put(r.Context(), oidcLoginStateKey, loginState)
+
+	http.Redirect(w, r, url, http.StatusFound)
+	return nil
+}
+
+// [GET] /auth/oidc/callback
+func (rt *Router) authOIDCCallbackHandler(w http.ResponseWriter, r *http.Request, params struct {
+	State            string `query:"state"`
+	Code             string `query:"code"`
+	Error            string `query:"error"`
+	ErrorDescription string `query:"error_description"`
+}) error {
+	loginState, _ := session.Pop[*auth.OIDCLoginState](r.Context(), oidcLoginStateKey)
+
+	page := pages.LoginPage{ValidationErrs: map[string]string{}, OIDCProviderName: rt.config.OIDCProviderName}
+
+	if params.Error != "" {
+		page.ValidationErrs["general"] = "Login failed: " + params.Error
+		if params.ErrorDescription != "" {
+			page.ValidationErrs["general"] = "Login failed: " + params.ErrorDescription
+		}
+		return page.Render(w, r)
+	}
+
+	user, err := rt.oidc.FinishLogin(r.Context(), control.FinishOIDCLoginCmd{
+		LoginState: loginState,
+		State:      params.State,
+		Code:       params.Code,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, control.ErrOIDCUserNotProvisioned):
+			page.ValidationErrs["general"] = "Your account has not been set up yet, please contact an administrator."
+		case errors.Is(err, control.ErrOIDCUsernameTaken):
+			page.ValidationErrs["general"] = "A different user with the same username already exists, please contact an administrator."
+		case errors.Is(err, auth.ErrUnauthorized):
+			page.ValidationErrs["general"] = "Login failed, please try again."
+		default:
+			return err
+		}
+
+		return page.Render(w, r)
+	}
+
+	err = session.RenewToken(r.Context())
+	if err != nil {
+		return err
+	}
+
+	session.Put(r.Context(), "user", user)
+	session.Put(r.Context(), "user_is_admin", user.IsAdmin())
 
 	http.Redirect(w, r, "/", http.StatusFound)
 	return nil
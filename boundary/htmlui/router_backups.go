@@ -0,0 +1,42 @@
+package htmlui
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/views/pages"
+)
+
+// [GET] /backups
+func (rt *Router) backupsHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
+	if !rt.requireAdmin(w, r) {
+		return nil
+	}
+
+	status, err := rt.backups.Status(r.Context())
+	if err != nil {
+		return err
+	}
+
+	page := pages.BackupsPage{Status: status}
+
+	return page.Render(w, r)
+}
+
+// [POST] /backups/download
+func (rt *Router) backupsDownloadSubmitHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
+	if !rt.requireAdmin(w, r) {
+		return nil
+	}
+
+	manifest, err := rt.backups.Backup(r.Context(), newAttachmentWriter(w, control.BackupFileName(time.Now()), "application/gzip"))
+	if err != nil {
+		return err
+	}
+
+	slog.InfoContext(r.Context(), "created backup", "files", len(manifest.Files), "migration_version", manifest.MigrationVersion)
+
+	return nil
+}
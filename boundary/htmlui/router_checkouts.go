@@ -0,0 +1,199 @@
+package htmlui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"strconv"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/server/session"
+	"github.com/RobinThrift/stuff/views"
+	"github.com/RobinThrift/stuff/views/pages"
+)
+
+type assetCheckoutParams struct {
+	TagOrID string `url:"id"`
+}
+
+// [GET] /assets/{id}/checkout
+func (rt *Router) assetsCheckOutHandler(w http.ResponseWriter, r *http.Request, params assetCheckoutParams) error {
+	user, ok := session.Get[*auth.User](r.Context(), "user")
+	if !ok {
+		return errors.New("can't find user in session")
+	}
+
+	asset, err := rt.getAsset(r.Context(), params.TagOrID)
+	if err != nil {
+		return err
+	}
+
+	page := &pages.AssetCheckOutPage{
+		Asset:          asset,
+		Checkout:       &entities.Checkout{CheckedOutTo: user.ID},
+		ValidationErrs: map[string]string{},
+	}
+
+	page.Users, err = rt.checkoutUserOptions(r.Context())
+	if err != nil {
+		return err
+	}
+
+	return page.Render(w, r)
+}
+
+// [POST] /assets/{id}/checkout
+func (rt *Router) assetsCheckOutSubmitHandler(w http.ResponseWriter, r *http.Request, params assetCheckoutParams) error {
+	user, ok := session.Get[*auth.User](r.Context(), "user")
+	if !ok {
+		return errors.New("can't find user in session")
+	}
+
+	asset, err := rt.getAsset(r.Context(), params.TagOrID)
+	if err != nil {
+		return err
+	}
+
+	page := &pages.AssetCheckOutPage{
+		Asset:          asset,
+		Checkout:       &entities.Checkout{},
+		ValidationErrs: map[string]string{},
+	}
+
+	page.Users, err = rt.checkoutUserOptions(r.Context())
+	if err != nil {
+		return err
+	}
+
+	err = rt.forms.Decode(page.Checkout, r.PostForm)
+	if err != nil {
+		return fmt.Errorf("error parsing form: %w", err)
+	}
+
+	if page.Checkout.CheckedOutTo == 0 {
+		page.ValidationErrs["checked_out_to"] = "Checked out to must not be empty"
+	}
+
+	page.Checkout.Note = html.UnescapeString(policy.Sanitize(page.Checkout.Note))
+
+	if len(page.ValidationErrs) != 0 {
+		return page.Render(w, r)
+	}
+
+	_, err = rt.assets.CheckOut(r.Context(), control.CheckOutAssetCmd{
+		AssetID:      asset.ID,
+		CheckedOutTo: page.Checkout.CheckedOutTo,
+		DueAt:        page.Checkout.DueAt,
+		Note:         page.Checkout.Note,
+		CreatedBy:    user.ID,
+	})
+	if err != nil {
+		if errors.Is(err, control.ErrAssetAlreadyCheckedOut) {
+			page.ValidationErrs["general"] = err.Error()
+			return page.Render(w, r)
+		}
+		return err
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, fmt.Sprintf("Asset '%s' checked out", asset.Name))
+
+	http.Redirect(w, r, fmt.Sprintf("/assets/%v", asset.ID), http.StatusFound)
+	return nil
+}
+
+// [GET] /assets/{id}/checkin
+func (rt *Router) assetsCheckInHandler(w http.ResponseWriter, r *http.Request, params assetCheckoutParams) error {
+	asset, err := rt.getAsset(r.Context(), params.TagOrID)
+	if err != nil {
+		return err
+	}
+
+	checkout, err := rt.assets.GetOpenCheckout(r.Context(), asset.ID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotCheckedOut) {
+			return views.ErrorPageErr{Err: err, Code: http.StatusNotFound}
+		}
+		return err
+	}
+
+	page := &pages.AssetCheckInPage{
+		Asset:          asset,
+		Checkout:       checkout,
+		ValidationErrs: map[string]string{},
+	}
+
+	return page.Render(w, r)
+}
+
+// [POST] /assets/{id}/checkin
+func (rt *Router) assetsCheckInSubmitHandler(w http.ResponseWriter, r *http.Request, params assetCheckoutParams) error {
+	asset, err := rt.getAsset(r.Context(), params.TagOrID)
+	if err != nil {
+		return err
+	}
+
+	_, err = rt.assets.CheckIn(r.Context(), control.CheckInAssetCmd{
+		AssetID: asset.ID,
+		Note:    html.UnescapeString(policy.Sanitize(r.PostForm.Get("check_in_note"))),
+	})
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotCheckedOut) {
+			return views.ErrorPageErr{Err: err, Code: http.StatusNotFound}
+		}
+		return err
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, fmt.Sprintf("Asset '%s' checked in", asset.Name))
+
+	http.Redirect(w, r, fmt.Sprintf("/assets/%v", asset.ID), http.StatusFound)
+	return nil
+}
+
+type assetsOverdueParams struct {
+	Page     int `query:"page"`
+	PageSize int `query:"page_size"`
+}
+
+// [GET] /assets/overdue
+func (rt *Router) assetsOverdueHandler(w http.ResponseWriter, r *http.Request, params assetsOverdueParams) error {
+	if params.PageSize == 0 {
+		params.PageSize = 25
+	}
+
+	list, err := rt.assets.ListCheckouts(r.Context(), control.ListCheckoutsQuery{
+		OnlyOpen:    true,
+		OnlyOverdue: true,
+		Page:        params.Page,
+		PageSize:    params.PageSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	page := &pages.AssetOverduePage{
+		Checkouts: &views.Pagination[*entities.Checkout]{
+			ListPage: list,
+			URL:      r.URL,
+		},
+	}
+
+	return page.Render(w, r)
+}
+
+func (rt *Router) checkoutUserOptions(ctx context.Context) ([][]string, error) {
+	users, err := rt.users.List(ctx, control.ListUsersQuery{PageSize: 100, OrderBy: "display_name"})
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([][]string, 0, len(users.Items))
+	for _, u := range users.Items {
+		options = append(options, []string{u.DisplayName, strconv.FormatInt(u.ID, 10)})
+	}
+
+	return options, nil
+}
@@ -1,13 +1,18 @@
 package htmlui
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/views"
 )
 
 type exportAssetsParams struct {
-	Format string `url:"format"`
+	Format        string `url:"format"`
+	SavedSearchID int64  `query:"saved_search"`
 }
 
 func (rt *Router) exportAssetsHandler(w http.ResponseWriter, r *http.Request, params exportAssetsParams) error {
@@ -20,5 +25,86 @@ func (rt *Router) exportAssetsHandler(w http.ResponseWriter, r *http.Request, pa
 		w.Header().Add("content-type", "text/csv; charset=utf-8")
 	}
 
-	return rt.exporter.Export(r.Context(), w, control.ExportCmd{Format: params.Format})
+	return rt.exporter.Export(r.Context(), w, control.ExportCmd{Format: params.Format, SavedSearchID: params.SavedSearchID})
+}
+
+type exportAssetFilesParams struct {
+	TagOrID string `url:"id"`
+}
+
+// [GET] /assets/{id}/files.zip
+func (rt *Router) exportAssetFilesHandler(w http.ResponseWriter, r *http.Request, params exportAssetFilesParams) error {
+	asset, err := rt.getAsset(r.Context(), params.TagOrID)
+	if err != nil {
+		return err
+	}
+
+	filename := fmt.Sprintf("%s_files.zip", asset.Tag)
+	if asset.Tag == "" {
+		filename = fmt.Sprintf("asset_%d_files.zip", asset.ID)
+	}
+
+	return rt.exporter.ExportFiles(r.Context(), newAttachmentWriter(w, filename, "application/zip"), control.ExportFilesCmd{
+		AssetIDs: []int64{asset.ID},
+	})
+}
+
+// [POST] /assets/export/files
+func (rt *Router) exportFilesSubmitHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
+	err := r.ParseForm()
+	if err != nil {
+		return err
+	}
+
+	ids := make([]int64, 0, len(r.PostForm["selected_asset_ids"]))
+	for _, v := range r.PostForm["selected_asset_ids"] {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return views.ErrorPageErr{Err: fmt.Errorf("invalid asset id %q: %w", v, err), Code: http.StatusBadRequest}
+		}
+		ids = append(ids, id)
+	}
+
+	var savedSearchID int64
+	if v := r.PostForm.Get("saved_search"); v != "" {
+		savedSearchID, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return views.ErrorPageErr{Err: fmt.Errorf("invalid saved search id %q: %w", v, err), Code: http.StatusBadRequest}
+		}
+	}
+
+	err = rt.exporter.ExportFiles(r.Context(), newAttachmentWriter(w, "assets_files.zip", "application/zip"), control.ExportFilesCmd{
+		AssetIDs:      ids,
+		SavedSearchID: savedSearchID,
+	})
+	if errors.Is(err, control.ErrNoAssetsSelected) {
+		views.SetFlashMessage(r.Context(), views.FlashMessageError, "Select at least one asset to download its files")
+		http.Redirect(w, r, "/assets", http.StatusFound)
+		return nil
+	}
+
+	return err
+}
+
+// attachmentWriter only sets the download headers once the first byte is written,
+// so errors occurring before that can still be rendered as a regular error page.
+type attachmentWriter struct {
+	http.ResponseWriter
+	filename    string
+	contentType string
+	started     bool
+}
+
+func newAttachmentWriter(w http.ResponseWriter, filename string, contentType string) *attachmentWriter {
+	return &attachmentWriter{ResponseWriter: w, filename: filename, contentType: contentType}
+}
+
+func (aw *attachmentWriter) Write(b []byte) (int, error) {
+	if !aw.started {
+		aw.started = true
+		aw.Header().Add("content-disposition", fmt.Sprintf("attachment; filename=%q", aw.filename))
+		aw.Header().Add("content-type", aw.contentType)
+	}
+
+	return aw.ResponseWriter.Write(b)
 }
@@ -9,13 +9,24 @@ import (
 	"github.com/RobinThrift/stuff/views/pages"
 )
 
+type labelsParams struct {
+	SavedSearchID int64 `query:"saved_search"`
+}
+
 // [GET] /assets/labels
-func (rt *Router) labelsHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
+func (rt *Router) labelsHandler(w http.ResponseWriter, r *http.Request, params labelsParams) error {
 	page := pages.LabelSheetCreatorPage{
+		SavedSearchID:  params.SavedSearchID,
 		Assets:         []*entities.Asset{},
 		ValidationErrs: map[string]string{},
 	}
 
+	var err error
+	page.SavedSearches, err = rt.savedSearches.List(r.Context())
+	if err != nil {
+		return err
+	}
+
 	return page.Render(w, r)
 }
 
@@ -31,6 +42,11 @@ func (rt *Router) labelsSubmitHandler(w http.ResponseWriter, r *http.Request, pa
 		ValidationErrs: map[string]string{},
 	}
 
+	page.SavedSearches, err = rt.savedSearches.List(r.Context())
+	if err != nil {
+		return err
+	}
+
 	err = rt.forms.Decode(&page, r.PostForm)
 	if err != nil {
 		slog.ErrorContext(r.Context(), "error decoding label creation form", "error", err)
@@ -48,8 +64,9 @@ func (rt *Router) labelsSubmitHandler(w http.ResponseWriter, r *http.Request, pa
 	}
 
 	query := control.GenerateLabelSheetQuery{
-		BaseURL: rt.config.BaseURL,
-		IDs:     page.SelectedAssetIDs,
+		BaseURL:       rt.config.BaseURL,
+		IDs:           page.SelectedAssetIDs,
+		SavedSearchID: page.SavedSearchID,
 		Sheet: &entities.Sheet{
 			SkipNumLabels: page.SkipLabels,
 			PageSize:      entities.PageSize(page.PageSize),
@@ -0,0 +1,202 @@
+package htmlui
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/server/session"
+	"github.com/RobinThrift/stuff/views"
+	"github.com/RobinThrift/stuff/views/pages"
+)
+
+// [GET] /saved-searches
+func (rt *Router) savedSearchesListHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
+	user, ok := session.Get[*auth.User](r.Context(), "user")
+	if !ok {
+		return errors.New("can't find user in session")
+	}
+
+	searches, err := rt.savedSearches.List(r.Context())
+	if err != nil {
+		return err
+	}
+
+	page := pages.SavedSearchesListPage{Searches: searches, User: user}
+
+	return page.Render(w, r)
+}
+
+// [POST] /saved-searches/new
+func (rt *Router) savedSearchesNewSubmitHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
+	user, ok := session.Get[*auth.User](r.Context(), "user")
+	if !ok {
+		return errors.New("can't find user in session")
+	}
+
+	filters, err := entities.ParseAssetFilters(r.PostForm.Get("filters"))
+	if err != nil {
+		return views.ErrorPageErr{Err: fmt.Errorf("invalid filters: %w", err), Code: http.StatusBadRequest}
+	}
+
+	search, validationErrs, err := rt.savedSearches.Create(r.Context(), control.CreateSavedSearchCmd{
+		User:    user,
+		Name:    r.PostForm.Get("name"),
+		Query:   r.PostForm.Get("query"),
+		Filters: filters,
+		Shared:  r.PostForm.Get("shared") == "on",
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(validationErrs) != 0 {
+		msgs := make([]string, 0, len(validationErrs))
+		for _, msg := range validationErrs {
+			msgs = append(msgs, msg)
+		}
+		slices.Sort(msgs)
+
+		values := url.Values(filters.Values())
+		values.Set("query", r.PostForm.Get("query"))
+
+		views.SetFlashMessage(r.Context(), views.FlashMessageError, "Error saving search: "+strings.Join(msgs, ", "))
+		http.Redirect(w, r, "/assets?"+values.Encode(), http.StatusFound)
+		return nil
+	}
+
+	if r.PostForm.Get("pin") == "on" {
+		err = rt.setSavedSearchPinned(r, user, search.ID, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, fmt.Sprintf("Saved search %s", search.Name))
+
+	http.Redirect(w, r, views.SavedSearchURL(search), http.StatusFound)
+	return nil
+}
+
+type savedSearchesParams struct {
+	ID int64 `url:"id"`
+}
+
+// [POST] /saved-searches/{id}/pin
+func (rt *Router) savedSearchesPinSubmitHandler(w http.ResponseWriter, r *http.Request, params savedSearchesParams) error {
+	user, ok := session.Get[*auth.User](r.Context(), "user")
+	if !ok {
+		return errors.New("can't find user in session")
+	}
+
+	pinned, err := strconv.ParseBool(r.PostForm.Get("pinned"))
+	if err != nil {
+		return views.ErrorPageErr{Err: fmt.Errorf("invalid value for pinned: %w", err), Code: http.StatusBadRequest}
+	}
+
+	if pinned {
+		_, err = rt.savedSearches.Get(r.Context(), params.ID)
+		if err != nil {
+			if errors.Is(err, control.ErrSavedSearchNotFound) {
+				return views.ErrorPageErr{Err: err, Code: http.StatusNotFound}
+			}
+			return err
+		}
+	}
+
+	err = rt.setSavedSearchPinned(r, user, params.ID, pinned)
+	if err != nil {
+		return err
+	}
+
+	redirectTo := r.PostForm.Get("referer")
+	if !strings.HasPrefix(redirectTo, "/") || strings.HasPrefix(redirectTo, "//") {
+		redirectTo = "/saved-searches"
+	}
+
+	http.Redirect(w, r, redirectTo, http.StatusFound)
+	return nil
+}
+
+// [POST] /saved-searches/{id}/delete
+func (rt *Router) savedSearchesDeleteSubmitHandler(w http.ResponseWriter, r *http.Request, params savedSearchesParams) error {
+	user, ok := session.Get[*auth.User](r.Context(), "user")
+	if !ok {
+		return errors.New("can't find user in session")
+	}
+
+	err := rt.savedSearches.Delete(r.Context(), user, params.ID)
+	if err != nil {
+		if !errors.Is(err, control.ErrSavedSearchNotFound) {
+			return err
+		}
+		views.SetFlashMessage(r.Context(), views.FlashMessageError, "Saved search not found")
+	} else {
+		views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, "Deleted saved search")
+	}
+
+	if slices.Contains(user.Preferences.PinnedSavedSearches, params.ID) {
+		err = rt.setSavedSearchPinned(r, user, params.ID, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	http.Redirect(w, r, "/saved-searches", http.StatusFound)
+	return nil
+}
+
+// setSavedSearchPinned adds the search to or removes it from the user's pinned searches and updates the
+// user in the session, like the other preferences.
+func (rt *Router) setSavedSearchPinned(r *http.Request, user *auth.User, id int64, pinned bool) error {
+	ids := slices.DeleteFunc(slices.Clone(user.Preferences.PinnedSavedSearches), func(pinnedID int64) bool {
+		return pinnedID == id
+	})
+
+	if pinned {
+		ids = append(ids, id)
+	}
+
+	if ids == nil {
+		ids = []int64{}
+	}
+
+	user.Preferences.PinnedSavedSearches = ids
+
+	err := rt.users.SetUserPreferences(r.Context(), user)
+	if err != nil {
+		return err
+	}
+
+	session.Put(r.Context(), "user", user)
+
+	return nil
+}
+
+// pinnedSavedSearchesMiddleware loads the pinned searches of the user, so they can be shown in the sidebar.
+func (rt *Router) pinnedSavedSearchesMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok := session.Get[*auth.User](r.Context(), "user")
+		if !ok || user == nil || len(user.Preferences.PinnedSavedSearches) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		pinned, err := rt.savedSearches.ListPinned(r.Context(), user.Preferences.PinnedSavedSearches)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error loading pinned saved searches", "error", err)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(views.WithPinnedSavedSearches(r.Context(), pinned)))
+	})
+}
@@ -0,0 +1,46 @@
+package htmlui
+
+import (
+	"net/http"
+
+	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/views/pages"
+)
+
+// [GET] /storage
+func (rt *Router) storageCheckHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
+	if !rt.requireAdmin(w, r) {
+		return nil
+	}
+
+	page := pages.StorageCheckPage{VerifyChecksums: true}
+
+	return page.Render(w, r)
+}
+
+// [POST] /storage/check
+func (rt *Router) storageCheckSubmitHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
+	if !rt.requireAdmin(w, r) {
+		return nil
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		return err
+	}
+
+	page := pages.StorageCheckPage{
+		VerifyChecksums: r.PostForm.Get("verify_checksums") == "on",
+		Repair:          r.PostForm.Get("repair") == "on",
+	}
+
+	page.Report, err = rt.files.Check(r.Context(), control.CheckFilesCmd{
+		VerifyChecksums: page.VerifyChecksums,
+		Repair:          page.Repair,
+	})
+	if err != nil {
+		return err
+	}
+
+	return page.Render(w, r)
+}
@@ -0,0 +1,104 @@
+package htmlui
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/views"
+	"github.com/RobinThrift/stuff/views/pages"
+)
+
+type trashListParams struct {
+	Page     int `query:"page"`
+	PageSize int `query:"page_size"`
+}
+
+// [GET] /trash
+func (rt *Router) trashListHandler(w http.ResponseWriter, r *http.Request, params trashListParams) error {
+	if !rt.requireAdmin(w, r) {
+		return nil
+	}
+
+	if params.PageSize == 0 {
+		params.PageSize = 25
+	}
+
+	assets, err := rt.assets.List(r.Context(), control.ListAssetsQuery{
+		OnlyDeleted: true,
+		Page:        params.Page,
+		PageSize:    params.PageSize,
+		OrderBy:     "deleted_at",
+		OrderDir:    "desc",
+	})
+	if err != nil {
+		return err
+	}
+
+	page := &pages.AssetTrashPage{
+		Assets: &views.Pagination[*entities.Asset]{
+			ListPage: assets,
+			URL:      r.URL,
+		},
+	}
+
+	return page.Render(w, r)
+}
+
+type trashItemParams struct {
+	ID int64 `url:"id"`
+}
+
+// [POST] /trash/{id}/restore
+func (rt *Router) trashRestoreSubmitHandler(w http.ResponseWriter, r *http.Request, params trashItemParams) error {
+	if !rt.requireAdmin(w, r) {
+		return nil
+	}
+
+	asset, err := rt.assets.Restore(r.Context(), params.ID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) || errors.Is(err, control.ErrAssetNotDeleted) {
+			return views.ErrorPageErr{Err: err, Code: http.StatusNotFound}
+		}
+		return err
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, fmt.Sprintf("Asset '%s' restored", asset.Name))
+
+	http.Redirect(w, r, fmt.Sprintf("/assets/%v", asset.ID), http.StatusFound)
+	return nil
+}
+
+// [POST] /trash/{id}/purge
+func (rt *Router) trashPurgeSubmitHandler(w http.ResponseWriter, r *http.Request, params trashItemParams) error {
+	if !rt.requireAdmin(w, r) {
+		return nil
+	}
+
+	err := rt.assets.Purge(r.Context(), params.ID)
+	if err != nil {
+		if errors.Is(err, control.ErrAssetNotFound) || errors.Is(err, control.ErrAssetNotDeleted) {
+			return views.ErrorPageErr{Err: err, Code: http.StatusNotFound}
+		}
+		return err
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, "Asset purged")
+
+	http.Redirect(w, r, "/trash", http.StatusFound)
+	return nil
+}
+
+// requireAdmin redirects users without the admin role to the start page. The submit handlers don't need it,
+// as the controllers enforce the role themselves.
+func (rt *Router) requireAdmin(w http.ResponseWriter, r *http.Request) bool {
+	if err := rt.perms.RequireRole(r.Context(), auth.RoleAdmin); err != nil {
+		http.Redirect(w, r, "/", http.StatusFound)
+		return false
+	}
+
+	return true
+}
@@ -48,10 +48,8 @@ func (rt *Router) usersListHandler(w http.ResponseWriter, r *http.Request, param
 
 // [GET] /users/new
 func (rt *Router) usersNewHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
-	if user, ok := session.Get[*auth.User](r.Context(), "user"); !ok {
-		return errors.New("can't find user in session")
-	} else if !user.IsAdmin {
-		http.Redirect(w, r, "/", http.StatusFound)
+	if !rt.requireAdmin(w, r) {
+		return nil
 	}
 
 	page := pages.UsersNewPage{User: &auth.User{}, ValidationErrs: map[string]string{}}
@@ -60,12 +58,6 @@ func (rt *Router) usersNewHandler(w http.ResponseWriter, r *http.Request, params
 
 // [POST] /users/new
 func (rt *Router) usersNewSubmitHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
-	if user, ok := session.Get[*auth.User](r.Context(), "user"); !ok {
-		return errors.New("can't find user in session")
-	} else if !user.IsAdmin {
-		http.Redirect(w, r, "/", http.StatusFound)
-	}
-
 	page := pages.UsersNewPage{User: &auth.User{}, ValidationErrs: map[string]string{}}
 
 	err := rt.forms.Decode(page.User, r.PostForm)
@@ -73,6 +65,8 @@ func (rt *Router) usersNewSubmitHandler(w http.ResponseWriter, r *http.Request,
 		return err
 	}
 
+	page.User.Role = auth.Role(r.PostForm.Get("role"))
+
 	if page.User.Username == "" {
 		page.ValidationErrs["username"] = control.ErrUsernameEmpty.Error()
 	}
@@ -92,6 +86,10 @@ func (rt *Router) usersNewSubmitHandler(w http.ResponseWriter, r *http.Request,
 
 	err = rt.auth.CreateUser(r.Context(), control.CreateUserCmd{User: page.User, PlaintextPasswd: initPasswd})
 	if err != nil {
+		if errors.Is(err, auth.ErrForbidden) {
+			return err
+		}
+
 		slog.ErrorContext(r.Context(), "error creating user", "error", err)
 		page.ValidationErrs["general"] = fmt.Sprintf("error creating user: %v", err)
 		return page.Render(w, r)
@@ -111,6 +109,12 @@ func (rt *Router) usersCurrentHandler(w http.ResponseWriter, r *http.Request, pa
 	}
 
 	page := pages.UsersCurrentPage{User: user, ValidationErrs: map[string]string{}}
+
+	err := rt.loadAPITokens(r, &page)
+	if err != nil {
+		return err
+	}
+
 	return page.Render(w, r)
 }
 
@@ -138,6 +142,11 @@ func (rt *Router) usersCurrentSubmitHandler(w http.ResponseWriter, r *http.Reque
 			return errors.New("can't find user in session")
 		}
 
+		err = rt.loadAPITokens(r, &page)
+		if err != nil {
+			return err
+		}
+
 		return page.Render(w, r)
 	}
 
@@ -145,6 +154,12 @@ func (rt *Router) usersCurrentSubmitHandler(w http.ResponseWriter, r *http.Reque
 	if err != nil {
 		slog.ErrorContext(r.Context(), "error creating user", "error", err)
 		page.ValidationErrs["general"] = fmt.Sprintf("error creating user: %v", err)
+
+		err = rt.loadAPITokens(r, &page)
+		if err != nil {
+			return err
+		}
+
 		return page.Render(w, r)
 	}
 
@@ -166,6 +181,101 @@ func (rt *Router) usersCurrentInitChangePasswordHandler(w http.ResponseWriter, r
 	return nil
 }
 
+// [POST] /users/me/tokens
+func (rt *Router) usersCurrentTokensNewSubmitHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
+	user, ok := session.Get[*auth.User](r.Context(), "user")
+	if !ok {
+		return errors.New("can't find user in session")
+	}
+
+	page := pages.UsersCurrentPage{
+		User:                   user,
+		ValidationErrs:         map[string]string{},
+		APITokenForm:           &auth.APIToken{},
+		APITokenValidationErrs: map[string]string{},
+	}
+
+	err := rt.forms.Decode(page.APITokenForm, r.PostForm)
+	if err != nil {
+		return fmt.Errorf("error parsing form: %w", err)
+	}
+
+	expiresAt := page.APITokenForm.ExpiresAt
+	if !expiresAt.IsZero() {
+		// the date input only has day precision, so the token stays valid for the entire day
+		expiresAt = expiresAt.AddDate(0, 0, 1)
+	}
+
+	_, plaintext, validationErrs, err := rt.tokens.Create(r.Context(), control.CreateAPITokenCmd{
+		User:      user,
+		Name:      page.APITokenForm.Name,
+		Scope:     page.APITokenForm.Scope,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error creating API token", "error", err)
+		validationErrs = map[string]string{"general": fmt.Sprintf("error creating API token: %v", err)}
+	}
+
+	if len(validationErrs) != 0 {
+		page.APITokenValidationErrs = validationErrs
+	} else {
+		page.NewAPIToken = plaintext
+		page.APITokenForm = &auth.APIToken{}
+	}
+
+	err = rt.loadAPITokens(r, &page)
+	if err != nil {
+		return err
+	}
+
+	return page.Render(w, r)
+}
+
+type usersCurrentTokensDeleteParams struct {
+	ID int64 `url:"id"`
+}
+
+// [POST] /users/me/tokens/{id}/delete
+func (rt *Router) usersCurrentTokensDeleteSubmitHandler(w http.ResponseWriter, r *http.Request, params usersCurrentTokensDeleteParams) error {
+	user, ok := session.Get[*auth.User](r.Context(), "user")
+	if !ok {
+		return errors.New("can't find user in session")
+	}
+
+	err := rt.tokens.Delete(r.Context(), user.ID, params.ID)
+	if err != nil {
+		if !errors.Is(err, control.ErrAPITokenNotFound) {
+			return err
+		}
+		views.SetFlashMessage(r.Context(), views.FlashMessageError, "API token not found")
+	} else {
+		views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, "Deleted API token")
+	}
+
+	http.Redirect(w, r, "/users/me", http.StatusFound)
+	return nil
+}
+
+func (rt *Router) loadAPITokens(r *http.Request, page *pages.UsersCurrentPage) error {
+	tokens, err := rt.tokens.List(r.Context(), page.User.ID)
+	if err != nil {
+		return err
+	}
+
+	page.APITokens = tokens
+
+	if page.APITokenForm == nil {
+		page.APITokenForm = &auth.APIToken{}
+	}
+
+	if page.APITokenValidationErrs == nil {
+		page.APITokenValidationErrs = map[string]string{}
+	}
+
+	return nil
+}
+
 type usersResetPasswordParams struct {
 	ID int64 `url:"id"`
 }
@@ -177,8 +287,8 @@ func (rt *Router) usersResetPasswordHandler(w http.ResponseWriter, r *http.Reque
 		return errors.New("can't find user in session")
 	}
 
-	if !user.IsAdmin {
-		http.Redirect(w, r, "/", http.StatusFound)
+	if !rt.requireAdmin(w, r) {
+		return nil
 	}
 
 	if params.ID == user.ID {
@@ -198,10 +308,6 @@ func (rt *Router) usersResetPasswordSubmitHandler(w http.ResponseWriter, r *http
 		return errors.New("can't find user in session")
 	}
 
-	if !user.IsAdmin {
-		http.Redirect(w, r, "/", http.StatusFound)
-	}
-
 	page := pages.AuthPasswordResetPage{ValidationErrs: map[string]string{}}
 
 	if params.ID == user.ID {
@@ -217,6 +323,10 @@ func (rt *Router) usersResetPasswordSubmitHandler(w http.ResponseWriter, r *http
 
 	err := rt.auth.ResetPassword(r.Context(), control.ResetPasswordCmd{UserID: params.ID, PlaintextPasswd: tmpPasswd})
 	if err != nil {
+		if errors.Is(err, auth.ErrForbidden) {
+			return err
+		}
+
 		page.ValidationErrs["general"] = fmt.Sprintf("error updating user: %v", err)
 		return page.Render(w, r)
 	}
@@ -232,31 +342,52 @@ func (rt *Router) usersResetPasswordSubmitHandler(w http.ResponseWriter, r *http
 	return nil
 }
 
-type usersToggleAdminParams struct {
+type usersPermissionsParams struct {
 	ID int64 `url:"id"`
 }
 
-// [GET] /users/{id}/toggle_admin
-func (rt *Router) usersToggleAdminHandler(w http.ResponseWriter, r *http.Request, params usersToggleAdminParams) error {
+// [GET] /users/{id}/permissions
+func (rt *Router) usersPermissionsHandler(w http.ResponseWriter, r *http.Request, params usersPermissionsParams) error {
+	if !rt.requireAdmin(w, r) {
+		return nil
+	}
+
+	user, err := rt.users.Get(r.Context(), params.ID)
+	if err != nil {
+		return err
+	}
+
+	page := pages.UsersPermissionsPage{User: user, GrantForm: &auth.Grant{}, ValidationErrs: map[string]string{}}
+
+	page.Grants, err = rt.perms.ListGrants(r.Context(), user.ID)
+	if err != nil {
+		return err
+	}
+
+	return page.Render(w, r)
+}
+
+// [POST] /users/{id}/role
+func (rt *Router) usersSetRoleSubmitHandler(w http.ResponseWriter, r *http.Request, params usersPermissionsParams) error {
 	user, ok := session.Get[*auth.User](r.Context(), "user")
 	if !ok {
 		return errors.New("can't find user in session")
 	}
 
-	if !user.IsAdmin {
-		http.Redirect(w, r, "/", http.StatusFound)
-	}
-
 	if params.ID == user.ID {
 		views.SetFlashMessage(r.Context(), views.FlashMessageError, "Can't change own permissions")
 		http.Redirect(w, r, "/users", http.StatusFound)
 		return nil
 	}
 
-	err := rt.auth.ToggleAdmin(r.Context(), params.ID)
+	err := rt.auth.SetUserRole(r.Context(), control.SetUserRoleCmd{UserID: params.ID, Role: auth.Role(r.PostForm.Get("role"))})
 	if err != nil {
+		if errors.Is(err, auth.ErrForbidden) {
+			return err
+		}
+
 		views.SetFlashMessage(r.Context(), views.FlashMessageError, fmt.Sprintf("Error updating user: %v", err))
-		http.Redirect(w, r, "/users", http.StatusFound)
+		http.Redirect(w, r, fmt.Sprintf("/users/%d/permissions", params.ID), http.StatusFound)
 		return nil
 	}
 
@@ -267,7 +398,61 @@ func (rt *Router) usersToggleAdminHandler(w http.ResponseWriter, r *http.Request
 
 	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, fmt.Sprintf("Updated user %s (%d)", updated.Username, updated.ID))
 
-	http.Redirect(w, r, "/users", http.StatusFound)
+	http.Redirect(w, r, fmt.Sprintf("/users/%d/permissions", params.ID), http.StatusFound)
+	return nil
+}
+
+// [POST] /users/{id}/grants
+func (rt *Router) usersGrantsNewSubmitHandler(w http.ResponseWriter, r *http.Request, params usersPermissionsParams) error {
+	user, err := rt.users.Get(r.Context(), params.ID)
+	if err != nil {
+		return err
+	}
+
+	page := pages.UsersPermissionsPage{User: user, GrantForm: &auth.Grant{}, ValidationErrs: map[string]string{}}
+
+	err = rt.forms.Decode(page.GrantForm, r.PostForm)
+	if err != nil {
+		return err
+	}
+
+	page.GrantForm.UserID = user.ID
+
+	page.ValidationErrs, err = rt.perms.CreateGrant(r.Context(), page.GrantForm)
+	if err != nil {
+		return err
+	}
+
+	if len(page.ValidationErrs) != 0 {
+		page.Grants, err = rt.perms.ListGrants(r.Context(), user.ID)
+		if err != nil {
+			return err
+		}
+
+		return page.Render(w, r)
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, "Grant added")
+
+	http.Redirect(w, r, fmt.Sprintf("/users/%d/permissions", user.ID), http.StatusFound)
+	return nil
+}
+
+type usersGrantsDeleteParams struct {
+	ID      int64 `url:"id"`
+	GrantID int64 `url:"grantID"`
+}
+
+// [POST] /users/{id}/grants/{grantID}/delete
+func (rt *Router) usersGrantsDeleteSubmitHandler(w http.ResponseWriter, r *http.Request, params usersGrantsDeleteParams) error {
+	err := rt.perms.DeleteGrant(r.Context(), params.ID, params.GrantID)
+	if err != nil {
+		return err
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, "Grant deleted")
+
+	http.Redirect(w, r, fmt.Sprintf("/users/%d/permissions", params.ID), http.StatusFound)
 	return nil
 }
 
@@ -282,8 +467,8 @@ func (rt *Router) usersDeleteHandler(w http.ResponseWriter, r *http.Request, par
 		return errors.New("can't find user in session")
 	}
 
-	if !user.IsAdmin {
-		http.Redirect(w, r, "/", http.StatusFound)
+	if !rt.requireAdmin(w, r) {
+		return nil
 	}
 
 	if params.ID == user.ID {
@@ -308,10 +493,6 @@ func (rt *Router) usersDeleteSubmitHandler(w http.ResponseWriter, r *http.Reques
 		return errors.New("can't find user in session")
 	}
 
-	if !user.IsAdmin {
-		http.Redirect(w, r, "/", http.StatusFound)
-	}
-
 	if params.ID == user.ID {
 		views.SetFlashMessage(r.Context(), views.FlashMessageError, "Can't delete self")
 		http.Redirect(w, r, "/users", http.StatusFound)
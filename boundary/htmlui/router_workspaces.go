@@ -0,0 +1,221 @@
+package htmlui
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/server/session"
+	"github.com/RobinThrift/stuff/internal/workspace"
+	"github.com/RobinThrift/stuff/views"
+	"github.com/RobinThrift/stuff/views/pages"
+)
+
+// [GET] /workspaces
+func (rt *Router) workspacesListHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
+	if !rt.requireAdmin(w, r) {
+		return nil
+	}
+
+	page := pages.WorkspacesListPage{Form: &entities.Workspace{}, ValidationErrs: map[string]string{}}
+
+	var err error
+	page.Workspaces, err = rt.workspaces.List(r.Context())
+	if err != nil {
+		return err
+	}
+
+	return page.Render(w, r)
+}
+
+// [POST] /workspaces/new
+func (rt *Router) workspacesNewSubmitHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
+	page := pages.WorkspacesListPage{Form: &entities.Workspace{}, ValidationErrs: map[string]string{}}
+
+	err := rt.forms.Decode(page.Form, r.PostForm)
+	if err != nil {
+		return err
+	}
+
+	page.ValidationErrs, err = rt.workspaces.Create(r.Context(), page.Form)
+	if err != nil {
+		return err
+	}
+
+	if len(page.ValidationErrs) != 0 {
+		page.Workspaces, err = rt.workspaces.List(r.Context())
+		if err != nil {
+			return err
+		}
+
+		return page.Render(w, r)
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, fmt.Sprintf("Created workspace %s", page.Form.Name))
+
+	http.Redirect(w, r, fmt.Sprintf("/workspaces/%d", page.Form.ID), http.StatusFound)
+	return nil
+}
+
+type workspacesEditParams struct {
+	ID int64 `url:"id"`
+}
+
+// [GET] /workspaces/{id}
+func (rt *Router) workspacesEditHandler(w http.ResponseWriter, r *http.Request, params workspacesEditParams) error {
+	if !rt.requireAdmin(w, r) {
+		return nil
+	}
+
+	ws, err := rt.workspaces.Get(r.Context(), params.ID)
+	if err != nil {
+		return err
+	}
+
+	page := pages.WorkspacesEditPage{Workspace: ws, ValidationErrs: map[string]string{}, MemberValidationErrs: map[string]string{}}
+
+	page.Members, err = rt.workspaces.ListMembers(r.Context(), ws.ID)
+	if err != nil {
+		return err
+	}
+
+	return page.Render(w, r)
+}
+
+// [POST] /workspaces/{id}
+func (rt *Router) workspacesEditSubmitHandler(w http.ResponseWriter, r *http.Request, params workspacesEditParams) error {
+	ws, err := rt.workspaces.Get(r.Context(), params.ID)
+	if err != nil {
+		return err
+	}
+
+	page := pages.WorkspacesEditPage{Workspace: ws, ValidationErrs: map[string]string{}, MemberValidationErrs: map[string]string{}}
+
+	err = rt.forms.Decode(page.Workspace, r.PostForm)
+	if err != nil {
+		return err
+	}
+
+	page.ValidationErrs, err = rt.workspaces.Update(r.Context(), page.Workspace)
+	if err != nil {
+		return err
+	}
+
+	if len(page.ValidationErrs) != 0 {
+		page.Members, err = rt.workspaces.ListMembers(r.Context(), ws.ID)
+		if err != nil {
+			return err
+		}
+
+		return page.Render(w, r)
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, fmt.Sprintf("Updated workspace %s", page.Workspace.Name))
+
+	http.Redirect(w, r, fmt.Sprintf("/workspaces/%d", ws.ID), http.StatusFound)
+	return nil
+}
+
+// [POST] /workspaces/{id}/members
+func (rt *Router) workspacesMembersNewSubmitHandler(w http.ResponseWriter, r *http.Request, params workspacesEditParams) error {
+	ws, err := rt.workspaces.Get(r.Context(), params.ID)
+	if err != nil {
+		return err
+	}
+
+	page := pages.WorkspacesEditPage{
+		Workspace:            ws,
+		ValidationErrs:       map[string]string{},
+		MemberUsername:       r.PostForm.Get("username"),
+		MemberValidationErrs: map[string]string{},
+	}
+
+	user, err := rt.users.GetByUsername(r.Context(), page.MemberUsername)
+	if err != nil {
+		if !errors.Is(err, control.ErrUserNotFound) {
+			return err
+		}
+
+		page.MemberValidationErrs["username"] = err.Error()
+
+		page.Members, err = rt.workspaces.ListMembers(r.Context(), ws.ID)
+		if err != nil {
+			return err
+		}
+
+		return page.Render(w, r)
+	}
+
+	err = rt.workspaces.AddMember(r.Context(), ws.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, fmt.Sprintf("Added %s to workspace %s", user.Username, ws.Name))
+
+	http.Redirect(w, r, fmt.Sprintf("/workspaces/%d", ws.ID), http.StatusFound)
+	return nil
+}
+
+type workspacesMembersDeleteParams struct {
+	ID     int64 `url:"id"`
+	UserID int64 `url:"userID"`
+}
+
+// [POST] /workspaces/{id}/members/{userID}/delete
+func (rt *Router) workspacesMembersDeleteSubmitHandler(w http.ResponseWriter, r *http.Request, params workspacesMembersDeleteParams) error {
+	err := rt.workspaces.RemoveMember(r.Context(), params.ID, params.UserID)
+	if err != nil {
+		return err
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, "Member removed")
+
+	http.Redirect(w, r, fmt.Sprintf("/workspaces/%d", params.ID), http.StatusFound)
+	return nil
+}
+
+// [POST] /workspaces/{id}/delete
+func (rt *Router) workspacesDeleteSubmitHandler(w http.ResponseWriter, r *http.Request, params workspacesEditParams) error {
+	err := rt.workspaces.Delete(r.Context(), params.ID)
+	if err != nil {
+		if errors.Is(err, control.ErrWorkspaceNotEmpty) || errors.Is(err, control.ErrDeleteDefaultWorkspace) {
+			views.SetFlashMessage(r.Context(), views.FlashMessageError, fmt.Sprintf("Error deleting workspace: %v", err))
+			http.Redirect(w, r, fmt.Sprintf("/workspaces/%d", params.ID), http.StatusFound)
+			return nil
+		}
+		return err
+	}
+
+	views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, "Workspace deleted")
+
+	http.Redirect(w, r, "/workspaces", http.StatusFound)
+	return nil
+}
+
+// [POST] /workspaces/switch
+func (rt *Router) workspacesSwitchSubmitHandler(w http.ResponseWriter, r *http.Request, params struct{}) error {
+	id, err := strconv.ParseInt(r.PostForm.Get("workspace_id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	scope, ok := workspace.FromCtx(r.Context())
+	if !ok {
+		return errors.New("can't find workspace scope in request")
+	}
+
+	for _, ws := range scope.Available {
+		if ws.ID == id {
+			session.Put(r.Context(), "workspace_id", ws.ID)
+			views.SetFlashMessage(r.Context(), views.FlashMessageSuccess, fmt.Sprintf("Switched to workspace %s", ws.Name))
+			http.Redirect(w, r, "/assets", http.StatusFound)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %d", control.ErrWorkspaceNotFound, id)
+}
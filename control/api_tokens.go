@@ -0,0 +1,164 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+)
+
+var ErrAPITokenNotFound = errors.New("API token not found")
+var ErrAPITokenNameEmpty = errors.New("API token name must not be empty")
+var ErrAPITokenInvalidScope = errors.New("invalid API token scope")
+var ErrAPITokenExpiryInPast = errors.New("API token expiry must be in the future")
+
+// lastUsedResolution limits how often the last used timestamp of a token is written,
+// so that scripts making many requests don't cause a write for each one.
+const lastUsedResolution = time.Minute
+
+type APITokenControl struct {
+	db    *database.Database
+	users *UserControl
+	repo  APITokenRepo
+}
+
+type APITokenRepo interface {
+	ListForUser(ctx context.Context, exec bob.Executor, userID int64) ([]*auth.APIToken, error)
+	GetByHash(ctx context.Context, exec bob.Executor, hash []byte) (*auth.APIToken, error)
+	Create(ctx context.Context, exec bob.Executor, token *auth.APIToken) error
+	SetLastUsedAt(ctx context.Context, exec bob.Executor, id int64, at time.Time) error
+	Delete(ctx context.Context, exec bob.Executor, userID int64, id int64) error
+}
+
+func NewAPITokenControl(db *database.Database, users *UserControl, repo APITokenRepo) *APITokenControl {
+	return &APITokenControl{db: db, users: users, repo: repo}
+}
+
+func (tc *APITokenControl) List(ctx context.Context, userID int64) ([]*auth.APIToken, error) {
+	return database.InTransaction(ctx, tc.db, func(ctx context.Context, tx database.Executor) ([]*auth.APIToken, error) {
+		return tc.repo.ListForUser(ctx, tx, userID)
+	})
+}
+
+type CreateAPITokenCmd struct {
+	User      *auth.User
+	Name      string
+	Scope     auth.APITokenScope
+	ExpiresAt time.Time
+}
+
+// Create creates a new API token for the user and returns it together with the plaintext token,
+// which can't be retrieved again later.
+func (tc *APITokenControl) Create(ctx context.Context, cmd CreateAPITokenCmd) (*auth.APIToken, string, map[string]string, error) {
+	validationErrs := map[string]string{}
+
+	name := strings.TrimSpace(cmd.Name)
+	if name == "" {
+		validationErrs["name"] = ErrAPITokenNameEmpty.Error()
+	}
+
+	if !cmd.Scope.IsValid() || (cmd.Scope == auth.APITokenScopeAdmin && !cmd.User.IsAdmin()) {
+		validationErrs["scope"] = ErrAPITokenInvalidScope.Error()
+	}
+
+	if !cmd.ExpiresAt.IsZero() && !cmd.ExpiresAt.After(time.Now()) {
+		validationErrs["expires_at"] = ErrAPITokenExpiryInPast.Error()
+	}
+
+	if len(validationErrs) != 0 {
+		return nil, "", validationErrs, nil
+	}
+
+	plaintext, hash, err := auth.GenerateAPIToken()
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	token := &auth.APIToken{
+		UserID:    cmd.User.ID,
+		Name:      name,
+		Scope:     cmd.Scope,
+		Hash:      hash,
+		ExpiresAt: cmd.ExpiresAt,
+	}
+
+	err = tc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		return tc.repo.Create(ctx, tx, token)
+	})
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	return token, plaintext, validationErrs, nil
+}
+
+func (tc *APITokenControl) Delete(ctx context.Context, userID int64, id int64) error {
+	return tc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		err := tc.repo.Delete(ctx, tx, userID, id)
+		if err != nil {
+			if errors.Is(err, database.ErrAPITokenNotFound) {
+				return fmt.Errorf("%w: %d", ErrAPITokenNotFound, id)
+			}
+			return err
+		}
+
+		return nil
+	})
+}
+
+// Authenticate returns the user the plaintext token belongs to and records the use of the token.
+// Unless the token has the admin scope the returned user is never an admin, even if the actual user is.
+func (tc *APITokenControl) Authenticate(ctx context.Context, plaintext string) (*auth.User, *auth.APIToken, error) {
+	now := time.Now()
+
+	token, err := database.InTransaction(ctx, tc.db, func(ctx context.Context, tx database.Executor) (*auth.APIToken, error) {
+		token, err := tc.repo.GetByHash(ctx, tx, auth.HashAPIToken(plaintext))
+		if err != nil {
+			if errors.Is(err, database.ErrAPITokenNotFound) {
+				return nil, fmt.Errorf("%w: invalid API token", auth.ErrUnauthorized)
+			}
+			return nil, err
+		}
+
+		if token.IsExpired(now) {
+			return nil, fmt.Errorf("%w: API token expired", auth.ErrUnauthorized)
+		}
+
+		if now.Sub(token.LastUsedAt) >= lastUsedResolution {
+			token.LastUsedAt = now
+			err = tc.repo.SetLastUsedAt(ctx, tx, token.ID, now)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return token, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, err := tc.users.Get(ctx, token.UserID)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, nil, fmt.Errorf("%w: %w", auth.ErrUnauthorized, err)
+		}
+		return nil, nil, err
+	}
+
+	switch token.Scope {
+	case auth.APITokenScopeRead:
+		user.Role = auth.RoleViewer
+	case auth.APITokenScopeReadWrite:
+		if user.IsAdmin() {
+			user.Role = auth.RoleEditor
+		}
+	}
+
+	return user, token, nil
+}
@@ -0,0 +1,133 @@
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/sqlite"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPITokenControl(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	tokenCtrl, admin := newTestAPITokenControl(t)
+
+	_, _, validationErrs, err := tokenCtrl.Create(ctx, CreateAPITokenCmd{
+		User:      &auth.User{ID: admin.ID},
+		Name:      " ",
+		Scope:     auth.APITokenScopeAdmin,
+		ExpiresAt: time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Contains(t, validationErrs, "name")
+	assert.Contains(t, validationErrs, "scope")
+	assert.Contains(t, validationErrs, "expires_at")
+
+	readToken, readPlaintext, validationErrs, err := tokenCtrl.Create(ctx, CreateAPITokenCmd{
+		User:  admin,
+		Name:  "read",
+		Scope: auth.APITokenScopeRead,
+	})
+	require.NoError(t, err)
+	require.Empty(t, validationErrs)
+	assert.NotEqual(t, readPlaintext, string(readToken.Hash))
+
+	_, adminPlaintext, validationErrs, err := tokenCtrl.Create(ctx, CreateAPITokenCmd{
+		User:      admin,
+		Name:      "admin",
+		Scope:     auth.APITokenScopeAdmin,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Empty(t, validationErrs)
+
+	user, token, err := tokenCtrl.Authenticate(ctx, readPlaintext)
+	require.NoError(t, err)
+	assert.Equal(t, admin.ID, user.ID)
+	assert.Equal(t, auth.RoleViewer, user.Role)
+	assert.False(t, token.Allows("POST"))
+	assert.True(t, token.Allows("GET"))
+
+	user, _, err = tokenCtrl.Authenticate(ctx, adminPlaintext)
+	require.NoError(t, err)
+	assert.True(t, user.IsAdmin())
+
+	_, _, err = tokenCtrl.Authenticate(ctx, "stuff_invalid")
+	assert.ErrorIs(t, err, auth.ErrUnauthorized)
+
+	tokens, err := tokenCtrl.List(ctx, admin.ID)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	for _, token := range tokens {
+		assert.False(t, token.LastUsedAt.IsZero())
+	}
+
+	err = tokenCtrl.Delete(ctx, admin.ID+1, readToken.ID)
+	assert.ErrorIs(t, err, ErrAPITokenNotFound)
+
+	err = tokenCtrl.Delete(ctx, admin.ID, readToken.ID)
+	require.NoError(t, err)
+
+	_, _, err = tokenCtrl.Authenticate(ctx, readPlaintext)
+	assert.ErrorIs(t, err, auth.ErrUnauthorized)
+}
+
+func TestAPITokenControl_Expired(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	tokenCtrl, admin := newTestAPITokenControl(t)
+
+	plaintext, hash, err := auth.GenerateAPIToken()
+	require.NoError(t, err)
+
+	err = tokenCtrl.repo.Create(ctx, tokenCtrl.db.DB, &auth.APIToken{
+		UserID:    admin.ID,
+		Name:      "expired",
+		Scope:     auth.APITokenScopeReadWrite,
+		Hash:      hash,
+		ExpiresAt: time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	_, _, err = tokenCtrl.Authenticate(ctx, plaintext)
+	assert.ErrorIs(t, err, auth.ErrUnauthorized)
+}
+
+func newTestAPITokenControl(t *testing.T) (*APITokenControl, *auth.User) {
+	db, err := sqlite.NewSQLiteDB(&sqlite.Config{File: ":memory:", Timeout: time.Millisecond * 500})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err = db.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	defer cancel()
+
+	err = sqlite.RunMigrations(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	database := &database.Database{DB: bob.NewDB(db)}
+
+	admin := &auth.User{Username: "api_token_test_user", DisplayName: "API Token Test User", Role: auth.RoleAdmin}
+	err = (&sqlite.UserRepo{}).Create(ctx, database.DB, admin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewAPITokenControl(database, NewUserCtrl(database, NewPermissionControl(database, &sqlite.GrantRepo{}, &sqlite.AssetRepo{}), &sqlite.UserRepo{}, &sqlite.WorkspaceRepo{}), &sqlite.APITokenRepo{}), admin
+}
@@ -0,0 +1,141 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/requestid"
+	"github.com/RobinThrift/stuff/internal/server/session"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+)
+
+var ErrAssetEventNotFound = errors.New("asset event not found")
+var ErrAssetEventNotRevertable = errors.New("asset can't be reverted to this event")
+
+type AssetEventRepo interface {
+	Get(ctx context.Context, exec bob.Executor, id int64) (*entities.AssetEvent, error)
+	List(ctx context.Context, exec bob.Executor, query database.ListAssetEventsQuery) (*entities.ListPage[*entities.AssetEvent], error)
+	Create(ctx context.Context, exec bob.Executor, event *entities.AssetEvent) error
+}
+
+type ListAssetEventsQuery struct {
+	AssetID  int64
+	Page     int
+	PageSize int
+}
+
+// ListEvents lists the history of an asset. The asset must be visible to the user in the current workspace.
+// Listing the events of all assets at once spans every workspace and is only allowed for admins.
+func (ac *AssetControl) ListEvents(ctx context.Context, query ListAssetEventsQuery) (*entities.ListPage[*entities.AssetEvent], error) {
+	var err error
+	if query.AssetID != 0 {
+		err = ac.perms.RequireAssetIDRole(ctx, query.AssetID, auth.RoleViewer)
+	} else {
+		err = ac.perms.RequireRole(ctx, auth.RoleAdmin)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) (*entities.ListPage[*entities.AssetEvent], error) {
+		return ac.events.List(ctx, tx, database.ListAssetEventsQuery{
+			AssetID:  query.AssetID,
+			Page:     query.Page,
+			PageSize: query.PageSize,
+		})
+	})
+}
+
+type RevertAssetCmd struct {
+	AssetID int64
+	EventID int64
+}
+
+// Revert restores the asset to the state recorded in the snapshot of the given event, including parts, purchases
+// and custom attributes. The restored state is applied as a regular update, so the revert itself shows up
+// in the asset's history.
+func (ac *AssetControl) Revert(ctx context.Context, cmd RevertAssetCmd) (*entities.Asset, error) {
+	return database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) (*entities.Asset, error) {
+		return ac.revert(ctx, tx, cmd)
+	})
+}
+
+func (ac *AssetControl) revert(ctx context.Context, exec bob.Executor, cmd RevertAssetCmd) (*entities.Asset, error) {
+	event, err := ac.events.Get(ctx, exec, cmd.EventID)
+	if err != nil {
+		if errors.Is(err, database.ErrAssetEventNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrAssetEventNotFound, cmd.EventID)
+		}
+		return nil, err
+	}
+
+	if event.AssetID != cmd.AssetID {
+		return nil, fmt.Errorf("%w: %d", ErrAssetEventNotFound, cmd.EventID)
+	}
+
+	if !event.IsRevertable() {
+		return nil, fmt.Errorf("%w: %d", ErrAssetEventNotRevertable, cmd.EventID)
+	}
+
+	asset, err := ac.repo.Get(ctx, exec, database.GetAssetQuery{ID: cmd.AssetID, IncludePurchases: true, IncludeParts: true})
+	if err != nil {
+		if errors.Is(err, database.ErrAssetNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrAssetNotFound, cmd.AssetID)
+		}
+		return nil, err
+	}
+
+	event.Snapshot.Restore(asset)
+
+	createdBy := asset.MetaInfo.CreatedBy
+	if user, ok := session.Get[*auth.User](ctx, "user"); ok && user != nil {
+		createdBy = user.ID
+	}
+
+	for _, part := range asset.Parts {
+		part.CreatedBy = createdBy
+	}
+
+	return ac.update(ctx, exec, UpdateAssetCmd{Asset: asset})
+}
+
+// recordEvent writes the diff between before and after to the asset's history.
+// The acting user is taken from the session and the request ID from the context, if they are set.
+// The state of the asset after the event, or before it for deletions, is stored as a snapshot, so it can be restored later.
+// Updates which did not change any field are not recorded.
+func (ac *AssetControl) recordEvent(ctx context.Context, exec bob.Executor, typ entities.AssetEventType, assetID int64, before *entities.Asset, after *entities.Asset) error {
+	event := &entities.AssetEvent{
+		AssetID: assetID,
+		Type:    typ,
+		Changes: entities.DiffAssets(before, after),
+	}
+
+	if typ == entities.AssetEventUpdated && len(event.Changes) == 0 {
+		return nil
+	}
+
+	if after != nil {
+		event.Snapshot = entities.NewAssetSnapshot(after)
+	} else if before != nil {
+		event.Snapshot = entities.NewAssetSnapshot(before)
+	}
+
+	if user, ok := session.Get[*auth.User](ctx, "user"); ok && user != nil {
+		event.UserID = user.ID
+	}
+
+	if reqID, ok := requestid.FromCtx(ctx); ok {
+		event.RequestID = reqID
+	}
+
+	err := ac.events.Create(ctx, exec, event)
+	if err != nil {
+		return fmt.Errorf("error recording %s event for asset %d: %w", typ, assetID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package control
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/requestid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetControl_Events(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	ctx = requestid.WithCtx(ctx, "test-request-id")
+
+	assetCtrl := newTestAssetControl(t)
+
+	asset := newTestAsset(t)
+	asset.Status = entities.StatusInStorage
+
+	created, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: asset})
+	require.NoError(t, err)
+
+	update := *created
+	update.Name = "Updated Name"
+	update.Parts = append(update.Parts, &entities.Part{Tag: created.Tag + "-3", Name: "Part 3", CreatedBy: 1})
+	_, err = assetCtrl.Update(ctx, UpdateAssetCmd{Asset: &update})
+	require.NoError(t, err)
+
+	// updates without any changes are not recorded
+	unchanged, err := assetCtrl.Get(ctx, GetAssetQuery{ID: created.ID, IncludeParts: true, IncludePurchases: true})
+	require.NoError(t, err)
+	_, err = assetCtrl.Update(ctx, UpdateAssetCmd{Asset: unchanged})
+	require.NoError(t, err)
+
+	_, err = assetCtrl.CheckOut(ctx, CheckOutAssetCmd{AssetID: created.ID, CheckedOutTo: 1, CreatedBy: 1})
+	require.NoError(t, err)
+
+	err = assetCtrl.Delete(ctx, created)
+	require.NoError(t, err)
+
+	events, err := assetCtrl.ListEvents(ctx, ListAssetEventsQuery{AssetID: created.ID})
+	require.NoError(t, err)
+	require.Equal(t, 4, events.Total)
+
+	deleted, checkedOut, updated, createdEvent := events.Items[0], events.Items[1], events.Items[2], events.Items[3]
+
+	assert.Equal(t, entities.AssetEventCreated, createdEvent.Type)
+	assert.Equal(t, "test-request-id", createdEvent.RequestID)
+	assert.Contains(t, changedFields(createdEvent), "name")
+	assert.Contains(t, changedFields(createdEvent), "parts")
+
+	assert.Equal(t, entities.AssetEventUpdated, updated.Type)
+	assert.Equal(t, []string{"name", "partsTotalCounter", "parts"}, changedFields(updated))
+	assert.Equal(t, "Test Asset", updated.Changes[0].BeforeString())
+	assert.Equal(t, "Updated Name", updated.Changes[0].AfterString())
+
+	assert.Equal(t, entities.AssetEventCheckedOut, checkedOut.Type)
+	assert.Equal(t, []string{"status", "checkedOutTo"}, changedFields(checkedOut))
+
+	assert.Equal(t, entities.AssetEventDeleted, deleted.Type)
+	assert.Contains(t, changedFields(deleted), "name")
+	assert.Equal(t, "Updated Name", deleted.Changes[changeIndex(deleted, "name")].BeforeString())
+	assert.Equal(t, "", deleted.Changes[changeIndex(deleted, "name")].AfterString())
+}
+
+func TestAssetControl_Revert(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	assetCtrl := newTestAssetControl(t)
+
+	asset := newTestAsset(t)
+	asset.Status = entities.StatusInStorage
+
+	created, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: asset})
+	require.NoError(t, err)
+
+	update := updateAsset(created)
+	update.CustomAttrs = []entities.CustomAttr{{Name: "Attr3", Value: "value3"}}
+	_, err = assetCtrl.Update(ctx, UpdateAssetCmd{Asset: update})
+	require.NoError(t, err)
+
+	events, err := assetCtrl.ListEvents(ctx, ListAssetEventsQuery{AssetID: created.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, events.Total)
+
+	createdEvent := events.Items[1]
+	require.NotNil(t, createdEvent.Snapshot)
+
+	reverted, err := assetCtrl.Revert(ctx, RevertAssetCmd{AssetID: created.ID, EventID: createdEvent.ID})
+	require.NoError(t, err)
+
+	assert.Empty(t, entities.DiffAssets(created, reverted))
+
+	events, err = assetCtrl.ListEvents(ctx, ListAssetEventsQuery{AssetID: created.ID})
+	require.NoError(t, err)
+	require.Equal(t, 3, events.Total)
+	assert.Equal(t, entities.AssetEventUpdated, events.Items[0].Type)
+
+	_, err = assetCtrl.Revert(ctx, RevertAssetCmd{AssetID: created.ID + 1, EventID: createdEvent.ID})
+	assert.ErrorIs(t, err, ErrAssetEventNotFound)
+
+	err = assetCtrl.Delete(ctx, reverted)
+	require.NoError(t, err)
+
+	events, err = assetCtrl.ListEvents(ctx, ListAssetEventsQuery{AssetID: created.ID})
+	require.NoError(t, err)
+
+	_, err = assetCtrl.Revert(ctx, RevertAssetCmd{AssetID: created.ID, EventID: events.Items[0].ID})
+	assert.ErrorIs(t, err, ErrAssetEventNotRevertable)
+
+	t.Run("Keeps Parts Counter", func(t *testing.T) {
+		withoutParts := newTestAsset(t)
+		withoutParts.Parts = []*entities.Part{}
+		withoutParts.PartsTotalCounter = 0
+
+		created, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: withoutParts})
+		require.NoError(t, err)
+
+		update := *created
+		update.Parts = []*entities.Part{newTestAssetPart(&update)}
+		update.Parts = append(update.Parts, newTestAssetPart(&update))
+		updated, err := assetCtrl.Update(ctx, UpdateAssetCmd{Asset: &update})
+		require.NoError(t, err)
+		require.Equal(t, 2, updated.PartsTotalCounter)
+
+		events, err := assetCtrl.ListEvents(ctx, ListAssetEventsQuery{AssetID: created.ID})
+		require.NoError(t, err)
+		require.Equal(t, 2, events.Total)
+
+		reverted, err := assetCtrl.Revert(ctx, RevertAssetCmd{AssetID: created.ID, EventID: events.Items[1].ID})
+		require.NoError(t, err)
+		assert.Empty(t, reverted.Parts)
+		assert.Equal(t, 2, reverted.PartsTotalCounter)
+	})
+}
+
+func changedFields(event *entities.AssetEvent) []string {
+	fields := make([]string, 0, len(event.Changes))
+	for _, c := range event.Changes {
+		fields = append(fields, c.Field)
+	}
+	return fields
+}
+
+func changeIndex(event *entities.AssetEvent, field string) int {
+	for i, c := range event.Changes {
+		if c.Field == field {
+			return i
+		}
+	}
+	return -1
+}
@@ -5,36 +5,47 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"time"
 
+	"github.com/RobinThrift/stuff/auth"
 	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/search"
 	"github.com/RobinThrift/stuff/storage/database"
-	"github.com/RobinThrift/stuff/storage/database/sqlite"
 	"github.com/stephenafamo/bob"
 )
 
 var ErrAssetNotFound = errors.New("asset not found")
 var ErrAssetMissingTag = errors.New("asset is missing a tag")
 var ErrDeleteAsset = errors.New("error deleting asset")
+var ErrAssetNotDeleted = errors.New("asset is not in the trash")
+var ErrInvalidSearchQuery = errors.New("invalid search query")
 
 type AssetControl struct {
-	db *database.Database
+	db    *database.Database
+	perms *PermissionControl
 
 	tags  *TagControl
 	files *FileControl
 
-	repo AssetRepo
+	repo      AssetRepo
+	photos    PhotoRepo
+	checkouts CheckoutRepo
+	events    AssetEventRepo
 }
 
 type AssetRepo interface {
 	Get(ctx context.Context, exec bob.Executor, query database.GetAssetQuery) (*entities.Asset, error)
 	List(ctx context.Context, exec bob.Executor, query database.ListAssetsQuery) (*entities.ListPage[*entities.Asset], error)
+	ListFacets(ctx context.Context, exec bob.Executor, query database.ListAssetFacetsQuery) ([]*entities.Facet, error)
 	Create(ctx context.Context, exec bob.Executor, asset *entities.Asset) error
 	Update(ctx context.Context, exec bob.Executor, asset *entities.Asset) error
+	SetDeletedAt(ctx context.Context, exec bob.Executor, id int64, at time.Time) error
+	SetImage(ctx context.Context, exec bob.Executor, id int64, imageURL string, thumbnailURL string, previewURL string) error
 	Delete(ctx context.Context, exec bob.Executor, id int64) error
 }
 
-func NewAssetControl(db *database.Database, tags *TagControl, files *FileControl, repo AssetRepo) *AssetControl {
-	return &AssetControl{db: db, tags: tags, files: files, repo: repo}
+func NewAssetControl(db *database.Database, perms *PermissionControl, tags *TagControl, files *FileControl, repo AssetRepo, photos PhotoRepo, checkouts CheckoutRepo, events AssetEventRepo) *AssetControl {
+	return &AssetControl{db: db, perms: perms, tags: tags, files: files, repo: repo, photos: photos, checkouts: checkouts, events: events}
 }
 
 type GetAssetQuery struct {
@@ -43,23 +54,33 @@ type GetAssetQuery struct {
 	IncludePurchases bool
 	IncludeParts     bool
 	IncludeFiles     bool
+	IncludePhotos    bool
 	IncludeParent    bool
 	IncludeChildren  bool
+	IncludeDeleted   bool
 }
 
 func (ac *AssetControl) Get(ctx context.Context, query GetAssetQuery) (*entities.Asset, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) (*entities.Asset, error) {
 		asset, err := ac.repo.Get(ctx, tx, database.GetAssetQuery{
+			WorkspaceID:      workspaceID,
 			ID:               query.ID,
 			Tag:              query.Tag,
 			IncludePurchases: query.IncludePurchases,
 			IncludeParts:     query.IncludeParts,
 			IncludeFiles:     query.IncludeFiles,
+			IncludePhotos:    query.IncludePhotos,
 			IncludeParent:    query.IncludeParent,
 			IncludeChildren:  query.IncludeChildren,
+			IncludeDeleted:   query.IncludeDeleted,
 		})
 		if err != nil {
-			if errors.Is(err, sqlite.ErrAssetNotFound) {
+			if errors.Is(err, database.ErrAssetNotFound) {
 				return nil, fmt.Errorf("%w: %d/%s", ErrAssetNotFound, query.ID, query.Tag)
 			}
 			return nil, err
@@ -70,8 +91,10 @@ func (ac *AssetControl) Get(ctx context.Context, query GetAssetQuery) (*entities
 }
 
 type ListAssetsQuery struct {
-	SearchRaw    string
-	SearchFields map[string]string
+	// SearchRaw is a query in the search language of the search package.
+	SearchRaw string
+
+	Filters entities.AssetFilters
 
 	IDs []int64
 
@@ -83,25 +106,69 @@ type ListAssetsQuery struct {
 
 	AssetType entities.AssetType
 
+	OnlyDeleted bool
+
 	IncludeParts bool
 }
 
 func (ac *AssetControl) List(ctx context.Context, query ListAssetsQuery) (*entities.ListPage[*entities.Asset], error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	searchQuery, err := search.Parse(query.SearchRaw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSearchQuery, err)
+	}
+
 	return database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) (*entities.ListPage[*entities.Asset], error) {
 		return ac.repo.List(ctx, tx, database.ListAssetsQuery{
-			SearchRaw:    query.SearchRaw,
-			SearchFields: query.SearchFields,
+			WorkspaceID:  workspaceID,
+			Search:       searchQuery,
+			Filters:      query.Filters,
 			IDs:          query.IDs,
 			Page:         query.Page,
 			PageSize:     query.PageSize,
 			OrderBy:      query.OrderBy,
 			OrderDir:     query.OrderDir,
 			AssetType:    string(query.AssetType),
+			OnlyDeleted:  query.OnlyDeleted,
 			IncludeParts: query.IncludeParts,
 		})
 	})
 }
 
+type ListAssetFacetsQuery struct {
+	SearchRaw string
+	Filters   entities.AssetFilters
+	AssetType entities.AssetType
+	MaxValues int
+}
+
+// ListFacets counts the values of the facets for the assets matching the search query and the filters.
+func (ac *AssetControl) ListFacets(ctx context.Context, query ListAssetFacetsQuery) ([]*entities.Facet, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	searchQuery, err := search.Parse(query.SearchRaw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSearchQuery, err)
+	}
+
+	return database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) ([]*entities.Facet, error) {
+		return ac.repo.ListFacets(ctx, tx, database.ListAssetFacetsQuery{
+			WorkspaceID: workspaceID,
+			Search:      searchQuery,
+			Filters:     query.Filters,
+			AssetType:   string(query.AssetType),
+			MaxValues:   query.MaxValues,
+		})
+	})
+}
+
 type CreateAssetCmd struct {
 	Asset *entities.Asset
 	Image *entities.File
@@ -118,7 +185,19 @@ func (ac *AssetControl) Create(ctx context.Context, cmd CreateAssetCmd) (*entiti
 }
 
 func (ac *AssetControl) create(ctx context.Context, exec bob.Executor, cmd CreateAssetCmd) (*entities.Asset, error) {
-	var err error
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if workspaceID != 0 {
+		cmd.Asset.WorkspaceID = workspaceID
+	}
+
+	err = ac.perms.RequireAssetRole(ctx, cmd.Asset, auth.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
 
 	_, err = ac.tags.CreateIfNotExists(ctx, cmd.Asset.Tag)
 	if err != nil {
@@ -138,8 +217,12 @@ func (ac *AssetControl) create(ctx context.Context, exec bob.Executor, cmd Creat
 			return nil, err
 		}
 
-		cmd.Asset.ImageURL = cmd.Image.PublicPath
-		cmd.Asset.ThumbnailURL = cmd.Image.PublicPath
+		photo, err := ac.createPhoto(ctx, exec, cmd.Asset, cmd.Image, 0, true)
+		if err != nil {
+			return nil, err
+		}
+
+		setImage(cmd.Asset, photo)
 	}
 
 	err = ac.repo.Update(ctx, exec, cmd.Asset)
@@ -147,7 +230,17 @@ func (ac *AssetControl) create(ctx context.Context, exec bob.Executor, cmd Creat
 		return nil, err
 	}
 
-	return ac.repo.Get(ctx, exec, database.GetAssetQuery{Tag: cmd.Asset.Tag, IncludePurchases: true, IncludeParts: true})
+	created, err := ac.repo.Get(ctx, exec, database.GetAssetQuery{Tag: cmd.Asset.Tag, IncludePurchases: true, IncludeParts: true})
+	if err != nil {
+		return nil, err
+	}
+
+	err = ac.recordEvent(ctx, exec, entities.AssetEventCreated, created.ID, nil, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
 }
 
 type UpdateAssetCmd struct {
@@ -164,8 +257,31 @@ func (ac *AssetControl) Update(ctx context.Context, cmd UpdateAssetCmd) (*entiti
 func (ac *AssetControl) update(ctx context.Context, exec bob.Executor, cmd UpdateAssetCmd) (*entities.Asset, error) {
 	imgURL := cmd.Asset.ImageURL
 	thmbURL := cmd.Asset.ThumbnailURL
+	previewURL := cmd.Asset.PreviewURL
+
+	before, err := ac.repo.Get(ctx, exec, database.GetAssetQuery{ID: cmd.Asset.ID, IncludePurchases: true, IncludeParts: true})
+	if err != nil {
+		if errors.Is(err, database.ErrAssetNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrAssetNotFound, cmd.Asset.ID)
+		}
+		return nil, err
+	}
+
+	// assets can't be moved between workspaces
+	cmd.Asset.WorkspaceID = before.WorkspaceID
+
+	// the user must be allowed to edit the asset both before and after the update,
+	// otherwise assets could be moved into locations or categories the user has no access to
+	err = ac.perms.RequireAssetRole(ctx, before, auth.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ac.perms.RequireAssetRole(ctx, cmd.Asset, auth.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
 
-	var err error
 	if cmd.Image != nil {
 		cmd.Image.AssetID = cmd.Asset.ID
 		cmd.Image.Name = cmd.Asset.Tag + "_image" + path.Ext(cmd.Image.Name)
@@ -174,8 +290,25 @@ func (ac *AssetControl) update(ctx context.Context, exec bob.Executor, cmd Updat
 			return nil, fmt.Errorf("error writing image file for asset %v: %w", cmd.Asset.Tag, err)
 		}
 
-		cmd.Asset.ImageURL = cmd.Image.PublicPath
-		cmd.Asset.ThumbnailURL = cmd.Image.PublicPath
+		photos, err := ac.photos.ListForAsset(ctx, exec, cmd.Asset.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		// the new image replaces the primary photo at its position in the gallery
+		position := 0
+		for _, p := range photos {
+			if p.Primary {
+				position = p.Position
+			}
+		}
+
+		photo, err := ac.createPhoto(ctx, exec, cmd.Asset, cmd.Image, position, true)
+		if err != nil {
+			return nil, err
+		}
+
+		setImage(cmd.Asset, photo)
 	}
 
 	err = ac.repo.Update(ctx, exec, cmd.Asset)
@@ -184,22 +317,41 @@ func (ac *AssetControl) update(ctx context.Context, exec bob.Executor, cmd Updat
 	}
 
 	if imgURL != "" && cmd.Image != nil {
-		err := ac.files.DeleteByPublicPath(ctx, imgURL)
+		err := ac.files.DeleteByPublicPath(ctx, cmd.Asset.ID, imgURL)
 		if err != nil {
 			return nil, fmt.Errorf("error deleting old image for asset %s: %w", cmd.Asset.Tag, err)
 		}
 
-		if imgURL != thmbURL {
-			err := ac.files.DeleteByPublicPath(ctx, thmbURL)
+		if thmbURL != "" && imgURL != thmbURL {
+			err := ac.files.DeleteByPublicPath(ctx, cmd.Asset.ID, thmbURL)
 			if err != nil {
 				return nil, fmt.Errorf("error deleting old thumbnail for asset %s: %w", cmd.Asset.Tag, err)
 			}
 		}
+
+		if previewURL != "" && imgURL != previewURL {
+			err := ac.files.DeleteByPublicPath(ctx, cmd.Asset.ID, previewURL)
+			if err != nil {
+				return nil, fmt.Errorf("error deleting old preview for asset %s: %w", cmd.Asset.Tag, err)
+			}
+		}
+	}
+
+	updated, err := ac.repo.Get(ctx, exec, database.GetAssetQuery{ID: cmd.Asset.ID, IncludePurchases: true, IncludeParts: true, IncludeChildren: true})
+	if err != nil {
+		return nil, err
 	}
 
-	return ac.repo.Get(ctx, exec, database.GetAssetQuery{ID: cmd.Asset.ID, IncludePurchases: true, IncludeParts: true, IncludeChildren: true})
+	err = ac.recordEvent(ctx, exec, entities.AssetEventUpdated, updated.ID, before, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
 }
 
+// Delete moves the asset to the trash. Assets in the trash are hidden from all lists and searches,
+// but can be restored until they are purged.
 func (ac *AssetControl) Delete(ctx context.Context, asset *entities.Asset) error {
 	return ac.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
 		return ac.delete(ctx, tx, asset)
@@ -207,7 +359,241 @@ func (ac *AssetControl) Delete(ctx context.Context, asset *entities.Asset) error
 }
 
 func (ac *AssetControl) delete(ctx context.Context, exec bob.Executor, asset *entities.Asset) error {
-	err := ac.tags.MarkTagUnused(ctx, asset.Tag)
+	before, err := ac.repo.Get(ctx, exec, database.GetAssetQuery{ID: asset.ID, IncludePurchases: true, IncludeParts: true})
+	if err != nil {
+		if errors.Is(err, database.ErrAssetNotFound) {
+			return fmt.Errorf("%w: %w: %d", ErrDeleteAsset, ErrAssetNotFound, asset.ID)
+		}
+		return fmt.Errorf("%w: %w", ErrDeleteAsset, err)
+	}
+
+	err = ac.perms.RequireAssetRole(ctx, before, auth.RoleEditor)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDeleteAsset, err)
+	}
+
+	err = ac.repo.SetDeletedAt(ctx, exec, asset.ID, time.Now())
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDeleteAsset, err)
+	}
+
+	err = ac.recordEvent(ctx, exec, entities.AssetEventDeleted, asset.ID, before, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDeleteAsset, err)
+	}
+
+	return nil
+}
+
+// Restore moves the asset out of the trash.
+func (ac *AssetControl) Restore(ctx context.Context, id int64) (*entities.Asset, error) {
+	return database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) (*entities.Asset, error) {
+		asset, err := ac.getDeleted(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ac.perms.RequireAssetRole(ctx, asset, auth.RoleEditor)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ac.repo.SetDeletedAt(ctx, tx, asset.ID, time.Time{})
+		if err != nil {
+			return nil, fmt.Errorf("error restoring asset %s: %w", asset.Tag, err)
+		}
+
+		restored, err := ac.repo.Get(ctx, tx, database.GetAssetQuery{ID: asset.ID, IncludePurchases: true, IncludeParts: true})
+		if err != nil {
+			return nil, err
+		}
+
+		err = ac.recordEvent(ctx, tx, entities.AssetEventRestored, restored.ID, nil, restored)
+		if err != nil {
+			return nil, err
+		}
+
+		return restored, nil
+	})
+}
+
+// Purge permanently removes an asset from the trash, including its files, parts, purchases and checkout history.
+func (ac *AssetControl) Purge(ctx context.Context, id int64) error {
+	return ac.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		return ac.purge(ctx, tx, id)
+	})
+}
+
+// setImage uses the photo as the asset's image.
+func setImage(asset *entities.Asset, photo *entities.Photo) {
+	if photo == nil {
+		asset.ImageURL = ""
+		asset.ThumbnailURL = ""
+		asset.PreviewURL = ""
+		return
+	}
+
+	asset.ImageURL = photo.ImageURL
+	asset.ThumbnailURL = photo.ThumbnailURL
+	asset.PreviewURL = photo.PreviewURL
+}
+
+// GenerateMissingImageVariants generates the thumbnails and previews for all assets with an image that were created
+// before thumbnails were generated on upload. Returns the number of updated assets.
+func (ac *AssetControl) GenerateMissingImageVariants(ctx context.Context) (int, error) {
+	ids := []int64{}
+	query := database.ListAssetsQuery{MissingImageVariants: true, PageSize: 100}
+
+	err := ac.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		for {
+			page, err := ac.repo.List(ctx, tx, query)
+			if err != nil {
+				return err
+			}
+
+			for _, asset := range page.Items {
+				ids = append(ids, asset.ID)
+			}
+
+			query.Page++
+			if query.Page >= page.NumPages {
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	updated := 0
+	for _, id := range ids {
+		err = ac.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+			return ac.generateImageVariants(ctx, tx, id)
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		updated++
+	}
+
+	return updated, errors.Join(errs...)
+}
+
+func (ac *AssetControl) generateImageVariants(ctx context.Context, exec bob.Executor, id int64) error {
+	asset, err := ac.repo.Get(ctx, exec, database.GetAssetQuery{ID: id, IncludePhotos: true})
+	if err != nil {
+		return err
+	}
+
+	image, err := ac.files.getByPublicPath(ctx, exec, asset.ID, asset.ImageURL)
+	if err != nil {
+		return fmt.Errorf("error getting image of asset %s: %w", asset.Tag, err)
+	}
+
+	oldURLs := []string{asset.ThumbnailURL, asset.PreviewURL}
+
+	var photo *entities.Photo
+	for _, p := range asset.Photos {
+		if p.FileID == image.ID {
+			photo = p
+			oldURLs = append(oldURLs, p.ThumbnailURL, p.PreviewURL)
+		}
+	}
+
+	if photo == nil {
+		photo = &entities.Photo{AssetID: asset.ID, FileID: image.ID, ImageURL: image.PublicPath, Primary: true}
+	}
+
+	err = ac.writePhotoVariants(ctx, asset, image, photo)
+	if err != nil {
+		return err
+	}
+
+	if photo.ID == 0 {
+		_, err = ac.photos.Create(ctx, exec, photo)
+	} else {
+		err = ac.photos.Update(ctx, exec, photo)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = ac.repo.SetImage(ctx, exec, asset.ID, photo.ImageURL, photo.ThumbnailURL, photo.PreviewURL)
+	if err != nil {
+		return err
+	}
+
+	deleted := map[string]bool{photo.ImageURL: true, photo.ThumbnailURL: true, photo.PreviewURL: true}
+	for _, u := range oldURLs {
+		if u == "" || deleted[u] {
+			continue
+		}
+		deleted[u] = true
+
+		err = ac.files.DeleteByPublicPath(ctx, asset.ID, u)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PurgeDeletedBefore purges all assets which were moved to the trash before the given time
+// and returns the number of purged assets.
+func (ac *AssetControl) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	ids := []int64{}
+	query := database.ListAssetsQuery{OnlyDeleted: true, DeletedBefore: before, PageSize: 100}
+
+	err := ac.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		for {
+			page, err := ac.repo.List(ctx, tx, query)
+			if err != nil {
+				return err
+			}
+
+			for _, asset := range page.Items {
+				ids = append(ids, asset.ID)
+			}
+
+			query.Page++
+			if query.Page >= page.NumPages {
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	purged := 0
+	for _, id := range ids {
+		err = ac.Purge(ctx, id)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		purged++
+	}
+
+	return purged, errors.Join(errs...)
+}
+
+func (ac *AssetControl) purge(ctx context.Context, exec bob.Executor, id int64) error {
+	asset, err := ac.getDeleted(ctx, exec, id)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDeleteAsset, err)
+	}
+
+	err = ac.perms.RequireAssetRole(ctx, asset, auth.RoleAdmin)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDeleteAsset, err)
+	}
+
+	err = ac.tags.MarkTagUnused(ctx, asset.Tag)
 	if err != nil {
 		return fmt.Errorf("%w: error marking tag as unused: %w", ErrDeleteAsset, err)
 	}
@@ -217,10 +603,36 @@ func (ac *AssetControl) delete(ctx context.Context, exec bob.Executor, asset *en
 		return fmt.Errorf("%w: error deleting asset files: %w", ErrDeleteAsset, err)
 	}
 
+	err = ac.checkouts.DeleteAllForAsset(ctx, exec, asset.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDeleteAsset, err)
+	}
+
 	err = ac.repo.Delete(ctx, exec, asset.ID)
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrDeleteAsset, err)
 	}
 
+	err = ac.recordEvent(ctx, exec, entities.AssetEventPurged, asset.ID, asset, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDeleteAsset, err)
+	}
+
 	return nil
 }
+
+func (ac *AssetControl) getDeleted(ctx context.Context, exec bob.Executor, id int64) (*entities.Asset, error) {
+	asset, err := ac.repo.Get(ctx, exec, database.GetAssetQuery{ID: id, IncludePurchases: true, IncludeParts: true, IncludeDeleted: true})
+	if err != nil {
+		if errors.Is(err, database.ErrAssetNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrAssetNotFound, id)
+		}
+		return nil, err
+	}
+
+	if asset.MetaInfo.DeletedAt.IsZero() {
+		return nil, fmt.Errorf("%w: %s", ErrAssetNotDeleted, asset.Tag)
+	}
+
+	return asset, nil
+}
@@ -1,24 +1,30 @@
 package control
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"image"
+	"image/jpeg"
 	"math/rand"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/RobinThrift/stuff/auth"
 	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/search"
 	"github.com/RobinThrift/stuff/storage/blobs"
 	"github.com/RobinThrift/stuff/storage/database"
 	"github.com/RobinThrift/stuff/storage/database/sqlite"
 	nanoid "github.com/matoous/go-nanoid/v2"
 	"github.com/stephenafamo/bob"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAssetControl_CRUD(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
 	t.Cleanup(cancel)
 
 	assetCtrl := newTestAssetControl(t)
@@ -31,6 +37,7 @@ func TestAssetControl_CRUD(t *testing.T) {
 
 	asset.Children = []*entities.Asset{}
 	asset.Files = []*entities.File{}
+	asset.Photos = []*entities.Photo{}
 
 	asset.ID = created.ID
 	asset.MetaInfo.CreatedAt = created.MetaInfo.CreatedAt
@@ -72,9 +79,10 @@ func TestAssetControl_CRUD(t *testing.T) {
 	assert.Equal(t, asset.ImageURL, imgFile.PublicPath)
 	fileExitsts(t, imgFile.FullPath)
 
-	fetchedAfterUpdate, err := assetCtrl.Get(ctx, GetAssetQuery{ID: asset.ID, IncludeFiles: true})
+	fetchedAfterUpdate, err := assetCtrl.Get(ctx, GetAssetQuery{ID: asset.ID, IncludeFiles: true, IncludePhotos: true})
 	assert.NoError(t, err)
-	assert.Len(t, fetchedAfterUpdate.Files, 1)
+	assert.Len(t, fetchedAfterUpdate.Files, 0)
+	assert.Len(t, fetchedAfterUpdate.Photos, 1)
 
 	err = assetCtrl.Delete(ctx, asset)
 	assert.NoError(t, err)
@@ -83,6 +91,19 @@ func TestAssetControl_CRUD(t *testing.T) {
 	assert.ErrorIs(t, err, ErrAssetNotFound)
 	assert.Nil(t, fetchedAfterDelete)
 
+	trashed, err := assetCtrl.Get(ctx, GetAssetQuery{ID: asset.ID, IncludePhotos: true, IncludeDeleted: true})
+	assert.NoError(t, err)
+	assert.False(t, trashed.MetaInfo.DeletedAt.IsZero())
+	assert.Len(t, trashed.Photos, 1)
+	fileExitsts(t, imgFile.FullPath)
+
+	err = assetCtrl.Purge(ctx, asset.ID)
+	assert.NoError(t, err)
+
+	fetchedAfterPurge, err := assetCtrl.Get(ctx, GetAssetQuery{ID: asset.ID, IncludeDeleted: true})
+	assert.ErrorIs(t, err, ErrAssetNotFound)
+	assert.Nil(t, fetchedAfterPurge)
+
 	inUse := false
 	tags, err := assetCtrl.tags.List(ctx, ListTagsQuery{InUse: &inUse})
 	assert.NoError(t, err)
@@ -95,6 +116,190 @@ func TestAssetControl_CRUD(t *testing.T) {
 	fileNotExitsts(t, imgFile.FullPath)
 }
 
+func TestAssetControl_Trash(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	assetCtrl := newTestAssetControl(t)
+
+	created, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: newTestAsset(t)})
+	require.NoError(t, err)
+
+	_, err = assetCtrl.Restore(ctx, created.ID)
+	assert.ErrorIs(t, err, ErrAssetNotDeleted)
+
+	err = assetCtrl.Purge(ctx, created.ID)
+	assert.ErrorIs(t, err, ErrAssetNotDeleted)
+
+	err = assetCtrl.Delete(ctx, created)
+	require.NoError(t, err)
+
+	trash, err := assetCtrl.List(ctx, ListAssetsQuery{OnlyDeleted: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, trash.Total)
+
+	restored, err := assetCtrl.Restore(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, restored.MetaInfo.DeletedAt.IsZero())
+	assert.Len(t, restored.Parts, len(created.Parts))
+
+	err = assetCtrl.Delete(ctx, restored)
+	require.NoError(t, err)
+
+	purged, err := assetCtrl.PurgeDeletedBefore(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, purged)
+
+	purged, err = assetCtrl.PurgeDeletedBefore(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+
+	_, err = assetCtrl.Get(ctx, GetAssetQuery{ID: created.ID, IncludeDeleted: true})
+	assert.ErrorIs(t, err, ErrAssetNotFound)
+
+	events, err := assetCtrl.ListEvents(ctx, ListAssetEventsQuery{AssetID: created.ID})
+	require.NoError(t, err)
+	assert.Equal(t, entities.AssetEventPurged, events.Items[0].Type)
+	assert.Equal(t, entities.AssetEventRestored, events.Items[2].Type)
+}
+
+func TestAssetControl_ListSearch(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	assetCtrl := newTestAssetControl(t)
+
+	created, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: newTestAsset(t)})
+	require.NoError(t, err)
+
+	list, err := assetCtrl.List(ctx, ListAssetsQuery{SearchRaw: fmt.Sprintf("tag:%s status:%s", created.Tag, created.Status)})
+	require.NoError(t, err)
+	require.Equal(t, 1, list.Total)
+	assert.Equal(t, created.ID, list.Items[0].ID)
+
+	_, err = assetCtrl.List(ctx, ListAssetsQuery{SearchRaw: "status:broken OR"})
+	assert.ErrorIs(t, err, ErrInvalidSearchQuery)
+
+	var searchErr *search.Error
+	assert.ErrorAs(t, err, &searchErr)
+}
+
+func TestAssetControl_ListFacets(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	assetCtrl := newTestAssetControl(t)
+
+	created, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: newTestAsset(t)})
+	require.NoError(t, err)
+
+	facets, err := assetCtrl.ListFacets(ctx, ListAssetFacetsQuery{
+		SearchRaw: "tag:" + created.Tag,
+		Filters:   entities.AssetFilters{Status: []entities.Status{created.Status}},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, facets)
+	assert.Equal(t, entities.FacetStatus, facets[0].Name)
+	assert.Equal(t, []*entities.FacetValue{{Value: string(created.Status), Count: 1, Selected: true}}, facets[0].Values)
+
+	_, err = assetCtrl.ListFacets(ctx, ListAssetFacetsQuery{SearchRaw: "(laptop"})
+	assert.ErrorIs(t, err, ErrInvalidSearchQuery)
+}
+
+func TestAssetControl_ImageVariants(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	assetCtrl := newTestAssetControl(t)
+
+	created, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: newTestAsset(t), Image: newTestImage(t, 2000, 1000)})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, created.ImageURL, created.ThumbnailURL)
+	assert.NotEqual(t, created.ImageURL, created.PreviewURL)
+	assertImageVariants(t, assetCtrl, created)
+
+	updated, err := assetCtrl.Update(ctx, UpdateAssetCmd{Asset: created, Image: newTestImage(t, 800, 1600)})
+	require.NoError(t, err)
+	assertImageVariants(t, assetCtrl, updated)
+
+	t.Run("Unsupported Format", func(t *testing.T) {
+		asset, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: newTestAsset(t), Image: &entities.File{
+			Reader:    bytes.NewBufferString("not an image"),
+			Name:      "image.webp",
+			Filetype:  "image/webp",
+			CreatedBy: 1,
+		}})
+		require.NoError(t, err)
+
+		assert.Equal(t, asset.ImageURL, asset.ThumbnailURL)
+		assert.Equal(t, asset.ImageURL, asset.PreviewURL)
+	})
+
+	t.Run("Backfill", func(t *testing.T) {
+		// simulate an asset created before image variants were generated
+		err := assetCtrl.repo.SetImage(ctx, assetCtrl.db, updated.ID, updated.ImageURL, updated.ImageURL, "")
+		require.NoError(t, err)
+
+		generated, err := assetCtrl.GenerateMissingImageVariants(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, generated)
+
+		backfilled, err := assetCtrl.Get(ctx, GetAssetQuery{ID: updated.ID})
+		require.NoError(t, err)
+		assert.Equal(t, updated.ThumbnailURL, backfilled.ThumbnailURL)
+		assert.Equal(t, updated.PreviewURL, backfilled.PreviewURL)
+
+		generated, err = assetCtrl.GenerateMissingImageVariants(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, generated)
+	})
+}
+
+func assertImageVariants(t *testing.T, assetCtrl *AssetControl, asset *entities.Asset) {
+	t.Helper()
+
+	files, err := assetCtrl.files.List(auth.SystemCtx(context.Background()), ListFilesQuery{AssetID: asset.ID})
+	require.NoError(t, err)
+	require.Len(t, files.Items, 3)
+
+	variants := map[entities.FileVariant]*entities.File{}
+	for _, f := range files.Items {
+		variants[f.Variant] = f
+	}
+
+	require.Contains(t, variants, entities.FileVariantOriginal)
+	require.Contains(t, variants, entities.FileVariantThumbnail)
+	require.Contains(t, variants, entities.FileVariantPreview)
+	assert.Equal(t, asset.ImageURL, variants[entities.FileVariantOriginal].PublicPath)
+	assert.Equal(t, asset.ThumbnailURL, variants[entities.FileVariantThumbnail].PublicPath)
+	assert.Equal(t, asset.PreviewURL, variants[entities.FileVariantPreview].PublicPath)
+
+	for variant, size := range map[entities.FileVariant]int{entities.FileVariantThumbnail: thumbnailSize, entities.FileVariantPreview: previewSize} {
+		f, err := os.Open(variants[variant].FullPath)
+		require.NoError(t, err)
+
+		config, err := jpeg.DecodeConfig(f)
+		require.NoError(t, f.Close())
+		require.NoError(t, err)
+
+		assert.Equal(t, size, max(config.Width, config.Height), variant)
+	}
+}
+
+func newTestImage(t *testing.T, width int, height int) *entities.File {
+	var buf bytes.Buffer
+	err := jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height)), nil)
+	require.NoError(t, err)
+
+	return &entities.File{
+		Reader:    &buf,
+		Name:      "image.jpg",
+		Filetype:  "image/jpeg",
+		CreatedBy: 1,
+	}
+}
+
 func newTestAsset(t *testing.T) *entities.Asset {
 	tag, err := nanoid.Generate("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ", 6)
 	if err != nil {
@@ -215,7 +420,7 @@ func newTestAssetControl(t *testing.T) *AssetControl {
 		}
 	})
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
 	defer cancel()
 
 	err = sqlite.RunMigrations(ctx, db)
@@ -232,11 +437,15 @@ func newTestAssetControl(t *testing.T) *AssetControl {
 		t.Fatal(err)
 	}
 
+	perms := NewPermissionControl(database, &sqlite.GrantRepo{}, &sqlite.AssetRepo{})
+
 	return NewAssetControl(
 		database,
-		NewTagControl(database, "nanoid", &sqlite.TagRepo{}),
+		perms,
+		NewTagControl(TagControlConfig{Algorithm: "nanoid"}, database, &sqlite.TagRepo{}),
 		NewFileControl(
 			database,
+			perms,
 			&sqlite.FileRepo{},
 			&blobs.LocalFS{
 				RootDir: t.TempDir(),
@@ -244,5 +453,8 @@ func newTestAssetControl(t *testing.T) *AssetControl {
 			},
 		),
 		&sqlite.AssetRepo{},
+		&sqlite.PhotoRepo{},
+		&sqlite.CheckoutRepo{},
+		&sqlite.AssetEventRepo{},
 	)
 }
@@ -3,21 +3,24 @@ package control
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 
 	"github.com/RobinThrift/stuff/auth"
 	"github.com/RobinThrift/stuff/storage/database"
-	"github.com/RobinThrift/stuff/storage/database/sqlite"
 	"github.com/stephenafamo/bob"
 )
 
 var ErrInvalidCredentials = errors.New("invalid credentials")
 var ErrUsernameEmpty = errors.New("username must not be empty")
 var ErrPasswordEmpty = errors.New("password must not be empty")
+var ErrInvalidRole = errors.New("invalid role")
+var ErrNoAdminLeft = errors.New("can't demote user, must always have at least one admin")
 
 type AuthController struct {
 	config    AuthConfig
 	db        *database.Database
+	perms     *PermissionControl
 	users     *UserControl
 	localAuth LocalAuthRepo
 }
@@ -33,8 +36,8 @@ type AuthConfig struct {
 	Argon2Params auth.Argon2Params
 }
 
-func NewAuthController(config AuthConfig, db *database.Database, users *UserControl, localAuth LocalAuthRepo) *AuthController {
-	return &AuthController{config: config, db: db, users: users, localAuth: localAuth}
+func NewAuthController(config AuthConfig, db *database.Database, perms *PermissionControl, users *UserControl, localAuth LocalAuthRepo) *AuthController {
+	return &AuthController{config: config, db: db, perms: perms, users: users, localAuth: localAuth}
 }
 
 type GetUserForCredentialsQuery struct {
@@ -59,7 +62,7 @@ func (ac *AuthController) GetUserForCredentials(ctx context.Context, query GetUs
 	user, err := database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) (*auth.User, error) {
 		localUser, err := ac.localAuth.Get(ctx, tx, query.Username)
 		if err != nil {
-			if errors.Is(err, sqlite.ErrLocalAuthUserNotFound) {
+			if errors.Is(err, database.ErrLocalAuthUserNotFound) {
 				return nil, ErrInvalidCredentials
 			}
 
@@ -109,6 +112,19 @@ type CreateUserCmd struct {
 }
 
 func (ac *AuthController) CreateUser(ctx context.Context, cmd CreateUserCmd) error {
+	if err := ac.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return err
+	}
+
+	role := cmd.User.Role
+	if role == "" {
+		role = auth.RoleEditor
+	}
+
+	if !role.IsValid() {
+		return fmt.Errorf("%w: %s", ErrInvalidRole, role)
+	}
+
 	return ac.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
 		if cmd.PlaintextPasswd == "" {
 			return errors.New("initial password cannot be empty")
@@ -139,7 +155,7 @@ func (ac *AuthController) CreateUser(ctx context.Context, cmd CreateUserCmd) err
 		return ac.users.Create(ctx, &auth.User{
 			Username:    cmd.User.Username,
 			DisplayName: cmd.User.DisplayName,
-			IsAdmin:     cmd.User.IsAdmin,
+			Role:        role,
 			AuthRef:     cmd.User.Username,
 		})
 	})
@@ -173,7 +189,7 @@ func (ac *AuthController) ChangeUserCredentials(ctx context.Context, cmd ChangeU
 	localUser, err := ac.localAuth.Get(ctx, ac.db, cmd.User.Username)
 	if err != nil {
 		slog.ErrorContext(ctx, "error finding user for changing password", "error", err, "username", cmd.User.Username)
-		if errors.Is(err, sqlite.ErrLocalAuthUserNotFound) {
+		if errors.Is(err, database.ErrLocalAuthUserNotFound) {
 			return nil, ErrInvalidCredentials
 		}
 
@@ -220,14 +236,27 @@ func (ac *AuthController) ChangeUserCredentials(ctx context.Context, cmd ChangeU
 	return validationErrs, nil
 }
 
-func (ac *AuthController) ToggleAdmin(ctx context.Context, id int64) error {
+type SetUserRoleCmd struct {
+	UserID int64
+	Role   auth.Role
+}
+
+func (ac *AuthController) SetUserRole(ctx context.Context, cmd SetUserRoleCmd) error {
+	if err := ac.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return err
+	}
+
+	if !cmd.Role.IsValid() {
+		return fmt.Errorf("%w: %s", ErrInvalidRole, cmd.Role)
+	}
+
 	return ac.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
-		user, err := ac.users.Get(ctx, id)
+		user, err := ac.users.Get(ctx, cmd.UserID)
 		if err != nil {
 			return err
 		}
 
-		user.IsAdmin = !user.IsAdmin
+		user.Role = cmd.Role
 
 		err = ac.users.Update(ctx, user)
 		if err != nil {
@@ -240,7 +269,7 @@ func (ac *AuthController) ToggleAdmin(ctx context.Context, id int64) error {
 		}
 
 		if count == 0 {
-			return errors.New("can't demote user, must always have at leas one admin")
+			return ErrNoAdminLeft
 		}
 
 		return nil
@@ -253,6 +282,10 @@ type ResetPasswordCmd struct {
 }
 
 func (ac *AuthController) ResetPassword(ctx context.Context, cmd ResetPasswordCmd) error {
+	if err := ac.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return err
+	}
+
 	if cmd.PlaintextPasswd == "" {
 		return ErrPasswordEmpty
 	}
@@ -296,6 +329,10 @@ func (ac *AuthController) ResetPassword(ctx context.Context, cmd ResetPasswordCm
 }
 
 func (ac *AuthController) DeleteUser(ctx context.Context, userID int64) error {
+	if err := ac.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return err
+	}
+
 	return ac.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
 		user, err := ac.users.Get(ctx, userID)
 		if err != nil {
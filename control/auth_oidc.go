@@ -0,0 +1,132 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/storage/database"
+)
+
+var ErrOIDCUserNotProvisioned = errors.New("no user exists for this account")
+var ErrOIDCUsernameTaken = errors.New("username is already taken by another user")
+
+type OIDCAuthControl struct {
+	config   OIDCAuthConfig
+	db       *database.Database
+	users    *UserControl
+	provider OIDCProvider
+}
+
+type OIDCAuthConfig struct {
+	// AutoProvision creates a new user on the first login. Otherwise only users that already exist can log in.
+	AutoProvision bool
+	// AdminGroup, if set, grants admin rights to members of this group and revokes them from everyone else on every login.
+	AdminGroup string
+	// DefaultRole is given to newly provisioned users and admins which are no longer in the AdminGroup.
+	DefaultRole auth.Role
+}
+
+type OIDCProvider interface {
+	BeginLogin(ctx context.Context) (*auth.OIDCLoginState, string, error)
+	FinishLogin(ctx context.Context, loginState *auth.OIDCLoginState, state string, code string) (*auth.OIDCIdentity, error)
+}
+
+func NewOIDCAuthControl(config OIDCAuthConfig, db *database.Database, users *UserControl, provider OIDCProvider) *OIDCAuthControl {
+	if !config.DefaultRole.IsValid() {
+		config.DefaultRole = auth.RoleEditor
+	}
+
+	return &OIDCAuthControl{config: config, db: db, users: users, provider: provider}
+}
+
+func (oc *OIDCAuthControl) BeginLogin(ctx context.Context) (*auth.OIDCLoginState, string, error) {
+	return oc.provider.BeginLogin(ctx)
+}
+
+type FinishOIDCLoginCmd struct {
+	LoginState *auth.OIDCLoginState
+	State      string
+	Code       string
+}
+
+func (oc *OIDCAuthControl) FinishLogin(ctx context.Context, cmd FinishOIDCLoginCmd) (*auth.User, error) {
+	identity, err := oc.provider.FinishLogin(ctx, cmd.LoginState, cmd.State, cmd.Code)
+	if err != nil {
+		if errors.Is(err, auth.ErrOIDCInvalidState) || errors.Is(err, auth.ErrOIDCInvalidIDToken) {
+			return nil, fmt.Errorf("%w: %w", auth.ErrUnauthorized, err)
+		}
+		return nil, err
+	}
+
+	// The user isn't logged in yet, so syncing or provisioning their account is done by the system.
+	ctx = auth.SystemCtx(ctx)
+
+	return database.InTransaction(ctx, oc.db, func(ctx context.Context, tx database.Executor) (*auth.User, error) {
+		user, err := oc.users.GetByRef(ctx, identity.AuthRef())
+		if err != nil {
+			if !errors.Is(err, ErrUserNotFound) {
+				return nil, err
+			}
+
+			if !oc.config.AutoProvision {
+				return nil, fmt.Errorf("%w: %w: %s", auth.ErrUnauthorized, ErrOIDCUserNotProvisioned, identity.Username)
+			}
+
+			return oc.provision(ctx, identity)
+		}
+
+		role := oc.roleFor(identity, user.Role)
+		if role != user.Role {
+			user.Role = role
+			err = oc.users.Update(ctx, user)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return user, nil
+	})
+}
+
+func (oc *OIDCAuthControl) provision(ctx context.Context, identity *auth.OIDCIdentity) (*auth.User, error) {
+	_, err := oc.users.GetByUsername(ctx, identity.Username)
+	if err == nil {
+		return nil, fmt.Errorf("%w: %w: %s", auth.ErrUnauthorized, ErrOIDCUsernameTaken, identity.Username)
+	}
+
+	if !errors.Is(err, ErrUserNotFound) {
+		return nil, err
+	}
+
+	user := &auth.User{
+		Username:    identity.Username,
+		DisplayName: identity.DisplayName,
+		Role:        oc.roleFor(identity, oc.config.DefaultRole),
+		AuthRef:     identity.AuthRef(),
+	}
+
+	err = oc.users.Create(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("error creating user for OIDC account %s: %w", identity.Username, err)
+	}
+
+	return oc.users.GetByRef(ctx, user.AuthRef)
+}
+
+func (oc *OIDCAuthControl) roleFor(identity *auth.OIDCIdentity, current auth.Role) auth.Role {
+	if oc.config.AdminGroup == "" {
+		return current
+	}
+
+	if identity.InGroup(oc.config.AdminGroup) {
+		return auth.RoleAdmin
+	}
+
+	if current == auth.RoleAdmin {
+		return oc.config.DefaultRole
+	}
+
+	return current
+}
@@ -0,0 +1,355 @@
+package control
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/sqlite"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDCAuthControl(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	oidcCtrl, issuer := newTestOIDCAuthControl(t, OIDCAuthConfig{AutoProvision: true, AdminGroup: "stuff-admins"})
+
+	loginState, authURL, err := oidcCtrl.BeginLogin(ctx)
+	require.NoError(t, err)
+
+	state, code := issuer.authorize(t, authURL, map[string]any{
+		"preferred_username": "jdoe",
+		"name":               "Jane Doe",
+		"groups":             []string{"staff", "stuff-admins"},
+	})
+
+	user, err := oidcCtrl.FinishLogin(ctx, FinishOIDCLoginCmd{LoginState: loginState, State: state, Code: code})
+	require.NoError(t, err)
+	assert.NotZero(t, user.ID)
+	assert.Equal(t, "jdoe", user.Username)
+	assert.Equal(t, "Jane Doe", user.DisplayName)
+	assert.Equal(t, "oidc:jdoe-subject", user.AuthRef)
+	assert.Equal(t, auth.RoleAdmin, user.Role)
+	assert.True(t, user.IsOIDCUser())
+
+	// admin rights are revoked once the user is removed from the admin group
+	loginState, authURL, err = oidcCtrl.BeginLogin(ctx)
+	require.NoError(t, err)
+
+	state, code = issuer.authorize(t, authURL, map[string]any{
+		"preferred_username": "jdoe",
+		"name":               "Jane Doe",
+		"groups":             "staff",
+	})
+
+	again, err := oidcCtrl.FinishLogin(ctx, FinishOIDCLoginCmd{LoginState: loginState, State: state, Code: code})
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, again.ID)
+	assert.Equal(t, auth.RoleEditor, again.Role)
+}
+
+func TestOIDCAuthControl_InvalidLogin(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	oidcCtrl, issuer := newTestOIDCAuthControl(t, OIDCAuthConfig{AutoProvision: true})
+
+	claims := map[string]any{"preferred_username": "jdoe"}
+
+	t.Run("State Mismatch", func(t *testing.T) {
+		loginState, authURL, err := oidcCtrl.BeginLogin(ctx)
+		require.NoError(t, err)
+
+		_, code := issuer.authorize(t, authURL, claims)
+
+		_, err = oidcCtrl.FinishLogin(ctx, FinishOIDCLoginCmd{LoginState: loginState, State: "forged", Code: code})
+		assert.ErrorIs(t, err, auth.ErrUnauthorized)
+		assert.ErrorIs(t, err, auth.ErrOIDCInvalidState)
+	})
+
+	t.Run("Missing Login State", func(t *testing.T) {
+		_, authURL, err := oidcCtrl.BeginLogin(ctx)
+		require.NoError(t, err)
+
+		state, code := issuer.authorize(t, authURL, claims)
+
+		_, err = oidcCtrl.FinishLogin(ctx, FinishOIDCLoginCmd{State: state, Code: code})
+		assert.ErrorIs(t, err, auth.ErrOIDCInvalidState)
+	})
+
+	t.Run("Wrong Code Verifier", func(t *testing.T) {
+		loginState, authURL, err := oidcCtrl.BeginLogin(ctx)
+		require.NoError(t, err)
+
+		state, code := issuer.authorize(t, authURL, claims)
+		loginState.CodeVerifier += "x"
+
+		_, err = oidcCtrl.FinishLogin(ctx, FinishOIDCLoginCmd{LoginState: loginState, State: state, Code: code})
+		assert.Error(t, err)
+	})
+
+	t.Run("Nonce Mismatch", func(t *testing.T) {
+		loginState, authURL, err := oidcCtrl.BeginLogin(ctx)
+		require.NoError(t, err)
+
+		state, code := issuer.authorize(t, authURL, claims)
+		loginState.Nonce = "replayed"
+
+		_, err = oidcCtrl.FinishLogin(ctx, FinishOIDCLoginCmd{LoginState: loginState, State: state, Code: code})
+		assert.ErrorIs(t, err, auth.ErrOIDCInvalidIDToken)
+	})
+
+	t.Run("Username Taken", func(t *testing.T) {
+		err := oidcCtrl.users.Create(ctx, &auth.User{Username: "local", DisplayName: "Local", AuthRef: "local"})
+		require.NoError(t, err)
+
+		loginState, authURL, err := oidcCtrl.BeginLogin(ctx)
+		require.NoError(t, err)
+
+		state, code := issuer.authorize(t, authURL, map[string]any{"preferred_username": "local"})
+
+		_, err = oidcCtrl.FinishLogin(ctx, FinishOIDCLoginCmd{LoginState: loginState, State: state, Code: code})
+		assert.ErrorIs(t, err, ErrOIDCUsernameTaken)
+	})
+}
+
+func TestOIDCAuthControl_NoAutoProvision(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	oidcCtrl, issuer := newTestOIDCAuthControl(t, OIDCAuthConfig{})
+
+	loginState, authURL, err := oidcCtrl.BeginLogin(ctx)
+	require.NoError(t, err)
+
+	state, code := issuer.authorize(t, authURL, map[string]any{"preferred_username": "jdoe"})
+
+	_, err = oidcCtrl.FinishLogin(ctx, FinishOIDCLoginCmd{LoginState: loginState, State: state, Code: code})
+	assert.ErrorIs(t, err, auth.ErrUnauthorized)
+	assert.ErrorIs(t, err, ErrOIDCUserNotProvisioned)
+
+	err = oidcCtrl.users.Create(ctx, &auth.User{Username: "jdoe", DisplayName: "Jane Doe", AuthRef: "oidc:jdoe-subject"})
+	require.NoError(t, err)
+
+	loginState, authURL, err = oidcCtrl.BeginLogin(ctx)
+	require.NoError(t, err)
+
+	state, code = issuer.authorize(t, authURL, map[string]any{"preferred_username": "jdoe"})
+
+	user, err := oidcCtrl.FinishLogin(ctx, FinishOIDCLoginCmd{LoginState: loginState, State: state, Code: code})
+	require.NoError(t, err)
+	assert.Equal(t, "jdoe", user.Username)
+}
+
+func newTestOIDCAuthControl(t *testing.T, config OIDCAuthConfig) (*OIDCAuthControl, *mockOIDCIssuer) {
+	db, err := sqlite.NewSQLiteDB(&sqlite.Config{File: ":memory:", Timeout: time.Millisecond * 500})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err = db.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	defer cancel()
+
+	err = sqlite.RunMigrations(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	database := &database.Database{DB: bob.NewDB(db)}
+
+	issuer := newMockOIDCIssuer(t, "stuff-test-client")
+
+	provider := auth.NewOIDCProvider(auth.OIDCConfig{
+		IssuerURL:    issuer.srv.URL,
+		ClientID:     "stuff-test-client",
+		ClientSecret: "stuff-test-secret",
+		RedirectURL:  "http://localhost:8080/auth/oidc/callback",
+	})
+
+	return NewOIDCAuthControl(config, database, NewUserCtrl(database, NewPermissionControl(database, &sqlite.GrantRepo{}, &sqlite.AssetRepo{}), &sqlite.UserRepo{}, &sqlite.WorkspaceRepo{}), provider), issuer
+}
+
+// mockOIDCIssuer is a minimal OpenID Connect issuer, which hands out signed ID tokens for codes created by authorize.
+type mockOIDCIssuer struct {
+	srv      *httptest.Server
+	key      *rsa.PrivateKey
+	clientID string
+
+	mu    sync.Mutex
+	codes map[string]mockOIDCAuthRequest
+}
+
+type mockOIDCAuthRequest struct {
+	codeChallenge string
+	nonce         string
+	claims        map[string]any
+}
+
+func newMockOIDCIssuer(t *testing.T, clientID string) *mockOIDCIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := &mockOIDCIssuer{key: key, clientID: clientID, codes: map[string]mockOIDCAuthRequest{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", issuer.handleDiscovery)
+	mux.HandleFunc("/jwks", issuer.handleJWKS)
+	mux.HandleFunc("/token", issuer.handleToken)
+
+	issuer.srv = httptest.NewServer(mux)
+	t.Cleanup(issuer.srv.Close)
+
+	return issuer
+}
+
+// authorize simulates the user logging in at the issuer and returns the state and code the issuer would redirect back with.
+func (i *mockOIDCIssuer) authorize(t *testing.T, authURL string, claims map[string]any) (string, string) {
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+
+	query := u.Query()
+	require.Equal(t, i.srv.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	require.Equal(t, "code", query.Get("response_type"))
+	require.Equal(t, "S256", query.Get("code_challenge_method"))
+	require.Contains(t, query.Get("scope"), "openid")
+
+	code := fmt.Sprintf("code-%d", time.Now().UnixNano())
+
+	i.mu.Lock()
+	i.codes[code] = mockOIDCAuthRequest{
+		codeChallenge: query.Get("code_challenge"),
+		nonce:         query.Get("nonce"),
+		claims:        claims,
+	}
+	i.mu.Unlock()
+
+	return query.Get("state"), code
+}
+
+func (i *mockOIDCIssuer) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                i.srv.URL,
+		"authorization_endpoint":                i.srv.URL + "/authorize",
+		"token_endpoint":                        i.srv.URL + "/token",
+		"jwks_uri":                              i.srv.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (i *mockOIDCIssuer) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]any{{
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"kid": "test",
+			"n":   base64.RawURLEncoding.EncodeToString(i.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(i.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (i *mockOIDCIssuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid_request"})
+		return
+	}
+
+	i.mu.Lock()
+	req, ok := i.codes[r.PostForm.Get("code")]
+	delete(i.codes, r.PostForm.Get("code"))
+	i.mu.Unlock()
+
+	if !ok || r.PostForm.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid_grant"})
+		return
+	}
+
+	challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(challenge[:]) != req.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid_grant", "error_description": "PKCE verification failed"})
+		return
+	}
+
+	claims := map[string]any{
+		"iss":   i.srv.URL,
+		"sub":   "jdoe-subject",
+		"aud":   i.clientID,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": req.nonce,
+	}
+
+	for k, v := range req.claims {
+		claims[k] = v
+	}
+
+	idToken, err := i.sign(claims)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]any{"error": "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": "access-token",
+		"token_type":   "Bearer",
+		"expires_in":   60,
+		"id_token":     idToken,
+	})
+}
+
+func (i *mockOIDCIssuer) sign(claims map[string]any) (string, error) {
+	header, err := json.Marshal(map[string]any{"alg": "RS256", "kid": "test", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, i.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
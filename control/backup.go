@@ -0,0 +1,394 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RobinThrift/stuff"
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/backup"
+	"github.com/RobinThrift/stuff/internal/cron"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/sqlite"
+)
+
+// ErrBackupsNotSupported is returned when backing up a database that isn't managed by stuff itself.
+// PostgreSQL databases should be backed up using pg_dump.
+var ErrBackupsNotSupported = errors.New("backups are only supported for SQLite databases, use pg_dump to back up PostgreSQL databases")
+
+type BackupControl struct {
+	config BackupControlConfig
+	db     *database.Database
+	perms  *PermissionControl
+	files  FileRepo
+	blobs  FileBlobs
+
+	mu      sync.Mutex
+	lastRun *entities.BackupRun
+}
+
+type BackupControlConfig struct {
+	// TmpDir is used for the database snapshot.
+	TmpDir string
+	// Dir is where scheduled backups are written to. Scheduled backups are disabled if Dir or Schedule are not set.
+	Dir       string
+	Schedule  *cron.Schedule
+	Retention entities.BackupRetention
+}
+
+const (
+	backupFilePrefix     = "stuff_backup_"
+	backupFileSuffix     = ".tar.gz"
+	backupFileTimeFormat = "20060102T150405"
+)
+
+func NewBackupControl(config BackupControlConfig, db *database.Database, perms *PermissionControl, files FileRepo, blobs FileBlobs) *BackupControl {
+	return &BackupControl{config: config, db: db, perms: perms, files: files, blobs: blobs}
+}
+
+// Backup writes an archive with a consistent snapshot of the database, all stored files and a manifest to w.
+// The database snapshot is taken first, so files uploaded while the backup is running might be included without being referenced.
+func (bc *BackupControl) Backup(ctx context.Context, w io.Writer) (*backup.Manifest, error) {
+	err := bc.perms.RequireRole(ctx, auth.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if bc.db.Dialect == database.DialectPostgres {
+		return nil, ErrBackupsNotSupported
+	}
+
+	tmpDir, err := os.MkdirTemp(bc.config.TmpDir, "stuff_backup_*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	snapshotPath := filepath.Join(tmpDir, backup.DatabaseName)
+
+	// VACUUM INTO can't be run in a transaction, so the database is used directly
+	err = sqlite.Snapshot(ctx, bc.db, snapshotPath)
+	if err != nil {
+		return nil, err
+	}
+
+	migrationVersion, err := sqlite.MigrationVersion(ctx, bc.db)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &backup.Manifest{
+		StuffVersion:     stuff.Version,
+		CreatedAt:        time.Now(),
+		MigrationVersion: migrationVersion,
+		Files:            []backup.File{},
+	}
+
+	bw := backup.NewWriter(w, manifest)
+
+	err = bc.addDatabase(bw, snapshotPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bc.blobs.WalkFiles(ctx, func(blob *entities.File) error {
+		return bc.addFile(bw, blob)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = bw.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// BackupFileName returns the name of a backup archive created at t, which is recognised by the retention policy.
+func BackupFileName(t time.Time) string {
+	return backupFilePrefix + t.Format(backupFileTimeFormat) + backupFileSuffix
+}
+
+// RunScheduledBackup writes a backup to the configured backup directory and deletes old backups according to the retention policy.
+func (bc *BackupControl) RunScheduledBackup(ctx context.Context) error {
+	run := &entities.BackupRun{StartedAt: time.Now()}
+
+	err := bc.backupToDir(ctx, run)
+	if err == nil {
+		err = bc.pruneBackups()
+	}
+
+	run.FinishedAt = time.Now()
+	if err != nil {
+		run.Error = err.Error()
+	}
+
+	bc.mu.Lock()
+	bc.lastRun = run
+	bc.mu.Unlock()
+
+	return err
+}
+
+func (bc *BackupControl) backupToDir(ctx context.Context, run *entities.BackupRun) (err error) {
+	err = os.MkdirAll(bc.config.Dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	fhandle, err := os.CreateTemp(bc.config.Dir, ".stuff_backup_*.tmp")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, fhandle.Close(), os.Remove(fhandle.Name()))
+		}
+	}()
+
+	_, err = bc.Backup(ctx, fhandle)
+	if err != nil {
+		return err
+	}
+
+	err = fhandle.Close()
+	if err != nil {
+		return err
+	}
+
+	run.File = BackupFileName(run.StartedAt)
+
+	return os.Rename(fhandle.Name(), filepath.Join(bc.config.Dir, run.File))
+}
+
+func (bc *BackupControl) pruneBackups() error {
+	backups, err := bc.listBackups()
+	if err != nil {
+		return err
+	}
+
+	keep := backupsToKeep(backups, bc.config.Retention)
+
+	for _, b := range backups {
+		if keep[b.Name] {
+			continue
+		}
+
+		err = os.Remove(filepath.Join(bc.config.Dir, b.Name))
+		if err != nil {
+			return fmt.Errorf("error deleting old backup %s: %w", b.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// backupsToKeep selects the newest backup of each of the most recent days, weeks and months. The newest backup is always kept.
+// backups must be sorted from newest to oldest.
+func backupsToKeep(backups []*entities.BackupFile, retention entities.BackupRetention) map[string]bool {
+	keep := map[string]bool{}
+	if len(backups) == 0 {
+		return keep
+	}
+
+	keep[backups[0].Name] = true
+
+	keepNewestPerPeriod := func(n int, period func(t time.Time) string) {
+		periods := map[string]bool{}
+		for _, b := range backups {
+			if len(periods) >= n {
+				return
+			}
+
+			p := period(b.CreatedAt)
+			if periods[p] {
+				continue
+			}
+
+			periods[p] = true
+			keep[b.Name] = true
+		}
+	}
+
+	keepNewestPerPeriod(retention.Daily, func(t time.Time) string { return t.Format("2006-01-02") })
+	keepNewestPerPeriod(retention.Weekly, func(t time.Time) string {
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-%d", year, week)
+	})
+	keepNewestPerPeriod(retention.Monthly, func(t time.Time) string { return t.Format("2006-01") })
+
+	return keep
+}
+
+// listBackups returns the backups in the backup directory, sorted from newest to oldest.
+func (bc *BackupControl) listBackups() ([]*entities.BackupFile, error) {
+	entries, err := os.ReadDir(bc.config.Dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []*entities.BackupFile{}, nil
+		}
+		return nil, err
+	}
+
+	backups := make([]*entities.BackupFile, 0, len(entries))
+	for _, entry := range entries {
+		timestamp, ok := strings.CutPrefix(entry.Name(), backupFilePrefix)
+		if !ok || entry.IsDir() {
+			continue
+		}
+
+		timestamp, ok = strings.CutSuffix(timestamp, backupFileSuffix)
+		if !ok {
+			continue
+		}
+
+		createdAt, err := time.ParseInLocation(backupFileTimeFormat, timestamp, time.Local)
+		if err != nil {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		backups = append(backups, &entities.BackupFile{Name: entry.Name(), SizeBytes: info.Size(), CreatedAt: createdAt})
+	}
+
+	slices.SortFunc(backups, func(a, b *entities.BackupFile) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return backups, nil
+}
+
+// Status reports the configuration and the result of the last run of the scheduled backups.
+func (bc *BackupControl) Status(ctx context.Context) (*entities.BackupStatus, error) {
+	err := bc.perms.RequireRole(ctx, auth.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &entities.BackupStatus{
+		Enabled:   bc.config.Dir != "" && bc.config.Schedule != nil,
+		Dir:       bc.config.Dir,
+		Retention: bc.config.Retention,
+		Backups:   []*entities.BackupFile{},
+	}
+
+	if !status.Enabled {
+		return status, nil
+	}
+
+	status.Schedule = bc.config.Schedule.String()
+	status.NextRunAt = bc.config.Schedule.Next(time.Now())
+
+	bc.mu.Lock()
+	status.LastRun = bc.lastRun
+	bc.mu.Unlock()
+
+	status.Backups, err = bc.listBackups()
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (bc *BackupControl) addDatabase(bw *backup.Writer, snapshotPath string) error {
+	snapshot, err := os.Open(snapshotPath)
+	if err != nil {
+		return err
+	}
+
+	stat, err := snapshot.Stat()
+	if err != nil {
+		return errors.Join(err, snapshot.Close())
+	}
+
+	err = bw.AddDatabase(snapshot, stat.Size())
+	if err != nil {
+		return errors.Join(err, snapshot.Close())
+	}
+
+	return snapshot.Close()
+}
+
+func (bc *BackupControl) addFile(bw *backup.Writer, blob *entities.File) error {
+	r, err := bc.blobs.OpenFile(blob)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			// the file was deleted after it was listed
+			return nil
+		}
+		return fmt.Errorf("error opening %s: %w", blob.FullPath, err)
+	}
+
+	err = bw.AddFile(strings.TrimPrefix(blob.PublicPath, "/assets/files"), blob.FullPath, blob.SizeBytes, r)
+	if err != nil {
+		return errors.Join(err, r.Close())
+	}
+
+	return r.Close()
+}
+
+// RestoreFiles writes the files of a backup, that was extracted to dir, to the file storage.
+// Files that are stored at a different location than when the backup was created, e.g. because the file dir changed, are updated in the database.
+// The database must have been restored before.
+func (bc *BackupControl) RestoreFiles(ctx context.Context, dir string, manifest *backup.Manifest) error {
+	err := bc.perms.RequireRole(ctx, auth.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range manifest.Files {
+		restored, err := bc.restoreFile(dir, file)
+		if err != nil {
+			return err
+		}
+
+		if file.FullPath == "" || restored.FullPath == file.FullPath {
+			continue
+		}
+
+		err = bc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+			return bc.files.UpdateFullPath(ctx, tx, file.FullPath, restored.FullPath)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (bc *BackupControl) restoreFile(dir string, file backup.File) (*entities.File, error) {
+	fhandle, err := os.Open(filepath.Join(dir, filepath.FromSlash(file.Path)))
+	if err != nil {
+		return nil, err
+	}
+
+	// the file storage derives the path from the checksum and the extension, so the blob ends up at the same relative path
+	restored := &entities.File{Reader: fhandle, Name: path.Base(file.Path)}
+
+	err = bc.blobs.WriteFile(restored)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("error restoring %s: %w", file.Path, err), fhandle.Close())
+	}
+
+	return restored, fhandle.Close()
+}
@@ -0,0 +1,175 @@
+package control
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/backup"
+	"github.com/RobinThrift/stuff/internal/cron"
+	"github.com/RobinThrift/stuff/storage/blobs"
+	"github.com/RobinThrift/stuff/storage/database/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupControl(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	fileCtrl := newTestFileControl(t)
+	backupCtrl := NewBackupControl(BackupControlConfig{TmpDir: t.TempDir()}, fileCtrl.db, fileCtrl.perms, &sqlite.FileRepo{}, fileCtrl.blobs)
+
+	first, err := fileCtrl.WriteFile(ctx, newTestFile(t, 0, 1))
+	require.NoError(t, err)
+	second, err := fileCtrl.WriteFile(ctx, newTestFile(t, 1, 2))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	manifest, err := backupCtrl.Backup(ctx, &buf)
+	require.NoError(t, err)
+
+	latest, err := sqlite.LatestMigrationVersion()
+	require.NoError(t, err)
+	assert.Equal(t, latest, manifest.MigrationVersion)
+	assert.Equal(t, backup.DatabaseName, manifest.Database.Path)
+	require.Len(t, manifest.Files, 2)
+
+	dir := t.TempDir()
+	extracted, err := backup.Extract(&buf, dir)
+	require.NoError(t, err)
+	assert.Equal(t, manifest.Files, extracted.Files)
+
+	t.Run("Restore to Different File Dir", func(t *testing.T) {
+		restoredBlobs := &blobs.LocalFS{RootDir: t.TempDir(), TmpDir: t.TempDir()}
+		restoreCtrl := NewBackupControl(BackupControlConfig{}, fileCtrl.db, fileCtrl.perms, &sqlite.FileRepo{}, restoredBlobs)
+
+		err := restoreCtrl.RestoreFiles(ctx, dir, extracted)
+		require.NoError(t, err)
+
+		for _, id := range []int64{first.ID, second.ID} {
+			restored, err := fileCtrl.Get(ctx, id)
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(restored.FullPath, restoredBlobs.RootDir), restored.FullPath)
+			assert.FileExists(t, restored.FullPath)
+		}
+
+		restored, err := fileCtrl.Get(ctx, first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, first.PublicPath, restored.PublicPath)
+		assert.Equal(t, path.Base(first.FullPath), path.Base(restored.FullPath))
+	})
+}
+
+func TestBackupControl_RunScheduledBackup(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	schedule, err := cron.Parse("@daily")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	fileCtrl := newTestFileControl(t)
+	backupCtrl := NewBackupControl(BackupControlConfig{
+		TmpDir:    t.TempDir(),
+		Dir:       dir,
+		Schedule:  schedule,
+		Retention: entities.BackupRetention{Daily: 1},
+	}, fileCtrl.db, fileCtrl.perms, &sqlite.FileRepo{}, fileCtrl.blobs)
+
+	old := filepath.Join(dir, BackupFileName(time.Now().AddDate(0, 0, -2)))
+	require.NoError(t, os.WriteFile(old, []byte("old"), 0600))
+
+	unrelated := filepath.Join(dir, "notes.txt")
+	require.NoError(t, os.WriteFile(unrelated, []byte("notes"), 0600))
+
+	err = backupCtrl.RunScheduledBackup(ctx)
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, old)
+	assert.FileExists(t, unrelated)
+
+	status, err := backupCtrl.Status(ctx)
+	require.NoError(t, err)
+	assert.True(t, status.Enabled)
+	assert.Equal(t, "@daily", status.Schedule)
+	require.NotNil(t, status.LastRun)
+	assert.Empty(t, status.LastRun.Error)
+	require.Len(t, status.Backups, 1)
+	assert.Equal(t, status.LastRun.File, status.Backups[0].Name)
+	assert.Positive(t, status.Backups[0].SizeBytes)
+
+	fhandle, err := os.Open(filepath.Join(dir, status.LastRun.File))
+	require.NoError(t, err)
+	defer fhandle.Close()
+
+	_, err = backup.Extract(fhandle, t.TempDir())
+	assert.NoError(t, err)
+}
+
+func TestBackupsToKeep(t *testing.T) {
+	// 2023-12-13 is a wednesday
+	now := time.Date(2023, time.December, 13, 3, 0, 0, 0, time.UTC)
+
+	var backups []*entities.BackupFile
+	for i := 0; i < 90; i++ {
+		createdAt := now.AddDate(0, 0, -i)
+		backups = append(backups, &entities.BackupFile{Name: createdAt.Format(time.DateOnly), CreatedAt: createdAt})
+	}
+
+	// a second backup on the newest day
+	backups = append([]*entities.BackupFile{{Name: "newest", CreatedAt: now.Add(time.Hour)}}, backups...)
+
+	tt := []struct {
+		name      string
+		retention entities.BackupRetention
+		expected  []string
+	}{
+		{
+			name:     "Keeps Newest Without Retention",
+			expected: []string{"newest"},
+		},
+		{
+			name:      "Daily",
+			retention: entities.BackupRetention{Daily: 3},
+			expected:  []string{"newest", "2023-12-12", "2023-12-11"},
+		},
+		{
+			name:      "Weekly",
+			retention: entities.BackupRetention{Weekly: 3},
+			expected:  []string{"newest", "2023-12-10", "2023-12-03"},
+		},
+		{
+			name:      "Monthly",
+			retention: entities.BackupRetention{Monthly: 3},
+			expected:  []string{"newest", "2023-11-30", "2023-10-31"},
+		},
+		{
+			name:      "Overlapping",
+			retention: entities.BackupRetention{Daily: 2, Weekly: 2, Monthly: 2},
+			expected:  []string{"newest", "2023-12-12", "2023-12-10", "2023-11-30"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			keep := backupsToKeep(backups, tc.retention)
+
+			actual := make([]string, 0, len(keep))
+			for _, b := range backups {
+				if keep[b.Name] {
+					actual = append(actual, b.Name)
+				}
+			}
+
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
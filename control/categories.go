@@ -29,7 +29,17 @@ type ListCategoriesQuery struct {
 }
 
 func (cc *CategoryCtrl) List(ctx context.Context, query ListCategoriesQuery) (*entities.ListPage[*entities.Category], error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return database.InTransaction(ctx, cc.db, func(ctx context.Context, tx database.Executor) (*entities.ListPage[*entities.Category], error) {
-		return cc.repo.List(ctx, tx, database.ListCategoriesQuery(query))
+		return cc.repo.List(ctx, tx, database.ListCategoriesQuery{
+			WorkspaceID: workspaceID,
+			Search:      query.Search,
+			Page:        query.Page,
+			PageSize:    query.PageSize,
+		})
 	})
 }
@@ -0,0 +1,242 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+)
+
+var ErrAssetAlreadyCheckedOut = errors.New("asset is already checked out")
+var ErrAssetNotCheckedOut = errors.New("asset is not checked out")
+
+type CheckoutRepo interface {
+	GetOpenForAsset(ctx context.Context, exec bob.Executor, assetID int64) (*entities.Checkout, error)
+	List(ctx context.Context, exec bob.Executor, query database.ListCheckoutsQuery) (*entities.ListPage[*entities.Checkout], error)
+	Create(ctx context.Context, exec bob.Executor, checkout *entities.Checkout) (int64, error)
+	Update(ctx context.Context, exec bob.Executor, checkout *entities.Checkout) error
+	DeleteAllForAsset(ctx context.Context, exec bob.Executor, assetID int64) error
+}
+
+func (ac *AssetControl) GetOpenCheckout(ctx context.Context, assetID int64) (*entities.Checkout, error) {
+	err := ac.perms.RequireAssetIDRole(ctx, assetID, auth.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	return database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) (*entities.Checkout, error) {
+		checkout, err := ac.checkouts.GetOpenForAsset(ctx, tx, assetID)
+		if err != nil {
+			if errors.Is(err, database.ErrCheckoutNotFound) {
+				return nil, fmt.Errorf("%w: %d", ErrAssetNotCheckedOut, assetID)
+			}
+			return nil, err
+		}
+
+		return checkout, nil
+	})
+}
+
+type ListCheckoutsQuery struct {
+	AssetID     int64
+	OnlyOpen    bool
+	OnlyOverdue bool
+	Page        int
+	PageSize    int
+}
+
+func (ac *AssetControl) ListCheckouts(ctx context.Context, query ListCheckoutsQuery) (*entities.ListPage[*entities.Checkout], error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) (*entities.ListPage[*entities.Checkout], error) {
+		return ac.checkouts.List(ctx, tx, database.ListCheckoutsQuery{
+			WorkspaceID: workspaceID,
+			AssetID:     query.AssetID,
+			OnlyOpen:    query.OnlyOpen,
+			OnlyOverdue: query.OnlyOverdue,
+			Page:        query.Page,
+			PageSize:    query.PageSize,
+		})
+	})
+}
+
+type CheckOutAssetCmd struct {
+	AssetID      int64
+	CheckedOutTo int64
+	DueAt        time.Time
+	Note         string
+	CreatedBy    int64
+}
+
+// CheckOut lends the asset to the user referenced by cmd.CheckedOutTo and marks it as in use.
+// Only one checkout can be open per asset at a time.
+func (ac *AssetControl) CheckOut(ctx context.Context, cmd CheckOutAssetCmd) (*entities.Checkout, error) {
+	return database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) (*entities.Checkout, error) {
+		return ac.checkOut(ctx, tx, cmd)
+	})
+}
+
+func (ac *AssetControl) checkOut(ctx context.Context, exec bob.Executor, cmd CheckOutAssetCmd) (*entities.Checkout, error) {
+	asset, err := ac.getForCheckout(ctx, exec, cmd.AssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ac.checkouts.GetOpenForAsset(ctx, exec, asset.ID)
+	if err == nil {
+		return nil, fmt.Errorf("%w: %s", ErrAssetAlreadyCheckedOut, asset.Tag)
+	}
+
+	if !errors.Is(err, database.ErrCheckoutNotFound) {
+		return nil, err
+	}
+
+	checkout := &entities.Checkout{
+		AssetID:      asset.ID,
+		CheckedOutTo: cmd.CheckedOutTo,
+		DueAt:        cmd.DueAt,
+		Note:         cmd.Note,
+		CheckedOutAt: time.Now(),
+		CreatedBy:    cmd.CreatedBy,
+	}
+
+	checkout.ID, err = ac.checkouts.Create(ctx, exec, checkout)
+	if err != nil {
+		return nil, fmt.Errorf("error checking out asset %s: %w", asset.Tag, err)
+	}
+
+	before := *asset
+	asset.CheckedOutTo = cmd.CheckedOutTo
+	asset.Status = entities.StatusInUse
+
+	err = ac.repo.Update(ctx, exec, asset)
+	if err != nil {
+		return nil, fmt.Errorf("error updating asset %s in database: %w", asset.Tag, err)
+	}
+
+	err = ac.recordEvent(ctx, exec, entities.AssetEventCheckedOut, asset.ID, &before, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	return ac.checkouts.GetOpenForAsset(ctx, exec, asset.ID)
+}
+
+type CheckInAssetCmd struct {
+	AssetID int64
+	Note    string
+}
+
+// CheckIn closes the currently open checkout of the asset and puts the asset back into storage.
+func (ac *AssetControl) CheckIn(ctx context.Context, cmd CheckInAssetCmd) (*entities.Checkout, error) {
+	return database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) (*entities.Checkout, error) {
+		return ac.checkIn(ctx, tx, cmd)
+	})
+}
+
+func (ac *AssetControl) checkIn(ctx context.Context, exec bob.Executor, cmd CheckInAssetCmd) (*entities.Checkout, error) {
+	asset, err := ac.getForCheckout(ctx, exec, cmd.AssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	checkout, err := ac.checkouts.GetOpenForAsset(ctx, exec, asset.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrCheckoutNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrAssetNotCheckedOut, asset.Tag)
+		}
+		return nil, err
+	}
+
+	checkout.CheckedInAt = time.Now()
+	checkout.CheckInNote = cmd.Note
+
+	err = ac.checkouts.Update(ctx, exec, checkout)
+	if err != nil {
+		return nil, fmt.Errorf("error checking in asset %s: %w", asset.Tag, err)
+	}
+
+	before := *asset
+	asset.CheckedOutTo = 0
+	asset.Status = entities.StatusInStorage
+
+	err = ac.repo.Update(ctx, exec, asset)
+	if err != nil {
+		return nil, fmt.Errorf("error updating asset %s in database: %w", asset.Tag, err)
+	}
+
+	err = ac.recordEvent(ctx, exec, entities.AssetEventCheckedIn, asset.ID, &before, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkout, nil
+}
+
+// RecordOverdueCheckouts marks all open checkouts whose due date is before now as overdue
+// and returns them.
+func (ac *AssetControl) RecordOverdueCheckouts(ctx context.Context, now time.Time) ([]*entities.Checkout, error) {
+	return database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) ([]*entities.Checkout, error) {
+		var overdue []*entities.Checkout
+		query := database.ListCheckoutsQuery{OnlyOpen: true, DueBefore: now, PageSize: 100}
+
+		for {
+			page, err := ac.checkouts.List(ctx, tx, query)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, checkout := range page.Items {
+				if checkout.OverdueAt.IsZero() {
+					checkout.OverdueAt = now
+					err = ac.checkouts.Update(ctx, tx, checkout)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				overdue = append(overdue, checkout)
+			}
+
+			query.Page++
+			if query.Page >= page.NumPages {
+				break
+			}
+		}
+
+		return overdue, nil
+	})
+}
+
+func (ac *AssetControl) SetCheckoutReminded(ctx context.Context, checkout *entities.Checkout, at time.Time) error {
+	return ac.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		checkout.RemindedAt = at
+		return ac.checkouts.Update(ctx, tx, checkout)
+	})
+}
+
+func (ac *AssetControl) getForCheckout(ctx context.Context, exec bob.Executor, assetID int64) (*entities.Asset, error) {
+	// parts and purchases must be loaded, as AssetRepo.Update would remove them otherwise
+	asset, err := ac.repo.Get(ctx, exec, database.GetAssetQuery{ID: assetID, IncludePurchases: true, IncludeParts: true})
+	if err != nil {
+		if errors.Is(err, database.ErrAssetNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrAssetNotFound, assetID)
+		}
+		return nil, err
+	}
+
+	err = ac.perms.RequireAssetRole(ctx, asset, auth.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	return asset, nil
+}
@@ -0,0 +1,143 @@
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetControl_CheckOutCheckIn(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	assetCtrl := newTestAssetControl(t)
+	asset := newTestAsset(t)
+	asset.Status = entities.StatusInStorage
+
+	created, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: asset})
+	require.NoError(t, err)
+
+	_, err = assetCtrl.CheckIn(ctx, CheckInAssetCmd{AssetID: created.ID})
+	assert.ErrorIs(t, err, ErrAssetNotCheckedOut)
+
+	dueAt := time.Now().Add(time.Hour * 24 * 7).UTC().Truncate(time.Second)
+
+	checkout, err := assetCtrl.CheckOut(ctx, CheckOutAssetCmd{
+		AssetID:      created.ID,
+		CheckedOutTo: 1,
+		DueAt:        dueAt,
+		Note:         "for the conference",
+		CreatedBy:    1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, checkout.AssetID)
+	assert.Equal(t, int64(1), checkout.CheckedOutTo)
+	assert.Equal(t, "asset_test_user", checkout.CheckedOutToName)
+	assert.Equal(t, dueAt, checkout.DueAt)
+	assert.Equal(t, "for the conference", checkout.Note)
+	assert.False(t, checkout.IsCheckedIn())
+
+	_, err = assetCtrl.CheckOut(ctx, CheckOutAssetCmd{AssetID: created.ID, CheckedOutTo: 1, CreatedBy: 1})
+	assert.ErrorIs(t, err, ErrAssetAlreadyCheckedOut)
+
+	checkedOut, err := assetCtrl.Get(ctx, GetAssetQuery{ID: created.ID, IncludeParts: true, IncludePurchases: true})
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusInUse, checkedOut.Status)
+	assert.Equal(t, int64(1), checkedOut.CheckedOutTo)
+	assert.Len(t, checkedOut.Parts, len(created.Parts))
+	assert.Len(t, checkedOut.Purchases, len(created.Purchases))
+
+	checkedIn, err := assetCtrl.CheckIn(ctx, CheckInAssetCmd{AssetID: created.ID, Note: "all good"})
+	require.NoError(t, err)
+	assert.Equal(t, checkout.ID, checkedIn.ID)
+	assert.Equal(t, "all good", checkedIn.CheckInNote)
+	assert.True(t, checkedIn.IsCheckedIn())
+
+	returned, err := assetCtrl.Get(ctx, GetAssetQuery{ID: created.ID})
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusInStorage, returned.Status)
+	assert.Equal(t, int64(0), returned.CheckedOutTo)
+
+	_, err = assetCtrl.CheckOut(ctx, CheckOutAssetCmd{AssetID: created.ID, CheckedOutTo: 1, CreatedBy: 1})
+	require.NoError(t, err)
+
+	history, err := assetCtrl.ListCheckouts(ctx, ListCheckoutsQuery{AssetID: created.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 2, history.Total)
+	assert.False(t, history.Items[0].IsCheckedIn())
+	assert.True(t, history.Items[1].IsCheckedIn())
+
+	_, err = assetCtrl.CheckOut(ctx, CheckOutAssetCmd{AssetID: 1337, CheckedOutTo: 1, CreatedBy: 1})
+	assert.ErrorIs(t, err, ErrAssetNotFound)
+
+	err = assetCtrl.Delete(ctx, returned)
+	require.NoError(t, err)
+
+	history, err = assetCtrl.ListCheckouts(ctx, ListCheckoutsQuery{AssetID: created.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 2, history.Total)
+
+	err = assetCtrl.Purge(ctx, created.ID)
+	require.NoError(t, err)
+
+	history, err = assetCtrl.ListCheckouts(ctx, ListCheckoutsQuery{AssetID: created.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 0, history.Total)
+}
+
+func TestAssetControl_RecordOverdueCheckouts(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	assetCtrl := newTestAssetControl(t)
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	overdueAsset, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: newTestAsset(t)})
+	require.NoError(t, err)
+	_, err = assetCtrl.CheckOut(ctx, CheckOutAssetCmd{AssetID: overdueAsset.ID, CheckedOutTo: 1, CreatedBy: 1, DueAt: now.Add(-time.Hour * 24)})
+	require.NoError(t, err)
+
+	notDueAsset, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: newTestAsset(t)})
+	require.NoError(t, err)
+	_, err = assetCtrl.CheckOut(ctx, CheckOutAssetCmd{AssetID: notDueAsset.ID, CheckedOutTo: 1, CreatedBy: 1, DueAt: now.Add(time.Hour * 24)})
+	require.NoError(t, err)
+
+	noDueDateAsset, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: newTestAsset(t)})
+	require.NoError(t, err)
+	_, err = assetCtrl.CheckOut(ctx, CheckOutAssetCmd{AssetID: noDueDateAsset.ID, CheckedOutTo: 1, CreatedBy: 1})
+	require.NoError(t, err)
+
+	overdue, err := assetCtrl.RecordOverdueCheckouts(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, overdue, 1)
+	assert.Equal(t, overdueAsset.ID, overdue[0].AssetID)
+	assert.Equal(t, overdueAsset.Tag, overdue[0].AssetTag)
+	assert.Equal(t, now, overdue[0].OverdueAt)
+	assert.True(t, overdue[0].RemindedAt.IsZero())
+
+	err = assetCtrl.SetCheckoutReminded(ctx, overdue[0], now)
+	require.NoError(t, err)
+
+	overdue, err = assetCtrl.RecordOverdueCheckouts(ctx, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, overdue, 1)
+	assert.Equal(t, now, overdue[0].OverdueAt, "overdue timestamp must not be overwritten")
+	assert.Equal(t, now, overdue[0].RemindedAt)
+
+	list, err := assetCtrl.ListCheckouts(ctx, ListCheckoutsQuery{OnlyOpen: true, OnlyOverdue: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, list.Total)
+
+	_, err = assetCtrl.CheckIn(ctx, CheckInAssetCmd{AssetID: overdueAsset.ID})
+	require.NoError(t, err)
+
+	overdue, err = assetCtrl.RecordOverdueCheckouts(ctx, now)
+	require.NoError(t, err)
+	assert.Empty(t, overdue)
+}
@@ -28,11 +28,17 @@ type ListCustomAttrsQuery struct {
 }
 
 func (cac *CustomAttrCtrl) List(ctx context.Context, query ListCustomAttrsQuery) (*entities.ListPage[*entities.CustomAttr], error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return database.InTransaction(ctx, cac.db, func(ctx context.Context, tx database.Executor) (*entities.ListPage[*entities.CustomAttr], error) {
 		return cac.repo.List(ctx, tx, database.ListCustomAttrsQuery{
-			Search:   query.Search,
-			Page:     query.Page,
-			PageSize: query.PageSize,
+			WorkspaceID: workspaceID,
+			Search:      query.Search,
+			Page:        query.Page,
+			PageSize:    query.PageSize,
 		})
 	})
 }
@@ -2,28 +2,42 @@ package control
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
 	"github.com/RobinThrift/stuff/internal/exporter"
 	"github.com/RobinThrift/stuff/storage/database"
 )
 
+var ErrNoAssetsSelected = errors.New("no assets selected")
+
 type ExporterCtrl struct {
-	db     *database.Database
-	assets *AssetControl
+	db            *database.Database
+	assets        *AssetControl
+	files         *FileControl
+	savedSearches *SavedSearchControl
 }
 
-func NewExporterCtrl(db *database.Database, assets *AssetControl) *ExporterCtrl {
-	return &ExporterCtrl{db: db, assets: assets}
+func NewExporterCtrl(db *database.Database, assets *AssetControl, files *FileControl, savedSearches *SavedSearchControl) *ExporterCtrl {
+	return &ExporterCtrl{db: db, assets: assets, files: files, savedSearches: savedSearches}
 }
 
 type ExportCmd struct {
 	Format string
+	// SavedSearchID limits the export to the assets matching the saved search, if set.
+	SavedSearchID int64
 }
 
 func (ec *ExporterCtrl) Export(ctx context.Context, w io.Writer, cmd ExportCmd) error {
-	assets, err := ec.assets.List(ctx, ListAssetsQuery{})
+	query, err := ec.assetsQuery(ctx, cmd.SavedSearchID)
+	if err != nil {
+		return err
+	}
+
+	assets, err := ec.assets.List(ctx, query)
 	if err != nil {
 		return err
 	}
@@ -37,3 +51,115 @@ func (ec *ExporterCtrl) Export(ctx context.Context, w io.Writer, cmd ExportCmd)
 
 	return fmt.Errorf("unknown export format: %s", cmd.Format)
 }
+
+type ExportFilesCmd struct {
+	AssetIDs []int64
+	// SavedSearchID selects the assets matching the saved search, if no AssetIDs are set.
+	SavedSearchID int64
+}
+
+// ExportFiles writes the files of the selected assets to w as a ZIP archive, with one folder per asset named after its tag and name.
+// Generated image variants and files whose blob is known to be missing are skipped.
+// Nothing is written to w, if the assets or their files can't be loaded.
+func (ec *ExporterCtrl) ExportFiles(ctx context.Context, w io.Writer, cmd ExportFilesCmd) error {
+	if len(cmd.AssetIDs) == 0 && cmd.SavedSearchID == 0 {
+		return ErrNoAssetsSelected
+	}
+
+	query := ListAssetsQuery{IDs: cmd.AssetIDs}
+	if len(cmd.AssetIDs) == 0 {
+		var err error
+		query, err = ec.assetsQuery(ctx, cmd.SavedSearchID)
+		if err != nil {
+			return err
+		}
+	}
+
+	query.OrderBy = "tag"
+	query.OrderDir = "asc"
+
+	assets, err := ec.assets.List(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if len(assets.Items) == 0 {
+		if len(cmd.AssetIDs) == 0 {
+			return ErrNoAssetsSelected
+		}
+		return fmt.Errorf("%w: %v", ErrAssetNotFound, cmd.AssetIDs)
+	}
+
+	files := make(map[int64][]*entities.File, len(assets.Items))
+	for _, asset := range assets.Items {
+		err = ec.files.perms.RequireAssetRole(ctx, asset, auth.RoleViewer)
+		if err != nil {
+			return err
+		}
+
+		files[asset.ID], err = ec.listAssetFiles(ctx, asset.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	archive := exporter.NewFilesArchive(w)
+
+	for _, asset := range assets.Items {
+		for _, file := range files[asset.ID] {
+			err = ec.exportFile(archive, asset, file)
+			if err != nil {
+				return errors.Join(err, archive.Close())
+			}
+		}
+	}
+
+	return archive.Close()
+}
+
+// assetsQuery returns the query for the assets of the saved search, or for all assets if savedSearchID is 0.
+func (ec *ExporterCtrl) assetsQuery(ctx context.Context, savedSearchID int64) (ListAssetsQuery, error) {
+	if savedSearchID == 0 {
+		return ListAssetsQuery{}, nil
+	}
+
+	return ec.savedSearches.AssetsQuery(ctx, savedSearchID)
+}
+
+func (ec *ExporterCtrl) listAssetFiles(ctx context.Context, assetID int64) ([]*entities.File, error) {
+	query := ListFilesQuery{AssetID: assetID, PageSize: 100}
+	files := []*entities.File{}
+	for {
+		page, err := ec.files.List(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range page.Items {
+			if file.Variant != entities.FileVariantOriginal || file.Broken == entities.FileBrokenMissing {
+				continue
+			}
+			files = append(files, file)
+		}
+
+		if len(page.Items) < query.PageSize {
+			return files, nil
+		}
+
+		query.Page++
+	}
+}
+
+func (ec *ExporterCtrl) exportFile(archive *exporter.FilesArchive, asset *entities.Asset, file *entities.File) error {
+	r, err := ec.files.blobs.OpenFile(file)
+	if err != nil {
+		return fmt.Errorf("error opening %s: %w", file.FullPath, err)
+	}
+
+	err = archive.AddFile(asset, file, r)
+	if err != nil {
+		return errors.Join(err, r.Close())
+	}
+
+	return r.Close()
+}
@@ -0,0 +1,73 @@
+package control
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExporterCtrl_ExportFiles(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	assetCtrl := newTestAssetControl(t)
+	exporterCtrl := NewExporterCtrl(assetCtrl.db, assetCtrl, assetCtrl.files, NewSavedSearchControl(assetCtrl.db, assetCtrl.perms, &sqlite.SavedSearchRepo{}))
+
+	first := newTestAsset(t)
+	first.Name = "Drill: Cordless/18V"
+	first, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: first, Image: newTestImage(t, 400, 200)})
+	require.NoError(t, err)
+
+	second, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: newTestAsset(t)})
+	require.NoError(t, err)
+
+	for _, f := range []*entities.File{
+		{Reader: bytes.NewBufferString("first manual"), AssetID: first.ID, Name: "manual.pdf", CreatedBy: 1},
+		{Reader: bytes.NewBufferString("second manual"), AssetID: first.ID, Name: "manual.pdf", CreatedBy: 1},
+		{Reader: bytes.NewBufferString("invoice"), AssetID: second.ID, Name: "invoice.pdf", CreatedBy: 1},
+	} {
+		_, err = assetCtrl.files.WriteFile(ctx, f)
+		require.NoError(t, err)
+	}
+
+	var buf bytes.Buffer
+	err = exporterCtrl.ExportFiles(ctx, &buf, ExportFilesCmd{AssetIDs: []int64{first.ID, second.ID}})
+	require.NoError(t, err)
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	contents := map[string]string{}
+	for _, f := range archive.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		contents[f.Name] = string(content)
+	}
+
+	firstFolder := first.Tag + "_Drill_ Cordless_18V/"
+	secondFolder := second.Tag + "_Test Asset/"
+
+	assert.Len(t, contents, 4)
+	assert.Contains(t, contents, firstFolder+first.Tag+"_image.jpg")
+	assert.Equal(t, "first manual", contents[firstFolder+"manual.pdf"])
+	assert.Equal(t, "second manual", contents[firstFolder+"manual (2).pdf"])
+	assert.Equal(t, "invoice", contents[secondFolder+"invoice.pdf"])
+
+	t.Run("No Assets Selected", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := exporterCtrl.ExportFiles(ctx, &buf, ExportFilesCmd{})
+		assert.ErrorIs(t, err, ErrNoAssetsSelected)
+		assert.Zero(t, buf.Len())
+	})
+}
@@ -1,20 +1,31 @@
 package control
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
-
+	"image"
+	"io"
+	"log/slog"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
 	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/imaging"
 	"github.com/RobinThrift/stuff/storage/database"
-	"github.com/RobinThrift/stuff/storage/database/sqlite"
 	"github.com/stephenafamo/bob"
 )
 
 var ErrFileNotFound = errors.New("file not found")
 
 type FileControl struct {
-	db *database.Database
+	db    *database.Database
+	perms *PermissionControl
 
 	repo FileRepo
 
@@ -23,20 +34,35 @@ type FileControl struct {
 
 type FileRepo interface {
 	Get(ctx context.Context, exec bob.Executor, id int64) (*entities.File, error)
-	GetByPublicPath(ctx context.Context, exec bob.Executor, publicPath string) (*entities.File, error)
+	GetByPublicPath(ctx context.Context, exec bob.Executor, assetID int64, publicPath string) (*entities.File, error)
+	GetByHash(ctx context.Context, exec bob.Executor, sha256 []byte) (*entities.File, error)
+	CountReferences(ctx context.Context, exec bob.Executor, fullPath string) (int64, error)
 	List(ctx context.Context, exec bob.Executor, query database.ListFilesQuery) (*entities.ListPage[*entities.File], error)
 	Create(ctx context.Context, exec bob.Executor, file *entities.File) (int64, error)
 	Delete(ctx context.Context, exec bob.Executor, ids []int64) error
+	SetBroken(ctx context.Context, exec bob.Executor, ids []int64, reason entities.FileBrokenReason) error
+	UpdateFullPath(ctx context.Context, exec bob.Executor, oldPath string, newPath string) error
 }
 
 type FileBlobs interface {
 	WriteFile(*entities.File) error
+	OpenFile(*entities.File) (io.ReadCloser, error)
 	RemoveFile(*entities.File) error
+	WalkFiles(ctx context.Context, fn func(*entities.File) error) error
 }
 
-func NewFileControl(db *database.Database, repo FileRepo, blobs FileBlobs) *FileControl {
+const (
+	thumbnailSize = 320
+	previewSize   = 1280
+)
+
+// orphanGracePeriod protects blobs of uploads whose file row has not been committed yet from being reported as orphans.
+const orphanGracePeriod = time.Hour
+
+func NewFileControl(db *database.Database, perms *PermissionControl, repo FileRepo, blobs FileBlobs) *FileControl {
 	return &FileControl{
 		db:    db,
+		perms: perms,
 		repo:  repo,
 		blobs: blobs,
 	}
@@ -46,11 +72,17 @@ func (fc *FileControl) Get(ctx context.Context, id int64) (*entities.File, error
 	return database.InTransaction(ctx, fc.db, func(ctx context.Context, tx database.Executor) (*entities.File, error) {
 		file, err := fc.repo.Get(ctx, tx, id)
 		if err != nil {
-			if errors.Is(err, sqlite.ErrFileNotFound) {
+			if errors.Is(err, database.ErrFileNotFound) {
 				return nil, fmt.Errorf("%w: %d", ErrFileNotFound, id)
 			}
 			return nil, err
 		}
+
+		err = fc.perms.RequireAssetIDRole(ctx, file.AssetID, auth.RoleViewer)
+		if err != nil {
+			return nil, err
+		}
+
 		return file, nil
 	})
 }
@@ -78,29 +110,176 @@ func (fc *FileControl) WriteFile(ctx context.Context, file *entities.File) (*ent
 }
 
 func (fc *FileControl) writeFile(ctx context.Context, exec bob.Executor, file *entities.File) (*entities.File, error) {
-	err := fc.blobs.WriteFile(file)
+	err := fc.requireEditor(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fc.blobs.WriteFile(file)
 	if err != nil {
 		return nil, err
 	}
 
+	err = fc.dedup(ctx, exec, file)
+	if err != nil {
+		return nil, errors.Join(err, fc.removeUnreferencedBlob(ctx, exec, file))
+	}
+
 	createdID, err := fc.repo.Create(ctx, exec, file)
 	if err != nil {
-		return nil, errors.Join(err, fc.blobs.RemoveFile(file))
+		return nil, errors.Join(err, fc.removeUnreferencedBlob(ctx, exec, file))
 	}
 
 	created, err := fc.repo.Get(ctx, exec, createdID)
 	if err != nil {
-		return nil, errors.Join(err, fc.blobs.RemoveFile(file))
+		return nil, err
 	}
 
 	return created, nil
 }
 
-func (fc *FileControl) DeleteByPublicPath(ctx context.Context, publicPath string) error {
+// dedup points the file to the blob of an existing file with the same content. Blobs are content addressed,
+// but include the file extension, so identical files with different extensions would otherwise be stored twice.
+func (fc *FileControl) dedup(ctx context.Context, exec bob.Executor, file *entities.File) error {
+	existing, err := fc.repo.GetByHash(ctx, exec, file.Sha256)
+	if err != nil {
+		if errors.Is(err, database.ErrFileNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if existing.FullPath == file.FullPath {
+		return nil
+	}
+
+	err = fc.removeUnreferencedBlob(ctx, exec, file)
+	if err != nil {
+		return err
+	}
+
+	file.FullPath = existing.FullPath
+	file.PublicPath = existing.PublicPath
+
+	return nil
+}
+
+// removeUnreferencedBlob removes the file's blob, unless another file is still stored in it.
+func (fc *FileControl) removeUnreferencedBlob(ctx context.Context, exec bob.Executor, file *entities.File) error {
+	refs, err := fc.repo.CountReferences(ctx, exec, file.FullPath)
+	if err != nil {
+		return err
+	}
+
+	if refs != 0 {
+		return nil
+	}
+
+	return fc.blobs.RemoveFile(file)
+}
+
+// removeBlobAfterCommit removes the blob of a deleted file once the deletion has been committed, so the blob is kept
+// if the transaction is rolled back. Errors are only logged, as the file is already deleted. The blob is then left
+// as an orphan, that is removed by the next repair.
+func (fc *FileControl) removeBlobAfterCommit(ctx context.Context, file *entities.File) {
+	database.AfterCommit(ctx, func(ctx context.Context) {
+		err := fc.removeUnreferencedBlob(ctx, fc.db, file)
+		if err != nil {
+			slog.ErrorContext(ctx, "error removing blob of deleted file", "path", file.FullPath, "error", err)
+		}
+	})
+}
+
+// ImageVariants are the downscaled versions of an uploaded image.
+type ImageVariants struct {
+	Thumbnail *entities.File
+	Preview   *entities.File
+}
+
+// WriteImageVariants decodes the image file, applies the EXIF orientation and stores a thumbnail and a preview
+// as separate files of the same asset. Returns imaging.ErrUnsupportedFormat for files that aren't JPEG, PNG or GIF images.
+func (fc *FileControl) WriteImageVariants(ctx context.Context, image *entities.File) (*ImageVariants, error) {
+	return database.InTransaction(ctx, fc.db, func(ctx context.Context, tx database.Executor) (*ImageVariants, error) {
+		return fc.writeImageVariants(ctx, tx, image)
+	})
+}
+
+func (fc *FileControl) writeImageVariants(ctx context.Context, exec bob.Executor, image *entities.File) (*ImageVariants, error) {
+	err := fc.requireEditor(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := fc.blobs.OpenFile(image)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("error reading image %s: %w", image.Name, err), r.Close())
+	}
+
+	err = r.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := imaging.Decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding image %s: %w", image.Name, err)
+	}
+
+	preview := imaging.Fit(decoded, previewSize)
+
+	variants := &ImageVariants{}
+	variants.Preview, err = fc.writeImageVariant(ctx, exec, image, preview, entities.FileVariantPreview)
+	if err != nil {
+		return nil, err
+	}
+
+	variants.Thumbnail, err = fc.writeImageVariant(ctx, exec, image, imaging.Fit(preview, thumbnailSize), entities.FileVariantThumbnail)
+	if err != nil {
+		return nil, err
+	}
+
+	return variants, nil
+}
+
+func (fc *FileControl) writeImageVariant(ctx context.Context, exec bob.Executor, original *entities.File, img image.Image, variant entities.FileVariant) (*entities.File, error) {
+	var buf bytes.Buffer
+	err := imaging.EncodeJPEG(&buf, img)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding %s of image %s: %w", variant, original.Name, err)
+	}
+
+	return fc.writeFile(ctx, exec, &entities.File{
+		Reader:    &buf,
+		AssetID:   original.AssetID,
+		Name:      strings.TrimSuffix(original.Name, path.Ext(original.Name)) + "_" + string(variant) + ".jpg",
+		Filetype:  "image/jpeg",
+		Variant:   variant,
+		CreatedBy: original.CreatedBy,
+	})
+}
+
+func (fc *FileControl) getByPublicPath(ctx context.Context, exec bob.Executor, assetID int64, publicPath string) (*entities.File, error) {
+	file, err := fc.repo.GetByPublicPath(ctx, exec, assetID, publicPath)
+	if err != nil {
+		if errors.Is(err, database.ErrFileNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrFileNotFound, publicPath)
+		}
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (fc *FileControl) DeleteByPublicPath(ctx context.Context, assetID int64, publicPath string) error {
 	return fc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
-		file, err := fc.repo.GetByPublicPath(ctx, tx, publicPath)
+		file, err := fc.repo.GetByPublicPath(ctx, tx, assetID, publicPath)
 		if err != nil {
-			if errors.Is(err, sqlite.ErrFileNotFound) {
+			if errors.Is(err, database.ErrFileNotFound) {
 				return nil
 			}
 			return err
@@ -117,19 +296,19 @@ func (fc *FileControl) Delete(ctx context.Context, id int64) error {
 			return err
 		}
 
-		list, err := fc.repo.List(ctx, tx, database.ListFilesQuery{Hashes: [][]byte{file.Sha256}})
+		err = fc.requireEditor(ctx, file)
 		if err != nil {
 			return err
 		}
 
-		if len(list.Items) == 1 {
-			err = fc.blobs.RemoveFile(file)
-			if err != nil {
-				return err
-			}
+		err = fc.repo.Delete(ctx, tx, []int64{id})
+		if err != nil {
+			return err
 		}
 
-		return fc.repo.Delete(ctx, tx, []int64{id})
+		fc.removeBlobAfterCommit(ctx, file)
+
+		return nil
 	})
 }
 
@@ -140,20 +319,20 @@ func (fc *FileControl) DeleteAllForAsset(ctx context.Context, assetID int64) err
 }
 
 func (fc *FileControl) deleteAllForAsset(ctx context.Context, exec bob.Executor, assetID int64) error {
-	list, err := fc.repo.List(ctx, exec, database.ListFilesQuery{AssetID: assetID})
+	files, err := fc.listAll(ctx, exec, database.ListFilesQuery{AssetID: assetID})
 	if err != nil {
 		return err
 	}
-	files := list.Items
 
-	filesToDelete := make(map[string]*entities.File, len(files))
+	blobs := make(map[string]*entities.File, len(files))
+	fileIDs := make([]int64, 0, len(files))
+	for _, file := range files {
+		fileIDs = append(fileIDs, file.ID)
+		blobs[file.FullPath] = file
+	}
 
-	fileIDs := make([]int64, 0, len(filesToDelete))
-	hashes := make([][]byte, len(files))
-	for i := range files {
-		fileIDs = append(fileIDs, files[i].ID)
-		hashes[i] = files[i].Sha256
-		filesToDelete[fmt.Sprintf("%x", files[i].Sha256)] = files[i]
+	if len(fileIDs) == 0 {
+		return nil
 	}
 
 	err = fc.repo.Delete(ctx, exec, fileIDs)
@@ -161,23 +340,209 @@ func (fc *FileControl) deleteAllForAsset(ctx context.Context, exec bob.Executor,
 		return err
 	}
 
-	list, err = fc.repo.List(ctx, exec, database.ListFilesQuery{Hashes: hashes})
+	for _, file := range blobs {
+		fc.removeBlobAfterCommit(ctx, file)
+	}
+
+	return nil
+}
+
+type CheckFilesCmd struct {
+	// VerifyChecksums reads every blob to compare its checksum with the recorded one.
+	VerifyChecksums bool
+	// Repair deletes orphaned blobs and marks files with missing or mismatched blobs as broken.
+	Repair bool
+}
+
+// Check compares the files in the database with the blobs in the file storage and reports blobs without a file,
+// files without a blob and, optionally, blobs with a different checksum than recorded.
+func (fc *FileControl) Check(ctx context.Context, cmd CheckFilesCmd) (*entities.FileCheckReport, error) {
+	err := fc.perms.RequireRole(ctx, auth.RoleAdmin)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	report := &entities.FileCheckReport{
+		Orphans:           []*entities.File{},
+		Missing:           []*entities.File{},
+		Mismatched:        []*entities.File{},
+		ChecksumsVerified: cmd.VerifyChecksums,
+		StartedAt:         time.Now(),
+	}
+
+	// the files are listed before the blobs, as blobs are written before their file is committed. Uploads that finish
+	// during the check are thus never reported as missing, only their blobs as orphans, which the grace period protects.
+	files, err := database.InTransaction(ctx, fc.db, func(ctx context.Context, tx database.Executor) ([]*entities.File, error) {
+		return fc.listAll(ctx, tx, database.ListFilesQuery{})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	blobs := map[string]*entities.File{}
+	err = fc.blobs.WalkFiles(ctx, func(blob *entities.File) error {
+		blobs[blob.FullPath] = blob
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing stored files: %w", err)
+	}
+
+	report.NumBlobs = len(blobs)
+	report.NumFiles = len(files)
+
+	referenced := make(map[string]struct{}, len(files))
+	verified := map[string]bool{}
+	for _, file := range files {
+		referenced[file.FullPath] = struct{}{}
+
+		blob, ok := blobs[file.FullPath]
+		if !ok {
+			report.Missing = append(report.Missing, file)
+			continue
+		}
+
+		if !cmd.VerifyChecksums {
+			continue
+		}
+
+		matches, ok := verified[blob.FullPath]
+		if !ok {
+			matches, err = fc.verifyChecksum(file)
+			if err != nil {
+				return nil, err
+			}
+			verified[blob.FullPath] = matches
+		}
+
+		if !matches {
+			report.Mismatched = append(report.Mismatched, file)
+		}
 	}
-	filesForHashes := list.Items
 
-	for i := range filesForHashes {
-		hash := fmt.Sprintf("%x", filesForHashes[i].Sha256)
-		delete(filesToDelete, hash)
+	for fullPath, blob := range blobs {
+		if _, ok := referenced[fullPath]; ok {
+			continue
+		}
+
+		if time.Since(blob.UpdatedAt) < orphanGracePeriod {
+			continue
+		}
+
+		report.Orphans = append(report.Orphans, blob)
 	}
 
-	for _, file := range filesToDelete {
-		err = fc.blobs.RemoveFile(file)
+	slices.SortFunc(report.Orphans, func(a, b *entities.File) int {
+		return strings.Compare(a.FullPath, b.FullPath)
+	})
+
+	if cmd.Repair {
+		err = fc.repair(ctx, report, files)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		report.Repaired = true
 	}
 
-	return nil
+	report.FinishedAt = time.Now()
+
+	return report, nil
+}
+
+func (fc *FileControl) listAll(ctx context.Context, exec bob.Executor, query database.ListFilesQuery) ([]*entities.File, error) {
+	query.PageSize = 100
+	files := []*entities.File{}
+	for {
+		page, err := fc.repo.List(ctx, exec, query)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, page.Items...)
+
+		if len(page.Items) < query.PageSize {
+			return files, nil
+		}
+
+		query.Page++
+	}
+}
+
+func (fc *FileControl) verifyChecksum(file *entities.File) (bool, error) {
+	r, err := fc.blobs.OpenFile(file)
+	if err != nil {
+		return false, fmt.Errorf("error opening %s: %w", file.FullPath, err)
+	}
+
+	h := sha256.New()
+	_, err = io.Copy(h, r)
+	if err != nil {
+		return false, errors.Join(fmt.Errorf("error reading %s: %w", file.FullPath, err), r.Close())
+	}
+
+	err = r.Close()
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(h.Sum(nil), file.Sha256), nil
+}
+
+func (fc *FileControl) repair(ctx context.Context, report *entities.FileCheckReport, files []*entities.File) error {
+	// orphans are only removed if they are still unreferenced, as an upload of the same content might have referenced
+	// the blob again since the check. Storages that skip writing existing blobs, like S3, don't refresh their
+	// modification time, so the grace period alone doesn't protect them.
+	err := fc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		for _, orphan := range report.Orphans {
+			err := fc.removeUnreferencedBlob(ctx, tx, orphan)
+			if err != nil {
+				return fmt.Errorf("error deleting orphaned file %s: %w", orphan.FullPath, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	broken := make(map[int64]entities.FileBrokenReason, len(report.Missing)+len(report.Mismatched))
+	for _, file := range report.Missing {
+		broken[file.ID] = entities.FileBrokenMissing
+	}
+	for _, file := range report.Mismatched {
+		broken[file.ID] = entities.FileBrokenChecksumMismatch
+	}
+
+	byReason := map[entities.FileBrokenReason][]int64{}
+	for _, file := range files {
+		reason, isBroken := broken[file.ID]
+		if !isBroken && file.Broken == entities.FileBrokenChecksumMismatch && !report.ChecksumsVerified {
+			// the checksum wasn't checked again, so the file might still be broken
+			continue
+		}
+
+		if file.Broken != reason {
+			byReason[reason] = append(byReason[reason], file.ID)
+		}
+	}
+
+	return fc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		for reason, ids := range byReason {
+			err := fc.repo.SetBroken(ctx, tx, ids, reason)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (fc *FileControl) requireEditor(ctx context.Context, file *entities.File) error {
+	if file.AssetID == 0 {
+		return fc.perms.RequireRole(ctx, auth.RoleEditor)
+	}
+
+	return fc.perms.RequireAssetIDRole(ctx, file.AssetID, auth.RoleEditor)
 }
@@ -22,7 +22,7 @@ import (
 )
 
 func TestFileControl_CRUD(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
 	t.Cleanup(cancel)
 
 	fileCtrl := newTestFileControl(t)
@@ -67,6 +67,247 @@ func TestFileControl_CRUD(t *testing.T) {
 	assert.Len(t, list.Items, 1)
 }
 
+func TestFileControl_Dedup(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	fileCtrl := newTestFileControl(t)
+
+	datasheet := []byte("datasheet content")
+
+	first, err := fileCtrl.WriteFile(ctx, &entities.File{Reader: bytes.NewReader(datasheet), AssetID: 1, Name: "datasheet.pdf", CreatedBy: 1})
+	require.NoError(t, err)
+
+	second, err := fileCtrl.WriteFile(ctx, &entities.File{Reader: bytes.NewReader(datasheet), AssetID: 2, Name: "manual.PDF", CreatedBy: 1})
+	require.NoError(t, err)
+
+	third, err := fileCtrl.WriteFile(ctx, &entities.File{Reader: bytes.NewReader(datasheet), AssetID: 2, Name: "copy.pdf", CreatedBy: 1})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.ID, second.ID)
+	assert.Equal(t, first.FullPath, second.FullPath)
+	assert.Equal(t, first.PublicPath, second.PublicPath)
+	assert.Equal(t, first.FullPath, third.FullPath)
+
+	report, err := fileCtrl.Check(ctx, CheckFilesCmd{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.NumFiles)
+	assert.Equal(t, 1, report.NumBlobs)
+
+	err = fileCtrl.DeleteAllForAsset(ctx, 1)
+	require.NoError(t, err)
+	fileExitsts(t, first.FullPath)
+
+	err = fileCtrl.DeleteByPublicPath(ctx, 1, second.PublicPath)
+	require.NoError(t, err)
+
+	err = fileCtrl.Delete(ctx, second.ID)
+	require.NoError(t, err)
+	fileExitsts(t, first.FullPath)
+
+	err = fileCtrl.Delete(ctx, third.ID)
+	require.NoError(t, err)
+	assert.NoFileExists(t, first.FullPath)
+}
+
+func TestFileControl_Delete_Rollback(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	fileCtrl := newTestFileControl(t)
+
+	file, err := fileCtrl.WriteFile(ctx, newTestFile(t, 0, 1))
+	require.NoError(t, err)
+
+	errRollback := errors.New("rollback")
+	err = fileCtrl.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		err := fileCtrl.Delete(ctx, file.ID)
+		require.NoError(t, err)
+
+		err = fileCtrl.DeleteAllForAsset(ctx, 1)
+		require.NoError(t, err)
+
+		// the blob is only removed after the commit
+		fileExitsts(t, file.FullPath)
+
+		return errRollback
+	})
+	require.ErrorIs(t, err, errRollback)
+
+	fileExitsts(t, file.FullPath)
+	_, err = fileCtrl.Get(ctx, file.ID)
+	require.NoError(t, err)
+
+	err = fileCtrl.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		return fileCtrl.DeleteAllForAsset(ctx, 1)
+	})
+	require.NoError(t, err)
+	assert.NoFileExists(t, file.FullPath)
+}
+
+func TestFileControl_Check(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	fileCtrl := newTestFileControl(t)
+	localFS := fileCtrl.blobs.(*blobs.LocalFS)
+
+	files := make([]*entities.File, 0, 4)
+	for i := 0; i < 4; i++ {
+		f, err := fileCtrl.WriteFile(ctx, newTestFile(t, i, 1))
+		require.NoError(t, err)
+		files = append(files, f)
+	}
+
+	report, err := fileCtrl.Check(ctx, CheckFilesCmd{VerifyChecksums: true})
+	require.NoError(t, err)
+	assert.True(t, report.OK())
+	assert.Equal(t, 4, report.NumFiles)
+	assert.Equal(t, 4, report.NumBlobs)
+
+	// missing blob
+	err = os.Remove(files[0].FullPath)
+	require.NoError(t, err)
+
+	// checksum mismatch
+	err = os.WriteFile(files[1].FullPath, []byte("corrupted"), 0600)
+	require.NoError(t, err)
+
+	// orphaned blob, the recent one is ignored as its upload might still be in progress
+	orphan := &entities.File{Reader: bytes.NewBufferString("orphan"), Name: "orphan.txt"}
+	err = localFS.WriteFile(orphan)
+	require.NoError(t, err)
+	err = os.Chtimes(orphan.FullPath, time.Now().Add(-2*time.Hour), time.Now().Add(-2*time.Hour))
+	require.NoError(t, err)
+
+	recent := &entities.File{Reader: bytes.NewBufferString("recent"), Name: "recent.txt"}
+	err = localFS.WriteFile(recent)
+	require.NoError(t, err)
+
+	report, err = fileCtrl.Check(ctx, CheckFilesCmd{})
+	require.NoError(t, err)
+	assert.False(t, report.OK())
+	assert.Empty(t, report.Mismatched)
+
+	report, err = fileCtrl.Check(ctx, CheckFilesCmd{VerifyChecksums: true, Repair: true})
+	require.NoError(t, err)
+	assert.True(t, report.Repaired)
+	assert.Equal(t, 5, report.NumBlobs)
+
+	require.Len(t, report.Missing, 1)
+	assert.Equal(t, files[0].ID, report.Missing[0].ID)
+	require.Len(t, report.Mismatched, 1)
+	assert.Equal(t, files[1].ID, report.Mismatched[0].ID)
+	require.Len(t, report.Orphans, 1)
+	assert.Equal(t, orphan.FullPath, report.Orphans[0].FullPath)
+
+	assert.NoFileExists(t, orphan.FullPath)
+	assert.FileExists(t, recent.FullPath)
+
+	missing, err := fileCtrl.Get(ctx, files[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.FileBrokenMissing, missing.Broken)
+
+	mismatched, err := fileCtrl.Get(ctx, files[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.FileBrokenChecksumMismatch, mismatched.Broken)
+
+	intact, err := fileCtrl.Get(ctx, files[2].ID)
+	require.NoError(t, err)
+	assert.Empty(t, intact.Broken)
+
+	t.Run("Clears Repaired Files", func(t *testing.T) {
+		err = os.WriteFile(files[1].FullPath, []byte("File-1"), 0600)
+		require.NoError(t, err)
+
+		_, err := fileCtrl.Check(ctx, CheckFilesCmd{Repair: true})
+		require.NoError(t, err)
+
+		// the checksum was not verified again
+		mismatched, err := fileCtrl.Get(ctx, files[1].ID)
+		require.NoError(t, err)
+		assert.Equal(t, entities.FileBrokenChecksumMismatch, mismatched.Broken)
+
+		_, err = fileCtrl.Check(ctx, CheckFilesCmd{VerifyChecksums: true, Repair: true})
+		require.NoError(t, err)
+
+		mismatched, err = fileCtrl.Get(ctx, files[1].ID)
+		require.NoError(t, err)
+		assert.Empty(t, mismatched.Broken)
+	})
+}
+
+func TestFileControl_Check_UploadDuringCheck(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	fileCtrl := newTestFileControl(t)
+
+	var uploaded *entities.File
+	fileCtrl.blobs = &uploadAfterWalk{FileBlobs: fileCtrl.blobs, upload: func() {
+		var err error
+		uploaded, err = fileCtrl.WriteFile(ctx, newTestFile(t, 0, 1))
+		require.NoError(t, err)
+	}}
+
+	report, err := fileCtrl.Check(ctx, CheckFilesCmd{Repair: true})
+	require.NoError(t, err)
+	assert.Empty(t, report.Missing)
+
+	fetched, err := fileCtrl.Get(ctx, uploaded.ID)
+	require.NoError(t, err)
+	assert.Empty(t, fetched.Broken)
+}
+
+func TestFileControl_Check_OrphanReferencedAgain(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	fileCtrl := newTestFileControl(t)
+
+	orphaned, err := fileCtrl.WriteFile(ctx, newTestFile(t, 0, 1))
+	require.NoError(t, err)
+
+	err = fileCtrl.repo.Delete(ctx, fileCtrl.db, []int64{orphaned.ID})
+	require.NoError(t, err)
+	err = os.Chtimes(orphaned.FullPath, time.Now().Add(-2*time.Hour), time.Now().Add(-2*time.Hour))
+	require.NoError(t, err)
+
+	// the same content is uploaded again after the blob was reported as an orphan, but before the repair
+	var uploaded *entities.File
+	fileCtrl.blobs = &uploadAfterWalk{FileBlobs: fileCtrl.blobs, upload: func() {
+		var err error
+		uploaded, err = fileCtrl.WriteFile(ctx, &entities.File{
+			Reader:    bytes.NewBufferString("File-0"),
+			AssetID:   1,
+			Name:      orphaned.Name,
+			Filetype:  orphaned.Filetype,
+			CreatedBy: 1,
+		})
+		require.NoError(t, err)
+	}}
+
+	report, err := fileCtrl.Check(ctx, CheckFilesCmd{Repair: true})
+	require.NoError(t, err)
+	require.Len(t, report.Orphans, 1)
+	assert.Equal(t, orphaned.FullPath, report.Orphans[0].FullPath)
+
+	assert.Equal(t, orphaned.FullPath, uploaded.FullPath)
+	assert.FileExists(t, uploaded.FullPath)
+}
+
+// uploadAfterWalk uploads a file right after the blobs were listed, to simulate an upload finishing during a check.
+type uploadAfterWalk struct {
+	FileBlobs
+	upload func()
+}
+
+func (b *uploadAfterWalk) WalkFiles(ctx context.Context, fn func(*entities.File) error) error {
+	err := b.FileBlobs.WalkFiles(ctx, fn)
+	b.upload()
+	return err
+}
+
 func newTestFile(t *testing.T, i int, assetID int64) *entities.File {
 	name := fmt.Sprintf("File-%d", i)
 
@@ -102,7 +343,7 @@ func newTestFileControl(t *testing.T) *FileControl {
 		}
 	})
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
 	defer cancel()
 
 	err = sqlite.RunMigrations(ctx, db)
@@ -141,6 +382,7 @@ func newTestFileControl(t *testing.T) *FileControl {
 
 	return NewFileControl(
 		database,
+		NewPermissionControl(database, &sqlite.GrantRepo{}, &sqlite.AssetRepo{}),
 		&sqlite.FileRepo{},
 		&blobs.LocalFS{
 			RootDir: t.TempDir(),
@@ -70,6 +70,34 @@ func (ic *ImporterCtrl) Import(r *http.Request, cmd ImportCmd) (map[string]strin
 	return validationErrs, nil
 }
 
+// ImportFrom is like Import, but reads Snipe-IT JSON exports from r instead of a form upload. Returns the number of imported assets.
+func (ic *ImporterCtrl) ImportFrom(ctx context.Context, r io.Reader, cmd ImportCmd) (int, error) {
+	var assets []*entities.Asset
+	var err error
+
+	switch cmd.Format {
+	case "snipeit_json_export":
+		assets, err = importer.ReadSnipeITJSONExport(r)
+	case "snipeit_api":
+		assets, err = importer.ImportFromSnipeITAPI(ctx, cmd.SnipeITURL, cmd.SnipeITAPIKey)
+	default:
+		return 0, fmt.Errorf("unknown import format '%s'", cmd.Format)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	err = ic.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		return ic.createAssets(ctx, assets, cmd)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(assets), nil
+}
+
 func (ic *ImporterCtrl) createAssets(ctx context.Context, assets []*entities.Asset, cmd ImportCmd) error {
 	for i := range assets {
 		tag, err := ic.tags.Get(ctx, assets[i].Tag)
@@ -8,21 +8,36 @@ import (
 )
 
 type LabelController struct {
-	assets *AssetControl
+	assets        *AssetControl
+	savedSearches *SavedSearchControl
 }
 
-func NewLabelController(assets *AssetControl) *LabelController {
-	return &LabelController{assets: assets}
+func NewLabelController(assets *AssetControl, savedSearches *SavedSearchControl) *LabelController {
+	return &LabelController{assets: assets, savedSearches: savedSearches}
 }
 
 type GenerateLabelSheetQuery struct {
 	BaseURL *url.URL
 	IDs     []int64
-	Sheet   *entities.Sheet
+	// SavedSearchID limits the labels to the assets matching the saved search, if set.
+	SavedSearchID int64
+	Sheet         *entities.Sheet
 }
 
 func (lc *LabelController) GenerateLabelSheet(ctx context.Context, query GenerateLabelSheetQuery) ([]byte, error) {
-	assets, err := lc.assets.List(ctx, ListAssetsQuery{IDs: query.IDs, IncludeParts: true})
+	var assetsQuery ListAssetsQuery
+	if query.SavedSearchID != 0 {
+		var err error
+		assetsQuery, err = lc.savedSearches.AssetsQuery(ctx, query.SavedSearchID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	assetsQuery.IDs = query.IDs
+	assetsQuery.IncludeParts = true
+
+	assets, err := lc.assets.List(ctx, assetsQuery)
 	if err != nil {
 		return nil, err
 	}
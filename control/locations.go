@@ -30,8 +30,18 @@ type ListLocationsQuery struct {
 }
 
 func (lc *LocationControl) ListLocations(ctx context.Context, query ListLocationsQuery) (*entities.ListPage[*entities.Location], error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return database.InTransaction(ctx, lc.db, func(ctx context.Context, tx database.Executor) (*entities.ListPage[*entities.Location], error) {
-		return lc.locations.ListLocations(ctx, tx, database.ListLocationsQuery(query))
+		return lc.locations.ListLocations(ctx, tx, database.ListLocationsQuery{
+			WorkspaceID: workspaceID,
+			Search:      query.Search,
+			Page:        query.Page,
+			PageSize:    query.PageSize,
+		})
 	})
 }
 
@@ -42,7 +52,17 @@ type ListPositionCodesQuery struct {
 }
 
 func (lc *LocationControl) ListPositionCodes(ctx context.Context, query ListPositionCodesQuery) (*entities.ListPage[*entities.PositionCode], error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return database.InTransaction(ctx, lc.db, func(ctx context.Context, tx database.Executor) (*entities.ListPage[*entities.PositionCode], error) {
-		return lc.locations.ListPositionCodes(ctx, tx, database.ListPositionCodesQuery(query))
+		return lc.locations.ListPositionCodes(ctx, tx, database.ListPositionCodesQuery{
+			WorkspaceID: workspaceID,
+			Search:      query.Search,
+			Page:        query.Page,
+			PageSize:    query.PageSize,
+		})
 	})
 }
@@ -29,11 +29,17 @@ type ListManufacturersQuery struct {
 }
 
 func (cc *ManufactuerCtrl) List(ctx context.Context, query ListManufacturersQuery) (*entities.ListPage[*entities.Manufacturer], error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return database.InTransaction(ctx, cc.db, func(ctx context.Context, tx database.Executor) (*entities.ListPage[*entities.Manufacturer], error) {
 		return cc.repo.List(ctx, tx, database.ListManufacturersQuery{
-			Search:   query.Search,
-			Page:     query.Page,
-			PageSize: query.PageSize,
+			WorkspaceID: workspaceID,
+			Search:      query.Search,
+			Page:        query.Page,
+			PageSize:    query.PageSize,
 		})
 	})
 }
@@ -29,7 +29,17 @@ type ListModelsQuery struct {
 }
 
 func (cc *ModelCtrl) List(ctx context.Context, query ListModelsQuery) (*entities.ListPage[*entities.Model], error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return database.InTransaction(ctx, cc.db, func(ctx context.Context, tx database.Executor) (*entities.ListPage[*entities.Model], error) {
-		return cc.repo.List(ctx, tx, database.ListModelsQuery(query))
+		return cc.repo.List(ctx, tx, database.ListModelsQuery{
+			WorkspaceID: workspaceID,
+			Search:      query.Search,
+			Page:        query.Page,
+			PageSize:    query.PageSize,
+		})
 	})
 }
@@ -0,0 +1,167 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/server/session"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+)
+
+var ErrGrantInvalidRole = errors.New("grants can only give the editor or admin role")
+var ErrGrantEmpty = errors.New("either location or category must be set")
+
+// PermissionControl checks whether the user of the current request may perform an action.
+// Requests without a user in the session are only allowed if the context was marked with [auth.SystemCtx],
+// like background jobs, CLI commands or the initial setup. All other requests without a user are rejected.
+type PermissionControl struct {
+	db     *database.Database
+	grants GrantRepo
+	assets AssetRepo
+}
+
+type GrantRepo interface {
+	ListForUser(ctx context.Context, exec bob.Executor, userID int64) ([]*auth.Grant, error)
+	Create(ctx context.Context, exec bob.Executor, grant *auth.Grant) error
+	Delete(ctx context.Context, exec bob.Executor, userID int64, id int64) error
+}
+
+func NewPermissionControl(db *database.Database, grants GrantRepo, assets AssetRepo) *PermissionControl {
+	return &PermissionControl{db: db, grants: grants, assets: assets}
+}
+
+// RequireRole checks the user's global role, ignoring any grants.
+func (pc *PermissionControl) RequireRole(ctx context.Context, role auth.Role) error {
+	user, ok := session.Get[*auth.User](ctx, "user")
+	if !ok || user == nil {
+		return requireSystemCtx(ctx)
+	}
+
+	if !user.Role.Includes(role) {
+		return fmt.Errorf("%w: user %s requires role %s", auth.ErrForbidden, user.Username, role)
+	}
+
+	return nil
+}
+
+// RequireAssetRole checks whether the user has the role for the asset, either globally or through
+// a grant for the asset's location or category. Assets outside of the current workspace are always forbidden.
+func (pc *PermissionControl) RequireAssetRole(ctx context.Context, asset *entities.Asset, role auth.Role) error {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if workspaceID != 0 && asset.WorkspaceID != workspaceID {
+		return fmt.Errorf("%w: asset %s is not in the current workspace", auth.ErrForbidden, asset.Tag)
+	}
+
+	user, ok := session.Get[*auth.User](ctx, "user")
+	if !ok || user == nil {
+		return requireSystemCtx(ctx)
+	}
+
+	if user.Role.Includes(role) {
+		return nil
+	}
+
+	grants, err := database.InTransaction(ctx, pc.db, func(ctx context.Context, tx database.Executor) ([]*auth.Grant, error) {
+		return pc.grants.ListForUser(ctx, tx, user.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, grant := range grants {
+		if grant.Role.Includes(role) && grant.Matches(asset.Location, asset.Category) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: user %s requires role %s for asset %s", auth.ErrForbidden, user.Username, role, asset.Tag)
+}
+
+// RequireAssetIDRole is like RequireAssetRole, but loads the asset first.
+func (pc *PermissionControl) RequireAssetIDRole(ctx context.Context, assetID int64, role auth.Role) error {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := pc.RequireRole(ctx, role); err == nil && workspaceID == 0 {
+		return nil
+	}
+
+	asset, err := database.InTransaction(ctx, pc.db, func(ctx context.Context, tx database.Executor) (*entities.Asset, error) {
+		return pc.assets.Get(ctx, tx, database.GetAssetQuery{ID: assetID, IncludeDeleted: true})
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrAssetNotFound) {
+			return fmt.Errorf("%w: %d", ErrAssetNotFound, assetID)
+		}
+		return err
+	}
+
+	return pc.RequireAssetRole(ctx, asset, role)
+}
+
+func requireSystemCtx(ctx context.Context) error {
+	if auth.IsSystemCtx(ctx) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: no user in session", auth.ErrUnauthorized)
+}
+
+func (pc *PermissionControl) ListGrants(ctx context.Context, userID int64) ([]*auth.Grant, error) {
+	if err := pc.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	return database.InTransaction(ctx, pc.db, func(ctx context.Context, tx database.Executor) ([]*auth.Grant, error) {
+		return pc.grants.ListForUser(ctx, tx, userID)
+	})
+}
+
+func (pc *PermissionControl) CreateGrant(ctx context.Context, grant *auth.Grant) (map[string]string, error) {
+	if err := pc.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	grant.Location = strings.TrimSpace(grant.Location)
+	grant.Category = strings.TrimSpace(grant.Category)
+
+	validationErrs := map[string]string{}
+
+	if grant.Role != auth.RoleEditor && grant.Role != auth.RoleAdmin {
+		validationErrs["role"] = ErrGrantInvalidRole.Error()
+	}
+
+	if grant.Location == "" && grant.Category == "" {
+		validationErrs["location"] = ErrGrantEmpty.Error()
+		validationErrs["category"] = ErrGrantEmpty.Error()
+	}
+
+	if len(validationErrs) != 0 {
+		return validationErrs, nil
+	}
+
+	return nil, pc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		return pc.grants.Create(ctx, tx, grant)
+	})
+}
+
+func (pc *PermissionControl) DeleteGrant(ctx context.Context, userID int64, id int64) error {
+	if err := pc.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return err
+	}
+
+	return pc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		return pc.grants.Delete(ctx, tx, userID, id)
+	})
+}
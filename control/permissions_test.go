@@ -0,0 +1,165 @@
+package control
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/server/session"
+	"github.com/RobinThrift/stuff/internal/workspace"
+	"github.com/RobinThrift/stuff/storage/database/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionControl(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	assetCtrl := newTestAssetControl(t)
+	perms := assetCtrl.perms
+	users := NewUserCtrl(assetCtrl.db, assetCtrl.perms, &sqlite.UserRepo{}, &sqlite.WorkspaceRepo{})
+
+	viewer := &auth.User{Username: "viewer", DisplayName: "Viewer", Role: auth.RoleViewer, AuthRef: "viewer"}
+	require.NoError(t, users.Create(ctx, viewer))
+
+	viewerCtx := userCtx(ctx, viewer)
+	editorCtx := userCtx(ctx, &auth.User{ID: viewer.ID, Username: "editor", Role: auth.RoleEditor})
+
+	asset := newTestAsset(t)
+	asset.Location = "Lab"
+	asset.Category = "Tools"
+
+	_, err := assetCtrl.Create(viewerCtx, CreateAssetCmd{Asset: asset})
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+
+	created, err := assetCtrl.Create(editorCtx, CreateAssetCmd{Asset: asset})
+	require.NoError(t, err)
+
+	_, err = assetCtrl.Update(viewerCtx, UpdateAssetCmd{Asset: created})
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+
+	t.Run("Grants", func(t *testing.T) {
+		validationErrs, err := perms.CreateGrant(viewerCtx, &auth.Grant{UserID: viewer.ID, Role: auth.RoleEditor, Location: "Lab"})
+		assert.ErrorIs(t, err, auth.ErrForbidden)
+		assert.Empty(t, validationErrs)
+
+		validationErrs, err = perms.CreateGrant(ctx, &auth.Grant{UserID: viewer.ID, Role: auth.RoleViewer})
+		require.NoError(t, err)
+		assert.Contains(t, validationErrs, "role")
+		assert.Contains(t, validationErrs, "location")
+
+		grant := &auth.Grant{UserID: viewer.ID, Role: auth.RoleEditor, Location: "Lab"}
+		validationErrs, err = perms.CreateGrant(ctx, grant)
+		require.NoError(t, err)
+		require.Empty(t, validationErrs)
+
+		created.Name = "Updated by viewer with grant"
+		updated, err := assetCtrl.Update(viewerCtx, UpdateAssetCmd{Asset: created})
+		require.NoError(t, err)
+		assert.Equal(t, "Updated by viewer with grant", updated.Name)
+
+		// assets can't be moved out of the granted location
+		updated.Location = "Office"
+		_, err = assetCtrl.Update(viewerCtx, UpdateAssetCmd{Asset: updated})
+		assert.ErrorIs(t, err, auth.ErrForbidden)
+
+		err = assetCtrl.Delete(viewerCtx, updated)
+		require.NoError(t, err)
+
+		// purging requires the admin role
+		err = assetCtrl.Purge(viewerCtx, updated.ID)
+		assert.ErrorIs(t, err, auth.ErrForbidden)
+
+		err = perms.DeleteGrant(ctx, viewer.ID, grant.ID)
+		require.NoError(t, err)
+
+		_, err = assetCtrl.Restore(viewerCtx, updated.ID)
+		assert.ErrorIs(t, err, auth.ErrForbidden)
+	})
+
+	t.Run("Without User", func(t *testing.T) {
+		noUserCtx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+
+		assert.ErrorIs(t, perms.RequireRole(noUserCtx, auth.RoleViewer), auth.ErrUnauthorized)
+		assert.ErrorIs(t, perms.RequireAssetIDRole(noUserCtx, created.ID, auth.RoleViewer), auth.ErrUnauthorized)
+		assert.NoError(t, perms.RequireAssetIDRole(ctx, created.ID, auth.RoleAdmin))
+
+		_, err := assetCtrl.Create(noUserCtx, CreateAssetCmd{Asset: newTestAsset(t)})
+		assert.ErrorIs(t, err, auth.ErrUnauthorized)
+
+		_, err = users.List(noUserCtx, ListUsersQuery{})
+		assert.ErrorIs(t, err, auth.ErrUnauthorized)
+	})
+
+	t.Run("Users", func(t *testing.T) {
+		list, err := users.List(viewerCtx, ListUsersQuery{})
+		require.NoError(t, err)
+		assert.NotEmpty(t, list.Items)
+
+		err = users.Create(viewerCtx, &auth.User{Username: "other", DisplayName: "Other", Role: auth.RoleViewer, AuthRef: "other"})
+		assert.ErrorIs(t, err, auth.ErrForbidden)
+
+		other := &auth.User{Username: "other", DisplayName: "Other", Role: auth.RoleViewer, AuthRef: "other"}
+		require.NoError(t, users.Create(userCtx(ctx, &auth.User{Username: "admin", Role: auth.RoleAdmin}), other))
+
+		self, err := users.Get(ctx, viewer.ID)
+		require.NoError(t, err)
+
+		self.DisplayName = "Viewer Renamed"
+		require.NoError(t, users.Update(viewerCtx, self))
+
+		self.Role = auth.RoleAdmin
+		assert.ErrorIs(t, users.Update(viewerCtx, self), auth.ErrForbidden)
+
+		other.DisplayName = "Renamed by viewer"
+		assert.ErrorIs(t, users.Update(viewerCtx, other), auth.ErrForbidden)
+
+		assert.ErrorIs(t, users.Delete(viewerCtx, other.ID), auth.ErrForbidden)
+
+		stored, err := users.Get(ctx, viewer.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Viewer Renamed", stored.DisplayName)
+		assert.Equal(t, auth.RoleViewer, stored.Role)
+	})
+
+	t.Run("Events", func(t *testing.T) {
+		events, err := assetCtrl.ListEvents(viewerCtx, ListAssetEventsQuery{AssetID: created.ID})
+		require.NoError(t, err)
+		assert.NotEmpty(t, events.Items)
+
+		_, err = assetCtrl.ListEvents(viewerCtx, ListAssetEventsQuery{})
+		assert.ErrorIs(t, err, auth.ErrForbidden)
+
+		otherWorkspaceCtx := workspace.WithCtx(viewerCtx, &workspace.Scope{Current: &entities.Workspace{ID: entities.DefaultWorkspaceID + 1}})
+		_, err = assetCtrl.ListEvents(otherWorkspaceCtx, ListAssetEventsQuery{AssetID: created.ID})
+		assert.ErrorIs(t, err, auth.ErrForbidden)
+	})
+
+	t.Run("Checkouts", func(t *testing.T) {
+		checkedOut, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: newTestAsset(t)})
+		require.NoError(t, err)
+
+		_, err = assetCtrl.CheckOut(ctx, CheckOutAssetCmd{AssetID: checkedOut.ID, CheckedOutTo: viewer.ID, CreatedBy: viewer.ID})
+		require.NoError(t, err)
+
+		checkout, err := assetCtrl.GetOpenCheckout(viewerCtx, checkedOut.ID)
+		require.NoError(t, err)
+		assert.Equal(t, viewer.ID, checkout.CheckedOutTo)
+
+		otherWorkspaceCtx := workspace.WithCtx(viewerCtx, &workspace.Scope{Current: &entities.Workspace{ID: entities.DefaultWorkspaceID + 1}})
+		_, err = assetCtrl.GetOpenCheckout(otherWorkspaceCtx, checkedOut.ID)
+		assert.ErrorIs(t, err, auth.ErrForbidden)
+
+		_, err = assetCtrl.GetOpenCheckout(context.Background(), checkedOut.ID)
+		assert.ErrorIs(t, err, auth.ErrUnauthorized)
+	})
+}
+
+func userCtx(ctx context.Context, user *auth.User) context.Context {
+	ctx = session.CtxWithStatelessSession(ctx)
+	session.Put(ctx, "user", user)
+	return ctx
+}
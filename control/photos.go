@@ -0,0 +1,341 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"slices"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/imaging"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+)
+
+var ErrPhotoNotFound = errors.New("photo not found")
+
+type PhotoRepo interface {
+	Get(ctx context.Context, exec bob.Executor, id int64) (*entities.Photo, error)
+	ListForAsset(ctx context.Context, exec bob.Executor, assetID int64) ([]*entities.Photo, error)
+	Create(ctx context.Context, exec bob.Executor, photo *entities.Photo) (int64, error)
+	Update(ctx context.Context, exec bob.Executor, photo *entities.Photo) error
+}
+
+type AddPhotosCmd struct {
+	AssetID int64
+	Images  []*entities.File
+}
+
+// AddPhotos adds the images to the end of the asset's gallery. If the asset has no image yet, the first
+// added photo becomes the primary photo.
+func (ac *AssetControl) AddPhotos(ctx context.Context, cmd AddPhotosCmd) ([]*entities.Photo, error) {
+	return database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) ([]*entities.Photo, error) {
+		asset, err := ac.getForPhotoEdit(ctx, tx, cmd.AssetID)
+		if err != nil {
+			return nil, err
+		}
+
+		position := 0
+		hasPrimary := false
+		for _, p := range asset.Photos {
+			position = max(position, p.Position+1)
+			hasPrimary = hasPrimary || p.Primary
+		}
+
+		added := make([]*entities.Photo, 0, len(cmd.Images))
+		for i, image := range cmd.Images {
+			image.AssetID = asset.ID
+			image.Name = asset.Tag + "_photo" + path.Ext(image.Name)
+			image, err = ac.files.WriteFile(ctx, image)
+			if err != nil {
+				return nil, fmt.Errorf("error writing photo for asset %s: %w", asset.Tag, err)
+			}
+
+			photo, err := ac.createPhoto(ctx, tx, asset, image, position+i, !hasPrimary && i == 0)
+			if err != nil {
+				return nil, err
+			}
+
+			added = append(added, photo)
+		}
+
+		if !hasPrimary && len(added) != 0 {
+			err = ac.setPrimaryImage(ctx, tx, asset, added[0])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return added, nil
+	})
+}
+
+type UpdatePhotoCmd struct {
+	AssetID int64
+	PhotoID int64
+	Caption string
+}
+
+func (ac *AssetControl) UpdatePhoto(ctx context.Context, cmd UpdatePhotoCmd) (*entities.Photo, error) {
+	return database.InTransaction(ctx, ac.db, func(ctx context.Context, tx database.Executor) (*entities.Photo, error) {
+		_, photo, err := ac.getPhotoForEdit(ctx, tx, cmd.AssetID, cmd.PhotoID)
+		if err != nil {
+			return nil, err
+		}
+
+		photo.Caption = cmd.Caption
+
+		err = ac.photos.Update(ctx, tx, photo)
+		if err != nil {
+			return nil, err
+		}
+
+		return photo, nil
+	})
+}
+
+// ReorderPhotos sorts the asset's photos in the order of the given photo IDs.
+// Photos that are missing from the list keep their relative order and are moved to the end.
+func (ac *AssetControl) ReorderPhotos(ctx context.Context, assetID int64, photoIDs []int64) error {
+	return ac.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		asset, err := ac.getForPhotoEdit(ctx, tx, assetID)
+		if err != nil {
+			return err
+		}
+
+		photos := slices.Clone(asset.Photos)
+		slices.SortStableFunc(photos, func(a, b *entities.Photo) int {
+			ai, bi := slices.Index(photoIDs, a.ID), slices.Index(photoIDs, b.ID)
+			switch {
+			case ai == bi:
+				return 0
+			case ai == -1:
+				return 1
+			case bi == -1:
+				return -1
+			default:
+				return ai - bi
+			}
+		})
+
+		for i, photo := range photos {
+			if photo.Position == i {
+				continue
+			}
+
+			photo.Position = i
+			err = ac.photos.Update(ctx, tx, photo)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// SetPrimaryPhoto uses the photo as the asset's image and thumbnail.
+func (ac *AssetControl) SetPrimaryPhoto(ctx context.Context, assetID int64, photoID int64) error {
+	return ac.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		asset, photo, err := ac.getPhotoForEdit(ctx, tx, assetID, photoID)
+		if err != nil {
+			return err
+		}
+
+		for _, p := range asset.Photos {
+			if p.Primary == (p.ID == photo.ID) {
+				continue
+			}
+
+			p.Primary = p.ID == photo.ID
+			err = ac.photos.Update(ctx, tx, p)
+			if err != nil {
+				return err
+			}
+		}
+
+		return ac.setPrimaryImage(ctx, tx, asset, photo)
+	})
+}
+
+// DeletePhoto removes the photo from the asset's gallery and deletes its files. If the photo was the primary
+// photo, the next photo in the gallery becomes the primary photo.
+func (ac *AssetControl) DeletePhoto(ctx context.Context, assetID int64, photoID int64) error {
+	return ac.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		asset, photo, err := ac.getPhotoForEdit(ctx, tx, assetID, photoID)
+		if err != nil {
+			return err
+		}
+
+		// deleting the image file also deletes the photo
+		err = ac.files.Delete(ctx, photo.FileID)
+		if err != nil {
+			return fmt.Errorf("error deleting photo %d of asset %s: %w", photo.ID, asset.Tag, err)
+		}
+
+		for _, u := range []string{photo.ThumbnailURL, photo.PreviewURL} {
+			if u == photo.ImageURL {
+				continue
+			}
+
+			err = ac.files.DeleteByPublicPath(ctx, asset.ID, u)
+			if err != nil {
+				return fmt.Errorf("error deleting photo %d of asset %s: %w", photo.ID, asset.Tag, err)
+			}
+		}
+
+		if !photo.Primary {
+			return nil
+		}
+
+		var next *entities.Photo
+		for _, p := range asset.Photos {
+			if p.ID != photo.ID {
+				next = p
+				break
+			}
+		}
+
+		if next != nil {
+			next.Primary = true
+			err = ac.photos.Update(ctx, tx, next)
+			if err != nil {
+				return err
+			}
+		}
+
+		return ac.setPrimaryImage(ctx, tx, asset, next)
+	})
+}
+
+// createPhoto generates the thumbnail and preview for the image and adds it to the asset's gallery.
+func (ac *AssetControl) createPhoto(ctx context.Context, exec bob.Executor, asset *entities.Asset, image *entities.File, position int, primary bool) (*entities.Photo, error) {
+	photo := &entities.Photo{
+		AssetID:  asset.ID,
+		FileID:   image.ID,
+		ImageURL: image.PublicPath,
+		Position: position,
+		Primary:  primary,
+	}
+
+	err := ac.writePhotoVariants(ctx, asset, image, photo)
+	if err != nil {
+		return nil, err
+	}
+
+	if primary {
+		err = ac.unsetPrimaryPhoto(ctx, exec, asset.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	photo.ID, err = ac.photos.Create(ctx, exec, photo)
+	if err != nil {
+		return nil, err
+	}
+
+	return photo, nil
+}
+
+// writePhotoVariants generates the thumbnail and preview for the photo.
+// Images that can't be decoded, like unsupported formats, are used as their own thumbnail and preview.
+func (ac *AssetControl) writePhotoVariants(ctx context.Context, asset *entities.Asset, image *entities.File, photo *entities.Photo) error {
+	photo.ThumbnailURL = image.PublicPath
+	photo.PreviewURL = image.PublicPath
+
+	variants, err := ac.files.WriteImageVariants(ctx, image)
+	if err != nil {
+		if errors.Is(err, imaging.ErrUnsupportedFormat) || errors.Is(err, imaging.ErrInvalidImage) || errors.Is(err, imaging.ErrImageTooLarge) {
+			slog.WarnContext(ctx, "can't generate thumbnail and preview for asset image", "asset", asset.Tag, "error", err)
+			return nil
+		}
+		return fmt.Errorf("error generating thumbnail and preview for asset %s: %w", asset.Tag, err)
+	}
+
+	photo.ThumbnailURL = variants.Thumbnail.PublicPath
+	photo.PreviewURL = variants.Preview.PublicPath
+
+	return nil
+}
+
+func (ac *AssetControl) unsetPrimaryPhoto(ctx context.Context, exec bob.Executor, assetID int64) error {
+	photos, err := ac.photos.ListForAsset(ctx, exec, assetID)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range photos {
+		if !p.Primary {
+			continue
+		}
+
+		p.Primary = false
+		err = ac.photos.Update(ctx, exec, p)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setPrimaryImage updates the asset's image to the photo and records the change.
+// A nil photo removes the image.
+func (ac *AssetControl) setPrimaryImage(ctx context.Context, exec bob.Executor, before *entities.Asset, photo *entities.Photo) error {
+	after := *before
+	setImage(&after, photo)
+
+	err := ac.repo.SetImage(ctx, exec, before.ID, after.ImageURL, after.ThumbnailURL, after.PreviewURL)
+	if err != nil {
+		return fmt.Errorf("error setting image of asset %s: %w", before.Tag, err)
+	}
+
+	return ac.recordEvent(ctx, exec, entities.AssetEventUpdated, before.ID, before, &after)
+}
+
+func (ac *AssetControl) getForPhotoEdit(ctx context.Context, exec bob.Executor, assetID int64) (*entities.Asset, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	asset, err := ac.repo.Get(ctx, exec, database.GetAssetQuery{
+		WorkspaceID:      workspaceID,
+		ID:               assetID,
+		IncludePurchases: true,
+		IncludeParts:     true,
+		IncludePhotos:    true,
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrAssetNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrAssetNotFound, assetID)
+		}
+		return nil, err
+	}
+
+	err = ac.perms.RequireAssetRole(ctx, asset, auth.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	return asset, nil
+}
+
+func (ac *AssetControl) getPhotoForEdit(ctx context.Context, exec bob.Executor, assetID int64, photoID int64) (*entities.Asset, *entities.Photo, error) {
+	asset, err := ac.getForPhotoEdit(ctx, exec, assetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, p := range asset.Photos {
+		if p.ID == photoID {
+			return asset, p, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("%w: %d", ErrPhotoNotFound, photoID)
+}
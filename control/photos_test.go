@@ -0,0 +1,115 @@
+package control
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetControl_Photos(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	assetCtrl := newTestAssetControl(t)
+
+	created, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: newTestAsset(t), Image: newTestImage(t, 400, 200)})
+	require.NoError(t, err)
+
+	first := assertPhotos(t, assetCtrl, created.ID, 1)[0]
+	assert.True(t, first.Primary)
+	assert.Equal(t, created.ImageURL, first.ImageURL)
+	assert.Equal(t, created.ThumbnailURL, first.ThumbnailURL)
+
+	added, err := assetCtrl.AddPhotos(ctx, AddPhotosCmd{
+		AssetID: created.ID,
+		Images:  []*entities.File{newTestImage(t, 300, 200), newTestImage(t, 200, 300)},
+	})
+	require.NoError(t, err)
+	require.Len(t, added, 2)
+	assert.False(t, added[0].Primary)
+	assert.False(t, added[1].Primary)
+
+	photos := assertPhotos(t, assetCtrl, created.ID, 3)
+	assert.Equal(t, []int64{first.ID, added[0].ID, added[1].ID}, photoIDs(photos))
+
+	updated, err := assetCtrl.UpdatePhoto(ctx, UpdatePhotoCmd{AssetID: created.ID, PhotoID: added[0].ID, Caption: "Side view"})
+	require.NoError(t, err)
+	assert.Equal(t, "Side view", updated.Caption)
+
+	t.Run("Reorder", func(t *testing.T) {
+		err := assetCtrl.ReorderPhotos(ctx, created.ID, []int64{added[1].ID, first.ID})
+		require.NoError(t, err)
+
+		photos := assertPhotos(t, assetCtrl, created.ID, 3)
+		assert.Equal(t, []int64{added[1].ID, first.ID, added[0].ID}, photoIDs(photos))
+		assert.Equal(t, "Side view", photos[2].Caption)
+	})
+
+	t.Run("Set Primary", func(t *testing.T) {
+		err := assetCtrl.SetPrimaryPhoto(ctx, created.ID, added[1].ID)
+		require.NoError(t, err)
+
+		photos := assertPhotos(t, assetCtrl, created.ID, 3)
+		assert.True(t, photos[0].Primary)
+		assert.False(t, photos[1].Primary)
+		assert.False(t, photos[2].Primary)
+
+		asset, err := assetCtrl.Get(ctx, GetAssetQuery{ID: created.ID})
+		require.NoError(t, err)
+		assert.Equal(t, added[1].ImageURL, asset.ImageURL)
+		assert.Equal(t, added[1].ThumbnailURL, asset.ThumbnailURL)
+		assert.Equal(t, added[1].PreviewURL, asset.PreviewURL)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := assetCtrl.DeletePhoto(ctx, created.ID, added[1].ID)
+		require.NoError(t, err)
+
+		photos := assertPhotos(t, assetCtrl, created.ID, 2)
+		assert.Equal(t, first.ID, photos[0].ID)
+		assert.True(t, photos[0].Primary)
+
+		asset, err := assetCtrl.Get(ctx, GetAssetQuery{ID: created.ID})
+		require.NoError(t, err)
+		assert.Equal(t, first.ImageURL, asset.ImageURL)
+
+		files, err := assetCtrl.files.List(ctx, ListFilesQuery{AssetID: created.ID})
+		require.NoError(t, err)
+		assert.Len(t, files.Items, 6)
+
+		for _, p := range photos {
+			err = assetCtrl.DeletePhoto(ctx, created.ID, p.ID)
+			require.NoError(t, err)
+		}
+
+		asset, err = assetCtrl.Get(ctx, GetAssetQuery{ID: created.ID})
+		require.NoError(t, err)
+		assert.Empty(t, asset.ImageURL)
+		assert.Empty(t, asset.ThumbnailURL)
+	})
+
+	_, err = assetCtrl.UpdatePhoto(ctx, UpdatePhotoCmd{AssetID: created.ID, PhotoID: first.ID})
+	assert.ErrorIs(t, err, ErrPhotoNotFound)
+}
+
+func assertPhotos(t *testing.T, assetCtrl *AssetControl, assetID int64, n int) []*entities.Photo {
+	t.Helper()
+
+	asset, err := assetCtrl.Get(auth.SystemCtx(context.Background()), GetAssetQuery{ID: assetID, IncludePhotos: true})
+	require.NoError(t, err)
+	require.Len(t, asset.Photos, n)
+
+	return asset.Photos
+}
+
+func photoIDs(photos []*entities.Photo) []int64 {
+	ids := make([]int64, 0, len(photos))
+	for _, p := range photos {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
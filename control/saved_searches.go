@@ -0,0 +1,218 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/search"
+	"github.com/RobinThrift/stuff/internal/server/session"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+)
+
+var ErrSavedSearchNotFound = errors.New("saved search not found")
+var ErrSavedSearchNameEmpty = errors.New("saved search name must not be empty")
+var ErrSavedSearchEmpty = errors.New("saved search must have a query or filters")
+
+// SavedSearchControl manages the named searches of the users. A search is only visible to the user who saved it,
+// unless it is shared, in which case it is visible to all users of the workspace.
+// Requests without a user in the session are only allowed with a system context, like the CLI's, which can get all searches.
+type SavedSearchControl struct {
+	db    *database.Database
+	perms *PermissionControl
+	repo  SavedSearchRepo
+}
+
+type SavedSearchRepo interface {
+	List(ctx context.Context, exec bob.Executor, query database.ListSavedSearchesQuery) ([]*entities.SavedSearch, error)
+	Get(ctx context.Context, exec bob.Executor, id int64) (*entities.SavedSearch, error)
+	Create(ctx context.Context, exec bob.Executor, search *entities.SavedSearch) error
+	Delete(ctx context.Context, exec bob.Executor, id int64) error
+}
+
+func NewSavedSearchControl(db *database.Database, perms *PermissionControl, repo SavedSearchRepo) *SavedSearchControl {
+	return &SavedSearchControl{db: db, perms: perms, repo: repo}
+}
+
+// List returns the searches of the current user and the shared searches of the current workspace.
+func (sc *SavedSearchControl) List(ctx context.Context) ([]*entities.SavedSearch, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var userID int64
+	if user, ok := session.Get[*auth.User](ctx, "user"); ok && user != nil {
+		userID = user.ID
+	} else if err = requireSystemCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	return database.InTransaction(ctx, sc.db, func(ctx context.Context, tx database.Executor) ([]*entities.SavedSearch, error) {
+		return sc.repo.List(ctx, tx, database.ListSavedSearchesQuery{WorkspaceID: workspaceID, UserID: userID})
+	})
+}
+
+// ListPinned returns the searches with the given IDs in the given order, skipping those that no longer exist
+// or are no longer visible to the current user.
+func (sc *SavedSearchControl) ListPinned(ctx context.Context, ids []int64) ([]*entities.SavedSearch, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	searches, err := sc.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int64]*entities.SavedSearch, len(searches))
+	for _, s := range searches {
+		byID[s.ID] = s
+	}
+
+	pinned := make([]*entities.SavedSearch, 0, len(ids))
+	for _, id := range ids {
+		if s, ok := byID[id]; ok {
+			pinned = append(pinned, s)
+		}
+	}
+
+	return pinned, nil
+}
+
+func (sc *SavedSearchControl) Get(ctx context.Context, id int64) (*entities.SavedSearch, error) {
+	user, ok := session.Get[*auth.User](ctx, "user")
+	if !ok || user == nil {
+		err := requireSystemCtx(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := database.InTransaction(ctx, sc.db, func(ctx context.Context, tx database.Executor) (*entities.SavedSearch, error) {
+		search, err := sc.repo.Get(ctx, tx, id)
+		if err != nil {
+			if errors.Is(err, database.ErrSavedSearchNotFound) {
+				return nil, fmt.Errorf("%w: %d", ErrSavedSearchNotFound, id)
+			}
+			return nil, err
+		}
+
+		return search, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if workspaceID != 0 && search.WorkspaceID != workspaceID {
+		return nil, fmt.Errorf("%w: %d", ErrSavedSearchNotFound, id)
+	}
+
+	if user != nil && !search.Shared && search.UserID != user.ID {
+		return nil, fmt.Errorf("%w: %d", ErrSavedSearchNotFound, id)
+	}
+
+	return search, nil
+}
+
+// AssetsQuery returns the query listing the assets matching the saved search.
+func (sc *SavedSearchControl) AssetsQuery(ctx context.Context, id int64) (ListAssetsQuery, error) {
+	search, err := sc.Get(ctx, id)
+	if err != nil {
+		return ListAssetsQuery{}, err
+	}
+
+	return ListAssetsQuery{SearchRaw: search.Query, Filters: search.Filters}, nil
+}
+
+type CreateSavedSearchCmd struct {
+	User    *auth.User
+	Name    string
+	Query   string
+	Filters entities.AssetFilters
+	// Shared searches are visible to all users of the workspace and can only be created by editors.
+	Shared bool
+}
+
+func (sc *SavedSearchControl) Create(ctx context.Context, cmd CreateSavedSearchCmd) (*entities.SavedSearch, map[string]string, error) {
+	validationErrs := map[string]string{}
+
+	name := strings.TrimSpace(cmd.Name)
+	if name == "" {
+		validationErrs["name"] = ErrSavedSearchNameEmpty.Error()
+	}
+
+	query := strings.TrimSpace(cmd.Query)
+	if query == "" && cmd.Filters.IsEmpty() {
+		validationErrs["query"] = ErrSavedSearchEmpty.Error()
+	}
+
+	if query != "" {
+		if _, err := search.Parse(query); err != nil {
+			validationErrs["query"] = err.Error()
+		}
+	}
+
+	if len(validationErrs) != 0 {
+		return nil, validationErrs, nil
+	}
+
+	if cmd.Shared {
+		err := sc.perms.RequireRole(ctx, auth.RoleEditor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if workspaceID == 0 {
+		workspaceID = entities.DefaultWorkspaceID
+	}
+
+	search := &entities.SavedSearch{
+		WorkspaceID: workspaceID,
+		UserID:      cmd.User.ID,
+		Name:        name,
+		Query:       query,
+		Filters:     cmd.Filters,
+		Shared:      cmd.Shared,
+	}
+
+	err = sc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		return sc.repo.Create(ctx, tx, search)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return search, validationErrs, nil
+}
+
+// Delete deletes the search. Only the user who saved the search, or an admin, may delete it.
+func (sc *SavedSearchControl) Delete(ctx context.Context, user *auth.User, id int64) error {
+	search, err := sc.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if search.UserID != user.ID && !user.IsAdmin() {
+		return fmt.Errorf("%w: saved search %d belongs to another user", auth.ErrForbidden, id)
+	}
+
+	return sc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		return sc.repo.Delete(ctx, tx, id)
+	})
+}
@@ -0,0 +1,139 @@
+package control
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedSearchControl(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	assetCtrl := newTestAssetControl(t)
+	users := NewUserCtrl(assetCtrl.db, assetCtrl.perms, &sqlite.UserRepo{}, &sqlite.WorkspaceRepo{})
+	savedSearches := NewSavedSearchControl(assetCtrl.db, assetCtrl.perms, &sqlite.SavedSearchRepo{})
+
+	editor := &auth.User{Username: "editor", DisplayName: "Editor", Role: auth.RoleEditor, AuthRef: "editor"}
+	require.NoError(t, users.Create(ctx, editor))
+	viewer := &auth.User{Username: "viewer", DisplayName: "Viewer", Role: auth.RoleViewer, AuthRef: "viewer"}
+	require.NoError(t, users.Create(ctx, viewer))
+
+	editorCtx := userCtx(ctx, editor)
+	viewerCtx := userCtx(ctx, viewer)
+
+	storageRoom := newTestAsset(t)
+	storageRoom.Location = "Storage Room B"
+	storageRoom, err := assetCtrl.Create(ctx, CreateAssetCmd{Asset: storageRoom})
+	require.NoError(t, err)
+
+	_, err = assetCtrl.Create(ctx, CreateAssetCmd{Asset: newTestAsset(t)})
+	require.NoError(t, err)
+
+	_, validationErrs, err := savedSearches.Create(editorCtx, CreateSavedSearchCmd{User: editor, Name: " "})
+	require.NoError(t, err)
+	assert.Contains(t, validationErrs, "name")
+	assert.Contains(t, validationErrs, "query")
+
+	_, validationErrs, err = savedSearches.Create(editorCtx, CreateSavedSearchCmd{User: editor, Name: "Laptops", Query: "(dell OR lenovo"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"query": "missing closing parenthesis at position 1"}, validationErrs)
+
+	shared, validationErrs, err := savedSearches.Create(editorCtx, CreateSavedSearchCmd{
+		User:    editor,
+		Name:    "Storage Room B",
+		Filters: entities.AssetFilters{Location: []string{"Storage Room B"}},
+		Shared:  true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, validationErrs)
+	assert.Equal(t, entities.DefaultWorkspaceID, shared.WorkspaceID)
+
+	private, validationErrs, err := savedSearches.Create(editorCtx, CreateSavedSearchCmd{
+		User:  editor,
+		Name:  "Makers",
+		Query: "manufacturer:Asset",
+	})
+	require.NoError(t, err)
+	require.Empty(t, validationErrs)
+
+	_, _, err = savedSearches.Create(viewerCtx, CreateSavedSearchCmd{User: viewer, Name: "Shared", Query: "drill", Shared: true})
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+
+	t.Run("Visibility", func(t *testing.T) {
+		list, err := savedSearches.List(editorCtx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Makers", "Storage Room B"}, savedSearchNames(list))
+
+		list, err = savedSearches.List(viewerCtx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Storage Room B"}, savedSearchNames(list))
+
+		_, err = savedSearches.Get(viewerCtx, private.ID)
+		assert.ErrorIs(t, err, ErrSavedSearchNotFound)
+
+		_, err = savedSearches.Get(context.Background(), private.ID)
+		assert.ErrorIs(t, err, auth.ErrUnauthorized)
+
+		_, err = savedSearches.List(context.Background())
+		assert.ErrorIs(t, err, auth.ErrUnauthorized)
+
+		search, err := savedSearches.Get(ctx, private.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Makers", search.Name)
+
+		pinned, err := savedSearches.ListPinned(viewerCtx, []int64{private.ID, shared.ID, shared.ID + 100})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Storage Room B"}, savedSearchNames(pinned))
+	})
+
+	t.Run("Sources", func(t *testing.T) {
+		query, err := savedSearches.AssetsQuery(viewerCtx, shared.ID)
+		require.NoError(t, err)
+
+		list, err := assetCtrl.List(viewerCtx, query)
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, storageRoom.ID, list.Items[0].ID)
+
+		exporterCtrl := NewExporterCtrl(assetCtrl.db, assetCtrl, assetCtrl.files, savedSearches)
+
+		var buf bytes.Buffer
+		err = exporterCtrl.Export(viewerCtx, &buf, ExportCmd{Format: "json", SavedSearchID: shared.ID})
+		require.NoError(t, err)
+
+		var exported []map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &exported))
+		require.Len(t, exported, 1)
+		assert.Equal(t, storageRoom.Tag, exported[0]["tag"])
+
+		err = exporterCtrl.Export(viewerCtx, &buf, ExportCmd{Format: "json", SavedSearchID: private.ID})
+		assert.ErrorIs(t, err, ErrSavedSearchNotFound)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := savedSearches.Delete(viewerCtx, viewer, shared.ID)
+		assert.ErrorIs(t, err, auth.ErrForbidden)
+
+		err = savedSearches.Delete(editorCtx, editor, shared.ID)
+		require.NoError(t, err)
+
+		_, err = savedSearches.Get(editorCtx, shared.ID)
+		assert.ErrorIs(t, err, ErrSavedSearchNotFound)
+	})
+}
+
+func savedSearchNames(searches []*entities.SavedSearch) []string {
+	names := make([]string, 0, len(searches))
+	for _, s := range searches {
+		names = append(names, s.Name)
+	}
+	return names
+}
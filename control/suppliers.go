@@ -29,7 +29,17 @@ type ListSuppliersQuery struct {
 }
 
 func (cc *SupplierCtrl) List(ctx context.Context, query ListSuppliersQuery) (*entities.ListPage[*entities.Supplier], error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return database.InTransaction(ctx, cc.db, func(ctx context.Context, tx database.Executor) (*entities.ListPage[*entities.Supplier], error) {
-		return cc.repo.List(ctx, tx, database.ListSuppliersQuery(query))
+		return cc.repo.List(ctx, tx, database.ListSuppliersQuery{
+			WorkspaceID: workspaceID,
+			Search:      query.Search,
+			Page:        query.Page,
+			PageSize:    query.PageSize,
+		})
 	})
 }
@@ -16,27 +16,34 @@ import (
 var ErrInvalidTag = errors.New("invalid tag")
 
 type TagControl struct {
-	algorithm string
-	db        *database.Database
-	repo      TagRepo
+	config TagControlConfig
+	db     *database.Database
+	repo   TagRepo
+}
+
+type TagControlConfig struct {
+	Algorithm string
+	// SequentialPerWorkspace makes the sequential algorithm count per workspace, prefixing the tags with the
+	// workspace's tag prefix.
+	SequentialPerWorkspace bool
 }
 
 type TagRepo interface {
 	List(ctx context.Context, exec bob.Executor, query database.ListTagsQuery) (*entities.ListPage[*entities.Tag], error)
-	GetUnused(ctx context.Context, exec bob.Executor) (*entities.Tag, error)
+	GetUnused(ctx context.Context, exec bob.Executor, workspaceID int64) (*entities.Tag, error)
 	Get(ctx context.Context, exec bob.Executor, tag string) (*entities.Tag, error)
 	Create(ctx context.Context, exec bob.Executor, tag *entities.Tag) error
 	MarkTagUsed(ctx context.Context, exec bob.Executor, tag string) error
 	MarkTagUnused(ctx context.Context, exec bob.Executor, tag string) error
 	Delete(ctx context.Context, exec bob.Executor, tag string) error
-	NextSequential(ctx context.Context, exec bob.Executor) (int64, error)
+	NextSequential(ctx context.Context, exec bob.Executor, workspaceID int64) (int64, error)
 }
 
-func NewTagControl(db *database.Database, algorithm string, repo TagRepo) *TagControl {
+func NewTagControl(config TagControlConfig, db *database.Database, repo TagRepo) *TagControl {
 	return &TagControl{
-		algorithm: algorithm,
-		db:        db,
-		repo:      repo,
+		config: config,
+		db:     db,
+		repo:   repo,
 	}
 }
 
@@ -50,14 +57,37 @@ type ListTagsQuery struct {
 }
 
 func (tc *TagControl) List(ctx context.Context, query ListTagsQuery) (*entities.ListPage[*entities.Tag], error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return database.InTransaction(ctx, tc.db, func(ctx context.Context, tx database.Executor) (*entities.ListPage[*entities.Tag], error) {
-		return tc.repo.List(ctx, tx, database.ListTagsQuery(query))
+		return tc.repo.List(ctx, tx, database.ListTagsQuery{
+			WorkspaceID: workspaceID,
+			Search:      query.Search,
+			InUse:       query.InUse,
+			Page:        query.Page,
+			PageSize:    query.PageSize,
+			OrderBy:     query.OrderBy,
+			OrderDir:    query.OrderDir,
+		})
 	})
 }
 
 func (tc *TagControl) GetNext(ctx context.Context) (string, error) {
+	ws, err := currentWorkspace(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var workspaceID int64
+	if ws != nil {
+		workspaceID = ws.ID
+	}
+
 	return database.InTransaction(ctx, tc.db, func(ctx context.Context, tx database.Executor) (string, error) {
-		unused, err := tc.repo.GetUnused(ctx, tx)
+		unused, err := tc.repo.GetUnused(ctx, tx, workspaceID)
 		if err != nil {
 			return "", err
 		}
@@ -66,7 +96,7 @@ func (tc *TagControl) GetNext(ctx context.Context) (string, error) {
 			return unused.Tag, nil
 		}
 
-		switch tc.algorithm {
+		switch tc.config.Algorithm {
 		case "nanoid":
 			return nanoid.Generate("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ", 6)
 		case "ksuid":
@@ -74,14 +104,22 @@ func (tc *TagControl) GetNext(ctx context.Context) (string, error) {
 		case "uuid":
 			return uuid.NewString(), nil
 		case "sequential":
+			if tc.config.SequentialPerWorkspace && ws != nil {
+				return tc.generateSequentialForWorkspace(ctx, ws)
+			}
 			return tc.generateSequential(ctx)
 		}
 
-		return "", fmt.Errorf("unknown tag algorithm: %s", tc.algorithm)
+		return "", fmt.Errorf("unknown tag algorithm: %s", tc.config.Algorithm)
 	})
 }
 
 func (tc *TagControl) Get(ctx context.Context, tag string) (*entities.Tag, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return database.InTransaction(ctx, tc.db, func(ctx context.Context, tx database.Executor) (*entities.Tag, error) {
 		tag, err := tc.repo.Get(ctx, tx, tag)
 		if err != nil {
@@ -90,6 +128,10 @@ func (tc *TagControl) Get(ctx context.Context, tag string) (*entities.Tag, error
 			}
 		}
 
+		if tag != nil && workspaceID != 0 && tag.WorkspaceID != workspaceID {
+			return nil, nil
+		}
+
 		return tag, nil
 	})
 }
@@ -99,6 +141,11 @@ func (tc *TagControl) CreateIfNotExists(ctx context.Context, tag string) (*entit
 		return nil, fmt.Errorf("%w: '%s'", ErrInvalidTag, tag)
 	}
 
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return database.InTransaction(ctx, tc.db, func(ctx context.Context, tx database.Executor) (*entities.Tag, error) {
 		found, err := tc.repo.Get(ctx, tx, tag)
 		if err != nil {
@@ -107,6 +154,10 @@ func (tc *TagControl) CreateIfNotExists(ctx context.Context, tag string) (*entit
 			}
 		}
 
+		if found != nil && workspaceID != 0 && found.WorkspaceID != workspaceID {
+			return nil, fmt.Errorf("%w: '%s' is already used in another workspace", ErrInvalidTag, tag)
+		}
+
 		if found != nil {
 			err = tc.repo.MarkTagUsed(ctx, tx, tag)
 			if err != nil {
@@ -114,15 +165,16 @@ func (tc *TagControl) CreateIfNotExists(ctx context.Context, tag string) (*entit
 			}
 
 			return &entities.Tag{
-				ID:        found.ID,
-				Tag:       found.Tag,
-				InUse:     true,
-				CreatedAt: found.CreatedAt,
-				UpdatedAt: found.UpdatedAt,
+				ID:          found.ID,
+				WorkspaceID: found.WorkspaceID,
+				Tag:         found.Tag,
+				InUse:       true,
+				CreatedAt:   found.CreatedAt,
+				UpdatedAt:   found.UpdatedAt,
 			}, nil
 		}
 
-		err = tc.repo.Create(ctx, tx, &entities.Tag{Tag: tag, InUse: true})
+		err = tc.repo.Create(ctx, tx, &entities.Tag{WorkspaceID: workspaceID, Tag: tag, InUse: true})
 		if err != nil {
 			return nil, err
 		}
@@ -133,11 +185,12 @@ func (tc *TagControl) CreateIfNotExists(ctx context.Context, tag string) (*entit
 		}
 
 		return &entities.Tag{
-			ID:        created.ID,
-			Tag:       created.Tag,
-			InUse:     true,
-			CreatedAt: created.CreatedAt,
-			UpdatedAt: created.UpdatedAt,
+			ID:          created.ID,
+			WorkspaceID: created.WorkspaceID,
+			Tag:         created.Tag,
+			InUse:       true,
+			CreatedAt:   created.CreatedAt,
+			UpdatedAt:   created.UpdatedAt,
 		}, nil
 	})
 }
@@ -156,7 +209,7 @@ func (tc *TagControl) Delete(ctx context.Context, tag string) error {
 
 func (tc *TagControl) generateSequential(ctx context.Context) (string, error) {
 	next, err := database.InTransaction(ctx, tc.db, func(ctx context.Context, tx database.Executor) (int64, error) {
-		return tc.repo.NextSequential(ctx, tx)
+		return tc.repo.NextSequential(ctx, tx, 0)
 	})
 	if err != nil {
 		return "", err
@@ -164,3 +217,29 @@ func (tc *TagControl) generateSequential(ctx context.Context) (string, error) {
 
 	return fmt.Sprintf("%0.6d", next), nil
 }
+
+// generateSequentialForWorkspace counts per workspace. Tags are still unique across all workspaces,
+// so numbers that were already taken, e.g. by a workspace without a prefix, are skipped.
+func (tc *TagControl) generateSequentialForWorkspace(ctx context.Context, ws *entities.Workspace) (string, error) {
+	return database.InTransaction(ctx, tc.db, func(ctx context.Context, tx database.Executor) (string, error) {
+		next, err := tc.repo.NextSequential(ctx, tx, ws.ID)
+		if err != nil {
+			return "", err
+		}
+
+		for {
+			tag := fmt.Sprintf("%s%0.6d", ws.TagPrefix, next)
+
+			_, err := tc.repo.Get(ctx, tx, tag)
+			if errors.Is(err, entities.ErrTagNotFound) {
+				return tag, nil
+			}
+
+			if err != nil {
+				return "", err
+			}
+
+			next++
+		}
+	})
+}
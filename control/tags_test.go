@@ -5,10 +5,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
 	"github.com/RobinThrift/stuff/storage/database"
 	"github.com/RobinThrift/stuff/storage/database/sqlite"
 	"github.com/stephenafamo/bob"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTagControl_CRUD(t *testing.T) {
@@ -16,7 +19,7 @@ func TestTagControl_CRUD(t *testing.T) {
 
 	for _, algo := range algorithms {
 		t.Run(algo, func(t *testing.T) {
-			ctx, cancel := context.WithCancel(context.Background())
+			ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
 			t.Cleanup(cancel)
 
 			tagCtrl := newTestTagControl(t, algo)
@@ -111,6 +114,55 @@ func TestTagControl_CRUD(t *testing.T) {
 
 }
 
+func TestTagControl_SequentialPerWorkspace(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	tagCtrl := newTestTagControl(t, "sequential")
+	tagCtrl.config.SequentialPerWorkspace = true
+
+	workspaces := NewWorkspaceControl(tagCtrl.db, NewPermissionControl(tagCtrl.db, &sqlite.GrantRepo{}, &sqlite.AssetRepo{}), &sqlite.WorkspaceRepo{})
+
+	defaultWS, err := workspaces.Get(ctx, entities.DefaultWorkspaceID)
+	require.NoError(t, err)
+
+	lab := &entities.Workspace{Name: "Lab", TagPrefix: "LAB-"}
+	_, err = workspaces.Create(ctx, lab)
+	require.NoError(t, err)
+
+	labCtx := workspaceCtx(ctx, lab)
+	defaultCtx := workspaceCtx(ctx, defaultWS)
+
+	for _, expected := range []string{"LAB-000001", "LAB-000002"} {
+		next, err := tagCtrl.GetNext(labCtx)
+		require.NoError(t, err)
+		assert.Equal(t, expected, next)
+
+		_, err = tagCtrl.CreateIfNotExists(labCtx, next)
+		require.NoError(t, err)
+	}
+
+	next, err := tagCtrl.GetNext(defaultCtx)
+	require.NoError(t, err)
+	assert.Equal(t, "000001", next)
+
+	// tags are unique across workspaces
+	_, err = tagCtrl.CreateIfNotExists(defaultCtx, "LAB-000001")
+	assert.ErrorIs(t, err, ErrInvalidTag)
+
+	found, err := tagCtrl.Get(defaultCtx, "LAB-000001")
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	// numbers that are already taken are skipped
+	_, err = tagCtrl.CreateIfNotExists(defaultCtx, "LAB-000003")
+	require.NoError(t, err)
+
+	next, err = tagCtrl.GetNext(labCtx)
+	require.NoError(t, err)
+	assert.Equal(t, "LAB-000004", next)
+}
+
 func newTestTagControl(t *testing.T, algorithm string) *TagControl {
 	db, err := sqlite.NewSQLiteDB(&sqlite.Config{File: ":memory:", Timeout: time.Millisecond * 500})
 	if err != nil {
@@ -123,7 +175,7 @@ func newTestTagControl(t *testing.T, algorithm string) *TagControl {
 		}
 	})
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
 	defer cancel()
 
 	err = sqlite.RunMigrations(ctx, db)
@@ -133,5 +185,5 @@ func newTestTagControl(t *testing.T, algorithm string) *TagControl {
 
 	database := &database.Database{DB: bob.NewDB(db)}
 
-	return NewTagControl(database, algorithm, &sqlite.TagRepo{})
+	return NewTagControl(TagControlConfig{Algorithm: algorithm}, database, &sqlite.TagRepo{})
 }
@@ -3,20 +3,24 @@ package control
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/RobinThrift/stuff/auth"
 	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/server/session"
+	"github.com/RobinThrift/stuff/internal/workspace"
 	"github.com/RobinThrift/stuff/storage/database"
-	"github.com/RobinThrift/stuff/storage/database/sqlite"
 	"github.com/stephenafamo/bob"
 )
 
 var ErrUserNotFound = errors.New("user not found")
 
 type UserControl struct {
-	db *database.Database
+	db    *database.Database
+	perms *PermissionControl
 
-	repo UserRepo
+	repo       UserRepo
+	workspaces WorkspaceRepo
 }
 
 type UserRepo interface {
@@ -32,15 +36,15 @@ type UserRepo interface {
 	UpsertPreferences(ctx context.Context, exec bob.Executor, user *auth.User) error
 }
 
-func NewUserCtrl(db *database.Database, repo UserRepo) *UserControl {
-	return &UserControl{db: db, repo: repo}
+func NewUserCtrl(db *database.Database, perms *PermissionControl, repo UserRepo, workspaces WorkspaceRepo) *UserControl {
+	return &UserControl{db: db, perms: perms, repo: repo, workspaces: workspaces}
 }
 
 func (cc *UserControl) Get(ctx context.Context, id int64) (*auth.User, error) {
 	return database.InTransaction(ctx, cc.db, func(ctx context.Context, tx database.Executor) (*auth.User, error) {
 		user, err := cc.repo.Get(ctx, tx, id)
 		if err != nil {
-			if errors.Is(err, sqlite.ErrUserNotFound) {
+			if errors.Is(err, database.ErrUserNotFound) {
 				return nil, ErrUserNotFound
 			}
 			return nil, err
@@ -54,7 +58,7 @@ func (cc *UserControl) GetByUsername(ctx context.Context, username string) (*aut
 	return database.InTransaction(ctx, cc.db, func(ctx context.Context, tx database.Executor) (*auth.User, error) {
 		user, err := cc.repo.GetByUsername(ctx, tx, username)
 		if err != nil {
-			if errors.Is(err, sqlite.ErrUserNotFound) {
+			if errors.Is(err, database.ErrUserNotFound) {
 				return nil, ErrUserNotFound
 			}
 			return nil, err
@@ -68,7 +72,7 @@ func (cc *UserControl) GetByRef(ctx context.Context, ref string) (*auth.User, er
 	return database.InTransaction(ctx, cc.db, func(ctx context.Context, tx database.Executor) (*auth.User, error) {
 		user, err := cc.repo.GetByRef(ctx, tx, ref)
 		if err != nil {
-			if errors.Is(err, sqlite.ErrUserNotFound) {
+			if errors.Is(err, database.ErrUserNotFound) {
 				return nil, ErrUserNotFound
 			}
 			return nil, err
@@ -86,25 +90,83 @@ type ListUsersQuery struct {
 	OrderDir string
 }
 
+// List is allowed for all users, as editors need to pick the user an asset is checked out to.
 func (cc *UserControl) List(ctx context.Context, query ListUsersQuery) (*entities.ListPage[*auth.User], error) {
+	if err := cc.perms.RequireRole(ctx, auth.RoleViewer); err != nil {
+		return nil, err
+	}
+
 	return database.InTransaction(ctx, cc.db, func(ctx context.Context, tx database.Executor) (*entities.ListPage[*auth.User], error) {
 		return cc.repo.List(ctx, tx, database.ListUsersQuery(query))
 	})
 }
 
+// Create adds the new user to the current workspace, or to the default workspace when the user
+// was not created as part of a request, e.g. during the initial setup or on first login.
 func (cc *UserControl) Create(ctx context.Context, user *auth.User) error {
+	if err := cc.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return err
+	}
+
+	workspaceID := entities.DefaultWorkspaceID
+	if scope, ok := workspace.FromCtx(ctx); ok && scope.Current != nil {
+		workspaceID = scope.Current.ID
+	}
+
 	return cc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
-		return cc.repo.Create(ctx, tx, user)
+		err := cc.repo.Create(ctx, tx, user)
+		if err != nil {
+			return err
+		}
+
+		return cc.workspaces.AddMember(ctx, tx, workspaceID, user.ID)
 	})
 }
 
+// Update saves the user. Admins can update every user, all other users only themselves
+// and without changing their own role.
 func (cc *UserControl) Update(ctx context.Context, user *auth.User) error {
 	return cc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		err := cc.requireSelfOrAdmin(ctx, tx, user)
+		if err != nil {
+			return err
+		}
+
 		return cc.repo.Update(ctx, tx, user)
 	})
 }
 
+func (cc *UserControl) requireSelfOrAdmin(ctx context.Context, exec bob.Executor, user *auth.User) error {
+	err := cc.perms.RequireRole(ctx, auth.RoleAdmin)
+	if err == nil {
+		return nil
+	}
+
+	current, ok := session.Get[*auth.User](ctx, "user")
+	if !ok || current == nil || current.ID != user.ID {
+		return err
+	}
+
+	stored, err := cc.repo.Get(ctx, exec, user.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	if stored.Role != user.Role {
+		return fmt.Errorf("%w: user %s can't change their own role", auth.ErrForbidden, current.Username)
+	}
+
+	return nil
+}
+
 func (cc *UserControl) Delete(ctx context.Context, id int64) error {
+	if err := cc.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return err
+	}
+
 	return cc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
 		return cc.repo.Delete(ctx, tx, id)
 	})
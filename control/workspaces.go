@@ -0,0 +1,239 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/workspace"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+)
+
+var ErrNoWorkspace = errors.New("user is not a member of any workspace")
+var ErrWorkspaceNotFound = errors.New("workspace not found")
+var ErrWorkspaceNameEmpty = errors.New("name must not be empty")
+var ErrWorkspaceNameTaken = errors.New("a workspace with this name already exists")
+var ErrWorkspaceNotEmpty = errors.New("workspace still contains assets")
+var ErrDeleteDefaultWorkspace = errors.New("the default workspace cannot be deleted")
+
+type WorkspaceControl struct {
+	db    *database.Database
+	perms *PermissionControl
+
+	repo WorkspaceRepo
+}
+
+type WorkspaceRepo interface {
+	List(ctx context.Context, exec bob.Executor) ([]*entities.Workspace, error)
+	ListForUser(ctx context.Context, exec bob.Executor, userID int64) ([]*entities.Workspace, error)
+	Get(ctx context.Context, exec bob.Executor, id int64) (*entities.Workspace, error)
+	Create(ctx context.Context, exec bob.Executor, workspace *entities.Workspace) error
+	Update(ctx context.Context, exec bob.Executor, workspace *entities.Workspace) error
+	Delete(ctx context.Context, exec bob.Executor, id int64) error
+	CountAssets(ctx context.Context, exec bob.Executor, id int64) (int64, error)
+	ListMembers(ctx context.Context, exec bob.Executor, id int64) ([]*auth.User, error)
+	AddMember(ctx context.Context, exec bob.Executor, id int64, userID int64) error
+	RemoveMember(ctx context.Context, exec bob.Executor, id int64, userID int64) error
+}
+
+func NewWorkspaceControl(db *database.Database, perms *PermissionControl, repo WorkspaceRepo) *WorkspaceControl {
+	return &WorkspaceControl{db: db, perms: perms, repo: repo}
+}
+
+// ListForUser lists all workspaces the user can switch to. Admins can access every workspace,
+// all other users only the ones they are a member of.
+func (wc *WorkspaceControl) ListForUser(ctx context.Context, user *auth.User) ([]*entities.Workspace, error) {
+	return database.InTransaction(ctx, wc.db, func(ctx context.Context, tx database.Executor) ([]*entities.Workspace, error) {
+		if user.IsAdmin() {
+			return wc.repo.List(ctx, tx)
+		}
+
+		return wc.repo.ListForUser(ctx, tx, user.ID)
+	})
+}
+
+func (wc *WorkspaceControl) List(ctx context.Context) ([]*entities.Workspace, error) {
+	if err := wc.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	return database.InTransaction(ctx, wc.db, func(ctx context.Context, tx database.Executor) ([]*entities.Workspace, error) {
+		return wc.repo.List(ctx, tx)
+	})
+}
+
+func (wc *WorkspaceControl) Get(ctx context.Context, id int64) (*entities.Workspace, error) {
+	if err := wc.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	return database.InTransaction(ctx, wc.db, func(ctx context.Context, tx database.Executor) (*entities.Workspace, error) {
+		return wc.get(ctx, tx, id)
+	})
+}
+
+func (wc *WorkspaceControl) Create(ctx context.Context, ws *entities.Workspace) (map[string]string, error) {
+	if err := wc.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	return database.InTransaction(ctx, wc.db, func(ctx context.Context, tx database.Executor) (map[string]string, error) {
+		validationErrs, err := wc.validate(ctx, tx, ws)
+		if err != nil || len(validationErrs) != 0 {
+			return validationErrs, err
+		}
+
+		return nil, wc.repo.Create(ctx, tx, ws)
+	})
+}
+
+func (wc *WorkspaceControl) Update(ctx context.Context, ws *entities.Workspace) (map[string]string, error) {
+	if err := wc.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	return database.InTransaction(ctx, wc.db, func(ctx context.Context, tx database.Executor) (map[string]string, error) {
+		_, err := wc.get(ctx, tx, ws.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		validationErrs, err := wc.validate(ctx, tx, ws)
+		if err != nil || len(validationErrs) != 0 {
+			return validationErrs, err
+		}
+
+		return nil, wc.repo.Update(ctx, tx, ws)
+	})
+}
+
+// Delete removes an empty workspace. Assets must be purged from the workspace first,
+// including the ones in the trash.
+func (wc *WorkspaceControl) Delete(ctx context.Context, id int64) error {
+	if err := wc.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return err
+	}
+
+	if id == entities.DefaultWorkspaceID {
+		return ErrDeleteDefaultWorkspace
+	}
+
+	return wc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		ws, err := wc.get(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		count, err := wc.repo.CountAssets(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if count != 0 {
+			return fmt.Errorf("%w: %s contains %d assets", ErrWorkspaceNotEmpty, ws.Name, count)
+		}
+
+		return wc.repo.Delete(ctx, tx, id)
+	})
+}
+
+func (wc *WorkspaceControl) ListMembers(ctx context.Context, id int64) ([]*auth.User, error) {
+	if err := wc.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	return database.InTransaction(ctx, wc.db, func(ctx context.Context, tx database.Executor) ([]*auth.User, error) {
+		return wc.repo.ListMembers(ctx, tx, id)
+	})
+}
+
+func (wc *WorkspaceControl) AddMember(ctx context.Context, id int64, userID int64) error {
+	if err := wc.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return err
+	}
+
+	return wc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		_, err := wc.get(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		return wc.repo.AddMember(ctx, tx, id, userID)
+	})
+}
+
+func (wc *WorkspaceControl) RemoveMember(ctx context.Context, id int64, userID int64) error {
+	if err := wc.perms.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return err
+	}
+
+	return wc.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		return wc.repo.RemoveMember(ctx, tx, id, userID)
+	})
+}
+
+func (wc *WorkspaceControl) get(ctx context.Context, exec bob.Executor, id int64) (*entities.Workspace, error) {
+	ws, err := wc.repo.Get(ctx, exec, id)
+	if err != nil {
+		if errors.Is(err, database.ErrWorkspaceNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrWorkspaceNotFound, id)
+		}
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+func (wc *WorkspaceControl) validate(ctx context.Context, exec bob.Executor, ws *entities.Workspace) (map[string]string, error) {
+	ws.Name = strings.TrimSpace(ws.Name)
+	ws.TagPrefix = strings.TrimSpace(ws.TagPrefix)
+
+	validationErrs := map[string]string{}
+
+	if ws.Name == "" {
+		validationErrs["name"] = ErrWorkspaceNameEmpty.Error()
+		return validationErrs, nil
+	}
+
+	existing, err := wc.repo.List(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range existing {
+		if e.ID != ws.ID && strings.EqualFold(e.Name, ws.Name) {
+			validationErrs["name"] = ErrWorkspaceNameTaken.Error()
+		}
+	}
+
+	return validationErrs, nil
+}
+
+// currentWorkspace returns the workspace the request is scoped to. Requests without a workspace scope,
+// like background jobs or the initial setup, return nil and operate on all workspaces.
+func currentWorkspace(ctx context.Context) (*entities.Workspace, error) {
+	scope, ok := workspace.FromCtx(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	if scope.Current == nil {
+		return nil, fmt.Errorf("%w: %w", auth.ErrForbidden, ErrNoWorkspace)
+	}
+
+	return scope.Current, nil
+}
+
+// currentWorkspaceID is like currentWorkspace, but returns 0 for requests without a workspace scope.
+func currentWorkspaceID(ctx context.Context) (int64, error) {
+	ws, err := currentWorkspace(ctx)
+	if err != nil || ws == nil {
+		return 0, err
+	}
+
+	return ws.ID, nil
+}
@@ -0,0 +1,125 @@
+package control
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/workspace"
+	"github.com/RobinThrift/stuff/storage/database/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceControl(t *testing.T) {
+	ctx, cancel := context.WithCancel(auth.SystemCtx(context.Background()))
+	t.Cleanup(cancel)
+
+	assetCtrl := newTestAssetControl(t)
+	workspaces := NewWorkspaceControl(assetCtrl.db, assetCtrl.perms, &sqlite.WorkspaceRepo{})
+	users := NewUserCtrl(assetCtrl.db, assetCtrl.perms, &sqlite.UserRepo{}, &sqlite.WorkspaceRepo{})
+	categories := NewCategoryCtrl(assetCtrl.db, &sqlite.CategoryRepo{})
+
+	defaultWS, err := workspaces.Get(ctx, entities.DefaultWorkspaceID)
+	require.NoError(t, err)
+
+	lab := &entities.Workspace{Name: " Lab ", TagPrefix: "LAB-"}
+	validationErrs, err := workspaces.Create(ctx, lab)
+	require.NoError(t, err)
+	require.Empty(t, validationErrs)
+	assert.Equal(t, "Lab", lab.Name)
+
+	validationErrs, err = workspaces.Create(ctx, &entities.Workspace{Name: "default"})
+	require.NoError(t, err)
+	assert.Contains(t, validationErrs, "name")
+
+	editor := &auth.User{Username: "editor", DisplayName: "Editor", Role: auth.RoleEditor, AuthRef: "editor"}
+	require.NoError(t, users.Create(ctx, editor))
+
+	available, err := workspaces.ListForUser(ctx, editor)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{defaultWS.ID}, workspaceIDs(available))
+
+	require.NoError(t, workspaces.AddMember(ctx, lab.ID, editor.ID))
+
+	available, err = workspaces.ListForUser(ctx, editor)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int64{defaultWS.ID, lab.ID}, workspaceIDs(available))
+
+	editorCtx := userCtx(ctx, editor)
+	labCtx := workspaceCtx(editorCtx, lab)
+	defaultCtx := workspaceCtx(editorCtx, defaultWS)
+
+	_, err = workspaces.List(editorCtx)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+
+	asset := newTestAsset(t)
+	asset.Category = "Oscilloscopes"
+	created, err := assetCtrl.Create(labCtx, CreateAssetCmd{Asset: asset})
+	require.NoError(t, err)
+	assert.Equal(t, lab.ID, created.WorkspaceID)
+
+	t.Run("Scoping", func(t *testing.T) {
+		list, err := assetCtrl.List(defaultCtx, ListAssetsQuery{})
+		require.NoError(t, err)
+		assert.Empty(t, list.Items)
+
+		list, err = assetCtrl.List(labCtx, ListAssetsQuery{})
+		require.NoError(t, err)
+		assert.Len(t, list.Items, 1)
+
+		_, err = assetCtrl.Get(defaultCtx, GetAssetQuery{ID: created.ID})
+		assert.ErrorIs(t, err, ErrAssetNotFound)
+
+		_, err = assetCtrl.Update(defaultCtx, UpdateAssetCmd{Asset: created})
+		assert.ErrorIs(t, err, auth.ErrForbidden)
+
+		cats, err := categories.List(defaultCtx, ListCategoriesQuery{})
+		require.NoError(t, err)
+		assert.Empty(t, cats.Items)
+
+		cats, err = categories.List(labCtx, ListCategoriesQuery{})
+		require.NoError(t, err)
+		assert.Len(t, cats.Items, 1)
+
+		// requests without a workspace scope see all workspaces
+		list, err = assetCtrl.List(ctx, ListAssetsQuery{})
+		require.NoError(t, err)
+		assert.Len(t, list.Items, 1)
+
+		// users without any workspace can't see anything
+		_, err = assetCtrl.List(workspace.WithCtx(editorCtx, &workspace.Scope{}), ListAssetsQuery{})
+		assert.ErrorIs(t, err, auth.ErrForbidden)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := workspaces.Delete(ctx, defaultWS.ID)
+		assert.ErrorIs(t, err, ErrDeleteDefaultWorkspace)
+
+		err = workspaces.Delete(ctx, lab.ID)
+		assert.ErrorIs(t, err, ErrWorkspaceNotEmpty)
+
+		require.NoError(t, assetCtrl.Delete(labCtx, created))
+		require.NoError(t, assetCtrl.Purge(ctx, created.ID))
+
+		err = workspaces.Delete(ctx, lab.ID)
+		require.NoError(t, err)
+
+		available, err = workspaces.ListForUser(ctx, editor)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{defaultWS.ID}, workspaceIDs(available))
+	})
+}
+
+func workspaceCtx(ctx context.Context, ws *entities.Workspace) context.Context {
+	return workspace.WithCtx(ctx, &workspace.Scope{Current: ws, Available: []*entities.Workspace{ws}})
+}
+
+func workspaceIDs(workspaces []*entities.Workspace) []int64 {
+	ids := make([]int64, 0, len(workspaces))
+	for _, ws := range workspaces {
+		ids = append(ids, ws.ID)
+	}
+	return ids
+}
@@ -30,8 +30,9 @@ const (
 )
 
 type Asset struct {
-	ID   int64     `form:"-"`
-	Type AssetType `form:"type"`
+	ID          int64     `form:"-"`
+	WorkspaceID int64     `form:"-"`
+	Type        AssetType `form:"type"`
 
 	ParentAssetID int64    `form:"parent_asset_id"`
 	Parent        *Asset   `form:"-"`
@@ -49,6 +50,7 @@ type Asset struct {
 	Notes         string       `form:"notes"`
 	ImageURL      string       `form:"-"`
 	ThumbnailURL  string       `form:"-"`
+	PreviewURL    string       `form:"-"`
 	WarrantyUntil time.Time    `form:"warranty_until,omitempty"`
 	Quantity      uint64       `form:"quantity"`
 	QuantityUnit  string       `form:"quantity_unit"`
@@ -65,15 +67,29 @@ type Asset struct {
 
 	Files []*File `form:"-"`
 
+	Photos []*Photo `form:"-"`
+
 	MetaInfo MetaInfo `form:"-"`
+
+	// Snippet is an excerpt of the text that matched the search query, only set for search results.
+	// The matches are wrapped in SnippetMatchStart and SnippetMatchEnd.
+	Snippet string `form:"-"`
 }
 
+// The matches in search snippets are marked with control characters, which can't be confused with the
+// text itself, so the snippet can be escaped before the markers are replaced with markup.
+const (
+	SnippetMatchStart = "\x02"
+	SnippetMatchEnd   = "\x03"
+)
+
 type CustomAttr struct {
 	Name  string `form:"name" json:"name,omitempty"`
 	Value any    `form:"value" json:"value,omitempty"`
 }
 
 type Purchase struct {
+	ID       int64          `form:"id,omitempty"`
 	Supplier string         `form:"supplier,omitempty"`
 	OrderNo  string         `form:"order_no,omitempty"`
 	Date     time.Time      `form:"order_date,omitempty"`
@@ -85,6 +101,7 @@ type MetaInfo struct {
 	CreatedBy int64
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt time.Time
 }
 
 type Part struct {
@@ -102,6 +119,24 @@ type Part struct {
 	UpdatedAt time.Time `form:"-"`
 }
 
+// Photo is an image in an asset's gallery. The primary photo is used as the asset's image.
+type Photo struct {
+	ID      int64
+	AssetID int64
+	FileID  int64
+
+	ImageURL     string
+	ThumbnailURL string
+	PreviewURL   string
+
+	Caption  string
+	Position int
+	Primary  bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
 type ListAssetsQuery struct {
 	Search *ListAssetsQuerySearch
 
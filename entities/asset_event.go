@@ -0,0 +1,262 @@
+package entities
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+type AssetEventType string
+
+const (
+	AssetEventCreated    AssetEventType = "CREATED"
+	AssetEventUpdated    AssetEventType = "UPDATED"
+	AssetEventDeleted    AssetEventType = "DELETED"
+	AssetEventRestored   AssetEventType = "RESTORED"
+	AssetEventPurged     AssetEventType = "PURGED"
+	AssetEventCheckedOut AssetEventType = "CHECKED_OUT"
+	AssetEventCheckedIn  AssetEventType = "CHECKED_IN"
+)
+
+type AssetEvent struct {
+	ID      int64
+	AssetID int64
+	Type    AssetEventType
+	Changes []*AssetFieldChange
+
+	// Snapshot is the state of the asset after the event, or before it for deleted assets.
+	// Events recorded before snapshots were introduced don't have one.
+	Snapshot *AssetSnapshot
+
+	UserID    int64
+	UserName  string
+	RequestID string
+
+	CreatedAt time.Time
+}
+
+// IsRevertable reports whether the asset can be restored to the state recorded by this event.
+func (e *AssetEvent) IsRevertable() bool {
+	return e.Snapshot != nil && e.Type != AssetEventDeleted && e.Type != AssetEventPurged
+}
+
+// AssetFieldChange holds the JSON encoded value of a single asset field before and after a change.
+type AssetFieldChange struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+func (c *AssetFieldChange) BeforeString() string {
+	return rawJSONToString(c.Before)
+}
+
+func (c *AssetFieldChange) AfterString() string {
+	return rawJSONToString(c.After)
+}
+
+// DiffAssets returns the fields that differ between before and after.
+// Either one may be nil, e.g. when an asset was created or deleted, in which case it is compared to an empty asset.
+// Parts are compared without their IDs and timestamps, as these change on every update.
+func DiffAssets(before *Asset, after *Asset) []*AssetFieldChange {
+	if before == nil {
+		before = &Asset{}
+	}
+
+	if after == nil {
+		after = &Asset{}
+	}
+
+	beforeFields := diffableAssetFields(before)
+	afterFields := diffableAssetFields(after)
+
+	changes := make([]*AssetFieldChange, 0, len(beforeFields))
+	for i := range beforeFields {
+		b, err := json.Marshal(beforeFields[i].value)
+		if err != nil {
+			b = []byte("null")
+		}
+
+		a, err := json.Marshal(afterFields[i].value)
+		if err != nil {
+			a = []byte("null")
+		}
+
+		if bytes.Equal(a, b) {
+			continue
+		}
+
+		changes = append(changes, &AssetFieldChange{Field: beforeFields[i].name, Before: b, After: a})
+	}
+
+	return changes
+}
+
+// AssetSnapshot holds all user editable fields of an asset, so it can be restored later.
+type AssetSnapshot struct {
+	Type              AssetType    `json:"type"`
+	ParentAssetID     int64        `json:"parentAssetID"`
+	Status            Status       `json:"status"`
+	Tag               string       `json:"tag"`
+	Name              string       `json:"name"`
+	Category          string       `json:"category"`
+	Model             string       `json:"model"`
+	ModelNo           string       `json:"modelNo"`
+	SerialNo          string       `json:"serialNo"`
+	Manufacturer      string       `json:"manufacturer"`
+	Notes             string       `json:"notes"`
+	ImageURL          string       `json:"imageURL"`
+	ThumbnailURL      string       `json:"thumbnailURL"`
+	WarrantyUntil     time.Time    `json:"warrantyUntil"`
+	Quantity          uint64       `json:"quantity"`
+	QuantityUnit      string       `json:"quantityUnit"`
+	CustomAttrs       []CustomAttr `json:"customAttrs"`
+	CheckedOutTo      int64        `json:"checkedOutTo"`
+	Location          string       `json:"location"`
+	PositionCode      string       `json:"positionCode"`
+	Purchases         []*Purchase  `json:"purchases"`
+	PartsTotalCounter int          `json:"partsTotalCounter"`
+	Parts             []*Part      `json:"parts"`
+}
+
+func NewAssetSnapshot(asset *Asset) *AssetSnapshot {
+	customAttrs := asset.CustomAttrs
+	if customAttrs == nil {
+		customAttrs = []CustomAttr{}
+	}
+
+	// IDs are left out, like those of the parts, so they don't show up as changes
+	purchases := make([]*Purchase, 0, len(asset.Purchases))
+	for _, p := range asset.Purchases {
+		purchase := *p
+		purchase.ID = 0
+		purchases = append(purchases, &purchase)
+	}
+
+	parts := make([]*Part, 0, len(asset.Parts))
+	for _, p := range asset.Parts {
+		parts = append(parts, &Part{
+			Tag:          p.Tag,
+			Name:         p.Name,
+			Location:     p.Location,
+			PositionCode: p.PositionCode,
+			Notes:        p.Notes,
+		})
+	}
+
+	return &AssetSnapshot{
+		Type:              asset.Type,
+		ParentAssetID:     asset.ParentAssetID,
+		Status:            asset.Status,
+		Tag:               asset.Tag,
+		Name:              asset.Name,
+		Category:          asset.Category,
+		Model:             asset.Model,
+		ModelNo:           asset.ModelNo,
+		SerialNo:          asset.SerialNo,
+		Manufacturer:      asset.Manufacturer,
+		Notes:             asset.Notes,
+		ImageURL:          asset.ImageURL,
+		ThumbnailURL:      asset.ThumbnailURL,
+		WarrantyUntil:     asset.WarrantyUntil,
+		Quantity:          asset.Quantity,
+		QuantityUnit:      asset.QuantityUnit,
+		CustomAttrs:       customAttrs,
+		CheckedOutTo:      asset.CheckedOutTo,
+		Location:          asset.Location,
+		PositionCode:      asset.PositionCode,
+		Purchases:         purchases,
+		PartsTotalCounter: asset.PartsTotalCounter,
+		Parts:             parts,
+	}
+}
+
+// Restore sets the fields of the asset to the values of the snapshot.
+// The tag, images and checkout state are left untouched, as they are not managed through regular updates
+// and the referenced resources may no longer exist. The parts counter is never decreased, so parts added after
+// the snapshot was taken don't get their tags reused.
+func (s *AssetSnapshot) Restore(asset *Asset) {
+	asset.Type = s.Type
+	asset.ParentAssetID = s.ParentAssetID
+	asset.Name = s.Name
+	asset.Category = s.Category
+	asset.Model = s.Model
+	asset.ModelNo = s.ModelNo
+	asset.SerialNo = s.SerialNo
+	asset.Manufacturer = s.Manufacturer
+	asset.Notes = s.Notes
+	asset.WarrantyUntil = s.WarrantyUntil
+	asset.Quantity = s.Quantity
+	asset.QuantityUnit = s.QuantityUnit
+	asset.Location = s.Location
+	asset.PositionCode = s.PositionCode
+	asset.PartsTotalCounter = max(asset.PartsTotalCounter, s.PartsTotalCounter)
+
+	if asset.CheckedOutTo == 0 {
+		asset.Status = s.Status
+	}
+
+	asset.CustomAttrs = make([]CustomAttr, len(s.CustomAttrs))
+	copy(asset.CustomAttrs, s.CustomAttrs)
+
+	asset.Purchases = make([]*Purchase, 0, len(s.Purchases))
+	for _, p := range s.Purchases {
+		purchase := *p
+		asset.Purchases = append(asset.Purchases, &purchase)
+	}
+
+	asset.Parts = make([]*Part, 0, len(s.Parts))
+	for _, p := range s.Parts {
+		part := *p
+		part.AssetID = asset.ID
+		asset.Parts = append(asset.Parts, &part)
+	}
+}
+
+type diffableField struct {
+	name  string
+	value any
+}
+
+func diffableAssetFields(asset *Asset) []diffableField {
+	s := NewAssetSnapshot(asset)
+
+	return []diffableField{
+		{"type", s.Type},
+		{"parentAssetID", s.ParentAssetID},
+		{"status", s.Status},
+		{"tag", s.Tag},
+		{"name", s.Name},
+		{"category", s.Category},
+		{"model", s.Model},
+		{"modelNo", s.ModelNo},
+		{"serialNo", s.SerialNo},
+		{"manufacturer", s.Manufacturer},
+		{"notes", s.Notes},
+		{"imageURL", s.ImageURL},
+		{"thumbnailURL", s.ThumbnailURL},
+		{"warrantyUntil", s.WarrantyUntil},
+		{"quantity", s.Quantity},
+		{"quantityUnit", s.QuantityUnit},
+		{"customAttrs", s.CustomAttrs},
+		{"checkedOutTo", s.CheckedOutTo},
+		{"location", s.Location},
+		{"positionCode", s.PositionCode},
+		{"purchases", s.Purchases},
+		{"partsTotalCounter", s.PartsTotalCounter},
+		{"parts", s.Parts},
+	}
+}
+
+func rawJSONToString(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil {
+		return str
+	}
+
+	return string(raw)
+}
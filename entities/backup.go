@@ -0,0 +1,36 @@
+package entities
+
+import "time"
+
+// BackupFile is a backup archive stored in the backup directory.
+type BackupFile struct {
+	Name      string
+	SizeBytes int64
+	CreatedAt time.Time
+}
+
+// BackupRun is the result of a scheduled backup.
+type BackupRun struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	File       string
+	Error      string
+}
+
+// BackupRetention is the number of most recent days, weeks and months for which the newest backup is kept.
+type BackupRetention struct {
+	Daily   int
+	Weekly  int
+	Monthly int
+}
+
+type BackupStatus struct {
+	Enabled   bool
+	Dir       string
+	Schedule  string
+	Retention BackupRetention
+	NextRunAt time.Time
+	// LastRun is nil if no scheduled backup was run since the server started.
+	LastRun *BackupRun
+	Backups []*BackupFile
+}
@@ -0,0 +1,34 @@
+package entities
+
+import "time"
+
+type Checkout struct {
+	ID        int64  `form:"-"`
+	AssetID   int64  `form:"-"`
+	AssetTag  string `form:"-"`
+	AssetName string `form:"-"`
+
+	CheckedOutTo     int64     `form:"checked_out_to"`
+	CheckedOutToName string    `form:"-"`
+	DueAt            time.Time `form:"due_at,omitempty"`
+	Note             string    `form:"note"`
+	CheckedOutAt     time.Time `form:"-"`
+
+	CheckedInAt time.Time `form:"-"`
+	CheckInNote string    `form:"check_in_note"`
+
+	OverdueAt  time.Time `form:"-"`
+	RemindedAt time.Time `form:"-"`
+
+	CreatedBy int64     `form:"-"`
+	CreatedAt time.Time `form:"-"`
+	UpdatedAt time.Time `form:"-"`
+}
+
+func (c *Checkout) IsCheckedIn() bool {
+	return !c.CheckedInAt.IsZero()
+}
+
+func (c *Checkout) IsOverdue(now time.Time) bool {
+	return !c.IsCheckedIn() && !c.DueAt.IsZero() && c.DueAt.Before(now)
+}
@@ -0,0 +1,248 @@
+package entities
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// The facets assets can be filtered by. The names are also used as URL parameters.
+const (
+	FacetStatus       = "status"
+	FacetCategory     = "category"
+	FacetLocation     = "location"
+	FacetManufacturer = "manufacturer"
+	FacetSupplier     = "supplier"
+	FacetWarranty     = "warranty"
+	// FacetCustomAttr values are formatted as `name:value`.
+	FacetCustomAttr = "attr"
+)
+
+type WarrantyState string
+
+const (
+	WarrantyActive  WarrantyState = "ACTIVE"
+	WarrantyExpired WarrantyState = "EXPIRED"
+	WarrantyNone    WarrantyState = "NONE"
+)
+
+// AssetFilters are the selected values of each facet. Values of the same facet are combined with OR,
+// different facets with AND.
+type AssetFilters struct {
+	Status       []Status
+	Category     []string
+	Location     []string
+	Manufacturer []string
+	Supplier     []string
+	Warranty     []WarrantyState
+	// CustomAttrs maps the names of custom attributes to the selected values.
+	CustomAttrs map[string][]string
+}
+
+// AssetFiltersFromValues reads the filters from URL parameters named after the facets.
+// Values of FacetCustomAttr without a name are ignored.
+func AssetFiltersFromValues(values map[string][]string) AssetFilters {
+	filters := AssetFilters{
+		Category:     values[FacetCategory],
+		Location:     values[FacetLocation],
+		Manufacturer: values[FacetManufacturer],
+		Supplier:     values[FacetSupplier],
+	}
+
+	for _, s := range values[FacetStatus] {
+		filters.Status = append(filters.Status, Status(strings.ToUpper(s)))
+	}
+
+	for _, w := range values[FacetWarranty] {
+		filters.Warranty = append(filters.Warranty, WarrantyState(strings.ToUpper(w)))
+	}
+
+	for _, attr := range values[FacetCustomAttr] {
+		name, value, ok := strings.Cut(attr, ":")
+		if !ok || name == "" {
+			continue
+		}
+
+		if filters.CustomAttrs == nil {
+			filters.CustomAttrs = map[string][]string{}
+		}
+
+		filters.CustomAttrs[name] = append(filters.CustomAttrs[name], value)
+	}
+
+	return filters
+}
+
+// ParseAssetFilters reads the filters from URL encoded parameters, as returned by Encode.
+func ParseAssetFilters(encoded string) (AssetFilters, error) {
+	values, err := url.ParseQuery(encoded)
+	if err != nil {
+		return AssetFilters{}, err
+	}
+
+	return AssetFiltersFromValues(values), nil
+}
+
+// Values returns the selected values as URL parameters named after the facets.
+func (f AssetFilters) Values() map[string][]string {
+	values := map[string][]string{}
+
+	if len(f.Status) != 0 {
+		values[FacetStatus] = stringSlice(f.Status)
+	}
+
+	if len(f.Category) != 0 {
+		values[FacetCategory] = f.Category
+	}
+
+	if len(f.Location) != 0 {
+		values[FacetLocation] = f.Location
+	}
+
+	if len(f.Manufacturer) != 0 {
+		values[FacetManufacturer] = f.Manufacturer
+	}
+
+	if len(f.Supplier) != 0 {
+		values[FacetSupplier] = f.Supplier
+	}
+
+	if len(f.Warranty) != 0 {
+		values[FacetWarranty] = stringSlice(f.Warranty)
+	}
+
+	names := make([]string, 0, len(f.CustomAttrs))
+	for name := range f.CustomAttrs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		for _, v := range f.CustomAttrs[name] {
+			values[FacetCustomAttr] = append(values[FacetCustomAttr], name+":"+v)
+		}
+	}
+
+	return values
+}
+
+// Encode returns the selected values URL encoded.
+func (f AssetFilters) Encode() string {
+	return url.Values(f.Values()).Encode()
+}
+
+// IsEmpty reports whether no facet values are selected.
+func (f AssetFilters) IsEmpty() bool {
+	return len(f.Status) == 0 && len(f.Category) == 0 && len(f.Location) == 0 && len(f.Manufacturer) == 0 &&
+		len(f.Supplier) == 0 && len(f.Warranty) == 0 && len(f.CustomAttrs) == 0
+}
+
+// Without returns a copy of the filters without the selected values of the facet,
+// as the counts of a facet must not be restricted by its own selection.
+// For FacetCustomAttr only the values of the custom attribute attr are removed.
+func (f AssetFilters) Without(facet string, attr string) AssetFilters {
+	switch facet {
+	case FacetStatus:
+		f.Status = nil
+	case FacetCategory:
+		f.Category = nil
+	case FacetLocation:
+		f.Location = nil
+	case FacetManufacturer:
+		f.Manufacturer = nil
+	case FacetSupplier:
+		f.Supplier = nil
+	case FacetWarranty:
+		f.Warranty = nil
+	case FacetCustomAttr:
+		customAttrs := make(map[string][]string, len(f.CustomAttrs))
+		for name, values := range f.CustomAttrs {
+			if name != attr {
+				customAttrs[name] = values
+			}
+		}
+		f.CustomAttrs = customAttrs
+	}
+
+	return f
+}
+
+// Selected returns the selected values of the facet.
+func (f AssetFilters) Selected(facet string, attr string) []string {
+	switch facet {
+	case FacetStatus:
+		return stringSlice(f.Status)
+	case FacetCategory:
+		return f.Category
+	case FacetLocation:
+		return f.Location
+	case FacetManufacturer:
+		return f.Manufacturer
+	case FacetSupplier:
+		return f.Supplier
+	case FacetWarranty:
+		return stringSlice(f.Warranty)
+	case FacetCustomAttr:
+		return f.CustomAttrs[attr]
+	}
+
+	return nil
+}
+
+func stringSlice[S ~string](values []S) []string {
+	s := make([]string, 0, len(values))
+	for _, v := range values {
+		s = append(s, string(v))
+	}
+	return s
+}
+
+// Facet lists the values of a field of the matching assets and how many assets have each value.
+type Facet struct {
+	// Name is one of the Facet* constants.
+	Name string
+	// Attr is the name of the custom attribute, only set for FacetCustomAttr.
+	Attr   string
+	Values []*FacetValue
+}
+
+type FacetValue struct {
+	Value    string
+	Count    int
+	Selected bool
+}
+
+// Param returns the value of the URL parameter selecting the value.
+func (f *Facet) Param(v *FacetValue) string {
+	if f.Name == FacetCustomAttr {
+		return f.Attr + ":" + v.Value
+	}
+
+	return v.Value
+}
+
+// NewFacet creates the facet from the values ordered by relevance and marks the selected ones.
+// Only the first maxValues values are kept, unless maxValues is 0, but selected values are never dropped.
+// Selected values, which no asset has with the other filters applied, are added with a count of 0.
+func NewFacet(name string, attr string, values []*FacetValue, filters AssetFilters, maxValues int) *Facet {
+	facet := &Facet{Name: name, Attr: attr, Values: make([]*FacetValue, 0, len(values))}
+	selected := filters.Selected(name, attr)
+
+	for i, v := range values {
+		v.Selected = slices.Contains(selected, v.Value)
+		if maxValues > 0 && i >= maxValues && !v.Selected {
+			continue
+		}
+
+		facet.Values = append(facet.Values, v)
+	}
+
+	for _, s := range selected {
+		found := slices.ContainsFunc(values, func(v *FacetValue) bool { return v.Value == s })
+		if !found {
+			facet.Values = append(facet.Values, &FacetValue{Value: s, Selected: true})
+		}
+	}
+
+	return facet
+}
@@ -20,7 +20,51 @@ type File struct {
 
 	Sha256 []byte
 
+	Variant FileVariant
+
+	// Broken is set by the storage check when the file's blob is missing or corrupted.
+	Broken FileBrokenReason
+
 	CreatedBy int64
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// FileVariant distinguishes uploaded files from the downscaled versions generated for images.
+type FileVariant string
+
+const (
+	FileVariantOriginal  FileVariant = "original"
+	FileVariantThumbnail FileVariant = "thumbnail"
+	FileVariantPreview   FileVariant = "preview"
+)
+
+type FileBrokenReason string
+
+const (
+	FileBrokenMissing          FileBrokenReason = "missing"
+	FileBrokenChecksumMismatch FileBrokenReason = "checksum_mismatch"
+)
+
+// FileCheckReport is the result of comparing the files in the database with the blobs in the file storage.
+type FileCheckReport struct {
+	// Orphans are blobs without a file referencing them.
+	Orphans []*File
+	// Missing are files whose blob does not exist.
+	Missing []*File
+	// Mismatched are files whose blob's checksum differs from the recorded one.
+	Mismatched []*File
+
+	NumFiles int
+	NumBlobs int
+
+	ChecksumsVerified bool
+	Repaired          bool
+
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+func (r *FileCheckReport) OK() bool {
+	return len(r.Orphans) == 0 && len(r.Missing) == 0 && len(r.Mismatched) == 0
+}
@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"net/url"
+	"time"
+)
+
+// SavedSearch is a named search query together with the selected facet values. The matching assets
+// are determined each time the search is used, so it acts as a dynamic collection.
+type SavedSearch struct {
+	ID          int64 `form:"-"`
+	WorkspaceID int64 `form:"-"`
+	// UserID is the user that created the search. Unless the search is shared, only they can see it.
+	UserID int64 `form:"-"`
+
+	Name    string       `form:"name"`
+	Query   string       `form:"query"`
+	Filters AssetFilters `form:"-"`
+	// Shared searches are visible to all users of the workspace.
+	Shared bool `form:"shared"`
+
+	CreatedAt time.Time `form:"-"`
+	UpdatedAt time.Time `form:"-"`
+}
+
+// Values returns the URL parameters to show the search in the asset list.
+func (s *SavedSearch) Values() url.Values {
+	values := url.Values(s.Filters.Values())
+	if s.Query != "" {
+		values.Set("query", s.Query)
+	}
+
+	return values
+}
@@ -8,9 +8,10 @@ import (
 var ErrTagNotFound = errors.New("tag not found")
 
 type Tag struct {
-	ID        int64
-	Tag       string
-	InUse     bool
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID          int64
+	WorkspaceID int64
+	Tag         string
+	InUse       bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
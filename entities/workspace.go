@@ -0,0 +1,20 @@
+package entities
+
+import "time"
+
+// DefaultWorkspaceID is the workspace all existing assets and tags were moved to when workspaces were introduced.
+// Users created by the system, e.g. through the initial setup or OIDC provisioning, become members of it.
+const DefaultWorkspaceID int64 = 1
+
+type Workspace struct {
+	ID   int64  `form:"-"`
+	Name string `form:"name"`
+
+	// TagPrefix is prepended to the sequential tags generated for this workspace,
+	// which keeps them apart from the other workspaces' tags.
+	TagPrefix  string `form:"tag_prefix"`
+	TagCounter int64  `form:"-"`
+
+	CreatedAt time.Time `form:"-"`
+	UpdatedAt time.Time `form:"-"`
+}
@@ -8,6 +8,10 @@ export interface paths {
         get: operations["ListAssets"]
         post: operations["CreateAsset"]
     }
+    "/v1/assets/facets": {
+        /** @description Counts the values of each facet for the assets matching the query. The counts of a facet ignore its own selected values. */
+        get: operations["ListAssetFacets"]
+    }
     "/v1/assets/{tagOrID}": {
         get: operations["GetAsset"]
         put: operations["UpdateAsset"]
@@ -18,6 +22,77 @@ export interface paths {
             }
         }
     }
+    "/v1/assets/{tagOrID}/checkout": {
+        get: operations["ListAssetCheckouts"]
+        post: operations["CheckOutAsset"]
+        delete: operations["CheckInAsset"]
+        parameters: {
+            path: {
+                tagOrID: string
+            }
+        }
+    }
+    "/v1/assets/{tagOrID}/history": {
+        get: operations["ListAssetEvents"]
+        parameters: {
+            path: {
+                tagOrID: string
+            }
+        }
+    }
+    "/v1/assets/{tagOrID}/parts": {
+        post: operations["CreateAssetPart"]
+        parameters: {
+            path: {
+                tagOrID: string
+            }
+        }
+    }
+    "/v1/assets/{tagOrID}/parts/{partTag}": {
+        put: operations["UpdateAssetPart"]
+        delete: operations["DeleteAssetPart"]
+        parameters: {
+            path: {
+                tagOrID: string
+                partTag: string
+            }
+        }
+    }
+    "/v1/assets/{tagOrID}/purchases": {
+        post: operations["CreateAssetPurchase"]
+        parameters: {
+            path: {
+                tagOrID: string
+            }
+        }
+    }
+    "/v1/assets/{tagOrID}/purchases/{purchaseID}": {
+        put: operations["UpdateAssetPurchase"]
+        delete: operations["DeleteAssetPurchase"]
+        parameters: {
+            path: {
+                tagOrID: string
+                purchaseID: number
+            }
+        }
+    }
+    "/v1/assets/{tagOrID}/files": {
+        post: operations["UploadAssetFiles"]
+        parameters: {
+            path: {
+                tagOrID: string
+            }
+        }
+    }
+    "/v1/assets/{tagOrID}/files/{fileID}": {
+        delete: operations["DeleteAssetFile"]
+        parameters: {
+            path: {
+                tagOrID: string
+                fileID: number
+            }
+        }
+    }
     "/v1/tags": {
         get: operations["ListTags"]
     }
@@ -79,7 +154,10 @@ export interface components {
             partsTotalCount?: number
             parts: components["schemas"]["AssetPart"][]
             files: components["schemas"]["AssetFile"][]
+            photos?: components["schemas"]["AssetPhoto"][]
             children?: components["schemas"]["Asset"][]
+            /** @description Excerpt of the text that matched the search query, with the matching words wrapped in `<mark>` tags. Only set for search results. */
+            snippet?: string
             createdBy: number
             /** Format: date */
             createdAt: string
@@ -91,6 +169,7 @@ export interface components {
             value: unknown
         }
         Purchase: {
+            readonly id?: number
             supplier?: string
             orderNo?: string
             /** Format: date */
@@ -126,6 +205,21 @@ export interface components {
             /** Format: date */
             updatedAt: string
         }
+        AssetPhoto: {
+            id: number
+            assetID: number
+            fileID: number
+            imageURL: string
+            thumbnailURL: string
+            previewURL: string
+            caption: string
+            position: number
+            primary: boolean
+            /** Format: date */
+            createdAt: string
+            /** Format: date */
+            updatedAt: string
+        }
         AssetListPage: {
             total: number
             numPages: number
@@ -133,6 +227,71 @@ export interface components {
             pageSize: number
             assets: components["schemas"]["Asset"][]
         }
+        AssetFacets: {
+            facets: components["schemas"]["Facet"][]
+        }
+        Facet: {
+            /** @description Name of the facet and the query parameter to filter by it. */
+            name: string
+            /** @description Name of the custom attribute, only set for the `attr` facet. */
+            attr?: string
+            values: components["schemas"]["FacetValue"][]
+        }
+        FacetValue: {
+            value: string
+            count: number
+            selected: boolean
+        }
+        Checkout: {
+            id: number
+            assetID: number
+            checkedOutTo: number
+            checkedOutToName?: string
+            /** Format: date */
+            dueAt?: string
+            note?: string
+            /** Format: date */
+            checkedOutAt: string
+            /** Format: date */
+            checkedInAt?: string
+            checkInNote?: string
+            createdBy: number
+            /** Format: date */
+            createdAt: string
+            /** Format: date */
+            updatedAt: string
+        }
+        CheckoutListPage: {
+            total: number
+            numPages: number
+            page: number
+            pageSize: number
+            checkouts: components["schemas"]["Checkout"][]
+        }
+        AssetEvent: {
+            id: number
+            assetID: number
+            /** @enum {string} */
+            type: "CREATED" | "UPDATED" | "DELETED" | "RESTORED" | "PURGED" | "CHECKED_OUT" | "CHECKED_IN"
+            changes: components["schemas"]["AssetFieldChange"][]
+            userID?: number
+            userName?: string
+            requestID?: string
+            /** Format: date-time */
+            createdAt: string
+        }
+        AssetFieldChange: {
+            field: string
+            before: unknown
+            after: unknown
+        }
+        AssetEventListPage: {
+            total: number
+            numPages: number
+            page: number
+            pageSize: number
+            events: components["schemas"]["AssetEvent"][]
+        }
         Tag: {
             id: number
             tag: string
@@ -224,6 +383,8 @@ export interface components {
             username: string
             displayName: string
             isAdmin: boolean
+            /** @enum {string} */
+            role: "viewer" | "editor" | "admin"
             /** Format: date */
             createdAt: string
             /** Format: date */
@@ -307,6 +468,43 @@ export interface components {
                 }
             }
         }
+        /** @description Check out the asset. If no user is set the asset is checked out to the current user. */
+        CheckOutAssetRequest: {
+            content: {
+                "application/json": {
+                    checkedOutTo?: number
+                    /** Format: date */
+                    dueAt?: string
+                    note?: string
+                }
+            }
+        }
+        /** @description Check the asset back in. */
+        CheckInAssetRequest: {
+            content: {
+                "application/json": {
+                    note?: string
+                }
+            }
+        }
+        /** @description The part's data. */
+        AssetPartRequest: {
+            content: {
+                "application/json": {
+                    tag?: string
+                    name: string
+                    location?: string
+                    positionCode?: string
+                    notes?: string
+                }
+            }
+        }
+        /** @description The purchase's data. */
+        PurchaseRequest: {
+            content: {
+                "application/json": components["schemas"]["Purchase"]
+            }
+        }
     }
     headers: never
     pathItems: never
@@ -325,7 +523,18 @@ export interface operations {
                 type?: string
                 order_by?: string
                 order_dir?: string
+                /** @description Search query, e.g. `laptop OR "desk lamp" status:IN_USE warranty<2025-01-01 attr.ram>=16`. */
                 query?: string
+                /** @description Only include assets with one of the statuses. */
+                status?: string[]
+                category?: string[]
+                location?: string[]
+                manufacturer?: string[]
+                supplier?: string[]
+                /** @description Warranty state, one of `ACTIVE`, `EXPIRED` or `NONE`. */
+                warranty?: string[]
+                /** @description Custom attribute values formatted as `name:value`, e.g. `attr=ram:16`. */
+                attr?: string[]
             }
         }
         responses: {
@@ -335,6 +544,46 @@ export interface operations {
                     "application/json": components["schemas"]["AssetListPage"]
                 }
             }
+            /** @description Invalid search query. */
+            400: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+        }
+    }
+    /** @description Counts the values of each facet for the assets matching the query. The counts of a facet ignore its own selected values. */
+    ListAssetFacets: {
+        parameters: {
+            query?: {
+                type?: string
+                /** @description Search query, same as for `/v1/assets`. */
+                query?: string
+                /** @description Only include assets with one of the statuses. */
+                status?: string[]
+                category?: string[]
+                location?: string[]
+                manufacturer?: string[]
+                supplier?: string[]
+                /** @description Warranty state, one of `ACTIVE`, `EXPIRED` or `NONE`. */
+                warranty?: string[]
+                /** @description Custom attribute values formatted as `name:value`, e.g. `attr=ram:16`. */
+                attr?: string[]
+            }
+        }
+        responses: {
+            /** @description The facets of the matching assets. */
+            200: {
+                content: {
+                    "application/json": components["schemas"]["AssetFacets"]
+                }
+            }
+            /** @description Invalid search query. */
+            400: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
         }
     }
     CreateAsset: {
@@ -358,6 +607,12 @@ export interface operations {
                     "application/json": components["schemas"]["Error"]
                 }
             }
+            /** @description Forbidden. */
+            403: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
         }
     }
     GetAsset: {
@@ -416,6 +671,12 @@ export interface operations {
                     "application/json": components["schemas"]["Error"]
                 }
             }
+            /** @description Forbidden. */
+            403: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
             /** @description Not found */
             404: {
                 content: {
@@ -441,6 +702,12 @@ export interface operations {
                     "application/json": components["schemas"]["Error"]
                 }
             }
+            /** @description Forbidden. */
+            403: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
             /** @description Not found. */
             404: {
                 content: {
@@ -449,6 +716,443 @@ export interface operations {
             }
         }
     }
+    ListAssetCheckouts: {
+        parameters: {
+            query?: {
+                page_size?: number
+                page?: number
+            }
+            path: {
+                tagOrID: string
+            }
+        }
+        responses: {
+            /** @description A paginated list of the asset's checkouts, newest first. */
+            200: {
+                content: {
+                    "application/json": components["schemas"]["CheckoutListPage"]
+                }
+            }
+            /** @description Unauthorized. */
+            401: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Asset not found. */
+            404: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+        }
+    }
+    CheckOutAsset: {
+        parameters: {
+            path: {
+                tagOrID: string
+            }
+        }
+        requestBody: components["requestBodies"]["CheckOutAssetRequest"]
+        responses: {
+            /** @description The newly created checkout. The asset's status is set to IN_USE. */
+            201: {
+                content: {
+                    "application/json": components["schemas"]["Checkout"]
+                }
+            }
+            /** @description Unauthorized. */
+            401: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Forbidden. */
+            403: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Asset not found. */
+            404: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description The asset is already checked out. */
+            409: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+        }
+    }
+    CheckInAsset: {
+        parameters: {
+            path: {
+                tagOrID: string
+            }
+        }
+        requestBody: components["requestBodies"]["CheckInAssetRequest"]
+        responses: {
+            /** @description The closed checkout. The asset's status is set to IN_STORAGE. */
+            200: {
+                content: {
+                    "application/json": components["schemas"]["Checkout"]
+                }
+            }
+            /** @description Unauthorized. */
+            401: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Forbidden. */
+            403: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Asset not found. */
+            404: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description The asset is not checked out. */
+            409: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+        }
+    }
+    ListAssetEvents: {
+        parameters: {
+            query?: {
+                page_size?: number
+                page?: number
+            }
+            path: {
+                tagOrID: string
+            }
+        }
+        responses: {
+            /** @description A paginated list of all changes made to the asset, newest first. */
+            200: {
+                content: {
+                    "application/json": components["schemas"]["AssetEventListPage"]
+                }
+            }
+            /** @description Unauthorized. */
+            401: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Asset not found. */
+            404: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+        }
+    }
+    CreateAssetPart: {
+        parameters: {
+            path: {
+                tagOrID: string
+            }
+        }
+        requestBody: components["requestBodies"]["AssetPartRequest"]
+        responses: {
+            /** @description The newly created part. If no tag is set the next part tag of the asset is used. */
+            201: {
+                content: {
+                    "application/json": components["schemas"]["AssetPart"]
+                }
+            }
+            /** @description Unauthorized. */
+            401: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Forbidden. */
+            403: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Asset not found. */
+            404: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description The asset already has a part with this tag. */
+            409: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+        }
+    }
+    UpdateAssetPart: {
+        parameters: {
+            path: {
+                tagOrID: string
+                partTag: string
+            }
+        }
+        requestBody: components["requestBodies"]["AssetPartRequest"]
+        responses: {
+            /** @description The updated part. */
+            200: {
+                content: {
+                    "application/json": components["schemas"]["AssetPart"]
+                }
+            }
+            /** @description Unauthorized. */
+            401: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Forbidden. */
+            403: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Asset or part not found. */
+            404: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description The asset already has a part with this tag. */
+            409: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+        }
+    }
+    DeleteAssetPart: {
+        parameters: {
+            path: {
+                tagOrID: string
+                partTag: string
+            }
+        }
+        responses: {
+            /** @description The part was deleted successfully. */
+            204: {
+                content: never
+            }
+            /** @description Unauthorized. */
+            401: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Forbidden. */
+            403: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Asset or part not found. */
+            404: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+        }
+    }
+    CreateAssetPurchase: {
+        parameters: {
+            path: {
+                tagOrID: string
+            }
+        }
+        requestBody: components["requestBodies"]["PurchaseRequest"]
+        responses: {
+            /** @description The newly added purchase. It is appended to the asset's purchases. */
+            201: {
+                content: {
+                    "application/json": components["schemas"]["Purchase"]
+                }
+            }
+            /** @description Unauthorized. */
+            401: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Forbidden. */
+            403: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Asset not found. */
+            404: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+        }
+    }
+    UpdateAssetPurchase: {
+        parameters: {
+            path: {
+                tagOrID: string
+                purchaseID: number
+            }
+        }
+        requestBody: components["requestBodies"]["PurchaseRequest"]
+        responses: {
+            /** @description The updated purchase. */
+            200: {
+                content: {
+                    "application/json": components["schemas"]["Purchase"]
+                }
+            }
+            /** @description Unauthorized. */
+            401: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Forbidden. */
+            403: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Asset or purchase not found. */
+            404: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+        }
+    }
+    DeleteAssetPurchase: {
+        parameters: {
+            path: {
+                tagOrID: string
+                purchaseID: number
+            }
+        }
+        responses: {
+            /** @description The purchase was deleted successfully. */
+            204: {
+                content: never
+            }
+            /** @description Unauthorized. */
+            401: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Forbidden. */
+            403: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Asset or purchase not found. */
+            404: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+        }
+    }
+    UploadAssetFiles: {
+        parameters: {
+            path: {
+                tagOrID: string
+            }
+        }
+        /** @description One or more files to attach to the asset. The filename of each part is used as the file's name. */
+        requestBody: {
+            content: {
+                "multipart/form-data": {
+                    files?: string[]
+                }
+            }
+        }
+        responses: {
+            /** @description The uploaded files. */
+            201: {
+                content: {
+                    "application/json": {
+                        files: components["schemas"]["AssetFile"][]
+                    }
+                }
+            }
+            /** @description Bad Request. */
+            400: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Unauthorized. */
+            401: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Forbidden. */
+            403: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Asset not found. */
+            404: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+        }
+    }
+    DeleteAssetFile: {
+        parameters: {
+            path: {
+                tagOrID: string
+                fileID: number
+            }
+        }
+        responses: {
+            /** @description The file was deleted successfully. */
+            204: {
+                content: never
+            }
+            /** @description Unauthorized. */
+            401: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Forbidden. */
+            403: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+            /** @description Asset or file not found. */
+            404: {
+                content: {
+                    "application/json": components["schemas"]["Error"]
+                }
+            }
+        }
+    }
     ListTags: {
         parameters: {
             query?: {
@@ -51,6 +51,12 @@ export function plugin(Alpine: typeof _Alpine) {
                         url: "/assets?type=consumable",
                         tags: ["list", "consumables"],
                     },
+                    {
+                        name: "Overdue Assets",
+                        icon: "clock",
+                        url: "/assets/overdue",
+                        tags: ["list", "checkout", "due"],
+                    },
                     {
                         name: "Import Assets",
                         icon: "arrow-square-in",
@@ -84,6 +90,17 @@ export function plugin(Alpine: typeof _Alpine) {
                     },
                 ],
             ],
+            [
+                "Saved Searches",
+                [
+                    {
+                        name: "All Saved Searches",
+                        icon: "magnifying-glass",
+                        url: "/saved-searches",
+                        tags: ["list", "search", "collections"],
+                    },
+                ],
+            ],
         ]
 
         if (isAdmin) {
@@ -104,6 +121,18 @@ export function plugin(Alpine: typeof _Alpine) {
                     },
                 ],
             ])
+
+            commands.push([
+                "Trash",
+                [
+                    {
+                        name: "Trash",
+                        icon: "trash-simple",
+                        url: "/trash",
+                        tags: ["deleted", "restore", "purge"],
+                    },
+                ],
+            ])
         }
 
         return {
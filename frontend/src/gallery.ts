@@ -0,0 +1,50 @@
+import type _Alpine from "alpinejs"
+
+// x-sortable makes the element's children reorderable via drag and drop.
+// After each drop the surrounding form is submitted, so the hidden inputs in
+// the children are sent in their new order.
+export function plugin(Alpine: typeof _Alpine) {
+    Alpine.directive("sortable", (el) => {
+        let dragged: HTMLElement | undefined
+
+        let onDragStart = (e: DragEvent) => {
+            dragged = (e.target as HTMLElement).closest<HTMLElement>(
+                "[draggable=true]",
+            ) ?? undefined
+            e.dataTransfer?.setData("text/plain", "")
+        }
+
+        let onDragOver = (e: DragEvent) => {
+            if (!dragged) {
+                return
+            }
+
+            e.preventDefault()
+
+            let target = (e.target as HTMLElement).closest<HTMLElement>(
+                "[draggable=true]",
+            )
+            if (!target || target === dragged || target.parentElement !== el) {
+                return
+            }
+
+            let rect = target.getBoundingClientRect()
+            let after = e.clientX > rect.left + rect.width / 2
+            el.insertBefore(dragged, after ? target.nextSibling : target)
+        }
+
+        let onDrop = (e: DragEvent) => {
+            if (!dragged) {
+                return
+            }
+
+            e.preventDefault()
+            dragged = undefined
+            el.closest("form")?.requestSubmit()
+        }
+
+        el.addEventListener("dragstart", onDragStart)
+        el.addEventListener("dragover", onDragOver)
+        el.addEventListener("drop", onDrop)
+    })
+}
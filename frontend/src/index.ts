@@ -2,6 +2,7 @@ import _Alpine from "alpinejs"
 import { plugin as autocomplete } from "./autocompleter"
 import { plugin as barcodeScanner } from "./barcode_scanner"
 import { plugin as commandpalette } from "./command_palette"
+import { plugin as gallery } from "./gallery"
 import { plugin as labels } from "./labels"
 import { plugin as settings } from "./settings"
 import { plugin as theme } from "./theme"
@@ -19,6 +20,7 @@ _Alpine.plugin(barcodeScanner)
 _Alpine.plugin(commandpalette)
 _Alpine.plugin(settings)
 _Alpine.plugin(uploader)
+_Alpine.plugin(gallery)
 _Alpine.plugin(labels)
 _Alpine.plugin(theme)
 
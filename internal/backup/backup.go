@@ -0,0 +1,248 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// FormatVersion is incremented on incompatible changes to the archive layout.
+const FormatVersion = 1
+
+const (
+	ManifestName = "manifest.json"
+	DatabaseName = "stuff.db"
+	FilesDir     = "files"
+)
+
+var ErrInvalidBackup = errors.New("invalid backup")
+
+// Manifest describes the contents of a backup archive. It is written as the last entry of the archive.
+type Manifest struct {
+	FormatVersion int       `json:"formatVersion"`
+	StuffVersion  string    `json:"stuffVersion"`
+	CreatedAt     time.Time `json:"createdAt"`
+	// MigrationVersion is the version of the last migration applied to the database snapshot.
+	MigrationVersion int64  `json:"migrationVersion"`
+	Database         File   `json:"database"`
+	Files            []File `json:"files"`
+}
+
+type File struct {
+	// Path inside the archive.
+	Path string `json:"path"`
+	// FullPath of the blob in the file storage the backup was created from.
+	FullPath  string `json:"fullPath,omitempty"`
+	SizeBytes int64  `json:"sizeBytes"`
+	Sha256    string `json:"sha256"`
+}
+
+// Writer writes a gzip compressed tar archive containing the database snapshot, the stored files and the manifest.
+type Writer struct {
+	gz       *gzip.Writer
+	tw       *tar.Writer
+	manifest *Manifest
+}
+
+func NewWriter(w io.Writer, manifest *Manifest) *Writer {
+	gz := gzip.NewWriter(w)
+
+	manifest.FormatVersion = FormatVersion
+
+	return &Writer{gz: gz, tw: tar.NewWriter(gz), manifest: manifest}
+}
+
+func (bw *Writer) AddDatabase(r io.Reader, size int64) error {
+	file, err := bw.add(DatabaseName, size, r)
+	if err != nil {
+		return err
+	}
+
+	bw.manifest.Database = *file
+
+	return nil
+}
+
+// AddFile adds a blob at relPath, which must be relative to the root of the file storage.
+func (bw *Writer) AddFile(relPath string, fullPath string, size int64, r io.Reader) error {
+	file, err := bw.add(path.Join(FilesDir, path.Clean("/"+relPath)), size, r)
+	if err != nil {
+		return err
+	}
+
+	file.FullPath = fullPath
+	bw.manifest.Files = append(bw.manifest.Files, *file)
+
+	return nil
+}
+
+// Close writes the manifest and flushes the archive. It does not close the underlying writer.
+func (bw *Writer) Close() error {
+	manifest, err := json.MarshalIndent(bw.manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding backup manifest: %w", err)
+	}
+
+	err = bw.tw.WriteHeader(&tar.Header{
+		Name:    ManifestName,
+		Mode:    0600,
+		Size:    int64(len(manifest)),
+		ModTime: bw.manifest.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("error writing backup manifest: %w", err)
+	}
+
+	_, err = bw.tw.Write(manifest)
+	if err != nil {
+		return fmt.Errorf("error writing backup manifest: %w", err)
+	}
+
+	return errors.Join(bw.tw.Close(), bw.gz.Close())
+}
+
+func (bw *Writer) add(name string, size int64, r io.Reader) (*File, error) {
+	err := bw.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    size,
+		ModTime: bw.manifest.CreatedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error adding %s to backup: %w", name, err)
+	}
+
+	h := sha256.New()
+	_, err = io.Copy(bw.tw, io.TeeReader(r, h))
+	if err != nil {
+		return nil, fmt.Errorf("error writing %s to backup: %w", name, err)
+	}
+
+	return &File{Path: name, SizeBytes: size, Sha256: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+// Extract unpacks the archive to dir and verifies the checksum of every entry against the manifest.
+func Extract(r io.Reader, dir string) (*Manifest, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBackup, err)
+	}
+	defer gz.Close()
+
+	checksums := map[string]string{}
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBackup, err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if !filepath.IsLocal(header.Name) {
+			return nil, fmt.Errorf("%w: invalid path %s", ErrInvalidBackup, header.Name)
+		}
+
+		checksums[path.Clean(header.Name)], err = extractFile(tr, filepath.Join(dir, filepath.FromSlash(header.Name)))
+		if err != nil {
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil, fmt.Errorf("%w: archive is truncated: %v", ErrInvalidBackup, err)
+			}
+			return nil, err
+		}
+	}
+
+	manifest, err := readManifest(filepath.Join(dir, ManifestName))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(checksums, ManifestName)
+
+	for _, file := range append([]File{manifest.Database}, manifest.Files...) {
+		checksum, ok := checksums[file.Path]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s is missing", ErrInvalidBackup, file.Path)
+		}
+
+		if checksum != file.Sha256 {
+			return nil, fmt.Errorf("%w: checksum mismatch for %s", ErrInvalidBackup, file.Path)
+		}
+
+		delete(checksums, file.Path)
+	}
+
+	if len(checksums) != 0 {
+		unlisted := make([]string, 0, len(checksums))
+		for name := range checksums {
+			unlisted = append(unlisted, name)
+		}
+		slices.Sort(unlisted)
+		return nil, fmt.Errorf("%w: %s not listed in the manifest", ErrInvalidBackup, strings.Join(unlisted, ", "))
+	}
+
+	return manifest, nil
+}
+
+func extractFile(r io.Reader, target string) (string, error) {
+	err := os.MkdirAll(filepath.Dir(target), 0755)
+	if err != nil {
+		return "", err
+	}
+
+	fhandle, err := os.OpenFile(target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	_, err = io.Copy(fhandle, io.TeeReader(r, h))
+	if err != nil {
+		return "", errors.Join(fmt.Errorf("error extracting %s: %w", target, err), fhandle.Close())
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), fhandle.Close()
+}
+
+func readManifest(file string) (*Manifest, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: manifest is missing", ErrInvalidBackup)
+		}
+		return nil, err
+	}
+
+	var manifest Manifest
+	err = json.Unmarshal(data, &manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: error decoding manifest: %v", ErrInvalidBackup, err)
+	}
+
+	if manifest.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("%w: unsupported format version %d", ErrInvalidBackup, manifest.FormatVersion)
+	}
+
+	if manifest.Database.Path != DatabaseName {
+		return nil, fmt.Errorf("%w: manifest does not include the database", ErrInvalidBackup)
+	}
+
+	return &manifest, nil
+}
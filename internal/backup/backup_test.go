@@ -0,0 +1,55 @@
+package backup
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackup(t *testing.T) {
+	var buf bytes.Buffer
+
+	bw := NewWriter(&buf, &Manifest{StuffVersion: "test", CreatedAt: time.Now(), MigrationVersion: 20231212091503})
+	require.NoError(t, bw.AddDatabase(strings.NewReader("database"), 8))
+	require.NoError(t, bw.AddFile("/2c/f2/ae.jpg", "/var/lib/stuff/files/2c/f2/ae.jpg", 5, strings.NewReader("image")))
+	require.NoError(t, bw.Close())
+
+	dir := t.TempDir()
+	manifest, err := Extract(bytes.NewReader(buf.Bytes()), dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, FormatVersion, manifest.FormatVersion)
+	assert.Equal(t, int64(20231212091503), manifest.MigrationVersion)
+	require.Len(t, manifest.Files, 1)
+	assert.Equal(t, "files/2c/f2/ae.jpg", manifest.Files[0].Path)
+	assert.Equal(t, "/var/lib/stuff/files/2c/f2/ae.jpg", manifest.Files[0].FullPath)
+
+	content, err := os.ReadFile(filepath.Join(dir, "files/2c/f2/ae.jpg"))
+	require.NoError(t, err)
+	assert.Equal(t, "image", string(content))
+
+	t.Run("Checksum Mismatch", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		bw := NewWriter(&buf, &Manifest{CreatedAt: time.Now()})
+		require.NoError(t, bw.AddDatabase(strings.NewReader("database"), 8))
+		require.NoError(t, bw.AddFile("/2c/f2/ae.jpg", "", 5, strings.NewReader("image")))
+		bw.manifest.Files[0].Sha256 = manifest.Database.Sha256
+		require.NoError(t, bw.Close())
+
+		_, err := Extract(&buf, t.TempDir())
+		assert.ErrorIs(t, err, ErrInvalidBackup)
+		assert.ErrorContains(t, err, "checksum mismatch for files/2c/f2/ae.jpg")
+	})
+
+	t.Run("Truncated Archive", func(t *testing.T) {
+		_, err := Extract(bytes.NewReader(buf.Bytes()[:len(buf.Bytes())/2]), t.TempDir())
+		assert.ErrorIs(t, err, ErrInvalidBackup)
+	})
+}
@@ -0,0 +1,209 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression with the five fields minute, hour, day of month, month and day of week.
+// Each field supports `*`, single values, ranges (`1-5`), lists (`1,15`) and steps (`*/15`, `0-30/10`).
+// The descriptors `@hourly`, `@daily`, `@weekly` and `@monthly` are supported as well.
+type Schedule struct {
+	expr string
+
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+
+	// cron matches either the day of month or the day of week, if both are restricted
+	dayOfMonthRestricted bool
+	dayOfWeekRestricted  bool
+}
+
+var descriptors = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+}
+
+type fieldBounds struct {
+	name string
+	min  int
+	max  int
+}
+
+var (
+	minuteBounds     = fieldBounds{"minute", 0, 59}
+	hourBounds       = fieldBounds{"hour", 0, 23}
+	dayOfMonthBounds = fieldBounds{"day of month", 1, 31}
+	monthBounds      = fieldBounds{"month", 1, 12}
+	// 7 is accepted as an alias for sunday
+	dayOfWeekBounds = fieldBounds{"day of week", 0, 7}
+)
+
+func Parse(expr string) (*Schedule, error) {
+	normalised := strings.TrimSpace(expr)
+	if descriptor, ok := descriptors[normalised]; ok {
+		normalised = descriptor
+	}
+
+	fields := strings.Fields(normalised)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields, got %d", expr, len(fields))
+	}
+
+	schedule := &Schedule{
+		expr:                 expr,
+		dayOfMonthRestricted: fields[2] != "*",
+		dayOfWeekRestricted:  fields[4] != "*",
+	}
+
+	var err error
+	for i, target := range []struct {
+		bits   *uint64
+		bounds fieldBounds
+	}{
+		{&schedule.minute, minuteBounds},
+		{&schedule.hour, hourBounds},
+		{&schedule.dayOfMonth, dayOfMonthBounds},
+		{&schedule.month, monthBounds},
+		{&schedule.dayOfWeek, dayOfWeekBounds},
+	} {
+		*target.bits, err = parseField(fields[i], target.bounds)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+		}
+	}
+
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+
+	return schedule, nil
+}
+
+func (s *Schedule) String() string {
+	return s.expr
+}
+
+// Next returns the first time after t that matches the schedule, or the zero time if there is none within the next five years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		switch {
+		case !has(s.month, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !has(s.hour, t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !has(s.minute, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := has(s.dayOfMonth, t.Day())
+	dayOfWeek := has(s.dayOfWeek, int(t.Weekday()))
+
+	if s.dayOfMonthRestricted && s.dayOfWeekRestricted {
+		return dayOfMonth || dayOfWeek
+	}
+
+	return dayOfMonth && dayOfWeek
+}
+
+func parseField(field string, bounds fieldBounds) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		itemBits, err := parseItem(item, bounds)
+		if err != nil {
+			return 0, err
+		}
+
+		bits |= itemBits
+	}
+
+	return bits, nil
+}
+
+func parseItem(item string, bounds fieldBounds) (uint64, error) {
+	rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepExpr)
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid step %q in %s field", stepExpr, bounds.name)
+		}
+	}
+
+	start, end := bounds.min, bounds.max
+	switch {
+	case rangeExpr == "*":
+	case strings.Contains(rangeExpr, "-"):
+		startExpr, endExpr, _ := strings.Cut(rangeExpr, "-")
+
+		var err error
+		start, err = parseValue(startExpr, bounds)
+		if err != nil {
+			return 0, err
+		}
+
+		end, err = parseValue(endExpr, bounds)
+		if err != nil {
+			return 0, err
+		}
+
+		if start > end {
+			return 0, fmt.Errorf("invalid range %q in %s field", rangeExpr, bounds.name)
+		}
+	default:
+		value, err := parseValue(rangeExpr, bounds)
+		if err != nil {
+			return 0, err
+		}
+
+		start = value
+		if !hasStep {
+			end = value
+		}
+	}
+
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << i
+	}
+
+	return bits, nil
+}
+
+func parseValue(expr string, bounds fieldBounds) (int, error) {
+	value, err := strconv.Atoi(expr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", expr, bounds.name)
+	}
+
+	if value < bounds.min || value > bounds.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d in %s field", value, bounds.min, bounds.max, bounds.name)
+	}
+
+	return value, nil
+}
+
+func has(bits uint64, i int) bool {
+	return bits&(1<<i) != 0
+}
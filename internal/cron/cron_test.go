@@ -0,0 +1,50 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	// 2023-12-13 is a wednesday
+	now := time.Date(2023, time.December, 13, 10, 17, 30, 0, time.UTC)
+
+	tt := []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2023, time.December, 13, 10, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2023, time.December, 13, 10, 30, 0, 0, time.UTC)},
+		{"0 3 * * *", time.Date(2023, time.December, 14, 3, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2023, time.December, 14, 0, 0, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2023, time.December, 13, 10, 30, 0, 0, time.UTC)},
+		{"0 2 * * 0", time.Date(2023, time.December, 17, 2, 0, 0, 0, time.UTC)},
+		{"0 2 * * 7", time.Date(2023, time.December, 17, 2, 0, 0, 0, time.UTC)},
+		{"0 2 * * 1-5", time.Date(2023, time.December, 14, 2, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// either the day of month or the day of week must match
+		{"0 0 1 * 5", time.Date(2023, time.December, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 9,17 * * *", time.Date(2023, time.December, 13, 17, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.expr, func(t *testing.T) {
+			schedule, err := Parse(tc.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, schedule.Next(now))
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "*/0 * * * *", "5-1 * * * *", "a * * * *", "@yearly"} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := Parse(expr)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package exporter
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/RobinThrift/stuff/entities"
+)
+
+// FilesArchive streams the files of assets as a ZIP archive, with one folder per asset.
+type FilesArchive struct {
+	zw *zip.Writer
+
+	folders     map[int64]string
+	folderNames map[string]bool
+	fileNames   map[string]bool
+}
+
+func NewFilesArchive(w io.Writer) *FilesArchive {
+	return &FilesArchive{
+		zw:          zip.NewWriter(w),
+		folders:     map[int64]string{},
+		folderNames: map[string]bool{},
+		fileNames:   map[string]bool{},
+	}
+}
+
+// AddFile adds the content of r as file to the asset's folder. Files with the same name are numbered, e.g. `manual (2).pdf`.
+func (fa *FilesArchive) AddFile(asset *entities.Asset, file *entities.File, r io.Reader) error {
+	filename := sanitizeFilename(file.Name, "file")
+	name := uniqueName(fa.fileNames, path.Join(fa.folder(asset), filename), path.Ext(filename))
+
+	fw, err := fa.zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: file.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("error adding %s to archive: %w", name, err)
+	}
+
+	_, err = io.Copy(fw, r)
+	if err != nil {
+		return fmt.Errorf("error writing %s to archive: %w", name, err)
+	}
+
+	return nil
+}
+
+func (fa *FilesArchive) Close() error {
+	return fa.zw.Close()
+}
+
+func (fa *FilesArchive) folder(asset *entities.Asset) string {
+	if folder, ok := fa.folders[asset.ID]; ok {
+		return folder
+	}
+
+	name := asset.Name
+	if asset.Tag != "" {
+		name = asset.Tag + "_" + asset.Name
+	}
+
+	folder := uniqueName(fa.folderNames, sanitizeFilename(name, "asset_"+strconv.FormatInt(asset.ID, 10)), "")
+	fa.folders[asset.ID] = folder
+
+	return folder
+}
+
+func uniqueName(seen map[string]bool, name string, ext string) string {
+	unique := name
+	for i := 2; seen[unique]; i++ {
+		unique = fmt.Sprintf("%s (%d)%s", strings.TrimSuffix(name, ext), i, ext)
+	}
+
+	seen[unique] = true
+
+	return unique
+}
+
+// sanitizeFilename replaces characters that are not allowed in file names on common file systems.
+func sanitizeFilename(name string, fallback string) string {
+	sanitized := strings.Map(func(r rune) rune {
+		if r < 0x20 || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+
+	sanitized = strings.Trim(sanitized, " .")
+	if sanitized == "" {
+		return fallback
+	}
+
+	return sanitized
+}
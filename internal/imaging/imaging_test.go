@@ -0,0 +1,124 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var red = color.RGBA{R: 255, A: 255}
+var blue = color.RGBA{B: 255, A: 255}
+
+func TestDecode_Orientation(t *testing.T) {
+	tt := []struct {
+		orientation int
+		width       int
+		height      int
+		// colour of the top left pixel, the source image has a red left half and a blue right half
+		topLeft color.RGBA
+		// colour of the bottom right pixel
+		bottomRight color.RGBA
+	}{
+		{orientation: 1, width: 64, height: 32, topLeft: red, bottomRight: blue},
+		{orientation: 2, width: 64, height: 32, topLeft: blue, bottomRight: red},
+		{orientation: 3, width: 64, height: 32, topLeft: blue, bottomRight: red},
+		{orientation: 6, width: 32, height: 64, topLeft: red, bottomRight: blue},
+		{orientation: 8, width: 32, height: 64, topLeft: blue, bottomRight: red},
+	}
+
+	for _, tt := range tt {
+		data := newTestJPEG(t, tt.orientation)
+
+		assert.Equal(t, tt.orientation, Orientation(data))
+
+		img, err := Decode(data)
+		require.NoError(t, err)
+
+		assert.Equal(t, tt.width, img.Bounds().Dx(), "orientation %d", tt.orientation)
+		assert.Equal(t, tt.height, img.Bounds().Dy(), "orientation %d", tt.orientation)
+		assertColour(t, tt.topLeft, img.RGBAAt(2, 2), "orientation %d", tt.orientation)
+		assertColour(t, tt.bottomRight, img.RGBAAt(img.Bounds().Dx()-3, img.Bounds().Dy()-3), "orientation %d", tt.orientation)
+	}
+}
+
+func TestDecode_TransparentPNG(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 4, 4))))
+
+	img, err := Decode(buf.Bytes())
+	require.NoError(t, err)
+
+	assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, img.RGBAAt(1, 1))
+}
+
+func TestDecode_UnsupportedFormat(t *testing.T) {
+	_, err := Decode([]byte("%PDF-1.7"))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestFit(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4000, 3000))
+
+	fitted := Fit(img, 400)
+	assert.Equal(t, 400, fitted.Bounds().Dx())
+	assert.Equal(t, 300, fitted.Bounds().Dy())
+
+	fitted = Fit(image.NewRGBA(image.Rect(0, 0, 100, 3000)), 300)
+	assert.Equal(t, 10, fitted.Bounds().Dx())
+	assert.Equal(t, 300, fitted.Bounds().Dy())
+
+	small := image.NewRGBA(image.Rect(0, 0, 50, 20))
+	assert.Same(t, small, Fit(small, 400))
+}
+
+func newTestJPEG(t *testing.T, orientation int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 64, 32))
+	for y := 0; y < 32; y++ {
+		for x := 0; x < 64; x++ {
+			if x < 32 {
+				img.SetRGBA(x, y, red)
+			} else {
+				img.SetRGBA(x, y, blue)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}))
+
+	if orientation == 1 {
+		return buf.Bytes()
+	}
+
+	// minimal big endian TIFF structure with a single IFD entry for the orientation
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08\x00\x01")
+	tiff = binary.BigEndian.AppendUint16(tiff, exifOrientationTag)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3) // SHORT
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, uint16(orientation))
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+
+	app1 := []byte{0xFF, 0xE1}
+	app1 = binary.BigEndian.AppendUint16(app1, uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	jpg := buf.Bytes()
+	return append(append([]byte{0xFF, 0xD8}, app1...), jpg[2:]...)
+}
+
+func assertColour(t *testing.T, expected color.RGBA, actual color.RGBA, msgAndArgs ...any) {
+	t.Helper()
+	// JPEG is lossy, so the colours only need to be roughly the same
+	assert.InDelta(t, expected.R, actual.R, 16, msgAndArgs...)
+	assert.InDelta(t, expected.G, actual.G, 16, msgAndArgs...)
+	assert.InDelta(t, expected.B, actual.B, 16, msgAndArgs...)
+}
@@ -34,17 +34,22 @@ func ImportFromSnipeITJSONExport(r *http.Request) ([]*entities.Asset, map[string
 		return nil, map[string]string{"import_file": err.Error()}, err
 	}
 
-	importData, err := io.ReadAll(uploaded)
+	assets, err := ReadSnipeITJSONExport(uploaded)
 	if err != nil {
-		return nil, map[string]string{"general": err.Error()}, err
+		return nil, nil, err
 	}
 
-	assets, err := mapSnipeITJSONExport(importData)
+	return assets, map[string]string{}, nil
+}
+
+// ReadSnipeITJSONExport reads assets from a Snipe-IT JSON export, e.g. from a file passed on the command line.
+func ReadSnipeITJSONExport(r io.Reader) ([]*entities.Asset, error) {
+	importData, err := io.ReadAll(r)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	return assets, map[string]string{}, nil
+	return mapSnipeITJSONExport(importData)
 }
 
 type snipeITJSONExport struct {
@@ -92,6 +97,7 @@ func mapSnipeITJSONExport(data []byte) ([]*entities.Asset, error) {
 		}
 
 		assets = append(assets, &entities.Asset{
+			Type:         entities.AssetTypeAsset,
 			Status:       mapSnipeITStatus(data.Status),
 			Tag:          fmt.Sprint(data.AssetTag),
 			Name:         data.AssetName,
@@ -0,0 +1,38 @@
+package notify
+
+import (
+	"context"
+	"errors"
+)
+
+type Notifier interface {
+	Notify(ctx context.Context, n *Notification) error
+}
+
+type Notification struct {
+	Type    string `json:"type"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+	Data    any    `json:"data,omitempty"`
+}
+
+// Multi sends every notification to all of the wrapped notifiers.
+type Multi []Notifier
+
+func (m Multi) Notify(ctx context.Context, n *Notification) error {
+	var errs []error
+	for _, notifier := range m {
+		if err := notifier.Notify(ctx, n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Nop discards all notifications. It is used when no notifier is configured.
+type Nop struct{}
+
+func (Nop) Notify(context.Context, *Notification) error {
+	return nil
+}
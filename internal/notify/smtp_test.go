@@ -0,0 +1,124 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTP_Notify(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	srv := newFakeSMTPServer(t)
+
+	notifier := NewSMTP(SMTPConfig{
+		Addr: srv.addr,
+		From: "stuff@example.com",
+		To:   []string{"admin@example.com", "it@example.com"},
+	})
+
+	err := notifier.Notify(ctx, &Notification{
+		Type:    "asset.overdue",
+		Subject: "Asset overdue",
+		Body:    "Asset 'Laptop' is overdue\nPlease return it.",
+	})
+	require.NoError(t, err)
+
+	mail := <-srv.mails
+	assert.Equal(t, "stuff@example.com", mail.from)
+	assert.Equal(t, []string{"admin@example.com", "it@example.com"}, mail.to)
+	assert.Contains(t, mail.data, "Subject: Asset overdue\r\n")
+	assert.Contains(t, mail.data, "To: admin@example.com, it@example.com\r\n")
+	assert.Contains(t, mail.data, "\r\n\r\nAsset 'Laptop' is overdue\r\nPlease return it.\r\n")
+}
+
+func TestSMTP_Notify_NoRecipients(t *testing.T) {
+	notifier := NewSMTP(SMTPConfig{Addr: "localhost:25", From: "stuff@example.com"})
+	err := notifier.Notify(context.Background(), &Notification{})
+	assert.ErrorIs(t, err, ErrNoRecipients)
+}
+
+type fakeMail struct {
+	from string
+	to   []string
+	data string
+}
+
+type fakeSMTPServer struct {
+	addr  string
+	mails chan fakeMail
+}
+
+// newFakeSMTPServer starts a minimal SMTP server, just enough for net/smtp.SendMail without TLS or auth.
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	srv := &fakeSMTPServer{addr: l.Addr().String(), mails: make(chan fakeMail, 1)}
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		srv.handle(textproto.NewConn(conn))
+	}()
+
+	return srv
+}
+
+func (srv *fakeSMTPServer) handle(conn *textproto.Conn) {
+	var mail fakeMail
+
+	_ = conn.PrintfLine("220 localhost ESMTP")
+
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			_ = conn.PrintfLine("250 localhost")
+		case "MAIL":
+			mail.from = strings.Trim(strings.TrimPrefix(line, "MAIL FROM:"), "<>")
+			_ = conn.PrintfLine("250 OK")
+		case "RCPT":
+			mail.to = append(mail.to, strings.Trim(strings.TrimPrefix(line, "RCPT TO:"), "<>"))
+			_ = conn.PrintfLine("250 OK")
+		case "DATA":
+			_ = conn.PrintfLine("354 Go ahead")
+			var data strings.Builder
+			r := bufio.NewReader(conn.DotReader())
+			for {
+				l, err := r.ReadString('\n')
+				data.WriteString(strings.ReplaceAll(l, "\n", "\r\n"))
+				if err != nil {
+					break
+				}
+			}
+			mail.data = data.String()
+			_ = conn.PrintfLine("250 OK")
+		case "QUIT":
+			_ = conn.PrintfLine("221 Bye")
+			srv.mails <- mail
+			return
+		default:
+			_ = conn.PrintfLine("502 Command not implemented")
+		}
+	}
+}
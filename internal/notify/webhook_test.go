@@ -0,0 +1,56 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhook_Notify(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	var received Notification
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	webhook := NewWebhook(WebhookConfig{URL: srv.URL})
+
+	err := webhook.Notify(ctx, &Notification{
+		Type:    "asset.overdue",
+		Subject: "Asset overdue",
+		Body:    "Asset 'Laptop' is overdue",
+		Data:    map[string]any{"assetID": 1},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "asset.overdue", received.Type)
+	assert.Equal(t, "Asset overdue", received.Subject)
+	assert.Equal(t, "Asset 'Laptop' is overdue", received.Body)
+	assert.Equal(t, map[string]any{"assetID": float64(1)}, received.Data)
+}
+
+func TestWebhook_Notify_ErrorStatus(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+
+	webhook := NewWebhook(WebhookConfig{URL: srv.URL})
+
+	err := webhook.Notify(ctx, &Notification{Type: "asset.overdue"})
+	assert.ErrorContains(t, err, "unexpected status code 500")
+}
@@ -0,0 +1,416 @@
+package search
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const dateFormat = "2006-01-02"
+
+// Parse parses the query into a tree of nodes. An empty query returns a nil Node.
+// Errors are always of type *Error.
+func Parse(query string) (Node, error) {
+	tokens, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	if last := len(tokens) - 1; tokens[last].kind == tokWord && (last == 0 || tokens[last-1].kind != tokField) {
+		tokens[last].prefix = true
+	}
+
+	p := &parser{tokens: tokens, end: len(query)}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %s", tok)}
+	}
+
+	return node, nil
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokPhrase
+	tokField
+	tokLParen
+	tokRParen
+	tokAnd
+	tokOr
+	tokNot
+)
+
+type token struct {
+	kind   tokenKind
+	pos    int
+	text   string
+	op     Op
+	prefix bool
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of query"
+	case tokPhrase:
+		return fmt.Sprintf("%q", t.text)
+	case tokField:
+		return fmt.Sprintf("field %s", t.text)
+	default:
+		return fmt.Sprintf("'%s'", t.text)
+	}
+}
+
+var fieldPattern = regexp.MustCompile(`^([A-Za-z][\w.]*)(<=|>=|[:=<>])`)
+
+func lex(query string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case isSpace(c):
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokLParen, pos: i, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokRParen, pos: i, text: ")"})
+			i++
+		case c == '"':
+			tok, end, err := lexPhrase(query, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, tok)
+			i = end
+		case c == '-' && i+1 < len(query) && !isSpace(query[i+1]) && query[i+1] != ')':
+			tokens = append(tokens, token{kind: tokNot, pos: i, text: "-"})
+			i++
+		default:
+			end := i
+			for end < len(query) && !isSpace(query[end]) && query[end] != '(' && query[end] != ')' && query[end] != '"' {
+				end++
+			}
+
+			word := query[i:end]
+			start := i
+			i = end
+
+			// words which only look like a field, e.g. MAC addresses or URLs, are searched for as they are
+			if m := fieldPattern.FindStringSubmatch(word); m != nil && isField(strings.ToLower(m[1])) {
+				op := Op(m[2])
+				if op == ":" {
+					op = OpEQ
+				}
+
+				tokens = append(tokens, token{kind: tokField, pos: start, text: strings.ToLower(m[1]), op: op})
+
+				word = word[len(m[0]):]
+				start += len(m[0])
+				if word == "" {
+					continue
+				}
+			} else {
+				switch word {
+				case "AND":
+					tokens = append(tokens, token{kind: tokAnd, pos: start, text: word})
+					continue
+				case "OR":
+					tokens = append(tokens, token{kind: tokOr, pos: start, text: word})
+					continue
+				case "NOT":
+					tokens = append(tokens, token{kind: tokNot, pos: start, text: word})
+					continue
+				}
+			}
+
+			text := strings.TrimRight(word, "*")
+			if text == "" {
+				// a lone `*` matches everything, so it is the same as no term at all
+				continue
+			}
+
+			tokens = append(tokens, token{kind: tokWord, pos: start, text: text, prefix: len(text) != len(word)})
+		}
+	}
+
+	return tokens, nil
+}
+
+// lexPhrase reads the quoted phrase starting at start. Quotes inside the phrase can be escaped with a backslash.
+func lexPhrase(query string, start int) (token, int, error) {
+	var b strings.Builder
+
+	for i := start + 1; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			if i+1 < len(query) {
+				i++
+				b.WriteByte(query[i])
+			}
+		case '"':
+			tok := token{kind: tokPhrase, pos: start, text: b.String()}
+			end := i + 1
+			if end < len(query) && query[end] == '*' {
+				tok.prefix = true
+				end++
+			}
+			return tok, end, nil
+		default:
+			b.WriteByte(query[i])
+		}
+	}
+
+	return token{}, 0, &Error{Pos: start, Msg: "missing closing quote"}
+}
+
+func isField(name string) bool {
+	if strings.HasPrefix(name, FieldAttrPrefix) {
+		return true
+	}
+
+	_, ok := fields[name]
+	return ok
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	end    int
+}
+
+func (p *parser) peek() token {
+	if p.pos >= len(p.tokens) {
+		return token{kind: tokEOF, pos: p.end}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.peek()
+	p.pos++
+	return tok
+}
+
+func (p *parser) parseOr() (Node, error) {
+	node, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []Node{node}
+	for p.peek().kind == tokOr {
+		p.next()
+
+		node, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+
+	return &Or{Nodes: nodes}, nil
+}
+
+func (p *parser) parseAnd() (Node, error) {
+	var nodes []Node
+
+	for {
+		switch p.peek().kind {
+		case tokEOF, tokRParen, tokOr:
+			if len(nodes) == 0 {
+				tok := p.peek()
+				return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %s", tok)}
+			}
+
+			if len(nodes) == 1 {
+				return nodes[0], nil
+			}
+
+			return &And{Nodes: nodes}, nil
+		case tokAnd:
+			if len(nodes) == 0 {
+				tok := p.peek()
+				return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %s", tok)}
+			}
+			p.next()
+		}
+
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, node)
+	}
+}
+
+func (p *parser) parseUnary() (Node, error) {
+	if p.peek().kind != tokNot {
+		return p.parsePrimary()
+	}
+
+	p.next()
+
+	node, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Not{Node: node}, nil
+}
+
+func (p *parser) parsePrimary() (Node, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokLParen:
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.peek().kind != tokRParen {
+			return nil, &Error{Pos: tok.pos, Msg: "missing closing parenthesis"}
+		}
+		p.next()
+
+		return node, nil
+	case tokWord:
+		return &Term{Text: tok.text, Prefix: tok.prefix}, nil
+	case tokPhrase:
+		return &Term{Text: tok.text, Phrase: true, Prefix: tok.prefix}, nil
+	case tokField:
+		value := p.peek()
+		if value.kind != tokWord && value.kind != tokPhrase {
+			return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("missing value for field %s", tok.text)}
+		}
+		p.next()
+
+		return fieldNode(tok, value)
+	default:
+		return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %s", tok)}
+	}
+}
+
+func fieldNode(field token, value token) (Node, error) {
+	if strings.HasPrefix(field.text, FieldAttrPrefix) {
+		return attrNode(field, value)
+	}
+
+	def, ok := fields[field.text]
+	if !ok {
+		return nil, &Error{Pos: field.pos, Msg: fmt.Sprintf("unknown field %s", field.text)}
+	}
+
+	if def.typ != TypeNumber && def.typ != TypeMoney && def.typ != TypeDate && field.op != OpEQ {
+		return nil, &Error{Pos: field.pos, Msg: fmt.Sprintf("field %s can't be compared with %s", field.text, field.op)}
+	}
+
+	switch def.typ {
+	case TypeText:
+		return &Term{Field: def.name, Text: value.text, Phrase: value.kind == tokPhrase, Prefix: value.prefix}, nil
+	case TypeKeyword:
+		text := value.text
+		if def.values != nil {
+			text = strings.ToUpper(text)
+			if !slices.Contains(def.values, text) {
+				return nil, &Error{Pos: value.pos, Msg: fmt.Sprintf("invalid value %s for field %s, must be one of %s", value.text, field.text, strings.Join(def.values, ", "))}
+			}
+		}
+
+		return &Compare{Field: def.name, Type: def.typ, Op: OpEQ, Text: text, Prefix: value.prefix}, nil
+	case TypeNumber, TypeMoney:
+		n, err := strconv.ParseFloat(value.text, 64)
+		if err != nil {
+			return nil, &Error{Pos: value.pos, Msg: fmt.Sprintf("invalid number %s for field %s", value.text, field.text)}
+		}
+
+		if def.typ == TypeMoney {
+			n = math.Round(n * 100)
+		}
+
+		return &Compare{Field: def.name, Type: def.typ, Op: field.op, Number: n}, nil
+	case TypeDate:
+		t, err := time.Parse(dateFormat, value.text)
+		if err != nil {
+			return nil, &Error{Pos: value.pos, Msg: fmt.Sprintf("invalid date %s for field %s, must be formatted as YYYY-MM-DD", value.text, field.text)}
+		}
+
+		return dateNode(def.name, field.op, t), nil
+	}
+
+	return nil, &Error{Pos: field.pos, Msg: fmt.Sprintf("unknown field %s", field.text)}
+}
+
+func attrNode(field token, value token) (Node, error) {
+	name := field.text[len(FieldAttrPrefix):]
+	if name == "" {
+		return nil, &Error{Pos: field.pos, Msg: "missing custom attribute name"}
+	}
+
+	if field.op == OpEQ {
+		return &Compare{Field: FieldAttrPrefix + name, Attr: name, Type: TypeAttr, Op: OpEQ, Text: value.text, Prefix: value.prefix}, nil
+	}
+
+	n, err := strconv.ParseFloat(value.text, 64)
+	if err != nil {
+		return nil, &Error{Pos: value.pos, Msg: fmt.Sprintf("invalid number %s for field %s", value.text, field.text)}
+	}
+
+	return &Compare{Field: FieldAttrPrefix + name, Attr: name, Type: TypeAttr, Op: field.op, Number: n}, nil
+}
+
+// StartOfDay returns the start of the day of t in UTC, the same boundary that dates in queries are compared with,
+// e.g. warranty<2024-01-31 matches everything before StartOfDay of the 31st.
+func StartOfDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// dateNode turns the comparison with a day into a comparison with the start of that or the following day.
+func dateNode(field string, op Op, day time.Time) Node {
+	nextDay := day.AddDate(0, 0, 1)
+
+	switch op {
+	case OpLT:
+		return &Compare{Field: field, Type: TypeDate, Op: OpLT, Time: day}
+	case OpLTE:
+		return &Compare{Field: field, Type: TypeDate, Op: OpLT, Time: nextDay}
+	case OpGT:
+		return &Compare{Field: field, Type: TypeDate, Op: OpGTE, Time: nextDay}
+	case OpGTE:
+		return &Compare{Field: field, Type: TypeDate, Op: OpGTE, Time: day}
+	default:
+		return &And{Nodes: []Node{
+			&Compare{Field: field, Type: TypeDate, Op: OpGTE, Time: day},
+			&Compare{Field: field, Type: TypeDate, Op: OpLT, Time: nextDay},
+		}}
+	}
+}
@@ -0,0 +1,182 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	day := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	nextDay := day.AddDate(0, 0, 1)
+
+	tt := []struct {
+		query string
+		exp   Node
+	}{
+		{query: "", exp: nil},
+		{query: "  * ", exp: nil},
+		{query: "laptop", exp: &Term{Text: "laptop", Prefix: true}},
+		{
+			query: "dell laptop ",
+			exp: &And{Nodes: []Node{
+				&Term{Text: "dell"},
+				&Term{Text: "laptop", Prefix: true},
+			}},
+		},
+		{
+			query: `del* "desk lamp"`,
+			exp: &And{Nodes: []Node{
+				&Term{Text: "del", Prefix: true},
+				&Term{Text: "desk lamp", Phrase: true},
+			}},
+		},
+		{
+			query: `"desk \"lamp\""*`,
+			exp:   &Term{Text: `desk "lamp"`, Phrase: true, Prefix: true},
+		},
+		{
+			query: "dell OR lenovo AND laptop",
+			exp: &Or{Nodes: []Node{
+				&Term{Text: "dell"},
+				&And{Nodes: []Node{&Term{Text: "lenovo"}, &Term{Text: "laptop", Prefix: true}}},
+			}},
+		},
+		{
+			query: "(dell OR lenovo) -broken NOT old",
+			exp: &And{Nodes: []Node{
+				&Or{Nodes: []Node{&Term{Text: "dell"}, &Term{Text: "lenovo"}}},
+				&Not{Node: &Term{Text: "broken"}},
+				&Not{Node: &Term{Text: "old", Prefix: true}},
+			}},
+		},
+		{
+			query: `name:laptop Category:"Office Supplies" serial: ABC*`,
+			exp: &And{Nodes: []Node{
+				&Term{Field: FieldName, Text: "laptop"},
+				&Term{Field: FieldCategory, Text: "Office Supplies", Phrase: true},
+				&Term{Field: FieldSerialNo, Text: "ABC", Prefix: true},
+			}},
+		},
+		{
+			query: "status:in_use type=Consumable location:Office*",
+			exp: &And{Nodes: []Node{
+				&Compare{Field: FieldStatus, Type: TypeKeyword, Op: OpEQ, Text: "IN_USE"},
+				&Compare{Field: FieldAssetType, Type: TypeKeyword, Op: OpEQ, Text: "CONSUMABLE"},
+				&Compare{Field: FieldLocation, Type: TypeKeyword, Op: OpEQ, Text: "Office", Prefix: true},
+			}},
+		},
+		{
+			query: "quantity<=5 purchase.amount>499.99",
+			exp: &And{Nodes: []Node{
+				&Compare{Field: FieldQuantity, Type: TypeNumber, Op: OpLTE, Number: 5},
+				&Compare{Field: FieldPurchaseAmount, Type: TypeMoney, Op: OpGT, Number: 49999},
+			}},
+		},
+		{
+			query: "warranty<2025-01-01",
+			exp:   &Compare{Field: FieldWarranty, Type: TypeDate, Op: OpLT, Time: day},
+		},
+		{
+			query: "warranty<=2025-01-01",
+			exp:   &Compare{Field: FieldWarranty, Type: TypeDate, Op: OpLT, Time: nextDay},
+		},
+		{
+			query: "purchase.date>2025-01-01",
+			exp:   &Compare{Field: FieldPurchaseDate, Type: TypeDate, Op: OpGTE, Time: nextDay},
+		},
+		{
+			query: "warranty_until:2025-01-01",
+			exp: &And{Nodes: []Node{
+				&Compare{Field: FieldWarranty, Type: TypeDate, Op: OpGTE, Time: day},
+				&Compare{Field: FieldWarranty, Type: TypeDate, Op: OpLT, Time: nextDay},
+			}},
+		},
+		{
+			query: "name: multi word value",
+			exp: &And{Nodes: []Node{
+				&Term{Field: FieldName, Text: "multi"},
+				&Term{Text: "word"},
+				&Term{Text: "value", Prefix: true},
+			}},
+		},
+		{
+			query: `name:"multi word value" category: cool`,
+			exp: &And{Nodes: []Node{
+				&Term{Field: FieldName, Text: "multi word value", Phrase: true},
+				&Term{Field: FieldCategory, Text: "cool"},
+			}},
+		},
+		{
+			query: "colour:blue 00:1a:2b:3c:4d:5e https://example.com/manual.pdf",
+			exp: &And{Nodes: []Node{
+				&Term{Text: "colour:blue"},
+				&Term{Text: "00:1a:2b:3c:4d:5e"},
+				&Term{Text: "https://example.com/manual.pdf", Prefix: true},
+			}},
+		},
+		{
+			query: "attr.ram>=16 attr.color:blue",
+			exp: &And{Nodes: []Node{
+				&Compare{Field: "attr.ram", Attr: "ram", Type: TypeAttr, Op: OpGTE, Number: 16},
+				&Compare{Field: "attr.color", Attr: "color", Type: TypeAttr, Op: OpEQ, Text: "blue"},
+			}},
+		},
+	}
+
+	for _, tt := range tt {
+		t.Run(tt.query, func(t *testing.T) {
+			node, err := Parse(tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, node)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tt := []struct {
+		query string
+		exp   string
+	}{
+		{query: `"desk lamp`, exp: "missing closing quote at position 1"},
+		{query: "(dell OR lenovo", exp: "missing closing parenthesis at position 1"},
+		{query: "dell)", exp: "unexpected ')' at position 5"},
+		{query: "()", exp: "unexpected ')' at position 2"},
+		{query: "OR dell", exp: "unexpected 'OR' at position 1"},
+		{query: "dell OR", exp: "unexpected end of query at position 8"},
+		{query: "dell AND", exp: "unexpected end of query at position 9"},
+		{query: "NOT", exp: "unexpected end of query at position 4"},
+		{query: "name:", exp: "missing value for field name at position 1"},
+		{query: "name<b", exp: "field name can't be compared with < at position 1"},
+		{query: "status:broken", exp: "invalid value broken for field status, must be one of IN_STORAGE, IN_USE, ARCHIVED at position 8"},
+		{query: "quantity>many", exp: "invalid number many for field quantity at position 10"},
+		{query: "warranty<01.01.2025", exp: "invalid date 01.01.2025 for field warranty, must be formatted as YYYY-MM-DD at position 10"},
+		{query: "attr.:blue", exp: "missing custom attribute name at position 1"},
+		{query: "attr.ram>lots", exp: "invalid number lots for field attr.ram at position 10"},
+	}
+
+	for _, tt := range tt {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := Parse(tt.query)
+			var searchErr *Error
+			require.ErrorAs(t, err, &searchErr)
+			assert.Equal(t, tt.exp, err.Error())
+		})
+	}
+}
+
+func TestTerm_Words(t *testing.T) {
+	assert.Equal(t, []string{"it", "s", "a", "laptop"}, (&Term{Text: "it's a-laptop!"}).Words())
+	assert.Empty(t, (&Term{Text: "'!"}).Words())
+}
+
+func TestStartOfDay(t *testing.T) {
+	berlin := time.FixedZone("CET", 60*60)
+	assert.Equal(t, time.Date(2024, time.January, 30, 0, 0, 0, 0, time.UTC), StartOfDay(time.Date(2024, time.January, 31, 0, 30, 0, 0, berlin)))
+
+	node, err := Parse("warranty<2024-01-31")
+	require.NoError(t, err)
+	assert.Equal(t, StartOfDay(time.Date(2024, time.January, 31, 23, 0, 0, 0, time.UTC)), node.(*Compare).Time)
+}
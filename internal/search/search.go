@@ -0,0 +1,174 @@
+// Package search parses the query language used to search for assets.
+//
+// A query consists of terms, which are combined with AND, unless they are separated by OR.
+// Terms can be negated with NOT or a leading `-` and grouped with parentheses:
+//
+//	dell laptop
+//	"desk lamp" OR (lamp -broken)
+//	name:laptop category:"Office Supplies"
+//	status:IN_USE warranty<2025-01-01
+//	purchase.amount>500 attr.ram>=16
+//
+// Words and quoted phrases are matched against the full text index, a trailing `*` turns them into a prefix search.
+// The last word of a query is treated as a prefix too, so results can be shown while typing.
+package search
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/RobinThrift/stuff/entities"
+)
+
+// Node is a node of a parsed search query.
+type Node interface {
+	node()
+}
+
+// And matches if all nodes match.
+type And struct {
+	Nodes []Node
+}
+
+// Or matches if at least one of the nodes matches.
+type Or struct {
+	Nodes []Node
+}
+
+// Not matches if the node doesn't match.
+type Not struct {
+	Node Node
+}
+
+// Term is a full text search term. An empty Field searches all text fields.
+type Term struct {
+	Field  string
+	Text   string
+	Phrase bool
+	Prefix bool
+}
+
+// Compare compares a field with a value. Which of the value fields is set depends on the Type of the field.
+// Date comparisons are always normalised to OpGTE and OpLT, so the day of the value is included or excluded as a whole.
+type Compare struct {
+	Field string
+	// Attr is the name of the custom attribute, only set if Type is TypeAttr.
+	Attr string
+	Type FieldType
+	Op   Op
+
+	Text   string
+	Prefix bool
+	Number float64
+	Time   time.Time
+}
+
+func (*And) node()     {}
+func (*Or) node()      {}
+func (*Not) node()     {}
+func (*Term) node()    {}
+func (*Compare) node() {}
+
+// Words splits the text into words the same way the full text index does.
+func (t *Term) Words() []string {
+	return strings.FieldsFunc(t.Text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+type Op string
+
+const (
+	OpEQ  Op = "="
+	OpLT  Op = "<"
+	OpLTE Op = "<="
+	OpGT  Op = ">"
+	OpGTE Op = ">="
+)
+
+type FieldType int
+
+const (
+	// TypeText is searched using the full text index.
+	TypeText FieldType = iota
+	// TypeKeyword is compared case-insensitively with the whole value, or its beginning for prefix searches.
+	TypeKeyword
+	TypeNumber
+	// TypeMoney values are converted to minor units, like the stored purchase amounts.
+	TypeMoney
+	TypeDate
+	// TypeAttr compares the value of a custom attribute, either as text or as a number for range comparisons.
+	TypeAttr
+)
+
+const (
+	FieldName         = "name"
+	FieldTag          = "tag"
+	FieldCategory     = "category"
+	FieldModel        = "model"
+	FieldModelNo      = "model_no"
+	FieldSerialNo     = "serial_no"
+	FieldManufacturer = "manufacturer"
+	FieldNotes        = "notes"
+
+	FieldStatus       = "status"
+	FieldAssetType    = "type"
+	FieldLocation     = "location"
+	FieldPositionCode = "position_code"
+	FieldQuantity     = "quantity"
+	FieldWarranty     = "warranty"
+
+	FieldPurchaseSupplier = "purchase.supplier"
+	FieldPurchaseOrderNo  = "purchase.order_no"
+	FieldPurchaseCurrency = "purchase.currency"
+	FieldPurchaseAmount   = "purchase.amount"
+	FieldPurchaseDate     = "purchase.date"
+
+	FieldAttrPrefix = "attr."
+)
+
+type fieldDef struct {
+	name   string
+	typ    FieldType
+	values []string
+}
+
+var fields = map[string]fieldDef{
+	FieldName:         {name: FieldName, typ: TypeText},
+	FieldTag:          {name: FieldTag, typ: TypeText},
+	FieldCategory:     {name: FieldCategory, typ: TypeText},
+	FieldModel:        {name: FieldModel, typ: TypeText},
+	FieldModelNo:      {name: FieldModelNo, typ: TypeText},
+	"modelno":         {name: FieldModelNo, typ: TypeText},
+	FieldSerialNo:     {name: FieldSerialNo, typ: TypeText},
+	"serialno":        {name: FieldSerialNo, typ: TypeText},
+	"serial":          {name: FieldSerialNo, typ: TypeText},
+	FieldManufacturer: {name: FieldManufacturer, typ: TypeText},
+	FieldNotes:        {name: FieldNotes, typ: TypeText},
+
+	FieldStatus:       {name: FieldStatus, typ: TypeKeyword, values: []string{string(entities.StatusInStorage), string(entities.StatusInUse), string(entities.StatusArchived)}},
+	FieldAssetType:    {name: FieldAssetType, typ: TypeKeyword, values: []string{string(entities.AssetTypeAsset), string(entities.AssetTypeComponent), string(entities.AssetTypeConsumable)}},
+	FieldLocation:     {name: FieldLocation, typ: TypeKeyword},
+	FieldPositionCode: {name: FieldPositionCode, typ: TypeKeyword},
+	FieldQuantity:     {name: FieldQuantity, typ: TypeNumber},
+	FieldWarranty:     {name: FieldWarranty, typ: TypeDate},
+	"warranty_until":  {name: FieldWarranty, typ: TypeDate},
+
+	FieldPurchaseSupplier: {name: FieldPurchaseSupplier, typ: TypeKeyword},
+	FieldPurchaseOrderNo:  {name: FieldPurchaseOrderNo, typ: TypeKeyword},
+	FieldPurchaseCurrency: {name: FieldPurchaseCurrency, typ: TypeKeyword},
+	FieldPurchaseAmount:   {name: FieldPurchaseAmount, typ: TypeMoney},
+	FieldPurchaseDate:     {name: FieldPurchaseDate, typ: TypeDate},
+}
+
+// Error is returned for queries that can't be parsed. Pos is the byte offset in the query the error occurred at.
+type Error struct {
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Pos+1)
+}
@@ -1,15 +1,22 @@
 package server
 
 import (
+	"context"
 	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
 	"github.com/RobinThrift/stuff/internal/requestid"
 	"github.com/RobinThrift/stuff/internal/server/session"
+	"github.com/RobinThrift/stuff/internal/workspace"
 	"github.com/RobinThrift/stuff/views"
 	"github.com/alexedwards/scs/v2"
 	"github.com/go-chi/chi/v5/middleware"
@@ -48,12 +55,84 @@ func logReqMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+type APITokenAuthenticator interface {
+	Authenticate(ctx context.Context, token string) (*auth.User, *auth.APIToken, error)
+}
+
+// apiTokenMiddleware authenticates requests below prefix which carry an `Authorization: Bearer` header.
+// Authenticated requests get a stateless session, so they skip the session cookie and CSRF checks.
+func apiTokenMiddleware(tokens APITokenAuthenticator, prefix string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, prefix) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			user, apiToken, err := tokens.Authenticate(r.Context(), token)
+			if err != nil {
+				if errors.Is(err, auth.ErrUnauthorized) {
+					writeAPIError(w, r, http.StatusUnauthorized, "stuff/api/v1/Unauthorized", err)
+					return
+				}
+
+				slog.ErrorContext(r.Context(), "error authenticating API token", "error", err)
+				writeAPIError(w, r, http.StatusInternalServerError, "stuff/api/v1/internalServerError", err)
+				return
+			}
+
+			if !apiToken.Allows(r.Method) {
+				writeAPIError(w, r, http.StatusForbidden, "stuff/api/v1/Forbidden", errors.New("API token scope does not allow this request"))
+				return
+			}
+
+			ctx := session.CtxWithStatelessSession(r.Context())
+			session.Put(ctx, "user", user)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func writeAPIError(w http.ResponseWriter, r *http.Request, code int, typ string, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	b, err := json.Marshal(map[string]any{
+		"code":   code,
+		"title":  http.StatusText(code),
+		"detail": err.Error(),
+		"type":   typ,
+	})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while trying to marshal api error to json", "error", err)
+		return
+	}
+
+	_, err = w.Write(b)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while writing http response", "error", err)
+	}
+}
+
 func sessionMiddleware(sessionManager *scs.SessionManager, skipFor []string) func(next http.Handler) http.Handler {
 	gob.Register(&auth.User{})
+	gob.Register(&auth.OIDCLoginState{})
 	gob.Register(views.FlashMessage{})
 
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if session.IsStateless(r.Context()) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			for _, s := range skipFor {
 				if strings.HasPrefix(r.URL.Path, s) {
 					next.ServeHTTP(w, r)
@@ -88,6 +167,54 @@ func loginRedirectMiddleware(skipFor []string) func(next http.Handler) http.Hand
 	}
 }
 
+type WorkspaceResolver interface {
+	ListForUser(ctx context.Context, user *auth.User) ([]*entities.Workspace, error)
+}
+
+// workspaceMiddleware scopes requests of logged in users to a workspace. API clients select the workspace using the
+// `X-Stuff-Workspace` header, the web UI stores it in the session. Without a selection the user's first workspace is used.
+func workspaceMiddleware(workspaces WorkspaceResolver) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, ok := session.Get[*auth.User](r.Context(), "user")
+			if !ok || user == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			available, err := workspaces.ListForUser(r.Context(), user)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "error listing workspaces for user", "error", err, "user", user.Username)
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+
+			selected, _ := session.Get[int64](r.Context(), "workspace_id")
+			if header := r.Header.Get("X-Stuff-Workspace"); header != "" {
+				selected, err = strconv.ParseInt(header, 10, 64)
+				if err != nil {
+					writeAPIError(w, r, http.StatusBadRequest, "stuff/api/v1/BadRequest", fmt.Errorf("invalid X-Stuff-Workspace header: %w", err))
+					return
+				}
+			}
+
+			scope := &workspace.Scope{Available: available}
+			for _, ws := range available {
+				if ws.ID == selected {
+					scope.Current = ws
+					break
+				}
+			}
+
+			if scope.Current == nil && len(available) != 0 {
+				scope.Current = available[0]
+			}
+
+			next.ServeHTTP(w, r.WithContext(workspace.WithCtx(r.Context(), scope)))
+		})
+	}
+}
+
 func csrfMiddleware(secure bool, skipFor []string) (func(next http.Handler) http.Handler, error) {
 	csrfSecret, err := genCSRFSecret()
 	if err != nil {
@@ -110,6 +237,11 @@ func csrfMiddleware(secure bool, skipFor []string) (func(next http.Handler) http
 
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if session.IsStateless(r.Context()) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			for _, s := range skipFor {
 				if strings.HasPrefix(r.URL.Path, s) {
 					next.ServeHTTP(w, r)
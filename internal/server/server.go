@@ -17,7 +17,7 @@ type Server struct {
 	srv *http.Server
 }
 
-func NewServer(addr string, useSecureCookies bool, sm *scs.SessionManager) (*Server, error) {
+func NewServer(addr string, useSecureCookies bool, sm *scs.SessionManager, tokens APITokenAuthenticator, workspaces WorkspaceResolver) (*Server, error) {
 	srv := &Server{}
 
 	mux := chi.NewMux()
@@ -30,9 +30,11 @@ func NewServer(addr string, useSecureCookies bool, sm *scs.SessionManager) (*Ser
 	mux.Use(
 		requestIDMiddleware,
 		logReqMiddleware,
+		apiTokenMiddleware(tokens, "/api/"),
 		sessionMiddleware(sm, []string{"/static", "/manifest"}),
 		csrfMiddleware,
-		loginRedirectMiddleware([]string{"/login", "/auth/changepassword", "/static/", "/manifest/"}),
+		loginRedirectMiddleware([]string{"/login", "/auth/changepassword", "/auth/oidc/", "/static/", "/manifest/"}),
+		workspaceMiddleware(workspaces),
 		middleware.Compress(5),
 	)
 
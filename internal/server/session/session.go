@@ -9,11 +9,25 @@ import (
 type ctxSessMngrKeyType string
 
 const ctxSessMngrKey = ctxSessMngrKeyType("ctxSessionKey")
+const ctxStatelessKey = ctxSessMngrKeyType("ctxStatelessSessionKey")
+
+type statelessSession map[string]any
 
 func CtxWithSessionManager(ctx context.Context, sm *scs.SessionManager) context.Context {
 	return context.WithValue(ctx, ctxSessMngrKey, sm)
 }
 
+// CtxWithStatelessSession attaches a session to the context which only lives for the duration of the request
+// and is never persisted. It is used for requests which are not authenticated by a session cookie, e.g. API tokens.
+func CtxWithStatelessSession(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxStatelessKey, statelessSession{})
+}
+
+func IsStateless(ctx context.Context) bool {
+	_, ok := ctx.Value(ctxStatelessKey).(statelessSession)
+	return ok
+}
+
 func RenewToken(ctx context.Context) error {
 	sm, ok := ctx.Value(ctxSessMngrKey).(*scs.SessionManager)
 	if !ok {
@@ -24,6 +38,11 @@ func RenewToken(ctx context.Context) error {
 }
 
 func Put(ctx context.Context, key string, value any) {
+	if stateless, ok := ctx.Value(ctxStatelessKey).(statelessSession); ok {
+		stateless[key] = value
+		return
+	}
+
 	sm, ok := ctx.Value(ctxSessMngrKey).(*scs.SessionManager)
 	if !ok {
 		return
@@ -35,6 +54,11 @@ func Put(ctx context.Context, key string, value any) {
 func Get[V any](ctx context.Context, key string) (V, bool) {
 	var defaultVal V
 
+	if stateless, ok := ctx.Value(ctxStatelessKey).(statelessSession); ok {
+		val, ok := stateless[key].(V)
+		return val, ok
+	}
+
 	sm, ok := ctx.Value(ctxSessMngrKey).(*scs.SessionManager)
 	if !ok {
 		return defaultVal, false
@@ -51,6 +75,12 @@ func Get[V any](ctx context.Context, key string) (V, bool) {
 func Pop[V any](ctx context.Context, key string) (V, bool) {
 	var defaultVal V
 
+	if stateless, ok := ctx.Value(ctxStatelessKey).(statelessSession); ok {
+		val, ok := stateless[key].(V)
+		delete(stateless, key)
+		return val, ok
+	}
+
 	sm, ok := ctx.Value(ctxSessMngrKey).(*scs.SessionManager)
 	if !ok {
 		return defaultVal, false
@@ -65,6 +95,11 @@ func Pop[V any](ctx context.Context, key string) (V, bool) {
 }
 
 func Remove(ctx context.Context, key string) {
+	if stateless, ok := ctx.Value(ctxStatelessKey).(statelessSession); ok {
+		delete(stateless, key)
+		return
+	}
+
 	sm, ok := ctx.Value(ctxSessMngrKey).(*scs.SessionManager)
 	if !ok {
 		return
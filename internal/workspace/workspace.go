@@ -0,0 +1,31 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/RobinThrift/stuff/entities"
+)
+
+type ctxScopeKeyType string
+
+const ctxScopeKey = ctxScopeKeyType("ctxWorkspaceScopeKey")
+
+// Scope is the workspace a request operates in, together with all workspaces the user can switch to.
+// Current is nil if the user isn't a member of any workspace.
+type Scope struct {
+	Current   *entities.Workspace
+	Available []*entities.Workspace
+}
+
+func WithCtx(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, ctxScopeKey, scope)
+}
+
+func FromCtx(ctx context.Context) (*Scope, bool) {
+	scope, ok := ctx.Value(ctxScopeKey).(*Scope)
+	if ok && scope != nil {
+		return scope, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/RobinThrift/stuff/control"
+)
+
+// BackupJob writes a backup to the configured backup directory and deletes old backups according to the retention policy.
+type BackupJob struct {
+	backups *control.BackupControl
+}
+
+func NewBackupJob(backups *control.BackupControl) *BackupJob {
+	return &BackupJob{backups: backups}
+}
+
+func (bj *BackupJob) Run(ctx context.Context) error {
+	err := bj.backups.RunScheduledBackup(ctx)
+	if err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "created scheduled backup")
+
+	return nil
+}
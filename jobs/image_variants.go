@@ -0,0 +1,27 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/RobinThrift/stuff/control"
+)
+
+// ImageVariantsBackfillJob generates thumbnails and previews for asset images that were uploaded before they were
+// generated automatically.
+type ImageVariantsBackfillJob struct {
+	assets *control.AssetControl
+}
+
+func NewImageVariantsBackfillJob(assets *control.AssetControl) *ImageVariantsBackfillJob {
+	return &ImageVariantsBackfillJob{assets: assets}
+}
+
+func (ij *ImageVariantsBackfillJob) Run(ctx context.Context) error {
+	updated, err := ij.assets.GenerateMissingImageVariants(ctx)
+	if updated != 0 {
+		slog.InfoContext(ctx, "generated missing thumbnails and previews", "count", updated)
+	}
+
+	return err
+}
@@ -38,7 +38,7 @@ func (ij *InitJob) Run(ctx context.Context) error {
 			return nil
 		}
 
-		err = ij.auth.CreateUser(ctx, control.CreateUserCmd{User: &auth.User{Username: ij.config.Username, DisplayName: "Admin", IsAdmin: true}, PlaintextPasswd: ij.config.Password})
+		err = ij.auth.CreateUser(ctx, control.CreateUserCmd{User: &auth.User{Username: ij.config.Username, DisplayName: "Admin", Role: auth.RoleAdmin}, PlaintextPasswd: ij.config.Password})
 		return err
 	})
 
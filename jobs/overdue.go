@@ -0,0 +1,78 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/control"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/notify"
+)
+
+// OverdueJob records checkouts that are past their due date as overdue and sends reminders for them.
+type OverdueJob struct {
+	config   OverdueJobConfig
+	assets   *control.AssetControl
+	notifier notify.Notifier
+}
+
+type OverdueJobConfig struct {
+	// ReminderInterval is the minimum time between two reminders for the same checkout.
+	ReminderInterval time.Duration
+}
+
+func NewOverdueJob(config OverdueJobConfig, assets *control.AssetControl, notifier notify.Notifier) *OverdueJob {
+	return &OverdueJob{config: config, assets: assets, notifier: notifier}
+}
+
+func (oj *OverdueJob) Run(ctx context.Context) error {
+	now := time.Now()
+
+	overdue, err := oj.assets.RecordOverdueCheckouts(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, checkout := range overdue {
+		if !checkout.RemindedAt.IsZero() && now.Sub(checkout.RemindedAt) < oj.config.ReminderInterval {
+			continue
+		}
+
+		err = oj.notifier.Notify(ctx, overdueNotification(checkout))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error sending overdue reminder for asset %s: %w", checkout.AssetTag, err))
+			continue
+		}
+
+		err = oj.assets.SetCheckoutReminded(ctx, checkout, now)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func overdueNotification(checkout *entities.Checkout) *notify.Notification {
+	return &notify.Notification{
+		Type:    "asset.overdue",
+		Subject: fmt.Sprintf("Overdue: %s (%s)", checkout.AssetName, checkout.AssetTag),
+		Body: fmt.Sprintf(
+			"Asset '%s' (%s) checked out to %s was due on %s and has not been checked in yet.",
+			checkout.AssetName, checkout.AssetTag, checkout.CheckedOutToName, checkout.DueAt.Format(time.DateOnly),
+		),
+		Data: map[string]any{
+			"checkoutID":       checkout.ID,
+			"assetID":          checkout.AssetID,
+			"assetTag":         checkout.AssetTag,
+			"assetName":        checkout.AssetName,
+			"checkedOutTo":     checkout.CheckedOutTo,
+			"checkedOutToName": checkout.CheckedOutToName,
+			"dueAt":            checkout.DueAt,
+			"overdueAt":        checkout.OverdueAt,
+		},
+	}
+}
@@ -0,0 +1,144 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/RobinThrift/stuff/internal/cron"
+)
+
+type Job interface {
+	Run(ctx context.Context) error
+}
+
+// Scheduler runs jobs in the background at a fixed interval or on a cron schedule until it is stopped.
+type Scheduler struct {
+	jobs   []scheduledJob
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+type scheduledJob struct {
+	name     string
+	interval time.Duration
+	schedule *cron.Schedule
+	job      Job
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Every registers a job to be run every interval. Jobs with an interval <= 0 are ignored.
+// Must be called before Start.
+func (s *Scheduler) Every(name string, interval time.Duration, job Job) {
+	if interval <= 0 {
+		return
+	}
+
+	s.jobs = append(s.jobs, scheduledJob{name: name, interval: interval, job: job})
+}
+
+// Once registers a job to be run a single time in the background, right after the scheduler was started.
+// Must be called before Start.
+func (s *Scheduler) Once(name string, job Job) {
+	s.jobs = append(s.jobs, scheduledJob{name: name, job: job})
+}
+
+// Cron registers a job to be run whenever the schedule matches. Jobs with a nil schedule are ignored.
+// Must be called before Start.
+func (s *Scheduler) Cron(name string, schedule *cron.Schedule, job Job) {
+	if schedule == nil {
+		return
+	}
+
+	s.jobs = append(s.jobs, scheduledJob{name: name, schedule: schedule, job: job})
+}
+
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go func(j scheduledJob) {
+			defer s.wg.Done()
+			s.run(ctx, j)
+		}(j)
+	}
+}
+
+func (s *Scheduler) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-done:
+		return nil
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, j scheduledJob) {
+	if j.schedule != nil {
+		s.runCron(ctx, j)
+		return
+	}
+
+	if j.interval <= 0 {
+		s.runJob(ctx, j)
+		return
+	}
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runJob(ctx, j)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) runCron(ctx context.Context, j scheduledJob) {
+	for {
+		next := j.schedule.Next(time.Now())
+		if next.IsZero() {
+			slog.WarnContext(ctx, "job schedule never matches", "job", j.name, "schedule", j.schedule.String())
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.runJob(ctx, j)
+	}
+}
+
+func (s *Scheduler) runJob(ctx context.Context, j scheduledJob) {
+	slog.DebugContext(ctx, "running job", "job", j.name)
+	if err := j.job.Run(ctx); err != nil {
+		slog.ErrorContext(ctx, "error running job", "job", j.name, "error", err)
+	}
+}
@@ -0,0 +1,32 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/RobinThrift/stuff/control"
+)
+
+// TrashPurgeJob permanently removes assets which have been in the trash for longer than the configured retention period.
+type TrashPurgeJob struct {
+	config TrashPurgeJobConfig
+	assets *control.AssetControl
+}
+
+type TrashPurgeJobConfig struct {
+	Retention time.Duration
+}
+
+func NewTrashPurgeJob(config TrashPurgeJobConfig, assets *control.AssetControl) *TrashPurgeJob {
+	return &TrashPurgeJob{config: config, assets: assets}
+}
+
+func (tj *TrashPurgeJob) Run(ctx context.Context) error {
+	purged, err := tj.assets.PurgeDeletedBefore(ctx, time.Now().Add(-tj.config.Retention))
+	if purged != 0 {
+		slog.InfoContext(ctx, "purged assets from trash", "count", purged)
+	}
+
+	return err
+}
@@ -1,12 +1,15 @@
 package blobs
 
 import (
+	"context"
 	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/RobinThrift/stuff/entities"
@@ -48,16 +51,11 @@ func (fs *LocalFS) WriteFile(file *entities.File) (err error) {
 		return err
 	}
 
-	ext := path.Ext(file.Name)
 	file.Sha256 = h.Sum(nil)
-	for _, b := range file.Sha256 {
-		file.FullPath = file.FullPath + "/" + fmt.Sprintf("%x", b)
-	}
-
-	file.FullPath += ext
+	relPath := contentAddressedPath(file.Sha256, path.Ext(file.Name))
 
-	file.PublicPath = "/assets/files" + file.FullPath
-	file.FullPath = path.Join(fs.RootDir, file.FullPath)
+	file.PublicPath = "/assets/files" + relPath
+	file.FullPath = path.Join(fs.RootDir, relPath)
 
 	err = ensureDirExists(path.Dir(file.FullPath))
 	if err != nil {
@@ -81,6 +79,9 @@ func (fs *LocalFS) RemoveFile(file *entities.File) error {
 
 	err := os.Remove(file.FullPath)
 	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
 		return err
 	}
 
@@ -112,6 +113,81 @@ func (fs *LocalFS) RemoveFile(file *entities.File) error {
 
 }
 
+func (fs *LocalFS) OpenFile(file *entities.File) (io.ReadCloser, error) {
+	if !strings.HasPrefix(file.FullPath, fs.RootDir) {
+		return nil, fmt.Errorf("invalid file path: file path is not in configured file dir: %s", file.FullPath)
+	}
+
+	return os.Open(file.FullPath)
+}
+
+// WalkFiles calls fn for every file stored below the root dir. The files only have their paths, size and modification time set.
+func (fs *LocalFS) WalkFiles(ctx context.Context, fn func(*entities.File) error) error {
+	_, err := os.Stat(fs.RootDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	tmpDir := filepath.Clean(fs.TmpDir)
+
+	return filepath.WalkDir(fs.RootDir, func(fullPath string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if entry.IsDir() {
+			if fs.TmpDir != "" && filepath.Clean(fullPath) == tmpDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(fs.RootDir, fullPath)
+		if err != nil {
+			return err
+		}
+
+		return fn(&entities.File{
+			Name:       entry.Name(),
+			SizeBytes:  info.Size(),
+			PublicPath: "/assets/files/" + filepath.ToSlash(relPath),
+			FullPath:   path.Join(fs.RootDir, filepath.ToSlash(relPath)),
+			UpdatedAt:  info.ModTime(),
+		})
+	})
+}
+
+// ServeHTTP serves the file at the request path, which must be relative to the public `/assets/files/` path.
+func (fs *LocalFS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	http.FileServer(http.Dir(fs.RootDir)).ServeHTTP(w, r)
+}
+
+// contentAddressedPath splits the hash into one directory per byte, e.g. `/2c/f2/.../ae.jpg`.
+func contentAddressedPath(sha256 []byte, ext string) string {
+	var p strings.Builder
+	for _, b := range sha256 {
+		fmt.Fprintf(&p, "/%x", b)
+	}
+
+	return p.String() + ext
+}
+
 func ensureDirExists(dir string) error {
 	stat, err := os.Stat(dir)
 	if err != nil {
@@ -0,0 +1,406 @@
+package blobs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/RobinThrift/stuff/entities"
+)
+
+// S3 stores files in an S3 compatible object storage, using the same content addressed paths as LocalFS.
+type S3 struct {
+	// Endpoint of the S3 API, e.g. https://s3.eu-central-1.amazonaws.com or http://localhost:9000 for MinIO.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// Prefix is prepended to all object keys, so multiple instances can share a bucket.
+	Prefix string
+	// PathStyle addresses the bucket in the path instead of the host name, as required by most self-hosted implementations.
+	PathStyle bool
+	// PresignedRedirect makes ServeHTTP redirect to a presigned URL instead of streaming the file.
+	PresignedRedirect bool
+	PresignExpiry     time.Duration
+	TmpDir            string
+
+	HTTPClient *http.Client
+
+	now func() time.Time
+}
+
+func (s3 *S3) WriteFile(file *entities.File) (err error) {
+	fhandle, err := os.CreateTemp(s3.TmpDir, "s3_upload_*")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		err = errors.Join(err, fhandle.Close(), os.Remove(fhandle.Name()))
+	}()
+
+	h := sha256.New()
+
+	file.SizeBytes, err = io.Copy(fhandle, io.TeeReader(file, h))
+	if err != nil {
+		return err
+	}
+
+	_, err = fhandle.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	file.Sha256 = h.Sum(nil)
+	relPath := contentAddressedPath(file.Sha256, path.Ext(file.Name))
+	key := s3.key(relPath)
+
+	file.PublicPath = "/assets/files" + relPath
+	file.FullPath = "s3://" + s3.Bucket + "/" + key
+
+	// objects are content addressed, so an existing object already has the same content
+	exists, err := s3.exists(key, file.SizeBytes)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return nil
+	}
+
+	req, err := s3.newRequest(http.MethodPut, key, io.NopCloser(fhandle))
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = file.SizeBytes
+	if file.Filetype != "" {
+		req.Header.Set("Content-Type", file.Filetype)
+	}
+
+	res, err := s3.do(req, hex.EncodeToString(file.Sha256))
+	if err != nil {
+		return fmt.Errorf("error uploading %s to S3: %w", key, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error uploading %s to S3: %w", key, readS3Error(res))
+	}
+
+	return nil
+}
+
+func (s3 *S3) exists(key string, size int64) (bool, error) {
+	req, err := s3.newRequest(http.MethodHead, key, nil)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := s3.do(req, emptyPayloadHash)
+	if err != nil {
+		return false, fmt.Errorf("error checking whether %s exists in S3: %w", key, err)
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return res.ContentLength == size, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("error checking whether %s exists in S3: unexpected status %s", key, res.Status)
+	}
+}
+
+func (s3 *S3) OpenFile(file *entities.File) (io.ReadCloser, error) {
+	key, ok := s3.keyFromFullPath(file.FullPath)
+	if !ok {
+		return nil, fmt.Errorf("invalid file path: file path is not in configured bucket: %s", file.FullPath)
+	}
+
+	req, err := s3.newRequest(http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s3.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s from S3: %w", key, err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		return nil, fmt.Errorf("error getting %s from S3: %w", key, readS3Error(res))
+	}
+
+	return res.Body, nil
+}
+
+func (s3 *S3) RemoveFile(file *entities.File) error {
+	key, ok := s3.keyFromFullPath(file.FullPath)
+	if !ok {
+		return fmt.Errorf("invalid file path for deletion: file path is not in configured bucket: %s", file.FullPath)
+	}
+
+	req, err := s3.newRequest(http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := s3.do(req, emptyPayloadHash)
+	if err != nil {
+		return fmt.Errorf("error deleting %s from S3: %w", key, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error deleting %s from S3: %w", key, readS3Error(res))
+	}
+
+	return nil
+}
+
+type s3ListBucketResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// WalkFiles calls fn for every object below the configured prefix. The files only have their paths, size and modification time set.
+func (s3 *S3) WalkFiles(ctx context.Context, fn func(*entities.File) error) error {
+	prefix := s3.key("/")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	continuationToken := ""
+	for {
+		u, err := s3.objectURL("")
+		if err != nil {
+			return err
+		}
+
+		query := url.Values{}
+		query.Set("list-type", "2")
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+		u.RawQuery = canonicalQuery(query)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return err
+		}
+
+		res, err := s3.do(req, emptyPayloadHash)
+		if err != nil {
+			return fmt.Errorf("error listing objects in S3: %w", err)
+		}
+
+		if res.StatusCode != http.StatusOK {
+			err = readS3Error(res)
+			return errors.Join(fmt.Errorf("error listing objects in S3: %w", err), res.Body.Close())
+		}
+
+		var result s3ListBucketResult
+		err = xml.NewDecoder(res.Body).Decode(&result)
+		if err != nil {
+			return errors.Join(fmt.Errorf("error decoding S3 object list: %w", err), res.Body.Close())
+		}
+
+		err = res.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range result.Contents {
+			if strings.HasSuffix(obj.Key, "/") {
+				continue
+			}
+
+			err = fn(&entities.File{
+				Name:       path.Base(obj.Key),
+				SizeBytes:  obj.Size,
+				PublicPath: "/assets/files/" + strings.TrimPrefix(obj.Key, prefix),
+				FullPath:   "s3://" + s3.Bucket + "/" + obj.Key,
+				UpdatedAt:  obj.LastModified,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return nil
+		}
+
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+// ServeHTTP serves the file at the request path, which must be relative to the public `/assets/files/` path.
+// The file is either streamed from the bucket or the client is redirected to a presigned URL.
+func (s3 *S3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	key := s3.key(path.Clean("/" + r.URL.Path))
+
+	if s3.PresignedRedirect {
+		presigned, err := s3.presign(r.Method, key)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error presigning S3 URL", "error", err, "key", key)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		http.Redirect(w, r, presigned, http.StatusFound)
+		return
+	}
+
+	req, err := s3.newRequest(r.Method, key, nil)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error creating S3 request", "error", err, "key", key)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	req = req.WithContext(r.Context())
+	for _, h := range []string{"Range", "If-None-Match", "If-Modified-Since", "If-Match", "If-Unmodified-Since"} {
+		if v := r.Header.Get(h); v != "" {
+			req.Header.Set(h, v)
+		}
+	}
+
+	res, err := s3.do(req, emptyPayloadHash)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error getting file from S3", "error", err, "key", key)
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusPartialContent, http.StatusNotModified:
+	case http.StatusNotFound, http.StatusForbidden:
+		http.NotFound(w, r)
+		return
+	default:
+		slog.ErrorContext(r.Context(), "error getting file from S3", "error", readS3Error(res), "key", key)
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+
+	for _, h := range []string{"Content-Type", "Content-Length", "Content-Range", "Accept-Ranges", "ETag", "Last-Modified"} {
+		if v := res.Header.Get(h); v != "" {
+			w.Header().Set(h, v)
+		}
+	}
+
+	w.WriteHeader(res.StatusCode)
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	_, err = io.Copy(w, res.Body)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error streaming file from S3", "error", err, "key", key)
+	}
+}
+
+func (s3 *S3) key(relPath string) string {
+	return strings.TrimPrefix(path.Join(s3.Prefix, relPath), "/")
+}
+
+func (s3 *S3) keyFromFullPath(fullPath string) (string, bool) {
+	return strings.CutPrefix(fullPath, "s3://"+s3.Bucket+"/")
+}
+
+func (s3 *S3) objectURL(key string) (*url.URL, error) {
+	u, err := url.Parse(s3.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 endpoint %s: %w", s3.Endpoint, err)
+	}
+
+	objPath := "/" + key
+	if s3.PathStyle {
+		objPath = "/" + s3.Bucket + objPath
+	} else {
+		u.Host = s3.Bucket + "." + u.Host
+	}
+
+	u.Path = objPath
+	u.RawPath = uriEncode(objPath, false)
+
+	return u, nil
+}
+
+func (s3 *S3) newRequest(method string, key string, body io.Reader) (*http.Request, error) {
+	u, err := s3.objectURL(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return http.NewRequest(method, u.String(), body) //nolint:noctx // context is set by the caller if required
+}
+
+func (s3 *S3) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	s3.sign(req, payloadHash, s3.currentTime())
+
+	client := s3.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return client.Do(req)
+}
+
+func (s3 *S3) presign(method string, key string) (string, error) {
+	u, err := s3.objectURL(key)
+	if err != nil {
+		return "", err
+	}
+
+	expiry := s3.PresignExpiry
+	if expiry == 0 {
+		expiry = 15 * time.Minute
+	}
+
+	return s3.presignURL(method, u, expiry, s3.currentTime()), nil
+}
+
+func (s3 *S3) currentTime() time.Time {
+	if s3.now != nil {
+		return s3.now()
+	}
+
+	return time.Now()
+}
+
+func readS3Error(res *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+	return fmt.Errorf("unexpected status %s: %s", res.Status, strings.TrimSpace(string(body)))
+}
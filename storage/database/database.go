@@ -12,8 +12,17 @@ import (
 const OrderASC = "ASC"
 const OrderDESC = "DESC"
 
+type Dialect string
+
+const (
+	DialectSQLite   Dialect = "sqlite"
+	DialectPostgres Dialect = "postgres"
+)
+
 type Database struct {
 	bob.DB
+	// Dialect of the underlying database, defaults to SQLite.
+	Dialect            Dialect
 	EnableDebugLogging bool
 }
 
@@ -23,10 +32,10 @@ type Executor interface {
 }
 
 func (db *Database) InTransaction(ctx context.Context, fn func(ctx context.Context, tx Executor) error) error {
-	if tx, ok := txFromCtx(ctx); ok {
-		var exec Executor = tx
+	if current, ok := txFromCtx(ctx); ok {
+		var exec Executor = current.tx
 		if db.EnableDebugLogging {
-			exec = bob.Debug(tx)
+			exec = bob.Debug(current.tx)
 		}
 		return fn(ctx, exec)
 	}
@@ -36,14 +45,15 @@ func (db *Database) InTransaction(ctx context.Context, fn func(ctx context.Conte
 		return fmt.Errorf("error beginning transaction: %w", err)
 	}
 
-	ctx = ctxWithTx(ctx, tx)
+	current := &txCtx{tx: tx}
+	innerCtx := ctxWithTx(ctx, current)
 
 	var exec Executor = tx
 	if db.EnableDebugLogging {
 		exec = bob.Debug(tx)
 	}
 
-	_, err = exec.ExecContext(ctx, "PRAGMA defer_foreign_keys = 1")
+	_, err = exec.ExecContext(innerCtx, db.deferForeignKeysStmt())
 	if err != nil {
 		err = fmt.Errorf("error setting foreign key check to deferred: %w", err)
 		if rbErr := tx.Rollback(); rbErr != nil {
@@ -52,7 +62,7 @@ func (db *Database) InTransaction(ctx context.Context, fn func(ctx context.Conte
 		return err
 	}
 
-	if err := fn(ctx, exec); err != nil {
+	if err := fn(innerCtx, exec); err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
 			return fmt.Errorf("error rolling back: %w. original error: %v", rbErr, err)
 		}
@@ -63,9 +73,26 @@ func (db *Database) InTransaction(ctx context.Context, fn func(ctx context.Conte
 		return fmt.Errorf("error committing transaction: %w", err)
 	}
 
+	for _, fn := range current.afterCommit {
+		fn(ctx)
+	}
+
 	return nil
 }
 
+// AfterCommit registers fn to be run once the transaction in ctx has been committed, e.g. to remove files
+// which are only unreferenced after the commit. fn is discarded if the transaction is rolled back and it is
+// run right away if ctx has no transaction.
+func AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	current, ok := txFromCtx(ctx)
+	if !ok {
+		fn(ctx)
+		return
+	}
+
+	current.afterCommit = append(current.afterCommit, fn)
+}
+
 func InTransaction[R any](ctx context.Context, db *Database, fn func(ctx context.Context, tx Executor) (R, error)) (R, error) {
 	var result R
 	err := db.InTransaction(ctx, func(ctx context.Context, tx Executor) error {
@@ -86,15 +113,28 @@ func InTransaction[R any](ctx context.Context, db *Database, fn func(ctx context
 	return result, nil
 }
 
+func (db *Database) deferForeignKeysStmt() string {
+	if db.Dialect == DialectPostgres {
+		return "SET CONSTRAINTS ALL DEFERRED"
+	}
+
+	return "PRAGMA defer_foreign_keys = 1"
+}
+
 type ctxTxKeyType string
 
 const ctxTxKey = ctxTxKeyType("ctxTxKey")
 
-func txFromCtx(ctx context.Context) (bob.Tx, bool) {
-	tx, ok := ctx.Value(ctxTxKey).(bob.Tx)
+type txCtx struct {
+	tx          bob.Tx
+	afterCommit []func(ctx context.Context)
+}
+
+func txFromCtx(ctx context.Context) (*txCtx, bool) {
+	tx, ok := ctx.Value(ctxTxKey).(*txCtx)
 	return tx, ok
 }
 
-func ctxWithTx(parent context.Context, tx bob.Tx) context.Context {
+func ctxWithTx(parent context.Context, tx *txCtx) context.Context {
 	return context.WithValue(parent, ctxTxKey, tx)
 }
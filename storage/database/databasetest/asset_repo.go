@@ -0,0 +1,651 @@
+package databasetest
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/search"
+	"github.com/RobinThrift/stuff/storage/database"
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAssetRepoCRUD(t *testing.T, exec bob.Executor, repos Repos) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ar := repos.Assets // nolint: varnamelen // this is fine for the test
+
+	asset := newTestAsset(t)
+
+	err := ar.Create(ctx, exec, asset)
+	assert.NoError(t, err)
+
+	created, err := ar.Get(ctx, exec, database.GetAssetQuery{ID: 1, IncludePurchases: true, IncludeParts: true})
+	assert.NoError(t, err)
+
+	asset.Children = []*entities.Asset{}
+	asset.Files = []*entities.File{}
+	asset.Photos = []*entities.Photo{}
+
+	asset.ID = created.ID
+	asset.MetaInfo.CreatedAt = created.MetaInfo.CreatedAt
+	asset.MetaInfo.UpdatedAt = created.MetaInfo.UpdatedAt
+
+	asset.Parts[0].ID = created.Parts[0].ID
+	asset.Parts[0].AssetID = created.ID
+	asset.Parts[0].CreatedAt = created.Parts[0].CreatedAt
+	asset.Parts[0].UpdatedAt = created.Parts[0].UpdatedAt
+
+	asset.Parts[1].ID = created.Parts[1].ID
+	asset.Parts[1].AssetID = created.ID
+	asset.Parts[1].CreatedAt = created.Parts[1].CreatedAt
+	asset.Parts[1].UpdatedAt = created.Parts[1].UpdatedAt
+
+	asset.Purchases[0].ID = created.Purchases[0].ID
+	asset.Purchases[1].ID = created.Purchases[1].ID
+
+	assert.Equal(t, asset, created)
+
+	updated := updateAsset(created)
+
+	err = ar.Update(ctx, exec, updated)
+	assert.NoError(t, err)
+
+	fetched, err := ar.Get(ctx, exec, database.GetAssetQuery{ID: 1, IncludePurchases: true, IncludeParts: true})
+	assert.NoError(t, err)
+
+	updated.MetaInfo.UpdatedAt = fetched.MetaInfo.UpdatedAt
+
+	updated.Parts[0].ID = fetched.Parts[0].ID
+	updated.Parts[0].CreatedAt = fetched.Parts[0].CreatedAt
+	updated.Parts[0].UpdatedAt = fetched.Parts[0].UpdatedAt
+
+	updated.Parts[1].ID = fetched.Parts[1].ID
+	updated.Parts[1].CreatedAt = fetched.Parts[1].CreatedAt
+	updated.Parts[1].UpdatedAt = fetched.Parts[1].UpdatedAt
+
+	assert.Equal(t, updated.Parts[0], fetched.Parts[0])
+
+	err = ar.Delete(ctx, exec, fetched.ID)
+	assert.NoError(t, err)
+	_, err = ar.Get(ctx, exec, database.GetAssetQuery{ID: 1})
+	assert.ErrorIs(t, err, database.ErrAssetNotFound)
+}
+
+func testAssetRepoListPagination(t *testing.T, exec bob.Executor, repos Repos) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repo := repos.Assets
+
+	types := []entities.AssetType{entities.AssetTypeAsset, entities.AssetTypeComponent, entities.AssetTypeConsumable}
+
+	for i := 0; i < 100; i++ {
+		asset := newTestAsset(t)
+		asset.Name = fmt.Sprintf("%s %d", asset.Name, i)
+		asset.Type = types[i%3]
+		err := repo.Create(ctx, exec, asset)
+		assert.NoError(t, err)
+	}
+
+	type exp struct {
+		len      int
+		total    int
+		page     int
+		pageSize int
+		numPages int
+	}
+
+	tt := []struct {
+		name string
+		q    database.ListAssetsQuery
+		exp  exp
+	}{
+		{
+			"List all",
+			database.ListAssetsQuery{},
+			exp{
+				len:      100,
+				total:    100,
+				page:     0,
+				pageSize: 100,
+				numPages: 1,
+			},
+		},
+		{
+			"Page size 25",
+			database.ListAssetsQuery{PageSize: 25},
+			exp{
+				len:      25,
+				total:    100,
+				page:     0,
+				pageSize: 25,
+				numPages: 4,
+			},
+		},
+		{
+			"All filtered",
+			database.ListAssetsQuery{AssetType: string(entities.AssetTypeAsset)},
+			exp{
+				len:      34,
+				total:    34,
+				page:     0,
+				pageSize: 34,
+				numPages: 1,
+			},
+		},
+		{
+			"Filtered by FTS",
+			database.ListAssetsQuery{Search: mustParseSearch(t, `name:"Test Asset 1"*`)},
+			exp{
+				len:      11,
+				total:    11,
+				page:     0,
+				pageSize: 11,
+				numPages: 1,
+			},
+		},
+		{
+			"Filtered by FTS paginated",
+			database.ListAssetsQuery{Search: mustParseSearch(t, `name:"Test Asset 1"*`), Page: 2, PageSize: 5},
+			exp{
+				len:      1,
+				total:    11,
+				page:     2,
+				pageSize: 5,
+				numPages: 3,
+			},
+		},
+		{
+			"Filtered by FTS and type",
+			database.ListAssetsQuery{Search: mustParseSearch(t, `type:asset name:"Test Asset 1"*`)},
+			exp{
+				len:      3,
+				total:    3,
+				page:     0,
+				pageSize: 3,
+				numPages: 1,
+			},
+		},
+		{
+			"Filtered by FTS with OR and NOT",
+			database.ListAssetsQuery{Search: mustParseSearch(t, `(name:"Test Asset 1"* OR name:"Test Asset 2"*) -name:"Test Asset 2"`)},
+			exp{
+				len:      21,
+				total:    21,
+				page:     0,
+				pageSize: 21,
+				numPages: 1,
+			},
+		},
+	}
+
+	for _, tt := range tt {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := repo.List(ctx, exec, tt.q)
+			assert.NoError(t, err)
+
+			assert.Len(t, list.Items, tt.exp.len, "len of items")
+			assert.Equal(t, tt.exp.total, list.Total, "total num of items")
+			assert.Equal(t, tt.exp.page, list.Page, "page num")
+			assert.Equal(t, tt.exp.pageSize, list.PageSize, "page size")
+			assert.Equal(t, tt.exp.numPages, list.NumPages, "num of pages")
+		})
+	}
+}
+
+func testAssetRepoSoftDelete(t *testing.T, exec bob.Executor, repos Repos) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repo := repos.Assets
+
+	kept := newTestAsset(t)
+	kept.Name = "Kept Asset"
+	err := repo.Create(ctx, exec, kept)
+	assert.NoError(t, err)
+
+	trashed := newTestAsset(t)
+	trashed.Name = "Trashed Asset"
+	err = repo.Create(ctx, exec, trashed)
+	assert.NoError(t, err)
+
+	deletedAt := time.Now().Add(-time.Hour)
+	err = repo.SetDeletedAt(ctx, exec, trashed.ID, deletedAt)
+	assert.NoError(t, err)
+
+	_, err = repo.Get(ctx, exec, database.GetAssetQuery{ID: trashed.ID})
+	assert.ErrorIs(t, err, database.ErrAssetNotFound)
+
+	fetched, err := repo.Get(ctx, exec, database.GetAssetQuery{ID: trashed.ID, IncludeDeleted: true})
+	assert.NoError(t, err)
+	assert.Equal(t, deletedAt.Unix(), fetched.MetaInfo.DeletedAt.Unix())
+
+	list, err := repo.List(ctx, exec, database.ListAssetsQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, list.Total)
+	assert.Equal(t, kept.ID, list.Items[0].ID)
+
+	list, err = repo.List(ctx, exec, database.ListAssetsQuery{Search: mustParseSearch(t, "Asset")})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, list.Total)
+	assert.Equal(t, kept.ID, list.Items[0].ID)
+
+	list, err = repo.List(ctx, exec, database.ListAssetsQuery{OnlyDeleted: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, list.Total)
+	assert.Equal(t, trashed.ID, list.Items[0].ID)
+
+	list, err = repo.List(ctx, exec, database.ListAssetsQuery{OnlyDeleted: true, DeletedBefore: deletedAt.Add(-time.Minute)})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, list.Total)
+
+	err = repo.SetDeletedAt(ctx, exec, trashed.ID, time.Time{})
+	assert.NoError(t, err)
+
+	list, err = repo.List(ctx, exec, database.ListAssetsQuery{Search: mustParseSearch(t, "Trashed")})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, list.Total)
+	assert.Equal(t, trashed.ID, list.Items[0].ID)
+}
+
+func testAssetRepoSearch(t *testing.T, exec bob.Executor, repos Repos) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repo := repos.Assets
+
+	laptop := newTestAsset(t)
+	laptop.Name = "Dell Laptop"
+	laptop.Type = entities.AssetTypeAsset
+	laptop.Status = entities.StatusInUse
+	laptop.Location = "Office 1"
+	laptop.WarrantyUntil = time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+	laptop.CustomAttrs = []entities.CustomAttr{{Name: "RAM", Value: "16 GB"}, {Name: "Colour", Value: "Black"}}
+	laptop.Purchases = []*entities.Purchase{{Supplier: "Computer Shop", Amount: 89900, Currency: "EUR", Date: time.Date(2023, time.May, 2, 0, 0, 0, 0, time.UTC)}}
+	assert.NoError(t, repo.Create(ctx, exec, laptop))
+
+	lamp := newTestAsset(t)
+	lamp.Name = "Desk Lamp"
+	lamp.Type = entities.AssetTypeAsset
+	lamp.Status = entities.StatusInStorage
+	lamp.Location = "Storage"
+	lamp.WarrantyUntil = time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	lamp.CustomAttrs = []entities.CustomAttr{{Name: "Colour", Value: "White"}, {Name: "Watts", Value: 8.0}}
+	lamp.Purchases = []*entities.Purchase{{Supplier: "Furniture Store", Amount: 2999, Currency: "EUR", Date: time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)}}
+	assert.NoError(t, repo.Create(ctx, exec, lamp))
+
+	cables := newTestAsset(t)
+	cables.Name = "USB-C Cables"
+	cables.Type = entities.AssetTypeConsumable
+	cables.Status = entities.StatusInStorage
+	cables.Location = "Storage"
+	cables.Quantity = 25
+	cables.Notes = "Charging cable for the lamp"
+	cables.WarrantyUntil = time.Time{}
+	cables.CustomAttrs = nil
+	cables.Purchases = nil
+	assert.NoError(t, repo.Create(ctx, exec, cables))
+
+	tt := []struct {
+		query string
+		exp   []string
+	}{
+		{query: "lapt", exp: []string{"Dell Laptop"}},
+		{query: `"desk lamp"`, exp: []string{"Desk Lamp"}},
+		{query: "usb-c", exp: []string{"USB-C Cables"}},
+		{query: "laptop OR lamp", exp: []string{"Dell Laptop", "Desk Lamp", "USB-C Cables"}},
+		{query: "-laptop", exp: []string{"Desk Lamp", "USB-C Cables"}},
+		{query: "!!", exp: []string{}},
+		{query: "status:in_use", exp: []string{"Dell Laptop"}},
+		{query: "NOT type:consumable", exp: []string{"Dell Laptop", "Desk Lamp"}},
+		{query: "location:office*", exp: []string{"Dell Laptop"}},
+		{query: "location:storage quantity>10", exp: []string{"USB-C Cables"}},
+		{query: "warranty<2025-01-01", exp: []string{"Dell Laptop"}},
+		{query: "warranty:2025-03-01", exp: []string{"Desk Lamp"}},
+		{query: "warranty>2025-03-01", exp: []string{}},
+		{query: "purchase.amount>500", exp: []string{"Dell Laptop"}},
+		{query: "purchase.amount<=29.99", exp: []string{"Desk Lamp"}},
+		{query: "purchase.date>=2024-01-01", exp: []string{"Desk Lamp"}},
+		{query: `purchase.supplier:"computer shop"`, exp: []string{"Dell Laptop"}},
+		{query: "attr.ram>=16", exp: []string{"Dell Laptop"}},
+		{query: "attr.ram>16", exp: []string{}},
+		{query: "attr.watts<10", exp: []string{"Desk Lamp"}},
+		{query: "attr.colour:white OR attr.colour:bl*", exp: []string{"Dell Laptop", "Desk Lamp"}},
+	}
+
+	for _, tt := range tt {
+		t.Run(tt.query, func(t *testing.T) {
+			list, err := repo.List(ctx, exec, database.ListAssetsQuery{Search: mustParseSearch(t, tt.query), OrderBy: "id"})
+			assert.NoError(t, err)
+
+			names := make([]string, 0, len(list.Items))
+			for _, a := range list.Items {
+				names = append(names, a.Name)
+			}
+
+			assert.Equal(t, tt.exp, names)
+			assert.Equal(t, len(tt.exp), list.Total)
+		})
+	}
+}
+
+func testAssetRepoSearchRanked(t *testing.T, exec bob.Executor, repos Repos) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repo := repos.Assets
+
+	for _, a := range []struct{ name, notes string }{
+		{"USB Cables", "Next to the lamp"},
+		{"Monitor Arm", "Next to the lamp"},
+		{"Desk Lamp", "LED"},
+	} {
+		asset := newTestAsset(t)
+		asset.Name = a.name
+		asset.Notes = a.notes
+		assert.NoError(t, repo.Create(ctx, exec, asset))
+	}
+
+	list, err := repo.List(ctx, exec, database.ListAssetsQuery{Search: mustParseSearch(t, "lamp")})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, list.Total)
+	if assert.Len(t, list.Items, 3) {
+		assert.Equal(t, "Desk Lamp", list.Items[0].Name)
+		assert.Equal(t, "Desk "+entities.SnippetMatchStart+"Lamp"+entities.SnippetMatchEnd, list.Items[0].Snippet)
+		// equally ranked assets are ordered by their id
+		assert.Equal(t, "USB Cables", list.Items[1].Name)
+		assert.Equal(t, "Next to the "+entities.SnippetMatchStart+"lamp"+entities.SnippetMatchEnd, list.Items[1].Snippet)
+		assert.Equal(t, "Monitor Arm", list.Items[2].Name)
+	}
+
+	list, err = repo.List(ctx, exec, database.ListAssetsQuery{Search: mustParseSearch(t, "lamp"), Page: 2, PageSize: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, list.Total)
+	assert.Equal(t, 3, list.NumPages)
+	if assert.Len(t, list.Items, 1) {
+		assert.Equal(t, "Monitor Arm", list.Items[0].Name)
+	}
+
+	list, err = repo.List(ctx, exec, database.ListAssetsQuery{Search: mustParseSearch(t, "lamp"), OrderBy: "name", OrderDir: "desc"})
+	assert.NoError(t, err)
+	if assert.Len(t, list.Items, 3) {
+		assert.Equal(t, "USB Cables", list.Items[0].Name)
+	}
+
+	list, err = repo.List(ctx, exec, database.ListAssetsQuery{Search: mustParseSearch(t, "status:in_use")})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, list.Total)
+	assert.Empty(t, list.Items[0].Snippet)
+}
+
+func testAssetRepoListFacets(t *testing.T, exec bob.Executor, repos Repos) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repo := repos.Assets
+
+	for _, a := range []struct {
+		name          string
+		status        entities.Status
+		category      string
+		manufacturer  string
+		warrantyUntil time.Time
+		purchases     bool
+		attr          entities.CustomAttr
+	}{
+		{"Dell Laptop", entities.StatusInUse, "Laptops", "Dell", time.Now().AddDate(1, 0, 0), true, entities.CustomAttr{Name: "RAM", Value: 16}},
+		{"Lenovo Laptop", entities.StatusInUse, "Laptops", "Lenovo", time.Now().AddDate(-1, 0, 0), true, entities.CustomAttr{Name: "RAM", Value: 8}},
+		{"Dell Monitor", entities.StatusInStorage, "Monitors", "Dell", time.Time{}, false, entities.CustomAttr{Name: "Colour", Value: "black"}},
+	} {
+		asset := newTestAsset(t)
+		asset.Name = a.name
+		asset.Status = a.status
+		asset.Category = a.category
+		asset.Manufacturer = a.manufacturer
+		asset.WarrantyUntil = a.warrantyUntil
+		asset.CustomAttrs = []entities.CustomAttr{a.attr}
+		if !a.purchases {
+			asset.Purchases = nil
+		}
+		assert.NoError(t, repo.Create(ctx, exec, asset))
+	}
+
+	facets, err := repo.ListFacets(ctx, exec, database.ListAssetFacetsQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*entities.FacetValue{{Value: "IN_USE", Count: 2}, {Value: "IN_STORAGE", Count: 1}}, findFacet(facets, entities.FacetStatus, "").Values)
+	assert.Equal(t, []*entities.FacetValue{{Value: "Laptops", Count: 2}, {Value: "Monitors", Count: 1}}, findFacet(facets, entities.FacetCategory, "").Values)
+	assert.Equal(t, []*entities.FacetValue{{Value: "Dell", Count: 2}, {Value: "Lenovo", Count: 1}}, findFacet(facets, entities.FacetManufacturer, "").Values)
+	assert.Equal(t, []*entities.FacetValue{{Value: "Shop 65", Count: 2}, {Value: "Shop 66", Count: 2}}, findFacet(facets, entities.FacetSupplier, "").Values)
+	assert.Equal(t, []*entities.FacetValue{{Value: "ACTIVE", Count: 1}, {Value: "EXPIRED", Count: 1}, {Value: "NONE", Count: 1}}, findFacet(facets, entities.FacetWarranty, "").Values)
+	assert.Equal(t, []*entities.FacetValue{{Value: "16", Count: 1}, {Value: "8", Count: 1}}, findFacet(facets, entities.FacetCustomAttr, "RAM").Values)
+	assert.Equal(t, []*entities.FacetValue{{Value: "black", Count: 1}}, findFacet(facets, entities.FacetCustomAttr, "Colour").Values)
+
+	filters := entities.AssetFilters{Category: []string{"Laptops"}}
+
+	facets, err = repo.ListFacets(ctx, exec, database.ListAssetFacetsQuery{Filters: filters})
+	assert.NoError(t, err)
+	// the selection of a facet doesn't change its own counts
+	assert.Equal(t, []*entities.FacetValue{{Value: "Laptops", Count: 2, Selected: true}, {Value: "Monitors", Count: 1}}, findFacet(facets, entities.FacetCategory, "").Values)
+	assert.Equal(t, []*entities.FacetValue{{Value: "IN_USE", Count: 2}}, findFacet(facets, entities.FacetStatus, "").Values)
+	assert.Nil(t, findFacet(facets, entities.FacetCustomAttr, "Colour"))
+
+	list, err := repo.List(ctx, exec, database.ListAssetsQuery{Filters: filters, OrderBy: "name"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, list.Total)
+
+	filters = entities.AssetFilters{
+		Manufacturer: []string{"Dell"},
+		Warranty:     []entities.WarrantyState{entities.WarrantyActive, entities.WarrantyNone},
+		CustomAttrs:  map[string][]string{"RAM": {"16"}},
+	}
+
+	facets, err = repo.ListFacets(ctx, exec, database.ListAssetFacetsQuery{Filters: filters, Search: mustParseSearch(t, "laptop")})
+	assert.NoError(t, err)
+	assert.Equal(t, []*entities.FacetValue{{Value: "16", Count: 1, Selected: true}}, findFacet(facets, entities.FacetCustomAttr, "RAM").Values)
+	assert.Equal(t, []*entities.FacetValue{{Value: "ACTIVE", Count: 1, Selected: true}, {Value: "NONE", Selected: true}}, findFacet(facets, entities.FacetWarranty, "").Values)
+
+	list, err = repo.List(ctx, exec, database.ListAssetsQuery{Filters: filters})
+	assert.NoError(t, err)
+	if assert.Len(t, list.Items, 1) {
+		assert.Equal(t, "Dell Laptop", list.Items[0].Name)
+	}
+
+	facets, err = repo.ListFacets(ctx, exec, database.ListAssetFacetsQuery{Filters: filters, MaxValues: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []*entities.FacetValue{{Value: "Dell", Count: 1, Selected: true}}, findFacet(facets, entities.FacetManufacturer, "").Values)
+}
+
+func testAssetRepoListFacetsWarrantyBoundary(t *testing.T, exec bob.Executor, repos Repos) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repo := repos.Assets
+
+	today := search.StartOfDay(time.Now())
+
+	for _, a := range []struct {
+		name          string
+		warrantyUntil time.Time
+	}{
+		{"Ends Yesterday", today.Add(-time.Second)},
+		{"Ends Today", today},
+		{"Ends Tomorrow", today.AddDate(0, 0, 1)},
+	} {
+		asset := newTestAsset(t)
+		asset.Name = a.name
+		asset.WarrantyUntil = a.warrantyUntil
+		assert.NoError(t, repo.Create(ctx, exec, asset))
+	}
+
+	facets, err := repo.ListFacets(ctx, exec, database.ListAssetFacetsQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*entities.FacetValue{{Value: "ACTIVE", Count: 2}, {Value: "EXPIRED", Count: 1}}, findFacet(facets, entities.FacetWarranty, "").Values)
+
+	// the facets and the search agree on which warranties have expired
+	for _, tt := range []struct {
+		state  entities.WarrantyState
+		search string
+		exp    []string
+	}{
+		{entities.WarrantyExpired, "warranty<" + today.Format("2006-01-02"), []string{"Ends Yesterday"}},
+		{entities.WarrantyActive, "warranty>=" + today.Format("2006-01-02"), []string{"Ends Today", "Ends Tomorrow"}},
+	} {
+		byFacet, err := repo.List(ctx, exec, database.ListAssetsQuery{Filters: entities.AssetFilters{Warranty: []entities.WarrantyState{tt.state}}, OrderBy: "id"})
+		assert.NoError(t, err)
+
+		bySearch, err := repo.List(ctx, exec, database.ListAssetsQuery{Search: mustParseSearch(t, tt.search), OrderBy: "id"})
+		assert.NoError(t, err)
+
+		assert.Equal(t, tt.exp, assetNames(byFacet.Items), tt.state)
+		assert.Equal(t, tt.exp, assetNames(bySearch.Items), tt.search)
+	}
+}
+
+func assetNames(assets []*entities.Asset) []string {
+	names := make([]string, 0, len(assets))
+	for _, a := range assets {
+		names = append(names, a.Name)
+	}
+	return names
+}
+
+func findFacet(facets []*entities.Facet, name string, attr string) *entities.Facet {
+	for _, f := range facets {
+		if f.Name == name && f.Attr == attr {
+			return f
+		}
+	}
+
+	return nil
+}
+
+func mustParseSearch(t *testing.T, query string) search.Node {
+	node, err := search.Parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return node
+}
+
+func newTestAsset(t *testing.T) *entities.Asset {
+	tag, err := nanoid.Generate("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ", 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	asset := &entities.Asset{
+		Type:          entities.AssetTypeConsumable,
+		Status:        entities.StatusInUse,
+		Tag:           tag,
+		Name:          "Test Asset",
+		Category:      "Regular Assets",
+		Model:         "Asset Model",
+		ModelNo:       "98765",
+		SerialNo:      "45678",
+		Manufacturer:  "Asset Maker",
+		Notes:         "Notes about asset",
+		ImageURL:      "/path/to/image",
+		ThumbnailURL:  "/path/to/thumbnail",
+		WarrantyUntil: randTime(),
+		Quantity:      3,
+		QuantityUnit:  "pc",
+		CustomAttrs: []entities.CustomAttr{
+			{Name: "Attr1", Value: "value1"},
+			{Name: "Attr2", Value: 3.0},
+		},
+		Location:          "Where it was put",
+		PositionCode:      "yes",
+		Purchases:         []*entities.Purchase{},
+		Parts:             []*entities.Part{},
+		PartsTotalCounter: 1,
+		MetaInfo:          entities.MetaInfo{CreatedBy: 1},
+	}
+
+	asset.Parts = []*entities.Part{newTestAssetPart(asset)}
+	asset.Parts = append(asset.Parts, newTestAssetPart(asset))
+
+	asset.Purchases = append(asset.Purchases, newTestPurchase(asset))
+	asset.Purchases = append(asset.Purchases, newTestPurchase(asset))
+
+	return asset
+}
+
+func updateAsset(asset *entities.Asset) *entities.Asset {
+	updated := *asset
+
+	updated.Status = entities.StatusInStorage
+	updated.Name = asset.Name + " changed" // nolint const
+	updated.Category += " changed"
+	updated.Model += " changed"
+	updated.ModelNo += " changed"
+	updated.SerialNo += " changed"
+	updated.Manufacturer += " changed"
+	updated.Notes += " changed"
+	updated.ImageURL += " changed"
+	updated.ThumbnailURL += " changed"
+	updated.WarrantyUntil = asset.WarrantyUntil.Add(time.Hour * 24)
+	updated.Quantity++
+	updated.QuantityUnit = "pieces"
+	updated.Location = "somehwere else"
+	updated.PositionCode = "nope"
+
+	updated.Parts = updated.Parts[1:]
+	updated.PartsTotalCounter = len(updated.Parts)
+	updated.Parts = append(updated.Parts, newTestAssetPart(&updated))
+
+	updated.Purchases = updated.Purchases[1:]
+	updated.Purchases = append(updated.Purchases, newTestPurchase(&updated))
+
+	return &updated
+}
+
+func newTestAssetPart(asset *entities.Asset) *entities.Part {
+	char := 65 + asset.PartsTotalCounter
+	asset.PartsTotalCounter = len(asset.Parts) + 1
+	return &entities.Part{
+		AssetID:      asset.ID,
+		Tag:          fmt.Sprintf("%s-%v", asset.Tag, char),
+		Name:         fmt.Sprintf("Part %v", char),
+		Location:     fmt.Sprintf("%s - part %v", asset.Location, char),
+		PositionCode: fmt.Sprintf("%s - part %v", asset.PositionCode, char),
+		Notes:        fmt.Sprintf("this is part %s-%v", asset.Tag, char),
+		CreatedBy:    asset.MetaInfo.CreatedBy,
+	}
+}
+
+func newTestPurchase(asset *entities.Asset) *entities.Purchase {
+	char := 65 + len(asset.Purchases)
+	return &entities.Purchase{
+		Supplier: fmt.Sprintf("Shop %v", char),
+		OrderNo:  fmt.Sprintf("#%v-%v", char, randMonetaryAmount(10000, 200000)),
+		Date:     randTime(),
+		Amount:   randMonetaryAmount(1000, 100000),
+		Currency: randFrom([]string{"GBP", "EUR"}),
+	}
+}
+
+func randMonetaryAmount(min int, max int) entities.MonetaryAmount {
+	return entities.MonetaryAmount(rand.Intn(max-min) + min)
+}
+
+func randFrom[T any](items []T) T {
+	i := rand.Intn(len(items))
+	return items[i]
+}
+
+func randTime() time.Time {
+	return time.Date(
+		2023,
+		randFrom([]time.Month{time.January, time.February, time.March, time.April}),
+		rand.Intn(8)+8, rand.Intn(8)+8, rand.Intn(60),
+		0, 0, time.UTC,
+	)
+}
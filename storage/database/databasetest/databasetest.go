@@ -0,0 +1,100 @@
+// Package databasetest contains the tests of the repositories, which are shared by all database backends,
+// so SQLite and PostgreSQL are tested against the same expectations.
+package databasetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+)
+
+type AssetRepo interface {
+	Get(ctx context.Context, exec bob.Executor, query database.GetAssetQuery) (*entities.Asset, error)
+	List(ctx context.Context, exec bob.Executor, query database.ListAssetsQuery) (*entities.ListPage[*entities.Asset], error)
+	ListFacets(ctx context.Context, exec bob.Executor, query database.ListAssetFacetsQuery) ([]*entities.Facet, error)
+	Create(ctx context.Context, exec bob.Executor, asset *entities.Asset) error
+	Update(ctx context.Context, exec bob.Executor, asset *entities.Asset) error
+	SetDeletedAt(ctx context.Context, exec bob.Executor, id int64, at time.Time) error
+	Delete(ctx context.Context, exec bob.Executor, id int64) error
+}
+
+type FileRepo interface {
+	Create(ctx context.Context, exec bob.Executor, file *entities.File) (int64, error)
+	Get(ctx context.Context, exec bob.Executor, id int64) (*entities.File, error)
+	GetByPublicPath(ctx context.Context, exec bob.Executor, assetID int64, publicPath string) (*entities.File, error)
+	GetByHash(ctx context.Context, exec bob.Executor, sha256 []byte) (*entities.File, error)
+	CountReferences(ctx context.Context, exec bob.Executor, fullPath string) (int64, error)
+	List(ctx context.Context, exec bob.Executor, query database.ListFilesQuery) (*entities.ListPage[*entities.File], error)
+	Delete(ctx context.Context, exec bob.Executor, ids []int64) error
+}
+
+type ModelRepo interface {
+	List(ctx context.Context, exec bob.Executor, query database.ListModelsQuery) (*entities.ListPage[*entities.Model], error)
+}
+
+type TagRepo interface {
+	List(ctx context.Context, exec bob.Executor, query database.ListTagsQuery) (*entities.ListPage[*entities.Tag], error)
+	GetUnused(ctx context.Context, exec bob.Executor, workspaceID int64) (*entities.Tag, error)
+	Create(ctx context.Context, exec bob.Executor, tag *entities.Tag) error
+	MarkTagUsed(ctx context.Context, exec bob.Executor, tag string) error
+	MarkTagUnused(ctx context.Context, exec bob.Executor, tag string) error
+	Delete(ctx context.Context, exec bob.Executor, tag string) error
+	NextSequential(ctx context.Context, exec bob.Executor, workspaceID int64) (int64, error)
+}
+
+type UserRepo interface {
+	GetByUsername(ctx context.Context, exec bob.Executor, username string) (*auth.User, error)
+	Create(ctx context.Context, exec bob.Executor, toCreate *auth.User) error
+	UpsertPreferences(ctx context.Context, exec bob.Executor, user *auth.User) error
+}
+
+type WorkspaceRepo interface {
+	Create(ctx context.Context, exec bob.Executor, workspace *entities.Workspace) error
+}
+
+// Repos are the repositories of the database backend under test.
+type Repos struct {
+	Assets     AssetRepo
+	Files      FileRepo
+	Models     ModelRepo
+	Tags       TagRepo
+	Users      UserRepo
+	Workspaces WorkspaceRepo
+}
+
+// NewExecFunc returns an executor for a new, migrated and empty database. Foreign keys must not be enforced,
+// as the tests don't create all referenced rows.
+type NewExecFunc func(t *testing.T) bob.Executor
+
+// Run runs all repository tests as subtests of t, each with a new database.
+func Run(t *testing.T, newExec NewExecFunc, repos Repos) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, exec bob.Executor, repos Repos)
+	}{
+		{"AssetRepo_CRUD", testAssetRepoCRUD},
+		{"AssetRepo_ListPagination", testAssetRepoListPagination},
+		{"AssetRepo_SoftDelete", testAssetRepoSoftDelete},
+		{"AssetRepo_Search", testAssetRepoSearch},
+		{"AssetRepo_SearchRanked", testAssetRepoSearchRanked},
+		{"AssetRepo_ListFacets", testAssetRepoListFacets},
+		{"AssetRepo_ListFacets_WarrantyBoundary", testAssetRepoListFacetsWarrantyBoundary},
+		{"FileRepo_CRUD", testFileRepoCRUD},
+		{"ModelRepo_List", testModelRepoList},
+		{"TagRepo_CRUD", testTagRepoCRUD},
+		{"TagRepo_NextSequential", testTagRepoNextSequential},
+		{"TagRepo_NextSequential_PerWorkspace", testTagRepoNextSequentialPerWorkspace},
+		{"UserRepo_UpsertPreferences", testUserRepoUpsertPreferences},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newExec(t), repos)
+		})
+	}
+}
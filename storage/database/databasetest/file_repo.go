@@ -1,11 +1,10 @@
-package sqlite
+package databasetest
 
 import (
 	"context"
 	"crypto/sha256"
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/RobinThrift/stuff/entities"
 	"github.com/RobinThrift/stuff/storage/database"
@@ -13,11 +12,11 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestFileRepo_CRUD(t *testing.T) {
+func testFileRepoCRUD(t *testing.T, exec bob.Executor, repos Repos) {
 	ctx, cancel := context.WithCancel(context.Background())
 	t.Cleanup(cancel)
 
-	fr, exec := newTestFileRepo(t) // nolint: varnamelen // this is fine for the test
+	fr := repos.Files // nolint: varnamelen // this is fine for the test
 
 	for i := 0; i < 5; i++ {
 		f := newTestFile(t, i, 10)
@@ -32,10 +31,13 @@ func TestFileRepo_CRUD(t *testing.T) {
 	}
 
 	publicPath := newTestFile(t, 0, 10).PublicPath
-	fetchedByPublicPath, err := fr.GetByPublicPath(ctx, exec, publicPath)
+	fetchedByPublicPath, err := fr.GetByPublicPath(ctx, exec, 10, publicPath)
 	assert.NoError(t, err)
 	assert.Equal(t, publicPath, fetchedByPublicPath.PublicPath)
 
+	_, err = fr.GetByPublicPath(ctx, exec, 20, publicPath)
+	assert.ErrorIs(t, err, database.ErrFileNotFound)
+
 	list, err := fr.List(ctx, exec, database.ListFilesQuery{AssetID: 10})
 	assert.NoError(t, err)
 	assert.Len(t, list.Items, 5)
@@ -64,6 +66,14 @@ func TestFileRepo_CRUD(t *testing.T) {
 	assert.Len(t, list.Items, 2)
 	assert.Equal(t, list.Items[0].Sha256, list.Items[1].Sha256)
 
+	byHash, err := fr.GetByHash(ctx, exec, fileWithDuplicateHash.Sha256)
+	assert.NoError(t, err)
+	assert.Equal(t, list.Items[0].ID, byHash.ID)
+
+	refs, err := fr.CountReferences(ctx, exec, fileWithDuplicateHash.FullPath)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), refs)
+
 	fetched, err := fr.Get(ctx, exec, insertedID)
 	assert.NoError(t, err)
 
@@ -97,31 +107,3 @@ func newTestFile(t *testing.T, i int, assetID int64) *entities.File {
 		CreatedBy:  1,
 	}
 }
-
-func newTestFileRepo(t *testing.T) (*FileRepo, bob.Executor) {
-	db, err := NewSQLiteDB(&Config{File: ":memory:", Timeout: time.Millisecond * 500})
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	t.Cleanup(func() {
-		if err = db.Close(); err != nil {
-			t.Error(err)
-		}
-	})
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	_, err = db.ExecContext(ctx, "PRAGMA foreign_keys = 0")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	err = RunMigrations(ctx, db)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	return &FileRepo{}, bob.NewDB(db)
-}
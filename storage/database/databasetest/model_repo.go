@@ -1,10 +1,9 @@
-package sqlite
+package databasetest
 
 import (
 	"context"
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/RobinThrift/stuff/entities"
 	"github.com/RobinThrift/stuff/storage/database"
@@ -12,11 +11,11 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestModelRepoList(t *testing.T) {
+func testModelRepoList(t *testing.T, exec bob.Executor, repos Repos) {
 	ctx, cancel := context.WithCancel(context.Background())
 	t.Cleanup(cancel)
 
-	mr, exec := newTestModelRepo(t) // nolint: varnamelen // this is fine for the test
+	mr := repos.Models // nolint: varnamelen // this is fine for the test
 
 	models := []struct {
 		name string
@@ -32,7 +31,7 @@ func TestModelRepoList(t *testing.T) {
 		{"model-3", ""},
 	}
 
-	ar := &AssetRepo{}
+	ar := repos.Assets
 	for i := 0; i <= 100; i++ {
 		model := models[i%len(models)]
 		err := ar.Create(ctx, exec, &entities.Asset{
@@ -50,31 +49,3 @@ func TestModelRepoList(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, list.Items, 8)
 }
-
-func newTestModelRepo(t *testing.T) (*ModelRepo, bob.Executor) {
-	db, err := NewSQLiteDB(&Config{File: ":memory:", Timeout: time.Millisecond * 500})
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	t.Cleanup(func() {
-		if err = db.Close(); err != nil {
-			t.Error(err)
-		}
-	})
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	_, err = db.ExecContext(ctx, "PRAGMA foreign_keys = 0")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	err = RunMigrations(ctx, db)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	return &ModelRepo{}, bob.NewDB(db)
-}
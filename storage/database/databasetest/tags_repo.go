@@ -1,10 +1,9 @@
-package sqlite
+package databasetest
 
 import (
 	"context"
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/RobinThrift/stuff/entities"
 	"github.com/RobinThrift/stuff/storage/database"
@@ -12,18 +11,18 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestTagRepo_CRUD(t *testing.T) {
+func testTagRepoCRUD(t *testing.T, exec bob.Executor, repos Repos) {
 	ctx, cancel := context.WithCancel(context.Background())
 	t.Cleanup(cancel)
 
-	tr, exec := newTestTagRepo(t) // nolint: varnamelen // this is fine for the test
+	tr := repos.Tags // nolint: varnamelen // this is fine for the test
 
 	for i := 0; i < 10; i++ {
 		err := tr.Create(ctx, exec, &entities.Tag{Tag: fmt.Sprintf("tag-%d", i)})
 		assert.NoError(t, err)
 	}
 
-	unused, err := tr.GetUnused(ctx, exec)
+	unused, err := tr.GetUnused(ctx, exec, 0)
 	assert.NoError(t, err)
 	assert.NotNil(t, unused)
 
@@ -32,7 +31,7 @@ func TestTagRepo_CRUD(t *testing.T) {
 		assert.NoError(t, err)
 	}
 
-	unused, err = tr.GetUnused(ctx, exec)
+	unused, err = tr.GetUnused(ctx, exec, 0)
 	assert.NoError(t, err)
 	assert.Nil(t, unused)
 
@@ -41,7 +40,7 @@ func TestTagRepo_CRUD(t *testing.T) {
 		assert.NoError(t, err)
 	}
 
-	unused, err = tr.GetUnused(ctx, exec)
+	unused, err = tr.GetUnused(ctx, exec, 0)
 	assert.NoError(t, err)
 	assert.NotNil(t, unused)
 
@@ -61,41 +60,55 @@ func TestTagRepo_CRUD(t *testing.T) {
 	assert.Len(t, list.Items, 0)
 }
 
-func TestTagRepo_NextSequential(t *testing.T) {
+func testTagRepoNextSequential(t *testing.T, exec bob.Executor, repos Repos) {
 	ctx, cancel := context.WithCancel(context.Background())
 	t.Cleanup(cancel)
 
-	tr, exec := newTestTagRepo(t)
+	tr := repos.Tags
 
 	for i := 0; i < 10; i++ {
 		err := tr.Create(ctx, exec, &entities.Tag{Tag: fmt.Sprintf("tag-%d", i)})
 		assert.NoError(t, err)
 	}
 
-	next, err := tr.NextSequential(ctx, exec)
+	next, err := tr.NextSequential(ctx, exec, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(11), next)
 }
 
-func newTestTagRepo(t *testing.T) (*TagRepo, bob.Executor) {
-	db, err := NewSQLiteDB(&Config{File: ":memory:", Timeout: time.Millisecond * 500})
-	if err != nil {
-		t.Fatal(err)
-	}
+func testTagRepoNextSequentialPerWorkspace(t *testing.T, exec bob.Executor, repos Repos) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
 
-	t.Cleanup(func() {
-		if err = db.Close(); err != nil {
-			t.Error(err)
-		}
-	})
+	tr := repos.Tags
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	workspace := &entities.Workspace{Name: "Lab", TagPrefix: "LAB-"}
+	err := repos.Workspaces.Create(ctx, exec, workspace)
+	assert.NoError(t, err)
 
-	err = RunMigrations(ctx, db)
-	if err != nil {
-		t.Fatal(err)
+	for i := 0; i < 10; i++ {
+		err := tr.Create(ctx, exec, &entities.Tag{Tag: fmt.Sprintf("tag-%d", i)})
+		assert.NoError(t, err)
 	}
 
-	return &TagRepo{}, bob.NewDB(db)
+	for i := 0; i < 3; i++ {
+		err := tr.Create(ctx, exec, &entities.Tag{Tag: fmt.Sprintf("LAB-%d", i), WorkspaceID: workspace.ID})
+		assert.NoError(t, err)
+	}
+
+	next, err := tr.NextSequential(ctx, exec, workspace.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), next)
+
+	next, err = tr.NextSequential(ctx, exec, entities.DefaultWorkspaceID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), next)
+
+	unused, err := tr.GetUnused(ctx, exec, entities.DefaultWorkspaceID)
+	assert.NoError(t, err)
+	assert.Equal(t, entities.DefaultWorkspaceID, unused.WorkspaceID)
+
+	list, err := tr.List(ctx, exec, database.ListTagsQuery{WorkspaceID: workspace.ID})
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 3)
 }
@@ -1,17 +1,16 @@
-package sqlite
+package databasetest
 
 import (
 	"context"
 	"testing"
-	"time"
 
 	"github.com/RobinThrift/stuff/auth"
 	"github.com/stephenafamo/bob"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestUserRepo_UpsertPreferences(t *testing.T) {
-	userRepo, exec := newTestUserRepo(t)
+func testUserRepoUpsertPreferences(t *testing.T, exec bob.Executor, repos Repos) {
+	userRepo := repos.Users
 
 	prefs := []auth.UserPreferences{
 		{},
@@ -22,6 +21,7 @@ func TestUserRepo_UpsertPreferences(t *testing.T) {
 			AssetListColumns:     []string{"id", "tag", "name"},
 			AssetListCompact:     true,
 			UserListCompact:      true,
+			PinnedSavedSearches:  []int64{1, 2},
 		},
 		{
 			SidebarClosedDesktop: false,
@@ -30,6 +30,7 @@ func TestUserRepo_UpsertPreferences(t *testing.T) {
 			AssetListColumns:     []string{"id", "image", "name"},
 			AssetListCompact:     false,
 			UserListCompact:      false,
+			PinnedSavedSearches:  []int64{2},
 		},
 		{
 			SidebarClosedDesktop: true,
@@ -38,11 +39,13 @@ func TestUserRepo_UpsertPreferences(t *testing.T) {
 			AssetListColumns:     []string{"id", "tag", "name"},
 			AssetListCompact:     true,
 			UserListCompact:      true,
+			PinnedSavedSearches:  []int64{1, 2},
 		},
 		{
-			ThemeName:        "default",
-			ThemeMode:        "dark",
-			AssetListColumns: []string{},
+			ThemeName:           "default",
+			ThemeMode:           "dark",
+			AssetListColumns:    []string{},
+			PinnedSavedSearches: []int64{},
 		},
 	}
 
@@ -52,7 +55,7 @@ func TestUserRepo_UpsertPreferences(t *testing.T) {
 	err := userRepo.Create(ctx, exec, &auth.User{
 		Username:               "testuser",
 		DisplayName:            "testuser",
-		IsAdmin:                true,
+		Role:                   auth.RoleAdmin,
 		RequiresPasswordChange: false,
 		AuthRef:                "testuser",
 	})
@@ -73,26 +76,3 @@ func TestUserRepo_UpsertPreferences(t *testing.T) {
 		assert.Equal(t, prefs, user.Preferences, i)
 	}
 }
-
-func newTestUserRepo(t *testing.T) (*UserRepo, bob.Executor) {
-	db, err := NewSQLiteDB(&Config{File: ":memory:", Timeout: time.Millisecond * 500})
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	t.Cleanup(func() {
-		if err = db.Close(); err != nil {
-			t.Error(err)
-		}
-	})
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	err = RunMigrations(ctx, db)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	return &UserRepo{}, bob.NewDB(db)
-}
@@ -0,0 +1,21 @@
+package database
+
+import "errors"
+
+// Errors returned by the repositories of all database backends.
+var (
+	ErrAPITokenNotFound      = errors.New("API token not found")
+	ErrAssetEventNotFound    = errors.New("asset event not found")
+	ErrAssetNotFound         = errors.New("asset not found")
+	ErrCreatingAsset         = errors.New("error creating asset")
+	ErrCheckoutNotFound      = errors.New("checkout not found")
+	ErrCreatingCheckout      = errors.New("error creating checkout")
+	ErrFileNotFound          = errors.New("file not found")
+	ErrCreatingFile          = errors.New("error creating file")
+	ErrLocalAuthUserNotFound = errors.New("user for local auth not found")
+	ErrPhotoNotFound         = errors.New("photo not found")
+	ErrCreatingPhoto         = errors.New("error creating photo")
+	ErrSavedSearchNotFound   = errors.New("saved search not found")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrWorkspaceNotFound     = errors.New("workspace not found")
+)
@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+)
+
+type APITokenRepo struct{}
+
+type apiTokenRow struct {
+	ID         int64        `db:"id"`
+	UserID     int64        `db:"user_id"`
+	Name       string       `db:"name"`
+	TokenHash  []byte       `db:"token_hash"`
+	Scope      string       `db:"scope"`
+	ExpiresAt  sql.NullTime `db:"expires_at"`
+	LastUsedAt sql.NullTime `db:"last_used_at"`
+	CreatedAt  time.Time    `db:"created_at"`
+	UpdatedAt  time.Time    `db:"updated_at"`
+}
+
+func (*APITokenRepo) ListForUser(ctx context.Context, exec bob.Executor, userID int64) ([]*auth.APIToken, error) {
+	tokens, err := bob.All(ctx, exec, psql.Select(
+		sm.From("api_tokens"),
+		sm.Where(psql.Quote("user_id").EQ(psql.Arg(userID))),
+		sm.OrderBy("id").Desc(),
+	), scan.StructMapper[*apiTokenRow]())
+	if err != nil {
+		return nil, fmt.Errorf("error getting API tokens for user %d: %w", userID, err)
+	}
+
+	list := make([]*auth.APIToken, 0, len(tokens))
+	for _, t := range tokens {
+		list = append(list, mapDBRowToAPIToken(t))
+	}
+
+	return list, nil
+}
+
+func (*APITokenRepo) GetByHash(ctx context.Context, exec bob.Executor, hash []byte) (*auth.APIToken, error) {
+	token, err := bob.One(ctx, exec, psql.Select(
+		sm.From("api_tokens"),
+		sm.Where(psql.Quote("token_hash").EQ(psql.Arg(hash))),
+	), scan.StructMapper[*apiTokenRow]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, database.ErrAPITokenNotFound
+		}
+		return nil, fmt.Errorf("error getting API token: %w", err)
+	}
+
+	return mapDBRowToAPIToken(token), nil
+}
+
+func (*APITokenRepo) Create(ctx context.Context, exec bob.Executor, token *auth.APIToken) error {
+	inserted, err := bob.One(ctx, exec, psql.Insert(
+		im.Into("api_tokens", "user_id", "name", "token_hash", "scope", "expires_at"),
+		im.Values(psql.Arg(token.UserID, token.Name, token.Hash, string(token.Scope), nullTime(token.ExpiresAt))),
+		im.Returning("*"),
+	), scan.StructMapper[*apiTokenRow]())
+	if err != nil {
+		return fmt.Errorf("error creating API token: %w", err)
+	}
+
+	token.ID = inserted.ID
+	token.CreatedAt = inserted.CreatedAt
+	token.UpdatedAt = inserted.UpdatedAt
+
+	return nil
+}
+
+func (*APITokenRepo) SetLastUsedAt(ctx context.Context, exec bob.Executor, id int64, at time.Time) error {
+	_, err := bob.Exec(ctx, exec, psql.Update(
+		um.Table("api_tokens"),
+		um.Set("last_used_at").ToArg(nullTime(at)),
+		um.Set("updated_at").ToArg(time.Now()),
+		um.Where(psql.Quote("id").EQ(psql.Arg(id))),
+	))
+	if err != nil {
+		return fmt.Errorf("error updating last use of API token %d: %w", id, err)
+	}
+
+	return nil
+}
+
+func (*APITokenRepo) Delete(ctx context.Context, exec bob.Executor, userID int64, id int64) error {
+	res, err := bob.Exec(ctx, exec, psql.Delete(
+		dm.From("api_tokens"),
+		dm.Where(psql.Quote("id").EQ(psql.Arg(id))),
+		dm.Where(psql.Quote("user_id").EQ(psql.Arg(userID))),
+	))
+	if err != nil {
+		return fmt.Errorf("error deleting API token %d: %w", id, err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error deleting API token %d: %w", id, err)
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("%w: %d", database.ErrAPITokenNotFound, id)
+	}
+
+	return nil
+}
+
+func mapDBRowToAPIToken(row *apiTokenRow) *auth.APIToken {
+	return &auth.APIToken{
+		ID:         row.ID,
+		UserID:     row.UserID,
+		Name:       row.Name,
+		Scope:      auth.APITokenScope(row.Scope),
+		Hash:       row.TokenHash,
+		ExpiresAt:  row.ExpiresAt.Time,
+		LastUsedAt: row.LastUsedAt.Time,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+}
@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/scan"
+)
+
+type AssetEventRepo struct{}
+
+type assetEventRow struct {
+	ID        int64          `db:"id"`
+	AssetID   int64          `db:"asset_id"`
+	Type      string         `db:"type"`
+	Changes   string         `db:"changes"`
+	Snapshot  sql.NullString `db:"snapshot"`
+	UserID    sql.NullInt64  `db:"user_id"`
+	RequestID sql.NullString `db:"request_id"`
+	CreatedAt time.Time      `db:"created_at"`
+
+	UserDisplayName string `db:"user_display_name"`
+	UserUsername    string `db:"user_username"`
+}
+
+func selectAssetEvents(qmods ...bob.Mod[*dialect.SelectQuery]) bob.BaseQuery[*dialect.SelectQuery] {
+	return psql.Select(append([]bob.Mod[*dialect.SelectQuery]{
+		sm.Columns(
+			"asset_events.*",
+			"coalesce(users.display_name, '') AS user_display_name",
+			"coalesce(users.username, '') AS user_username",
+		),
+		sm.From("asset_events"),
+		sm.LeftJoin("users").On(psql.Quote("users", "id").EQ(psql.Quote("asset_events", "user_id"))),
+	}, qmods...)...)
+}
+
+func (aer *AssetEventRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*entities.AssetEvent, error) {
+	event, err := bob.One(ctx, exec, selectAssetEvents(
+		sm.Where(psql.Quote("asset_events", "id").EQ(psql.Arg(id))),
+	), scan.StructMapper[*assetEventRow]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %d", database.ErrAssetEventNotFound, id)
+		}
+		return nil, fmt.Errorf("error getting asset event %d: %w", id, err)
+	}
+
+	return mapDBRowToAssetEvent(event)
+}
+
+func (aer *AssetEventRepo) List(ctx context.Context, exec bob.Executor, query database.ListAssetEventsQuery) (*entities.ListPage[*entities.AssetEvent], error) {
+	limit, offset := limitOffset(query.Page, query.PageSize)
+
+	count, err := bob.One(ctx, exec, psql.Select(
+		sm.Columns("count(*)"),
+		sm.From("asset_events"),
+		sm.Where(psql.Quote("asset_id").EQ(psql.Arg(query.AssetID))),
+	), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return nil, fmt.Errorf("error counting asset events: %w", err)
+	}
+
+	events, err := bob.All(ctx, exec, selectAssetEvents(
+		sm.Where(psql.Quote("asset_events", "asset_id").EQ(psql.Arg(query.AssetID))),
+		sm.OrderBy(psql.Quote("asset_events", "id")).Desc(),
+		sm.Limit(limit),
+		sm.Offset(offset),
+	), scan.StructMapper[*assetEventRow]())
+	if err != nil {
+		return nil, fmt.Errorf("error getting asset events: %w", err)
+	}
+
+	numPages, pageSize := calcNumPages(query.PageSize, count)
+	page := &entities.ListPage[*entities.AssetEvent]{
+		Items:    make([]*entities.AssetEvent, 0, len(events)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: pageSize,
+		NumPages: numPages,
+	}
+
+	for i := range events {
+		event, err := mapDBRowToAssetEvent(events[i])
+		if err != nil {
+			return nil, err
+		}
+		page.Items = append(page.Items, event)
+	}
+
+	return page, nil
+}
+
+func (aer *AssetEventRepo) Create(ctx context.Context, exec bob.Executor, event *entities.AssetEvent) error {
+	changes, err := json.Marshal(event.Changes)
+	if err != nil {
+		return fmt.Errorf("error encoding asset event changes: %w", err)
+	}
+
+	var snapshot sql.NullString
+	if event.Snapshot != nil {
+		encoded, err := json.Marshal(event.Snapshot)
+		if err != nil {
+			return fmt.Errorf("error encoding asset event snapshot: %w", err)
+		}
+		snapshot = nullStr(string(encoded))
+	}
+
+	inserted, err := bob.One(ctx, exec, psql.Insert(
+		im.Into("asset_events", "asset_id", "type", "changes", "user_id", "request_id", "snapshot"),
+		im.Values(psql.Arg(event.AssetID, string(event.Type), string(changes), nullInt64(event.UserID), nullStr(event.RequestID), snapshot)),
+		im.Returning("id", "created_at"),
+	), scan.StructMapper[struct {
+		ID        int64     `db:"id"`
+		CreatedAt time.Time `db:"created_at"`
+	}]())
+	if err != nil {
+		return fmt.Errorf("error creating asset event: %w", err)
+	}
+
+	event.ID = inserted.ID
+	event.CreatedAt = inserted.CreatedAt
+
+	return nil
+}
+
+func mapDBRowToAssetEvent(row *assetEventRow) (*entities.AssetEvent, error) {
+	event := &entities.AssetEvent{
+		ID:        row.ID,
+		AssetID:   row.AssetID,
+		Type:      entities.AssetEventType(row.Type),
+		UserID:    row.UserID.Int64,
+		RequestID: row.RequestID.String,
+		CreatedAt: row.CreatedAt,
+	}
+
+	err := json.Unmarshal([]byte(row.Changes), &event.Changes)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding changes of asset event %d: %w", row.ID, err)
+	}
+
+	if row.Snapshot.Valid {
+		err = json.Unmarshal([]byte(row.Snapshot.String), &event.Snapshot)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding snapshot of asset event %d: %w", row.ID, err)
+		}
+	}
+
+	event.UserName = row.UserDisplayName
+	if event.UserName == "" {
+		event.UserName = row.UserUsername
+	}
+
+	return event, nil
+}
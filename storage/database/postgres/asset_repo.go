@@ -0,0 +1,791 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+)
+
+type AssetRepo struct{}
+
+// assetColumns are all columns of the assets table except for the generated search vector.
+var assetColumns = []string{
+	"id", "workspace_id", "parent_asset_id", "type", "status",
+	"tag", "name", "category", "model", "model_no", "serial_no", "manufacturer", "notes",
+	"image_url", "thumbnail_url", "preview_url", "warranty_until", "custom_attrs",
+	"quantity", "quantity_unit", "checked_out_to", "location", "position_code", "parts_total_counter",
+	"created_by", "created_at", "updated_at", "deleted_at",
+}
+
+type assetRow struct {
+	ID                int64          `db:"id"`
+	WorkspaceID       int64          `db:"workspace_id"`
+	ParentAssetID     sql.NullInt64  `db:"parent_asset_id"`
+	Type              string         `db:"type"`
+	Status            string         `db:"status"`
+	Tag               sql.NullString `db:"tag"`
+	Name              string         `db:"name"`
+	Category          string         `db:"category"`
+	Model             sql.NullString `db:"model"`
+	ModelNo           sql.NullString `db:"model_no"`
+	SerialNo          sql.NullString `db:"serial_no"`
+	Manufacturer      sql.NullString `db:"manufacturer"`
+	Notes             sql.NullString `db:"notes"`
+	ImageURL          sql.NullString `db:"image_url"`
+	ThumbnailURL      sql.NullString `db:"thumbnail_url"`
+	PreviewURL        sql.NullString `db:"preview_url"`
+	WarrantyUntil     sql.NullTime   `db:"warranty_until"`
+	CustomAttrs       sql.NullString `db:"custom_attrs"`
+	Quantity          uint64         `db:"quantity"`
+	QuantityUnit      sql.NullString `db:"quantity_unit"`
+	CheckedOutTo      sql.NullInt64  `db:"checked_out_to"`
+	Location          sql.NullString `db:"location"`
+	PositionCode      sql.NullString `db:"position_code"`
+	PartsTotalCounter int64          `db:"parts_total_counter"`
+	CreatedBy         int64          `db:"created_by"`
+	CreatedAt         time.Time      `db:"created_at"`
+	UpdatedAt         time.Time      `db:"updated_at"`
+	DeletedAt         sql.NullTime   `db:"deleted_at"`
+}
+
+type partRow struct {
+	ID           int64          `db:"id"`
+	AssetID      int64          `db:"asset_id"`
+	Tag          string         `db:"tag"`
+	Name         string         `db:"name"`
+	Location     sql.NullString `db:"location"`
+	PositionCode sql.NullString `db:"position_code"`
+	Notes        sql.NullString `db:"notes"`
+	CreatedBy    int64          `db:"created_by"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+}
+
+type purchaseRow struct {
+	ID        int64          `db:"id"`
+	AssetID   int64          `db:"asset_id"`
+	Supplier  sql.NullString `db:"supplier"`
+	OrderNo   sql.NullString `db:"order_no"`
+	OrderDate sql.NullTime   `db:"order_date"`
+	Amount    sql.NullInt64  `db:"amount"`
+	Currency  sql.NullString `db:"currency"`
+	CreatedBy int64          `db:"created_by"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
+
+// assetRelations are the optionally loaded relations of assets, keyed by the asset's ID.
+type assetRelations struct {
+	parts     map[int64][]*partRow
+	purchases map[int64][]*purchaseRow
+	files     map[int64][]*fileRow
+	photos    map[int64][]*photoRow
+	parents   map[int64]*assetRow
+	children  []*assetRow
+}
+
+func selectAssets(qmods ...bob.Mod[*dialect.SelectQuery]) bob.BaseQuery[*dialect.SelectQuery] {
+	columns := make([]any, 0, len(assetColumns))
+	for _, c := range assetColumns {
+		columns = append(columns, psql.Quote("assets", c))
+	}
+
+	return psql.Select(append([]bob.Mod[*dialect.SelectQuery]{sm.Columns(columns...), sm.From("assets")}, qmods...)...)
+}
+
+func (ar *AssetRepo) Get(ctx context.Context, exec bob.Executor, query database.GetAssetQuery) (*entities.Asset, error) {
+	qmods := make([]bob.Mod[*dialect.SelectQuery], 0, 3)
+
+	switch {
+	case query.Tag != "" && query.ID != 0:
+		qmods = append(qmods, sm.Where(psql.Or(
+			psql.Quote("tag").EQ(psql.Arg(query.Tag)),
+			psql.Quote("id").EQ(psql.Arg(query.ID)),
+		)))
+	case query.Tag != "":
+		qmods = append(qmods, sm.Where(psql.Quote("tag").EQ(psql.Arg(query.Tag))))
+	case query.ID != 0:
+		qmods = append(qmods, sm.Where(psql.Quote("id").EQ(psql.Arg(query.ID))))
+	}
+
+	if !query.IncludeDeleted {
+		qmods = append(qmods, sm.Where(psql.Quote("deleted_at").IsNull()))
+	}
+
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, sm.Where(psql.Quote("workspace_id").EQ(psql.Arg(query.WorkspaceID))))
+	}
+
+	asset, err := bob.One(ctx, exec, selectAssets(append(qmods, sm.Limit(1))...), scan.StructMapper[*assetRow]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, database.ErrAssetNotFound
+		}
+		return nil, fmt.Errorf("error getting assets: %w", err)
+	}
+
+	rel, err := loadAssetRelations(ctx, exec, []*assetRow{asset}, relationsToLoad{
+		parts:     query.IncludeParts,
+		purchases: query.IncludePurchases,
+		files:     query.IncludeFiles,
+		photos:    query.IncludePhotos,
+		parent:    query.IncludeParent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if query.IncludeChildren {
+		rel.children, err = bob.All(ctx, exec, selectAssets(
+			sm.Where(psql.Quote("parent_asset_id").EQ(psql.Arg(asset.ID))),
+			sm.Where(psql.Quote("deleted_at").IsNull()),
+			sm.OrderBy("id"),
+		), scan.StructMapper[*assetRow]())
+		if err != nil {
+			return nil, fmt.Errorf("error getting asset children: %w", err)
+		}
+	}
+
+	return mapDBRowToAsset(asset, rel), nil
+}
+
+func (ar *AssetRepo) List(ctx context.Context, exec bob.Executor, query database.ListAssetsQuery) (*entities.ListPage[*entities.Asset], error) {
+	assets, count, err := listAssets(ctx, exec, query)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := loadAssetRelations(ctx, exec, assets, relationsToLoad{
+		parts:     query.IncludeParts,
+		purchases: query.IncludePurchases,
+		files:     query.IncludeFiles,
+		parent:    query.IncludeParent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var snippets map[int64]string
+	if query.Search != nil && len(assets) != 0 {
+		snippets, err = searchSnippets(ctx, exec, query.Search, assets)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	numPages, pageSize := calcNumPages(query.PageSize, count)
+	page := &entities.ListPage[*entities.Asset]{
+		Items:    make([]*entities.Asset, 0, len(assets)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: pageSize,
+		NumPages: numPages,
+	}
+
+	for i := range assets {
+		asset := mapDBRowToAsset(assets[i], rel)
+		asset.Snippet = snippets[asset.ID]
+		page.Items = append(page.Items, asset)
+	}
+
+	return page, nil
+}
+
+func (ar *AssetRepo) Create(ctx context.Context, exec bob.Executor, asset *entities.Asset) error {
+	workspaceID := asset.WorkspaceID
+	if workspaceID == 0 {
+		workspaceID = entities.DefaultWorkspaceID
+	}
+
+	customAttrs, err := marshalCustomAttrs(asset.CustomAttrs)
+	if err != nil {
+		return fmt.Errorf("%w: %w", database.ErrCreatingAsset, err)
+	}
+
+	id, err := bob.One(ctx, exec, psql.Insert(
+		im.Into("assets",
+			"parent_asset_id", "status", "tag", "name", "category", "model", "model_no", "serial_no", "manufacturer", "notes",
+			"image_url", "thumbnail_url", "preview_url", "warranty_until", "custom_attrs", "checked_out_to", "location", "position_code",
+			"parts_total_counter", "created_by", "type", "quantity", "quantity_unit", "workspace_id",
+		),
+		im.Values(psql.Arg(
+			nullInt64(asset.ParentAssetID), string(asset.Status), nullStr(asset.Tag), asset.Name, asset.Category,
+			nullStr(asset.Model), nullStr(asset.ModelNo), nullStr(asset.SerialNo), nullStr(asset.Manufacturer), nullStr(asset.Notes),
+			nullStr(asset.ImageURL), nullStr(asset.ThumbnailURL), nullStr(asset.PreviewURL), nullTime(asset.WarrantyUntil), customAttrs,
+			nullInt64(asset.CheckedOutTo), nullStr(asset.Location), nullStr(asset.PositionCode),
+			int64(max(asset.PartsTotalCounter, len(asset.Parts))), asset.MetaInfo.CreatedBy, string(asset.Type), asset.Quantity, nullStr(asset.QuantityUnit), workspaceID,
+		)),
+		im.Returning("id"),
+	), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return fmt.Errorf("%w: %w", database.ErrCreatingAsset, err)
+	}
+
+	err = createParts(ctx, exec, id, asset.Parts)
+	if err != nil {
+		return fmt.Errorf("%w: error creating parts: %w", database.ErrCreatingAsset, err)
+	}
+
+	asset.ID = id
+	asset.WorkspaceID = workspaceID
+
+	err = createPurchases(ctx, exec, asset, asset.Purchases)
+	if err != nil {
+		return fmt.Errorf("%w: error creating purchases: %w", database.ErrCreatingAsset, err)
+	}
+	return nil
+}
+
+func (ar *AssetRepo) Update(ctx context.Context, exec bob.Executor, asset *entities.Asset) error {
+	customAttrs, err := marshalCustomAttrs(asset.CustomAttrs)
+	if err != nil {
+		return err
+	}
+
+	umods := []bob.Mod[*dialect.UpdateQuery]{
+		um.Table("assets"),
+		um.Set("parent_asset_id").ToArg(nullInt64(asset.ParentAssetID)),
+		um.Set("status").ToArg(string(asset.Status)),
+		um.Set("tag").ToArg(nullStr(asset.Tag)),
+		um.Set("name").ToArg(asset.Name),
+		um.Set("category").ToArg(asset.Category),
+		um.Set("model").ToArg(nullStr(asset.Model)),
+		um.Set("model_no").ToArg(nullStr(asset.ModelNo)),
+		um.Set("serial_no").ToArg(nullStr(asset.SerialNo)),
+		um.Set("manufacturer").ToArg(nullStr(asset.Manufacturer)),
+		um.Set("notes").ToArg(nullStr(asset.Notes)),
+		um.Set("image_url").ToArg(nullStr(asset.ImageURL)),
+		um.Set("thumbnail_url").ToArg(nullStr(asset.ThumbnailURL)),
+		um.Set("preview_url").ToArg(nullStr(asset.PreviewURL)),
+		um.Set("warranty_until").ToArg(nullTime(asset.WarrantyUntil)),
+		um.Set("custom_attrs").ToArg(customAttrs),
+		um.Set("checked_out_to").ToArg(nullInt64(asset.CheckedOutTo)),
+		um.Set("location").ToArg(nullStr(asset.Location)),
+		um.Set("position_code").ToArg(nullStr(asset.PositionCode)),
+		um.Set("parts_total_counter").ToArg(int64(max(asset.PartsTotalCounter, len(asset.Parts)))),
+		um.Set("type").ToArg(string(asset.Type)),
+		um.Set("quantity").ToArg(asset.Quantity),
+		um.Set("quantity_unit").ToArg(nullStr(asset.QuantityUnit)),
+		um.Set("updated_at").ToArg(time.Now()),
+		um.Where(psql.Quote("id").EQ(psql.Arg(asset.ID))),
+	}
+
+	// the workspace is left unchanged if it is not set
+	if asset.WorkspaceID != 0 {
+		umods = append(umods, um.Set("workspace_id").ToArg(asset.WorkspaceID))
+	}
+
+	_, err = bob.Exec(ctx, exec, psql.Update(umods...))
+	if err != nil {
+		return err
+	}
+
+	err = deleteParts(ctx, exec, asset.ID)
+	if err != nil {
+		return err
+	}
+
+	err = createParts(ctx, exec, asset.ID, asset.Parts)
+	if err != nil {
+		return err
+	}
+
+	return updatePurchases(ctx, exec, asset)
+}
+
+// SetDeletedAt moves the asset to the trash, or restores it from there if at is the zero value.
+func (ar *AssetRepo) SetDeletedAt(ctx context.Context, exec bob.Executor, id int64, at time.Time) error {
+	_, err := bob.Exec(ctx, exec, psql.Update(
+		um.Table("assets"),
+		um.Set("deleted_at").ToArg(nullTime(at)),
+		um.Set("updated_at").ToArg(time.Now()),
+		um.Where(psql.Quote("id").EQ(psql.Arg(id))),
+	))
+	return err
+}
+
+// SetImage sets the URLs of the asset's image, or removes the image if they are empty.
+func (ar *AssetRepo) SetImage(ctx context.Context, exec bob.Executor, id int64, imageURL string, thumbnailURL string, previewURL string) error {
+	_, err := bob.Exec(ctx, exec, psql.Update(
+		um.Table("assets"),
+		um.Set("image_url").ToArg(nullStr(imageURL)),
+		um.Set("thumbnail_url").ToArg(nullStr(thumbnailURL)),
+		um.Set("preview_url").ToArg(nullStr(previewURL)),
+		um.Where(psql.Quote("id").EQ(psql.Arg(id))),
+	))
+	return err
+}
+
+// Delete deletes the asset with its purchases and parts. They are deleted first, as their foreign keys are checked immediately.
+func (ar *AssetRepo) Delete(ctx context.Context, exec bob.Executor, id int64) error {
+	err := deletePurchases(ctx, exec, id)
+	if err != nil {
+		return err
+	}
+
+	err = deleteParts(ctx, exec, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = bob.Exec(ctx, exec, psql.Delete(dm.From("assets"), dm.Where(psql.Quote("id").EQ(psql.Arg(id)))))
+	return err
+}
+
+func listAssets(ctx context.Context, exec bob.Executor, query database.ListAssetsQuery) ([]*assetRow, int64, error) {
+	limit := query.PageSize
+	offset := limit * query.Page
+
+	qmods, err := assetConditions(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var rankQuery string
+	var ranked bool
+	if query.Search != nil {
+		rankQuery, ranked = searchRankQuery(query.Search)
+	}
+
+	count, err := bob.One(ctx, exec, psql.Select(append(qmods, sm.Columns("count(*)"), sm.From("assets"))...), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return nil, 0, fmt.Errorf("error counting assets: %w", err)
+	}
+
+	if query.OrderBy != "" {
+		if query.OrderDir == "" {
+			query.OrderDir = database.OrderASC
+		}
+
+		qmods = append(qmods, orderByClause("assets", query.OrderBy, query.OrderDir))
+	} else if ranked {
+		qmods = append(qmods, sm.OrderBy(psql.Raw("ts_rank_cd(assets.search, to_tsquery('simple', ?))", rankQuery)).Desc())
+	}
+
+	qmods = append(qmods, sm.OrderBy(psql.Quote("assets", "id")))
+
+	if limit > 0 {
+		qmods = append(qmods, sm.Limit(limit))
+	}
+
+	if offset > 0 {
+		qmods = append(qmods, sm.Offset(offset))
+	}
+
+	assets, err := bob.All(ctx, exec, selectAssets(qmods...), scan.StructMapper[*assetRow]())
+	if err != nil {
+		return nil, 0, fmt.Errorf("error getting assets: %w", err)
+	}
+
+	return assets, count, nil
+}
+
+// assetConditions returns the conditions on the assets table shared by the list of assets and the facets.
+func assetConditions(query database.ListAssetsQuery) ([]bob.Mod[*dialect.SelectQuery], error) {
+	qmods := make([]bob.Mod[*dialect.SelectQuery], 0, 3)
+
+	if query.OnlyDeleted {
+		qmods = append(qmods, sm.Where(psql.Quote("deleted_at").IsNotNull()))
+	} else {
+		qmods = append(qmods, sm.Where(psql.Quote("deleted_at").IsNull()))
+	}
+
+	if !query.DeletedBefore.IsZero() {
+		qmods = append(qmods, sm.Where(psql.Quote("deleted_at").LT(psql.Arg(query.DeletedBefore))))
+	}
+
+	if len(query.IDs) != 0 {
+		qmods = append(qmods, sm.Where(psql.Quote("id").In(int64Args(query.IDs)...)))
+	}
+
+	if query.MissingImageVariants {
+		qmods = append(qmods, sm.Where(psql.Quote("image_url").IsNotNull()), sm.Where(psql.Quote("preview_url").IsNull()))
+	}
+
+	if query.AssetType != "" {
+		qmods = append(qmods, sm.Where(psql.Quote("type").EQ(psql.Arg(query.AssetType))))
+	}
+
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, sm.Where(psql.Quote("workspace_id").EQ(psql.Arg(query.WorkspaceID))))
+	}
+
+	if query.Search != nil {
+		condition, err := searchCondition(query.Search)
+		if err != nil {
+			return nil, err
+		}
+
+		qmods = append(qmods, sm.Where(condition))
+	}
+
+	qmods = append(qmods, filterConditions(query.Filters)...)
+
+	return qmods, nil
+}
+
+type relationsToLoad struct {
+	parts     bool
+	purchases bool
+	files     bool
+	photos    bool
+	parent    bool
+}
+
+func loadAssetRelations(ctx context.Context, exec bob.Executor, assets []*assetRow, load relationsToLoad) (*assetRelations, error) {
+	rel := &assetRelations{
+		parts:     map[int64][]*partRow{},
+		purchases: map[int64][]*purchaseRow{},
+		files:     map[int64][]*fileRow{},
+		photos:    map[int64][]*photoRow{},
+		parents:   map[int64]*assetRow{},
+	}
+
+	if len(assets) == 0 {
+		return rel, nil
+	}
+
+	ids := make([]int64, 0, len(assets))
+	parentIDs := make([]int64, 0)
+	for _, a := range assets {
+		ids = append(ids, a.ID)
+		if a.ParentAssetID.Valid {
+			parentIDs = append(parentIDs, a.ParentAssetID.Int64)
+		}
+	}
+
+	byAssetID := sm.Where(psql.Quote("asset_id").In(int64Args(ids)...))
+
+	if load.parts {
+		parts, err := bob.All(ctx, exec, psql.Select(sm.From("asset_parts"), byAssetID, sm.OrderBy("id")), scan.StructMapper[*partRow]())
+		if err != nil {
+			return nil, fmt.Errorf("error getting asset parts: %w", err)
+		}
+
+		for _, p := range parts {
+			rel.parts[p.AssetID] = append(rel.parts[p.AssetID], p)
+		}
+	}
+
+	if load.purchases {
+		purchases, err := bob.All(ctx, exec, psql.Select(sm.From("asset_purchases"), byAssetID, sm.OrderBy("id")), scan.StructMapper[*purchaseRow]())
+		if err != nil {
+			return nil, fmt.Errorf("error getting asset purchases: %w", err)
+		}
+
+		for _, p := range purchases {
+			rel.purchases[p.AssetID] = append(rel.purchases[p.AssetID], p)
+		}
+	}
+
+	if load.files {
+		// thumbnails, previews and photos are part of the photo gallery and not listed as files
+		files, err := bob.All(ctx, exec, psql.Select(
+			sm.From("asset_files"),
+			byAssetID,
+			sm.Where(psql.Quote("variant").EQ(psql.Arg(string(entities.FileVariantOriginal)))),
+			sm.Where(psql.Raw("asset_files.id NOT IN (SELECT file_id FROM asset_photos)")),
+			sm.OrderBy("id"),
+		), scan.StructMapper[*fileRow]())
+		if err != nil {
+			return nil, fmt.Errorf("error getting asset files: %w", err)
+		}
+
+		for _, f := range files {
+			rel.files[f.AssetID] = append(rel.files[f.AssetID], f)
+		}
+	}
+
+	if load.photos {
+		photos, err := listPhotos(ctx, exec, ids)
+		if err != nil {
+			return nil, fmt.Errorf("error getting asset photos: %w", err)
+		}
+
+		for _, p := range photos {
+			rel.photos[p.AssetID] = append(rel.photos[p.AssetID], p)
+		}
+	}
+
+	if load.parent && len(parentIDs) != 0 {
+		parents, err := bob.All(ctx, exec, selectAssets(sm.Where(psql.Quote("id").In(int64Args(parentIDs)...))), scan.StructMapper[*assetRow]())
+		if err != nil {
+			return nil, fmt.Errorf("error getting parent assets: %w", err)
+		}
+
+		for _, p := range parents {
+			rel.parents[p.ID] = p
+		}
+	}
+
+	return rel, nil
+}
+
+func createPurchases(ctx context.Context, exec bob.Executor, asset *entities.Asset, purchases []*entities.Purchase) error {
+	if len(purchases) == 0 {
+		return nil
+	}
+
+	rows := make([][]bob.Expression, 0, len(purchases))
+	for _, p := range purchases {
+		rows = append(rows, []bob.Expression{
+			psql.Arg(asset.ID),
+			psql.Arg(nullStr(p.Supplier)),
+			psql.Arg(nullStr(p.OrderNo)),
+			psql.Arg(nullTime(p.Date)),
+			psql.Arg(nullInt64(int64(p.Amount))),
+			psql.Arg(nullStr(p.Currency)),
+			psql.Arg(asset.MetaInfo.CreatedBy),
+			psql.Arg(time.Now()),
+		})
+	}
+
+	_, err := bob.Exec(ctx, exec, psql.Insert(
+		im.Into("asset_purchases", "asset_id", "supplier", "order_no", "order_date", "amount", "currency", "created_by", "updated_at"),
+		im.Rows(rows...),
+	))
+	return err
+}
+
+// updatePurchases updates the existing purchases in place, so they keep their IDs, adds the new ones and deletes
+// those that are no longer part of the asset.
+func updatePurchases(ctx context.Context, exec bob.Executor, asset *entities.Asset) error {
+	existing, err := bob.All(ctx, exec, psql.Select(
+		sm.Columns("id"),
+		sm.From("asset_purchases"),
+		sm.Where(psql.Quote("asset_id").EQ(psql.Arg(asset.ID))),
+	), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return err
+	}
+
+	removed := make(map[int64]struct{}, len(existing))
+	for _, id := range existing {
+		removed[id] = struct{}{}
+	}
+
+	for _, p := range asset.Purchases {
+		if _, ok := removed[p.ID]; ok {
+			delete(removed, p.ID)
+			_, err = bob.Exec(ctx, exec, psql.Update(
+				um.Table("asset_purchases"),
+				um.Set("supplier").ToArg(nullStr(p.Supplier)),
+				um.Set("order_no").ToArg(nullStr(p.OrderNo)),
+				um.Set("order_date").ToArg(nullTime(p.Date)),
+				um.Set("amount").ToArg(nullInt64(int64(p.Amount))),
+				um.Set("currency").ToArg(nullStr(p.Currency)),
+				um.Set("updated_at").ToArg(time.Now()),
+				um.Where(psql.Quote("id").EQ(psql.Arg(p.ID))),
+			))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		p.ID, err = bob.One(ctx, exec, psql.Insert(
+			im.Into("asset_purchases", "asset_id", "supplier", "order_no", "order_date", "amount", "currency", "created_by", "updated_at"),
+			im.Values(psql.Arg(
+				asset.ID, nullStr(p.Supplier), nullStr(p.OrderNo), nullTime(p.Date), nullInt64(int64(p.Amount)), nullStr(p.Currency),
+				asset.MetaInfo.CreatedBy, time.Now(),
+			)),
+			im.Returning("id"),
+		), scan.SingleColumnMapper[int64])
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(removed) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(removed))
+	for id := range removed {
+		ids = append(ids, id)
+	}
+
+	_, err = bob.Exec(ctx, exec, psql.Delete(dm.From("asset_purchases"), dm.Where(psql.Quote("id").In(int64Args(ids)...))))
+	return err
+}
+
+func deletePurchases(ctx context.Context, exec bob.Executor, assetID int64) error {
+	_, err := bob.Exec(ctx, exec, psql.Delete(dm.From("asset_purchases"), dm.Where(psql.Quote("asset_id").EQ(psql.Arg(assetID)))))
+	return err
+}
+
+func createParts(ctx context.Context, exec bob.Executor, assetID int64, parts []*entities.Part) error {
+	if len(parts) == 0 {
+		return nil
+	}
+
+	rows := make([][]bob.Expression, 0, len(parts))
+	for _, part := range parts {
+		rows = append(rows, []bob.Expression{
+			psql.Arg(assetID),
+			psql.Arg(part.Tag),
+			psql.Arg(part.Name),
+			psql.Arg(nullStr(part.Location)),
+			psql.Arg(nullStr(part.PositionCode)),
+			psql.Arg(nullStr(part.Notes)),
+			psql.Arg(part.CreatedBy),
+		})
+	}
+
+	_, err := bob.Exec(ctx, exec, psql.Insert(
+		im.Into("asset_parts", "asset_id", "tag", "name", "location", "position_code", "notes", "created_by"),
+		im.Rows(rows...),
+	))
+	return err
+}
+
+func deleteParts(ctx context.Context, exec bob.Executor, assetID int64) error {
+	_, err := bob.Exec(ctx, exec, psql.Delete(dm.From("asset_parts"), dm.Where(psql.Quote("asset_id").EQ(psql.Arg(assetID)))))
+	return err
+}
+
+func mapDBRowToAsset(row *assetRow, rel *assetRelations) *entities.Asset {
+	if row == nil {
+		return nil
+	}
+
+	if rel == nil {
+		rel = &assetRelations{}
+	}
+
+	// pgx returns the timestamps in the local time zone, they are converted to UTC like those read from SQLite
+	purchases := make([]*entities.Purchase, 0, len(rel.purchases[row.ID]))
+	for _, p := range rel.purchases[row.ID] {
+		purchases = append(purchases, &entities.Purchase{
+			ID:       p.ID,
+			Supplier: p.Supplier.String,
+			OrderNo:  p.OrderNo.String,
+			Date:     p.OrderDate.Time.UTC(),
+			Amount:   entities.MonetaryAmount(p.Amount.Int64),
+			Currency: p.Currency.String,
+		})
+	}
+
+	files := make([]*entities.File, 0, len(rel.files[row.ID]))
+	for _, f := range rel.files[row.ID] {
+		files = append(files, mapDBRowToFile(f))
+	}
+
+	photos := mapDBRowsToPhotos(rel.photos[row.ID])
+
+	parts := make([]*entities.Part, 0, len(rel.parts[row.ID]))
+	for _, p := range rel.parts[row.ID] {
+		parts = append(parts, &entities.Part{
+			ID:           p.ID,
+			AssetID:      p.AssetID,
+			Tag:          p.Tag,
+			Name:         p.Name,
+			Notes:        p.Notes.String,
+			Location:     p.Location.String,
+			PositionCode: p.PositionCode.String,
+			CreatedBy:    p.CreatedBy,
+			CreatedAt:    p.CreatedAt.UTC(),
+			UpdatedAt:    p.UpdatedAt.UTC(),
+		})
+	}
+
+	children := make([]*entities.Asset, 0, len(rel.children))
+	for _, child := range rel.children {
+		children = append(children, mapDBRowToAsset(child, nil))
+	}
+
+	return &entities.Asset{
+		ID:            row.ID,
+		WorkspaceID:   row.WorkspaceID,
+		Type:          entities.AssetType(row.Type),
+		ParentAssetID: row.ParentAssetID.Int64,
+		Parent:        mapDBRowToAsset(rel.parents[row.ParentAssetID.Int64], nil),
+		Status:        entities.Status(row.Status),
+		Tag:           row.Tag.String,
+		Name:          row.Name,
+		Category:      row.Category,
+		Model:         row.Model.String,
+		ModelNo:       row.ModelNo.String,
+		SerialNo:      row.SerialNo.String,
+		Manufacturer:  row.Manufacturer.String,
+		Notes:         row.Notes.String,
+		ImageURL:      row.ImageURL.String,
+		ThumbnailURL:  row.ThumbnailURL.String,
+		PreviewURL:    row.PreviewURL.String,
+		WarrantyUntil: row.WarrantyUntil.Time.UTC(),
+		CustomAttrs:   unmarshalCustomAttrs(row.CustomAttrs.String),
+		Quantity:      row.Quantity,
+		QuantityUnit:  row.QuantityUnit.String,
+		CheckedOutTo:  row.CheckedOutTo.Int64,
+		Location:      row.Location.String,
+		PositionCode:  row.PositionCode.String,
+
+		Purchases: purchases,
+
+		PartsTotalCounter: int(row.PartsTotalCounter),
+		Parts:             parts,
+
+		Files: files,
+
+		Photos: photos,
+
+		Children: children,
+
+		MetaInfo: entities.MetaInfo{
+			CreatedBy: row.CreatedBy,
+			CreatedAt: row.CreatedAt.UTC(),
+			UpdatedAt: row.UpdatedAt.UTC(),
+			DeletedAt: row.DeletedAt.Time.UTC(),
+		},
+	}
+}
+
+func marshalCustomAttrs(cas []entities.CustomAttr) (sql.NullString, error) {
+	if len(cas) == 0 {
+		return sql.NullString{}, nil
+	}
+
+	encoded := make([]map[string]any, 0, len(cas))
+	for _, ca := range cas {
+		encoded = append(encoded, map[string]any{"name": ca.Name, "value": ca.Value})
+	}
+
+	j, err := json.Marshal(encoded)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("error encoding custom attributes: %w", err)
+	}
+
+	return nullStr(string(j)), nil
+}
+
+func unmarshalCustomAttrs(encoded string) []entities.CustomAttr {
+	var decoded []map[string]any
+	if encoded != "" {
+		_ = json.Unmarshal([]byte(encoded), &decoded)
+	}
+
+	cas := make([]entities.CustomAttr, 0, len(decoded))
+	for _, ca := range decoded {
+		name, _ := ca["name"].(string)
+		cas = append(cas, entities.CustomAttr{
+			Name:  name,
+			Value: ca["value"],
+		})
+	}
+
+	return cas
+}
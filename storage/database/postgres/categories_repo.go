@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/scan"
+)
+
+type CategoryRepo struct{}
+
+func (cr *CategoryRepo) List(ctx context.Context, exec bob.Executor, query database.ListCategoriesQuery) (*entities.ListPage[*entities.Category], error) {
+	where := []bob.Expression{}
+
+	if query.Search != "" {
+		where = append(where, containsExpr("cat_name", query.Search))
+	}
+
+	if query.WorkspaceID != 0 {
+		where = append(where, psql.Quote("workspace_id").EQ(psql.Arg(query.WorkspaceID)))
+	}
+
+	categories, count, err := listView(ctx, exec, "categories", []string{"cat_name"}, where, query.Page, query.PageSize, scan.StructMapper[struct {
+		CatName string `db:"cat_name"`
+	}]())
+	if err != nil {
+		return nil, err
+	}
+
+	numPages, pageSize := calcNumPages(query.PageSize, count)
+	page := &entities.ListPage[*entities.Category]{
+		Items:    make([]*entities.Category, 0, len(categories)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: pageSize,
+		NumPages: numPages,
+	}
+
+	for _, c := range categories {
+		page.Items = append(page.Items, &entities.Category{Name: c.CatName})
+	}
+
+	return page, nil
+}
@@ -0,0 +1,210 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+)
+
+type CheckoutRepo struct{}
+
+type checkoutRow struct {
+	ID           int64          `db:"id"`
+	AssetID      int64          `db:"asset_id"`
+	CheckedOutTo int64          `db:"checked_out_to"`
+	DueAt        sql.NullTime   `db:"due_at"`
+	Note         sql.NullString `db:"note"`
+	CheckedOutAt time.Time      `db:"checked_out_at"`
+	CheckedInAt  sql.NullTime   `db:"checked_in_at"`
+	CheckInNote  sql.NullString `db:"check_in_note"`
+	OverdueAt    sql.NullTime   `db:"overdue_at"`
+	RemindedAt   sql.NullTime   `db:"reminded_at"`
+	CreatedBy    int64          `db:"created_by"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+
+	UserDisplayName string `db:"user_display_name"`
+	UserUsername    string `db:"user_username"`
+	AssetTag        string `db:"asset_tag"`
+	AssetName       string `db:"asset_name"`
+}
+
+func selectCheckouts(qmods ...bob.Mod[*dialect.SelectQuery]) bob.BaseQuery[*dialect.SelectQuery] {
+	return psql.Select(append([]bob.Mod[*dialect.SelectQuery]{
+		sm.Columns(
+			"asset_checkouts.*",
+			"coalesce(users.display_name, '') AS user_display_name",
+			"coalesce(users.username, '') AS user_username",
+			"coalesce(assets.tag, '') AS asset_tag",
+			"coalesce(assets.name, '') AS asset_name",
+		),
+		sm.From("asset_checkouts"),
+		sm.LeftJoin("users").On(psql.Quote("users", "id").EQ(psql.Quote("asset_checkouts", "checked_out_to"))),
+		sm.LeftJoin("assets").On(psql.Quote("assets", "id").EQ(psql.Quote("asset_checkouts", "asset_id"))),
+	}, qmods...)...)
+}
+
+func (cr *CheckoutRepo) GetOpenForAsset(ctx context.Context, exec bob.Executor, assetID int64) (*entities.Checkout, error) {
+	checkout, err := bob.One(ctx, exec, selectCheckouts(
+		sm.Where(psql.Quote("asset_checkouts", "asset_id").EQ(psql.Arg(assetID))),
+		sm.Where(psql.Quote("asset_checkouts", "checked_in_at").IsNull()),
+		sm.OrderBy(psql.Quote("asset_checkouts", "id")).Desc(),
+		sm.Limit(1),
+	), scan.StructMapper[*checkoutRow]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: no open checkout for asset %d", database.ErrCheckoutNotFound, assetID)
+		}
+		return nil, fmt.Errorf("error getting checkout: %w", err)
+	}
+
+	return mapDBRowToCheckout(checkout), nil
+}
+
+func (cr *CheckoutRepo) List(ctx context.Context, exec bob.Executor, query database.ListCheckoutsQuery) (*entities.ListPage[*entities.Checkout], error) {
+	limit, offset := limitOffset(query.Page, query.PageSize)
+
+	mods := []bob.Mod[*dialect.SelectQuery]{}
+
+	if query.AssetID != 0 {
+		mods = append(mods, sm.Where(psql.Quote("asset_checkouts", "asset_id").EQ(psql.Arg(query.AssetID))))
+	}
+
+	if query.OnlyOpen {
+		mods = append(mods, sm.Where(psql.Quote("asset_checkouts", "checked_in_at").IsNull()))
+	}
+
+	if query.OnlyOverdue {
+		mods = append(mods, sm.Where(psql.Quote("asset_checkouts", "overdue_at").IsNotNull()))
+	}
+
+	if !query.DueBefore.IsZero() {
+		mods = append(mods, sm.Where(psql.Quote("asset_checkouts", "due_at").LT(psql.Arg(query.DueBefore))))
+	}
+
+	if query.WorkspaceID != 0 {
+		mods = append(mods, sm.Where(psql.Raw("asset_checkouts.asset_id IN (SELECT id FROM assets WHERE workspace_id = ?)", query.WorkspaceID)))
+	}
+
+	count, err := bob.One(ctx, exec, psql.Select(append(mods, sm.Columns("count(*)"), sm.From("asset_checkouts"))...), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return nil, fmt.Errorf("error counting checkouts: %w", err)
+	}
+
+	mods = append(mods,
+		sm.OrderBy(psql.Quote("asset_checkouts", "checked_out_at")).Desc(),
+		sm.OrderBy(psql.Quote("asset_checkouts", "id")).Desc(),
+		sm.Limit(limit),
+		sm.Offset(offset),
+	)
+
+	checkouts, err := bob.All(ctx, exec, selectCheckouts(mods...), scan.StructMapper[*checkoutRow]())
+	if err != nil {
+		return nil, fmt.Errorf("error getting checkouts: %w", err)
+	}
+
+	numPages, pageSize := calcNumPages(query.PageSize, count)
+	page := &entities.ListPage[*entities.Checkout]{
+		Items:    make([]*entities.Checkout, 0, len(checkouts)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: pageSize,
+		NumPages: numPages,
+	}
+
+	for i := range checkouts {
+		page.Items = append(page.Items, mapDBRowToCheckout(checkouts[i]))
+	}
+
+	return page, nil
+}
+
+func (cr *CheckoutRepo) Create(ctx context.Context, exec bob.Executor, checkout *entities.Checkout) (int64, error) {
+	checkedOutAt := checkout.CheckedOutAt
+	if checkedOutAt.IsZero() {
+		checkedOutAt = time.Now()
+	}
+
+	id, err := bob.One(ctx, exec, psql.Insert(
+		im.Into("asset_checkouts", "asset_id", "checked_out_to", "due_at", "note", "checked_out_at", "created_by"),
+		im.Values(psql.Arg(checkout.AssetID, checkout.CheckedOutTo, nullTime(checkout.DueAt), nullStr(checkout.Note), checkedOutAt, checkout.CreatedBy)),
+		im.Returning("id"),
+	), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", database.ErrCreatingCheckout, err)
+	}
+
+	return id, nil
+}
+
+func (cr *CheckoutRepo) Update(ctx context.Context, exec bob.Executor, checkout *entities.Checkout) error {
+	_, err := bob.Exec(ctx, exec, psql.Update(
+		um.Table("asset_checkouts"),
+		um.Set("checked_out_to").ToArg(checkout.CheckedOutTo),
+		um.Set("due_at").ToArg(nullTime(checkout.DueAt)),
+		um.Set("note").ToArg(nullStr(checkout.Note)),
+		um.Set("checked_in_at").ToArg(nullTime(checkout.CheckedInAt)),
+		um.Set("check_in_note").ToArg(nullStr(checkout.CheckInNote)),
+		um.Set("overdue_at").ToArg(nullTime(checkout.OverdueAt)),
+		um.Set("reminded_at").ToArg(nullTime(checkout.RemindedAt)),
+		um.Set("updated_at").ToArg(time.Now()),
+		um.Where(psql.Quote("id").EQ(psql.Arg(checkout.ID))),
+	))
+	if err != nil {
+		return fmt.Errorf("error updating checkout %d: %w", checkout.ID, err)
+	}
+
+	return nil
+}
+
+func (cr *CheckoutRepo) DeleteAllForAsset(ctx context.Context, exec bob.Executor, assetID int64) error {
+	_, err := bob.Exec(ctx, exec, psql.Delete(
+		dm.From("asset_checkouts"),
+		dm.Where(psql.Quote("asset_id").EQ(psql.Arg(assetID))),
+	))
+	if err != nil {
+		return fmt.Errorf("error deleting checkouts for asset %d: %w", assetID, err)
+	}
+
+	return nil
+}
+
+func mapDBRowToCheckout(row *checkoutRow) *entities.Checkout {
+	checkout := &entities.Checkout{
+		ID:           row.ID,
+		AssetID:      row.AssetID,
+		CheckedOutTo: row.CheckedOutTo,
+		DueAt:        row.DueAt.Time,
+		Note:         row.Note.String,
+		CheckedOutAt: row.CheckedOutAt,
+		CheckedInAt:  row.CheckedInAt.Time,
+		CheckInNote:  row.CheckInNote.String,
+		OverdueAt:    row.OverdueAt.Time,
+		RemindedAt:   row.RemindedAt.Time,
+		CreatedBy:    row.CreatedBy,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+		AssetTag:     row.AssetTag,
+		AssetName:    row.AssetName,
+	}
+
+	checkout.CheckedOutToName = row.UserDisplayName
+	if checkout.CheckedOutToName == "" {
+		checkout.CheckedOutToName = row.UserUsername
+	}
+
+	return checkout
+}
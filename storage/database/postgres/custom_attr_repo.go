@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/scan"
+)
+
+type CustomAttrRepo struct{}
+
+func (cr *CustomAttrRepo) List(ctx context.Context, exec bob.Executor, query database.ListCustomAttrsQuery) (*entities.ListPage[*entities.CustomAttr], error) {
+	where := []bob.Expression{}
+
+	if query.Search != "" {
+		where = append(where, containsExpr("attr_name", query.Search))
+	}
+
+	if query.WorkspaceID != 0 {
+		where = append(where, psql.Quote("workspace_id").EQ(psql.Arg(query.WorkspaceID)))
+	}
+
+	attrs, count, err := listView(ctx, exec, "custom_attr_names", []string{"attr_name"}, where, query.Page, query.PageSize, scan.StructMapper[struct {
+		AttrName string `db:"attr_name"`
+	}]())
+	if err != nil {
+		return nil, err
+	}
+
+	numPages, pageSize := calcNumPages(query.PageSize, count)
+	page := &entities.ListPage[*entities.CustomAttr]{
+		Items:    make([]*entities.CustomAttr, 0, len(attrs)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: pageSize,
+		NumPages: numPages,
+	}
+
+	for _, c := range attrs {
+		page.Items = append(page.Items, &entities.CustomAttr{Name: c.AttrName})
+	}
+
+	return page, nil
+}
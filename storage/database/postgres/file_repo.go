@@ -0,0 +1,238 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+)
+
+type FileRepo struct{}
+
+type fileRow struct {
+	ID           int64     `db:"id"`
+	AssetID      int64     `db:"asset_id"`
+	Name         string    `db:"name"`
+	Filetype     string    `db:"filetype"`
+	Sha256       []byte    `db:"sha256"`
+	SizeBytes    int64     `db:"size_bytes"`
+	FullPath     string    `db:"full_path"`
+	PublicPath   string    `db:"public_path"`
+	Variant      string    `db:"variant"`
+	BrokenReason string    `db:"broken_reason"`
+	CreatedBy    int64     `db:"created_by"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+func (fr *FileRepo) Create(ctx context.Context, exec bob.Executor, file *entities.File) (int64, error) {
+	if file.Variant == "" {
+		file.Variant = entities.FileVariantOriginal
+	}
+
+	id, err := bob.One(ctx, exec, psql.Insert(
+		im.Into("asset_files", "asset_id", "name", "filetype", "sha256", "size_bytes", "created_by", "full_path", "public_path", "variant"),
+		im.Values(psql.Arg(file.AssetID, file.Name, file.Filetype, file.Sha256, file.SizeBytes, file.CreatedBy, file.FullPath, file.PublicPath, string(file.Variant))),
+		im.Returning("id"),
+	), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", database.ErrCreatingFile, err)
+	}
+	return id, nil
+}
+
+func (fr *FileRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*entities.File, error) {
+	file, err := bob.One(ctx, exec, psql.Select(
+		sm.From("asset_files"),
+		sm.Where(psql.Quote("id").EQ(psql.Arg(id))),
+	), scan.StructMapper[*fileRow]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %d", database.ErrFileNotFound, id)
+		}
+		return nil, fmt.Errorf("error getting asset file: %w", err)
+	}
+
+	return mapDBRowToFile(file), nil
+}
+
+// GetByPublicPath returns the asset's file with the public path.
+// Identical files share their public path, so the lookup must be limited to a single asset.
+func (fr *FileRepo) GetByPublicPath(ctx context.Context, exec bob.Executor, assetID int64, publicPath string) (*entities.File, error) {
+	file, err := bob.One(ctx, exec, psql.Select(
+		sm.From("asset_files"),
+		sm.Where(psql.Quote("asset_id").EQ(psql.Arg(assetID))),
+		sm.Where(psql.Quote("public_path").EQ(psql.Arg(publicPath))),
+		sm.OrderBy("id").Asc(),
+		sm.Limit(1),
+	), scan.StructMapper[*fileRow]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: public path: %s", database.ErrFileNotFound, publicPath)
+		}
+		return nil, fmt.Errorf("error getting asset file: %w", err)
+	}
+
+	return mapDBRowToFile(file), nil
+}
+
+// GetByHash returns the oldest file with the checksum, which owns the blob all identical files share.
+func (fr *FileRepo) GetByHash(ctx context.Context, exec bob.Executor, sha256 []byte) (*entities.File, error) {
+	file, err := bob.One(ctx, exec, psql.Select(
+		sm.From("asset_files"),
+		sm.Where(psql.Quote("sha256").EQ(psql.Arg(sha256))),
+		sm.OrderBy("id").Asc(),
+		sm.Limit(1),
+	), scan.StructMapper[*fileRow]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: sha256: %x", database.ErrFileNotFound, sha256)
+		}
+		return nil, fmt.Errorf("error getting asset file: %w", err)
+	}
+
+	return mapDBRowToFile(file), nil
+}
+
+// CountReferences returns the number of files which are stored in the blob at the full path.
+func (fr *FileRepo) CountReferences(ctx context.Context, exec bob.Executor, fullPath string) (int64, error) {
+	count, err := bob.One(ctx, exec, psql.Select(
+		sm.Columns("count(*)"),
+		sm.From("asset_files"),
+		sm.Where(psql.Quote("full_path").EQ(psql.Arg(fullPath))),
+	), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return 0, fmt.Errorf("error counting references to %s: %w", fullPath, err)
+	}
+
+	return count, nil
+}
+
+func (fr *FileRepo) List(ctx context.Context, exec bob.Executor, query database.ListFilesQuery) (*entities.ListPage[*entities.File], error) {
+	limit, offset := limitOffset(query.Page, query.PageSize)
+
+	mods := []bob.Mod[*dialect.SelectQuery]{sm.From("asset_files")}
+
+	if query.AssetID != 0 {
+		mods = append(mods, sm.Where(psql.Quote("asset_id").EQ(psql.Arg(query.AssetID))))
+	}
+
+	if len(query.Hashes) != 0 {
+		hashes := make([]bob.Expression, 0, len(query.Hashes))
+		for _, h := range query.Hashes {
+			hashes = append(hashes, psql.Arg(h))
+		}
+		mods = append(mods, sm.Where(psql.Quote("sha256").In(hashes...)))
+	}
+
+	if query.OnlyBroken {
+		mods = append(mods, sm.Where(psql.Quote("broken_reason").NE(psql.Arg(""))))
+	}
+
+	files, err := bob.All(ctx, exec, psql.Select(append(mods, sm.OrderBy("id").Asc(), sm.Limit(limit), sm.Offset(offset))...), scan.StructMapper[*fileRow]())
+	if err != nil {
+		return nil, fmt.Errorf("error getting files: %w", err)
+	}
+
+	count, err := bob.One(ctx, exec, psql.Select(append(mods, sm.Columns("count(*)"))...), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return nil, fmt.Errorf("error counting files: %w", err)
+	}
+
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = 1
+	}
+
+	page := &entities.ListPage[*entities.File]{
+		Items:    make([]*entities.File, 0, len(files)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		NumPages: int(count) / pageSize,
+	}
+
+	for i := range files {
+		page.Items = append(page.Items, mapDBRowToFile(files[i]))
+	}
+
+	return page, nil
+}
+
+func (fr *FileRepo) Delete(ctx context.Context, exec bob.Executor, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := bob.Exec(ctx, exec, psql.Delete(
+		dm.From("asset_files"),
+		dm.Where(psql.Quote("id").In(int64Args(ids)...)),
+	))
+	if err != nil {
+		return fmt.Errorf("error deleting asset files: %w", err)
+	}
+
+	return nil
+}
+
+// SetBroken marks the files as broken. An empty reason marks them as intact again.
+func (fr *FileRepo) SetBroken(ctx context.Context, exec bob.Executor, ids []int64, reason entities.FileBrokenReason) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := bob.Exec(ctx, exec, psql.Update(
+		um.Table("asset_files"),
+		um.Set("broken_reason").ToArg(string(reason)),
+		um.Where(psql.Quote("id").In(int64Args(ids)...)),
+	))
+	if err != nil {
+		return fmt.Errorf("error marking asset files as broken: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateFullPath moves all files stored in the blob at oldPath to newPath, e.g. after restoring a backup to a different file storage.
+func (fr *FileRepo) UpdateFullPath(ctx context.Context, exec bob.Executor, oldPath string, newPath string) error {
+	_, err := bob.Exec(ctx, exec, psql.Update(
+		um.Table("asset_files"),
+		um.Set("full_path").ToArg(newPath),
+		um.Where(psql.Quote("full_path").EQ(psql.Arg(oldPath))),
+	))
+	if err != nil {
+		return fmt.Errorf("error updating full path of asset files stored at %s: %w", oldPath, err)
+	}
+
+	return nil
+}
+
+func mapDBRowToFile(row *fileRow) *entities.File {
+	return &entities.File{
+		ID:         row.ID,
+		AssetID:    row.AssetID,
+		PublicPath: row.PublicPath,
+		FullPath:   row.FullPath,
+		Name:       row.Name,
+		Filetype:   row.Filetype,
+		Sha256:     row.Sha256,
+		Variant:    entities.FileVariant(row.Variant),
+		Broken:     entities.FileBrokenReason(row.BrokenReason),
+		SizeBytes:  row.SizeBytes,
+		CreatedBy:  row.CreatedBy,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+}
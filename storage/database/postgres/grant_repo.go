@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/scan"
+)
+
+type GrantRepo struct{}
+
+type grantRow struct {
+	ID        int64     `db:"id"`
+	UserID    int64     `db:"user_id"`
+	Role      string    `db:"role"`
+	Location  string    `db:"location"`
+	Category  string    `db:"category"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (*GrantRepo) ListForUser(ctx context.Context, exec bob.Executor, userID int64) ([]*auth.Grant, error) {
+	grants, err := bob.All(ctx, exec, psql.Select(
+		sm.From("user_grants"),
+		sm.Where(psql.Quote("user_id").EQ(psql.Arg(userID))),
+		sm.OrderBy("id"),
+	), scan.StructMapper[*grantRow]())
+	if err != nil {
+		return nil, fmt.Errorf("error getting grants for user %d: %w", userID, err)
+	}
+
+	list := make([]*auth.Grant, 0, len(grants))
+	for _, g := range grants {
+		list = append(list, mapDBRowToGrant(g))
+	}
+
+	return list, nil
+}
+
+func (*GrantRepo) Create(ctx context.Context, exec bob.Executor, grant *auth.Grant) error {
+	inserted, err := bob.One(ctx, exec, psql.Insert(
+		im.Into("user_grants", "user_id", "role", "location", "category"),
+		im.Values(psql.Arg(grant.UserID, string(grant.Role), grant.Location, grant.Category)),
+		im.Returning("*"),
+	), scan.StructMapper[*grantRow]())
+	if err != nil {
+		return fmt.Errorf("error creating grant: %w", err)
+	}
+
+	grant.ID = inserted.ID
+	grant.CreatedAt = inserted.CreatedAt
+	grant.UpdatedAt = inserted.UpdatedAt
+
+	return nil
+}
+
+func (*GrantRepo) Delete(ctx context.Context, exec bob.Executor, userID int64, id int64) error {
+	_, err := bob.Exec(ctx, exec, psql.Delete(
+		dm.From("user_grants"),
+		dm.Where(psql.Quote("id").EQ(psql.Arg(id))),
+		dm.Where(psql.Quote("user_id").EQ(psql.Arg(userID))),
+	))
+	if err != nil {
+		return fmt.Errorf("error deleting grant %d: %w", id, err)
+	}
+
+	return nil
+}
+
+func mapDBRowToGrant(row *grantRow) *auth.Grant {
+	return &auth.Grant{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		Role:      auth.Role(row.Role),
+		Location:  row.Location,
+		Category:  row.Category,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
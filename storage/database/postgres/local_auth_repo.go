@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+)
+
+type LocalAuthRepo struct{}
+
+type localAuthUserRow struct {
+	ID                     int64     `db:"id"`
+	Username               string    `db:"username"`
+	Algorithm              string    `db:"algorithm"`
+	Params                 string    `db:"params"`
+	Salt                   []byte    `db:"salt"`
+	Password               []byte    `db:"password"`
+	RequiresPasswordChange bool      `db:"requires_password_change"`
+	CreatedAt              time.Time `db:"created_at"`
+	UpdatedAt              time.Time `db:"updated_at"`
+}
+
+func (*LocalAuthRepo) Get(ctx context.Context, exec bob.Executor, username string) (*auth.LocalAuthUser, error) {
+	user, err := bob.One(ctx, exec, psql.Select(
+		sm.From("local_auth_users"),
+		sm.Where(psql.Quote("username").EQ(psql.Arg(username))),
+	), scan.StructMapper[*localAuthUserRow]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, database.ErrLocalAuthUserNotFound
+		}
+
+		return nil, err
+	}
+
+	return &auth.LocalAuthUser{
+		ID:                     user.ID,
+		Username:               user.Username,
+		Algorithm:              user.Algorithm,
+		Params:                 user.Params,
+		Salt:                   user.Salt,
+		Password:               user.Password,
+		RequiresPasswordChange: user.RequiresPasswordChange,
+		CreatedAt:              user.CreatedAt,
+		UpdatedAt:              user.UpdatedAt,
+	}, nil
+}
+
+func (*LocalAuthRepo) Create(ctx context.Context, exec bob.Executor, user *auth.LocalAuthUser) error {
+	inserted, err := bob.One(ctx, exec, psql.Insert(
+		im.Into("local_auth_users", "username", "algorithm", "params", "salt", "password", "requires_password_change"),
+		im.Values(psql.Arg(user.Username, user.Algorithm, user.Params, user.Salt, user.Password, user.RequiresPasswordChange)),
+		im.Returning("*"),
+	), scan.StructMapper[*localAuthUserRow]())
+	if err != nil {
+		return err
+	}
+
+	user.ID = inserted.ID
+	user.CreatedAt = inserted.CreatedAt
+	user.UpdatedAt = inserted.UpdatedAt
+
+	return nil
+}
+
+func (*LocalAuthRepo) Update(ctx context.Context, exec bob.Executor, user *auth.LocalAuthUser) error {
+	res, err := bob.Exec(ctx, exec, psql.Update(
+		um.Table("local_auth_users"),
+		um.Set("algorithm").ToArg(user.Algorithm),
+		um.Set("params").ToArg(user.Params),
+		um.Set("salt").ToArg(user.Salt),
+		um.Set("password").ToArg(user.Password),
+		um.Set("requires_password_change").ToArg(user.RequiresPasswordChange),
+		um.Set("updated_at").ToArg(time.Now()),
+		um.Where(psql.Quote("id").EQ(psql.Arg(user.ID))),
+	))
+	if err != nil {
+		return err
+	}
+
+	if updated, err := res.RowsAffected(); err == nil && updated == 0 {
+		return fmt.Errorf("error updating local auth user: %w", database.ErrLocalAuthUserNotFound)
+	}
+
+	return nil
+}
+
+func (*LocalAuthRepo) DeleteByUsername(ctx context.Context, exec bob.Executor, username string) error {
+	_, err := bob.Exec(ctx, exec, psql.Delete(
+		dm.From("local_auth_users"),
+		dm.Where(psql.Quote("username").EQ(psql.Arg(username))),
+	))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
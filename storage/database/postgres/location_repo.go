@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/scan"
+)
+
+type LocationRepo struct{}
+
+func (cr *LocationRepo) ListLocations(ctx context.Context, exec bob.Executor, query database.ListLocationsQuery) (*entities.ListPage[*entities.Location], error) {
+	where := []bob.Expression{}
+
+	if query.Search != "" {
+		where = append(where, containsExpr("loc_name", query.Search))
+	}
+
+	if query.WorkspaceID != 0 {
+		where = append(where, psql.Quote("workspace_id").EQ(psql.Arg(query.WorkspaceID)))
+	}
+
+	locations, count, err := listView(ctx, exec, "locations", []string{"loc_name"}, where, query.Page, query.PageSize, scan.StructMapper[struct {
+		LocName string `db:"loc_name"`
+	}]())
+	if err != nil {
+		return nil, err
+	}
+
+	numPages, pageSize := calcNumPages(query.PageSize, count)
+	page := &entities.ListPage[*entities.Location]{
+		Items:    make([]*entities.Location, 0, len(locations)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: pageSize,
+		NumPages: numPages,
+	}
+
+	for _, c := range locations {
+		page.Items = append(page.Items, &entities.Location{Name: c.LocName})
+	}
+
+	return page, nil
+}
+
+func (cr *LocationRepo) ListPositionCodes(ctx context.Context, exec bob.Executor, query database.ListPositionCodesQuery) (*entities.ListPage[*entities.PositionCode], error) {
+	where := []bob.Expression{}
+
+	if query.Search != "" {
+		where = append(where, containsExpr("pos_code", query.Search))
+	}
+
+	if query.WorkspaceID != 0 {
+		where = append(where, psql.Quote("workspace_id").EQ(psql.Arg(query.WorkspaceID)))
+	}
+
+	poscodes, count, err := listView(ctx, exec, "position_codes", []string{"pos_code"}, where, query.Page, query.PageSize, scan.StructMapper[struct {
+		PosCode string `db:"pos_code"`
+	}]())
+	if err != nil {
+		return nil, err
+	}
+
+	numPages, pageSize := calcNumPages(query.PageSize, count)
+	page := &entities.ListPage[*entities.PositionCode]{
+		Items:    make([]*entities.PositionCode, 0, len(poscodes)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: pageSize,
+		NumPages: numPages,
+	}
+
+	for _, c := range poscodes {
+		page.Items = append(page.Items, &entities.PositionCode{Code: c.PosCode})
+	}
+
+	return page, nil
+}
@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/scan"
+)
+
+type ManufacturerRepo struct{}
+
+func (cr *ManufacturerRepo) List(ctx context.Context, exec bob.Executor, query database.ListManufacturersQuery) (*entities.ListPage[*entities.Manufacturer], error) {
+	where := []bob.Expression{}
+
+	if query.Search != "" {
+		where = append(where, containsExpr("manufacturer", query.Search))
+	}
+
+	if query.WorkspaceID != 0 {
+		where = append(where, psql.Quote("workspace_id").EQ(psql.Arg(query.WorkspaceID)))
+	}
+
+	manufacturers, count, err := listView(ctx, exec, "manufacturers", []string{"manufacturer"}, where, query.Page, query.PageSize, scan.StructMapper[struct {
+		Manufacturer string `db:"manufacturer"`
+	}]())
+	if err != nil {
+		return nil, err
+	}
+
+	numPages, pageSize := calcNumPages(query.PageSize, count)
+	page := &entities.ListPage[*entities.Manufacturer]{
+		Items:    make([]*entities.Manufacturer, 0, len(manufacturers)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: pageSize,
+		NumPages: numPages,
+	}
+
+	for _, c := range manufacturers {
+		page.Items = append(page.Items, &entities.Manufacturer{Name: c.Manufacturer})
+	}
+
+	return page, nil
+}
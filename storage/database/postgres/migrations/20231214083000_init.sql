@@ -0,0 +1,310 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sessions (
+    id         BIGSERIAL PRIMARY KEY,
+    token      TEXT        NOT NULL,
+    data       BYTEA       NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+CREATE UNIQUE INDEX unique_session_token ON sessions(token);
+CREATE INDEX sessions_expires_at_idx ON sessions(expires_at);
+
+CREATE TABLE local_auth_users (
+    id                       BIGSERIAL PRIMARY KEY,
+    username                 TEXT    NOT NULL,
+    algorithm                TEXT    NOT NULL,
+    params                   TEXT    NOT NULL,
+    salt                     BYTEA   NOT NULL,
+    password                 BYTEA   NOT NULL,
+    requires_password_change BOOLEAN NOT NULL DEFAULT true,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX unique_usernames_auth_method_local ON local_auth_users(username);
+
+CREATE TABLE users (
+    id           BIGSERIAL PRIMARY KEY,
+    username     TEXT NOT NULL,
+    display_name TEXT NOT NULL,
+    auth_ref     TEXT NOT NULL,
+    role         TEXT NOT NULL DEFAULT 'editor',
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX unique_usernames ON users(username);
+CREATE UNIQUE INDEX unique_auth_ref ON users(auth_ref);
+
+CREATE TABLE user_preferences (
+    id      BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    key     TEXT   NOT NULL,
+    value   BYTEA  NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX user_preferences_key_user_id_idx ON user_preferences(user_id, key);
+
+CREATE TABLE user_grants (
+    id       BIGSERIAL PRIMARY KEY,
+    user_id  BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    role     TEXT   NOT NULL,
+    location TEXT   NOT NULL DEFAULT '',
+    category TEXT   NOT NULL DEFAULT '',
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX user_grants_user_id_idx ON user_grants(user_id);
+
+CREATE TABLE api_tokens (
+    id         BIGSERIAL PRIMARY KEY,
+    user_id    BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    name       TEXT   NOT NULL,
+    token_hash BYTEA  NOT NULL,
+    scope      TEXT   NOT NULL,
+
+    expires_at   TIMESTAMPTZ DEFAULT NULL,
+    last_used_at TIMESTAMPTZ DEFAULT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX api_tokens_token_hash_idx ON api_tokens(token_hash);
+CREATE INDEX api_tokens_user_id_idx ON api_tokens(user_id);
+
+CREATE TABLE workspaces (
+    id          BIGSERIAL PRIMARY KEY,
+    name        TEXT   NOT NULL,
+    tag_prefix  TEXT   NOT NULL DEFAULT '',
+    tag_counter BIGINT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX unique_workspace_name ON workspaces(name);
+
+INSERT INTO workspaces(id, name) VALUES (1, 'Default');
+SELECT setval(pg_get_serial_sequence('workspaces', 'id'), 1);
+
+CREATE TABLE workspace_members (
+    id           BIGSERIAL PRIMARY KEY,
+    workspace_id BIGINT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    user_id      BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX unique_workspace_member ON workspace_members(workspace_id, user_id);
+
+CREATE TABLE tags (
+    id           BIGSERIAL PRIMARY KEY,
+    workspace_id BIGINT  NOT NULL DEFAULT 1 REFERENCES workspaces(id) DEFERRABLE INITIALLY IMMEDIATE,
+    tag          TEXT    NOT NULL,
+    in_use       BOOLEAN NOT NULL DEFAULT false,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX unique_tag ON tags(tag);
+CREATE INDEX tags_workspace_id_idx ON tags(workspace_id);
+
+CREATE TABLE assets (
+    id              BIGSERIAL PRIMARY KEY,
+    workspace_id    BIGINT NOT NULL DEFAULT 1 REFERENCES workspaces(id) DEFERRABLE INITIALLY IMMEDIATE,
+    parent_asset_id BIGINT DEFAULT NULL REFERENCES assets(id) DEFERRABLE INITIALLY IMMEDIATE,
+    type            TEXT   NOT NULL DEFAULT 'ASSET' CHECK(type IN ('ASSET', 'COMPONENT', 'CONSUMABLE')),
+    status          TEXT   NOT NULL DEFAULT 'IN_STORAGE' CHECK(status IN ('IN_STORAGE', 'IN_USE', 'ARCHIVED')),
+
+    tag            TEXT DEFAULT NULL REFERENCES tags(tag) DEFERRABLE INITIALLY IMMEDIATE,
+    name           TEXT NOT NULL,
+    category       TEXT NOT NULL,
+    model          TEXT DEFAULT NULL,
+    model_no       TEXT DEFAULT NULL,
+    serial_no      TEXT DEFAULT NULL,
+    manufacturer   TEXT DEFAULT NULL,
+    notes          TEXT DEFAULT NULL,
+    image_url      TEXT DEFAULT NULL,
+    thumbnail_url  TEXT DEFAULT NULL,
+    preview_url    TEXT DEFAULT NULL,
+    warranty_until TIMESTAMPTZ DEFAULT NULL,
+    custom_attrs   JSONB DEFAULT NULL,
+
+    quantity      BIGINT NOT NULL DEFAULT 0,
+    quantity_unit TEXT DEFAULT NULL,
+
+    checked_out_to BIGINT DEFAULT NULL REFERENCES users(id) DEFERRABLE INITIALLY IMMEDIATE,
+    location       TEXT DEFAULT NULL,
+    position_code  TEXT DEFAULT NULL,
+
+    parts_total_counter BIGINT NOT NULL DEFAULT 0,
+
+    created_by BIGINT NOT NULL REFERENCES users(id) DEFERRABLE INITIALLY IMMEDIATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ DEFAULT NULL,
+
+    -- the 'simple' configuration doesn't stem, so prefix searches match the same way as in SQLite
+    search TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(name, '') || ' ' || coalesce(tag, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(category, '') || ' ' || coalesce(model, '') || ' ' || coalesce(model_no, '') || ' ' || coalesce(serial_no, '') || ' ' || coalesce(manufacturer, '')), 'B') ||
+        setweight(to_tsvector('simple', coalesce(notes, '') || ' ' || coalesce(custom_attrs::text, '')), 'C')
+    ) STORED
+);
+CREATE INDEX asset_name_idx ON assets(lower(name));
+CREATE INDEX assets_deleted_at_idx ON assets(deleted_at);
+CREATE INDEX assets_workspace_id_idx ON assets(workspace_id);
+CREATE INDEX assets_search_idx ON assets USING GIN(search);
+
+CREATE TABLE asset_parts (
+    id       BIGSERIAL PRIMARY KEY,
+    asset_id BIGINT NOT NULL REFERENCES assets(id) ON DELETE CASCADE DEFERRABLE INITIALLY DEFERRED,
+
+    tag           TEXT NOT NULL,
+    name          TEXT NOT NULL,
+    location      TEXT DEFAULT NULL,
+    position_code TEXT DEFAULT NULL,
+    notes         TEXT DEFAULT NULL,
+
+    created_by BIGINT NOT NULL REFERENCES users(id) DEFERRABLE INITIALLY IMMEDIATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX asset_parts_asset_id_idx ON asset_parts(asset_id);
+
+CREATE TABLE asset_purchases (
+    id       BIGSERIAL PRIMARY KEY,
+    asset_id BIGINT NOT NULL REFERENCES assets(id) DEFERRABLE INITIALLY IMMEDIATE,
+
+    supplier   TEXT DEFAULT NULL,
+    order_no   TEXT DEFAULT NULL,
+    order_date TIMESTAMPTZ DEFAULT NULL,
+    amount     BIGINT DEFAULT NULL,
+    currency   TEXT DEFAULT NULL,
+
+    created_by BIGINT NOT NULL REFERENCES users(id) DEFERRABLE INITIALLY IMMEDIATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX asset_purchases_asset_id_idx ON asset_purchases(asset_id);
+
+CREATE TABLE asset_files (
+    id       BIGSERIAL PRIMARY KEY,
+    asset_id BIGINT NOT NULL REFERENCES assets(id) DEFERRABLE INITIALLY IMMEDIATE,
+
+    name          TEXT   NOT NULL,
+    filetype      TEXT   NOT NULL,
+    sha256        BYTEA  NOT NULL,
+    size_bytes    BIGINT NOT NULL,
+    full_path     TEXT   NOT NULL,
+    public_path   TEXT   NOT NULL,
+    variant       TEXT   NOT NULL DEFAULT 'original',
+    broken_reason TEXT   NOT NULL DEFAULT '',
+
+    created_by BIGINT NOT NULL REFERENCES users(id) DEFERRABLE INITIALLY IMMEDIATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX asset_files_asset_id_idx ON asset_files(asset_id);
+CREATE INDEX asset_files_sha256_idx ON asset_files(sha256);
+CREATE INDEX asset_files_full_path_idx ON asset_files(full_path);
+
+CREATE TABLE asset_photos (
+    id      BIGSERIAL PRIMARY KEY,
+    asset_id BIGINT NOT NULL REFERENCES assets(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    file_id  BIGINT NOT NULL REFERENCES asset_files(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+
+    image_url     TEXT    NOT NULL,
+    thumbnail_url TEXT    NOT NULL,
+    preview_url   TEXT    NOT NULL,
+    caption       TEXT    NOT NULL DEFAULT '',
+    position      BIGINT  NOT NULL DEFAULT 0,
+    is_primary    BOOLEAN NOT NULL DEFAULT false,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX asset_photos_asset_id_idx ON asset_photos(asset_id);
+CREATE UNIQUE INDEX unique_asset_photo_file ON asset_photos(file_id);
+
+CREATE TABLE asset_checkouts (
+    id             BIGSERIAL PRIMARY KEY,
+    asset_id       BIGINT NOT NULL REFERENCES assets(id) DEFERRABLE INITIALLY IMMEDIATE,
+    checked_out_to BIGINT NOT NULL REFERENCES users(id) DEFERRABLE INITIALLY IMMEDIATE,
+
+    due_at         TIMESTAMPTZ DEFAULT NULL,
+    note           TEXT DEFAULT NULL,
+    checked_out_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    checked_in_at TIMESTAMPTZ DEFAULT NULL,
+    check_in_note TEXT DEFAULT NULL,
+
+    overdue_at  TIMESTAMPTZ DEFAULT NULL,
+    reminded_at TIMESTAMPTZ DEFAULT NULL,
+
+    created_by BIGINT NOT NULL REFERENCES users(id) DEFERRABLE INITIALLY IMMEDIATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX asset_checkouts_asset_id_idx ON asset_checkouts(asset_id);
+CREATE INDEX asset_checkouts_due_at_idx ON asset_checkouts(due_at) WHERE checked_in_at IS NULL;
+
+CREATE TABLE asset_events (
+    id         BIGSERIAL PRIMARY KEY,
+    -- no foreign key, so the history of deleted assets is kept
+    asset_id   BIGINT NOT NULL,
+    type       TEXT   NOT NULL,
+    changes    TEXT   NOT NULL,
+    snapshot   TEXT   DEFAULT NULL,
+
+    user_id    BIGINT DEFAULT NULL REFERENCES users(id) DEFERRABLE INITIALLY IMMEDIATE,
+    request_id TEXT   DEFAULT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX asset_events_asset_id_idx ON asset_events(asset_id);
+
+CREATE VIEW categories AS SELECT workspace_id, category AS cat_name FROM assets GROUP BY workspace_id, cat_name;
+
+CREATE VIEW manufacturers AS SELECT workspace_id, manufacturer FROM assets WHERE manufacturer IS NOT NULL GROUP BY workspace_id, manufacturer;
+
+CREATE VIEW models AS SELECT workspace_id, model, model_no FROM assets WHERE model IS NOT NULL OR model_no IS NOT NULL GROUP BY workspace_id, model, model_no;
+
+CREATE VIEW suppliers AS SELECT a.workspace_id AS workspace_id, p.supplier AS name FROM asset_purchases p JOIN assets a ON a.id = p.asset_id WHERE p.supplier IS NOT NULL GROUP BY a.workspace_id, name;
+
+CREATE VIEW locations AS SELECT workspace_id, location AS loc_name FROM assets WHERE location IS NOT NULL UNION SELECT a.workspace_id AS workspace_id, p.location AS loc_name FROM asset_parts p JOIN assets a ON a.id = p.asset_id WHERE p.location IS NOT NULL;
+
+CREATE VIEW position_codes AS SELECT workspace_id, position_code AS pos_code FROM assets WHERE position_code IS NOT NULL UNION SELECT a.workspace_id AS workspace_id, p.position_code AS pos_code FROM asset_parts p JOIN assets a ON a.id = p.asset_id WHERE p.position_code IS NOT NULL;
+
+CREATE VIEW custom_attr_names AS SELECT workspace_id, j.value->>'name' AS attr_name FROM assets, jsonb_array_elements(custom_attrs) j WHERE custom_attrs IS NOT NULL GROUP BY workspace_id, attr_name;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW custom_attr_names;
+DROP VIEW position_codes;
+DROP VIEW locations;
+DROP VIEW suppliers;
+DROP VIEW models;
+DROP VIEW manufacturers;
+DROP VIEW categories;
+
+DROP TABLE asset_events;
+DROP TABLE asset_checkouts;
+DROP TABLE asset_photos;
+DROP TABLE asset_files;
+DROP TABLE asset_purchases;
+DROP TABLE asset_parts;
+DROP TABLE assets;
+DROP TABLE tags;
+DROP TABLE workspace_members;
+DROP TABLE workspaces;
+DROP TABLE api_tokens;
+DROP TABLE user_grants;
+DROP TABLE user_preferences;
+DROP TABLE users;
+DROP TABLE local_auth_users;
+DROP TABLE sessions;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE saved_searches (
+    id           BIGSERIAL PRIMARY KEY,
+    workspace_id BIGINT  NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    user_id      BIGINT  NOT NULL REFERENCES users(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    name         TEXT    NOT NULL,
+    query        TEXT    NOT NULL DEFAULT '',
+    filters      TEXT    NOT NULL DEFAULT '',
+    shared       BOOLEAN NOT NULL DEFAULT false,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX saved_searches_workspace_id_user_id_idx ON saved_searches(workspace_id, user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE saved_searches;
+-- +goose StatementEnd
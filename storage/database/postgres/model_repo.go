@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/scan"
+)
+
+type ModelRepo struct{}
+
+func (cr *ModelRepo) List(ctx context.Context, exec bob.Executor, query database.ListModelsQuery) (*entities.ListPage[*entities.Model], error) {
+	where := []bob.Expression{}
+
+	if query.Search != "" {
+		where = append(where, psql.Or(containsExpr("model", query.Search), containsExpr("model_no", query.Search)))
+	}
+
+	if query.WorkspaceID != 0 {
+		where = append(where, psql.Quote("workspace_id").EQ(psql.Arg(query.WorkspaceID)))
+	}
+
+	models, count, err := listView(ctx, exec, "models", []string{"model", "model_no"}, where, query.Page, query.PageSize, scan.StructMapper[struct {
+		Model   sql.NullString `db:"model"`
+		ModelNo sql.NullString `db:"model_no"`
+	}]())
+	if err != nil {
+		return nil, err
+	}
+
+	numPages, pageSize := calcNumPages(query.PageSize, count)
+	page := &entities.ListPage[*entities.Model]{
+		Items:    make([]*entities.Model, 0, len(models)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: pageSize,
+		NumPages: numPages,
+	}
+
+	for _, m := range models {
+		model := &entities.Model{
+			Name:    m.Model.String,
+			ModelNo: m.ModelNo.String,
+		}
+
+		if model.Name != "" || model.ModelNo != "" {
+			page.Items = append(page.Items, model)
+		}
+	}
+
+	return page, nil
+}
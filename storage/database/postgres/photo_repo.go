@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+)
+
+type PhotoRepo struct{}
+
+type photoRow struct {
+	ID           int64     `db:"id"`
+	AssetID      int64     `db:"asset_id"`
+	FileID       int64     `db:"file_id"`
+	ImageURL     string    `db:"image_url"`
+	ThumbnailURL string    `db:"thumbnail_url"`
+	PreviewURL   string    `db:"preview_url"`
+	Caption      string    `db:"caption"`
+	Position     int64     `db:"position"`
+	IsPrimary    bool      `db:"is_primary"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+func (pr *PhotoRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*entities.Photo, error) {
+	photo, err := bob.One(ctx, exec, psql.Select(
+		sm.From("asset_photos"),
+		sm.Where(psql.Quote("id").EQ(psql.Arg(id))),
+	), scan.StructMapper[*photoRow]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %d", database.ErrPhotoNotFound, id)
+		}
+		return nil, fmt.Errorf("error getting photo: %w", err)
+	}
+
+	return mapDBRowToPhoto(photo), nil
+}
+
+// ListForAsset returns all photos of the asset, ordered by their position.
+func (pr *PhotoRepo) ListForAsset(ctx context.Context, exec bob.Executor, assetID int64) ([]*entities.Photo, error) {
+	photos, err := listPhotos(ctx, exec, []int64{assetID})
+	if err != nil {
+		return nil, fmt.Errorf("error getting photos for asset %d: %w", assetID, err)
+	}
+
+	return mapDBRowsToPhotos(photos), nil
+}
+
+func (pr *PhotoRepo) Create(ctx context.Context, exec bob.Executor, photo *entities.Photo) (int64, error) {
+	id, err := bob.One(ctx, exec, psql.Insert(
+		im.Into("asset_photos", "asset_id", "file_id", "image_url", "thumbnail_url", "preview_url", "caption", "position", "is_primary"),
+		im.Values(psql.Arg(photo.AssetID, photo.FileID, photo.ImageURL, photo.ThumbnailURL, photo.PreviewURL, photo.Caption, int64(photo.Position), photo.Primary)),
+		im.Returning("id"),
+	), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", database.ErrCreatingPhoto, err)
+	}
+
+	return id, nil
+}
+
+func (pr *PhotoRepo) Update(ctx context.Context, exec bob.Executor, photo *entities.Photo) error {
+	_, err := bob.Exec(ctx, exec, psql.Update(
+		um.Table("asset_photos"),
+		um.Set("thumbnail_url").ToArg(photo.ThumbnailURL),
+		um.Set("preview_url").ToArg(photo.PreviewURL),
+		um.Set("caption").ToArg(photo.Caption),
+		um.Set("position").ToArg(int64(photo.Position)),
+		um.Set("is_primary").ToArg(photo.Primary),
+		um.Set("updated_at").ToArg(time.Now()),
+		um.Where(psql.Quote("id").EQ(psql.Arg(photo.ID))),
+	))
+	if err != nil {
+		return fmt.Errorf("error updating photo %d: %w", photo.ID, err)
+	}
+
+	return nil
+}
+
+func listPhotos(ctx context.Context, exec bob.Executor, assetIDs []int64) ([]*photoRow, error) {
+	return bob.All(ctx, exec, psql.Select(
+		sm.From("asset_photos"),
+		sm.Where(psql.Quote("asset_id").In(int64Args(assetIDs)...)),
+		sm.OrderBy("position").Asc(),
+		sm.OrderBy("id").Asc(),
+	), scan.StructMapper[*photoRow]())
+}
+
+func mapDBRowsToPhotos(photos []*photoRow) []*entities.Photo {
+	mapped := make([]*entities.Photo, 0, len(photos))
+	for _, p := range photos {
+		mapped = append(mapped, mapDBRowToPhoto(p))
+	}
+	return mapped
+}
+
+func mapDBRowToPhoto(row *photoRow) *entities.Photo {
+	return &entities.Photo{
+		ID:           row.ID,
+		AssetID:      row.AssetID,
+		FileID:       row.FileID,
+		ImageURL:     row.ImageURL,
+		ThumbnailURL: row.ThumbnailURL,
+		PreviewURL:   row.PreviewURL,
+		Caption:      row.Caption,
+		Position:     int(row.Position),
+		Primary:      row.IsPrimary,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}
+}
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"time"
+
+	"github.com/pressly/goose/v3"
+	goosedb "github.com/pressly/goose/v3/database"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/mods"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+type Config struct {
+	URL string
+}
+
+func NewPostgresDB(config *Config) (*sql.DB, error) {
+	slog.Info("opening PostgreSQL database")
+
+	return sql.Open("pgx", config.URL)
+}
+
+func RunMigrations(ctx context.Context, db *sql.DB) error {
+	slog.InfoContext(ctx, "running migrations")
+
+	goose.SetBaseFS(migrations)
+	err := goose.SetDialect("postgres")
+	if err != nil {
+		panic(err)
+	}
+
+	goose.SetTableName("migrations")
+
+	err = goose.Up(db, "migrations")
+	if err != nil {
+		return fmt.Errorf("error running migrations: %w", err)
+	}
+
+	slog.InfoContext(ctx, "successfully ran migrations")
+	return nil
+}
+
+// RollbackMigration reverts the last applied migration.
+func RollbackMigration(ctx context.Context, db *sql.DB) (*goose.MigrationResult, error) {
+	provider, err := newMigrationProvider(db)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := provider.Down(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error rolling back migration: %w", err)
+	}
+
+	return result, nil
+}
+
+// MigrationStatus lists all migrations included in this build and whether they have been applied.
+func MigrationStatus(ctx context.Context, db *sql.DB) ([]*goose.MigrationStatus, error) {
+	provider, err := newMigrationProvider(db)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := provider.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting migration status: %w", err)
+	}
+
+	return status, nil
+}
+
+func newMigrationProvider(db *sql.DB) (*goose.Provider, error) {
+	store, err := goosedb.NewStore(goose.DialectPostgres, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	fsys, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	return goose.NewProvider("", db, fsys, goose.WithStore(store))
+}
+
+// orderByClause sorts case insensitively, like COLLATE NOCASE does for the SQLite backend.
+func orderByClause(table string, column string, dir string) mods.OrderBy[*dialect.SelectQuery] {
+	return mods.OrderBy[*dialect.SelectQuery]{
+		Expression: "lower(" + psql.Quote(table, column).String() + ")",
+		Direction:  dir,
+	}
+}
+
+func nullStr(str string) sql.NullString {
+	return sql.NullString{String: str, Valid: str != ""}
+}
+
+func nullInt64(i int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: i, Valid: i != 0}
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+// limitOffset applies the default and maximum page size of the list queries.
+func limitOffset(page int, pageSize int) (limit int, offset int) {
+	limit = pageSize
+
+	if limit == 0 {
+		limit = 50
+	}
+
+	if limit > 100 {
+		limit = 100
+	}
+
+	return limit, limit * page
+}
@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/RobinThrift/stuff/internal/search"
+	"github.com/RobinThrift/stuff/storage/database/databasetest"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToTSQuery(t *testing.T) {
+	tt := []struct {
+		term *search.Term
+		exp  string
+		ok   bool
+	}{
+		{term: &search.Term{Text: "laptop", Prefix: true}, exp: "'laptop':*", ok: true},
+		{term: &search.Term{Text: "laptop"}, exp: "'laptop'", ok: true},
+		{term: &search.Term{Text: "dell laptop", Phrase: true, Prefix: true}, exp: "'dell' <-> 'laptop':*", ok: true},
+		{term: &search.Term{Text: "it's a-laptop!"}, exp: "'it' <-> 's' <-> 'a' <-> 'laptop'", ok: true},
+		{term: &search.Term{Text: "  ", Prefix: true}, ok: false},
+		{term: &search.Term{Text: "'!", Prefix: true}, ok: false},
+	}
+
+	for _, tt := range tt {
+		t.Run(tt.term.Text, func(t *testing.T) {
+			tsquery, ok := toTSQuery(tt.term)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.exp, tsquery)
+		})
+	}
+}
+
+func TestSearchRankQuery(t *testing.T) {
+	tsquery, ok := searchRankQuery(mustParseSearch(t, `(dell OR "desk lamp") -broken status:in_use notes:cable`))
+	assert.True(t, ok)
+	assert.Equal(t, "('dell') | ('desk' <-> 'lamp') | ('cable')", tsquery)
+
+	_, ok = searchRankQuery(mustParseSearch(t, "-broken status:in_use"))
+	assert.False(t, ok)
+}
+
+func TestRunMigrations(t *testing.T) {
+	newTestDB(t)
+}
+
+func TestRepos(t *testing.T) {
+	databasetest.Run(t, func(t *testing.T) bob.Executor { return newTestTx(t) }, databasetest.Repos{
+		Assets:     &AssetRepo{},
+		Files:      &FileRepo{},
+		Models:     &ModelRepo{},
+		Tags:       &TagRepo{},
+		Users:      &UserRepo{},
+		Workspaces: &WorkspaceRepo{},
+	})
+}
+
+func mustParseSearch(t *testing.T, query string) search.Node {
+	node, err := search.Parse(query)
+	require.NoError(t, err)
+	return node
+}
+
+// newTestDB migrates a new schema in the database at STUFF_TEST_POSTGRES_URL. The test is skipped if it is not set.
+func newTestDB(t *testing.T) bob.DB {
+	connStr := os.Getenv("STUFF_TEST_POSTGRES_URL")
+	if connStr == "" {
+		t.Skip("STUFF_TEST_POSTGRES_URL not set")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	admin, err := NewPostgresDB(&Config{URL: connStr})
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, admin.Close()) })
+
+	// the name of the test isn't part of the schema name, as it would exceed the max. length of identifiers
+	schema := fmt.Sprintf("stuff_test_%d", time.Now().UnixNano())
+
+	_, err = admin.ExecContext(ctx, "CREATE SCHEMA "+schema)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := admin.ExecContext(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+		assert.NoError(t, err)
+	})
+
+	u, err := url.Parse(connStr)
+	require.NoError(t, err)
+	q := u.Query()
+	q.Set("search_path", schema)
+	u.RawQuery = q.Encode()
+
+	db, err := NewPostgresDB(&Config{URL: u.String()})
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, db.Close()) })
+
+	err = RunMigrations(ctx, db)
+	require.NoError(t, err)
+
+	return bob.NewDB(db)
+}
+
+// newTestTx starts a transaction in a new test database, that checks the foreign keys only on commit.
+// It is never committed but rolled back at the end of the test.
+func newTestTx(t *testing.T) bob.Tx {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	tx, err := newTestDB(t).BeginTx(ctx, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, tx.Rollback()) })
+
+	_, err = tx.ExecContext(ctx, "SET CONSTRAINTS ALL DEFERRED")
+	require.NoError(t, err)
+
+	return tx
+}
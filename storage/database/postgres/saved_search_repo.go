@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/scan"
+)
+
+type SavedSearchRepo struct{}
+
+type savedSearchRow struct {
+	ID          int64     `db:"id"`
+	WorkspaceID int64     `db:"workspace_id"`
+	UserID      int64     `db:"user_id"`
+	Name        string    `db:"name"`
+	Query       string    `db:"query"`
+	Filters     string    `db:"filters"`
+	Shared      bool      `db:"shared"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+func (*SavedSearchRepo) List(ctx context.Context, exec bob.Executor, query database.ListSavedSearchesQuery) ([]*entities.SavedSearch, error) {
+	qmods := []bob.Mod[*dialect.SelectQuery]{
+		sm.From("saved_searches"),
+		sm.Where(psql.Quote("user_id").EQ(psql.Arg(query.UserID)).Or(psql.Quote("shared"))),
+		sm.OrderBy("name"),
+		sm.OrderBy("id"),
+	}
+
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, sm.Where(psql.Quote("workspace_id").EQ(psql.Arg(query.WorkspaceID))))
+	}
+
+	rows, err := bob.All(ctx, exec, psql.Select(qmods...), scan.StructMapper[*savedSearchRow]())
+	if err != nil {
+		return nil, fmt.Errorf("error getting saved searches for user %d: %w", query.UserID, err)
+	}
+
+	list := make([]*entities.SavedSearch, 0, len(rows))
+	for _, row := range rows {
+		search, err := mapDBRowToSavedSearch(row)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, search)
+	}
+
+	return list, nil
+}
+
+func (*SavedSearchRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*entities.SavedSearch, error) {
+	row, err := bob.One(ctx, exec, psql.Select(
+		sm.From("saved_searches"),
+		sm.Where(psql.Quote("id").EQ(psql.Arg(id))),
+	), scan.StructMapper[*savedSearchRow]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %d", database.ErrSavedSearchNotFound, id)
+		}
+		return nil, fmt.Errorf("error getting saved search %d: %w", id, err)
+	}
+
+	return mapDBRowToSavedSearch(row)
+}
+
+func (*SavedSearchRepo) Create(ctx context.Context, exec bob.Executor, search *entities.SavedSearch) error {
+	inserted, err := bob.One(ctx, exec, psql.Insert(
+		im.Into("saved_searches", "workspace_id", "user_id", "name", "query", "filters", "shared"),
+		im.Values(psql.Arg(search.WorkspaceID, search.UserID, search.Name, search.Query, search.Filters.Encode(), search.Shared)),
+		im.Returning("*"),
+	), scan.StructMapper[*savedSearchRow]())
+	if err != nil {
+		return fmt.Errorf("error creating saved search: %w", err)
+	}
+
+	search.ID = inserted.ID
+	search.CreatedAt = inserted.CreatedAt
+	search.UpdatedAt = inserted.UpdatedAt
+
+	return nil
+}
+
+func (*SavedSearchRepo) Delete(ctx context.Context, exec bob.Executor, id int64) error {
+	_, err := bob.Exec(ctx, exec, psql.Delete(
+		dm.From("saved_searches"),
+		dm.Where(psql.Quote("id").EQ(psql.Arg(id))),
+	))
+	if err != nil {
+		return fmt.Errorf("error deleting saved search %d: %w", id, err)
+	}
+
+	return nil
+}
+
+func mapDBRowToSavedSearch(row *savedSearchRow) (*entities.SavedSearch, error) {
+	filters, err := entities.ParseAssetFilters(row.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing filters of saved search %d: %w", row.ID, err)
+	}
+
+	return &entities.SavedSearch{
+		ID:          row.ID,
+		WorkspaceID: row.WorkspaceID,
+		UserID:      row.UserID,
+		Name:        row.Name,
+		Query:       row.Query,
+		Filters:     filters,
+		Shared:      row.Shared,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,271 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/search"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/scan"
+)
+
+// searchCondition compiles the parsed search query into a condition on the assets table.
+// Text terms are matched with the search vector, or the vector of a single column if the term is restricted to a field.
+func searchCondition(node search.Node) (bob.Expression, error) {
+	switch n := node.(type) {
+	case *search.And:
+		conditions, err := searchConditions(n.Nodes)
+		if err != nil {
+			return nil, err
+		}
+		return psql.Group(psql.And(conditions...)), nil
+	case *search.Or:
+		conditions, err := searchConditions(n.Nodes)
+		if err != nil {
+			return nil, err
+		}
+		return psql.Group(psql.Or(conditions...)), nil
+	case *search.Not:
+		condition, err := searchCondition(n.Node)
+		if err != nil {
+			return nil, err
+		}
+		return psql.Not(psql.Group(condition)), nil
+	case *search.Term:
+		return termCondition(n)
+	case *search.Compare:
+		return compareCondition(n)
+	}
+
+	return nil, fmt.Errorf("unsupported search query node %T", node)
+}
+
+func searchConditions(nodes []search.Node) ([]bob.Expression, error) {
+	conditions := make([]bob.Expression, 0, len(nodes))
+	for _, node := range nodes {
+		condition, err := searchCondition(node)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	return conditions, nil
+}
+
+var searchTextColumns = map[string]string{
+	search.FieldName:         "name",
+	search.FieldTag:          "tag",
+	search.FieldCategory:     "category",
+	search.FieldModel:        "model",
+	search.FieldModelNo:      "model_no",
+	search.FieldSerialNo:     "serial_no",
+	search.FieldManufacturer: "manufacturer",
+	search.FieldNotes:        "notes",
+}
+
+func termCondition(term *search.Term) (bob.Expression, error) {
+	tsquery, ok := toTSQuery(term)
+	if !ok {
+		return psql.Raw("false"), nil
+	}
+
+	if term.Field == "" {
+		return psql.Raw("assets.search @@ to_tsquery('simple', ?)", tsquery), nil
+	}
+
+	column, ok := searchTextColumns[term.Field]
+	if !ok {
+		return nil, fmt.Errorf("unsupported search field %s", term.Field)
+	}
+
+	return psql.Raw("to_tsvector('simple', coalesce("+psql.Quote("assets", column).String()+", '')) @@ to_tsquery('simple', ?)", tsquery), nil
+}
+
+// toTSQuery builds a tsquery that matches the words of the term in order, like an FTS5 phrase in SQLite.
+// Returns false if the term contains no words.
+func toTSQuery(term *search.Term) (string, bool) {
+	words := term.Words()
+	if len(words) == 0 {
+		return "", false
+	}
+
+	tsterms := make([]string, 0, len(words))
+	for _, word := range words {
+		tsterms = append(tsterms, "'"+word+"'")
+	}
+
+	if term.Prefix {
+		tsterms[len(tsterms)-1] += ":*"
+	}
+
+	return strings.Join(tsterms, " <-> "), true
+}
+
+// searchRankQuery combines all terms, which aren't negated, into a single tsquery, that is used to rank the
+// results and to build the snippets. Returns false if the search query contains no such terms.
+func searchRankQuery(node search.Node) (string, bool) {
+	var tsqueries []string
+	collectRankTerms(node, &tsqueries)
+
+	if len(tsqueries) == 0 {
+		return "", false
+	}
+
+	return strings.Join(tsqueries, " | "), true
+}
+
+func collectRankTerms(node search.Node, tsqueries *[]string) {
+	switch n := node.(type) {
+	case *search.And:
+		for _, node := range n.Nodes {
+			collectRankTerms(node, tsqueries)
+		}
+	case *search.Or:
+		for _, node := range n.Nodes {
+			collectRankTerms(node, tsqueries)
+		}
+	case *search.Term:
+		if tsquery, ok := toTSQuery(n); ok {
+			*tsqueries = append(*tsqueries, "("+tsquery+")")
+		}
+	}
+}
+
+// searchHeadlineColumns are the columns the snippets are taken from, the same columns as the search vector
+// without the custom attributes, in the order of their weight.
+var searchHeadlineColumns = []string{
+	"assets.name", "assets.tag", "assets.category", "assets.model", "assets.model_no", "assets.serial_no", "assets.manufacturer", "assets.notes",
+}
+
+var searchHeadlineOptions = fmt.Sprintf(`StartSel="%s", StopSel="%s", FragmentDelimiter="…", MaxFragments=1, MaxWords=12, MinWords=3`, entities.SnippetMatchStart, entities.SnippetMatchEnd)
+
+type searchSnippet struct {
+	ID      int64  `db:"id"`
+	Snippet string `db:"snippet"`
+}
+
+// searchSnippets returns a short excerpt of the first matching column for each asset, like the snippets in SQLite,
+// with the matching words wrapped in entities.SnippetMatchStart and entities.SnippetMatchEnd.
+func searchSnippets(ctx context.Context, exec bob.Executor, node search.Node, assets []*assetRow) (map[int64]string, error) {
+	rankQuery, ok := searchRankQuery(node)
+	if !ok {
+		return nil, nil
+	}
+
+	ids := make([]int64, 0, len(assets))
+	for _, a := range assets {
+		ids = append(ids, a.ID)
+	}
+
+	headlines := make([]string, 0, len(searchHeadlineColumns))
+	args := make([]any, 0, len(searchHeadlineColumns)*3)
+	for _, column := range searchHeadlineColumns {
+		headlines = append(headlines, "CASE WHEN to_tsvector('simple', coalesce("+column+", '')) @@ to_tsquery('simple', ?) THEN ts_headline('simple', "+column+", to_tsquery('simple', ?), ?) END")
+		args = append(args, rankQuery, rankQuery, searchHeadlineOptions)
+	}
+
+	rows, err := bob.All(ctx, exec,
+		psql.Select(
+			sm.Columns(
+				psql.Quote("assets", "id").As("id"),
+				psql.Raw("coalesce("+strings.Join(headlines, ", ")+", '')", args...).As("snippet"),
+			),
+			sm.From("assets"),
+			sm.Where(psql.Quote("assets", "id").In(int64Args(ids)...)),
+			sm.Where(psql.Raw("assets.search @@ to_tsquery('simple', ?)", rankQuery)),
+		),
+		scan.StructMapper[*searchSnippet](),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting search snippets: %w", err)
+	}
+
+	snippets := make(map[int64]string, len(rows))
+	for _, row := range rows {
+		snippets[row.ID] = row.Snippet
+	}
+
+	return snippets, nil
+}
+
+var searchAssetColumns = map[string]string{
+	search.FieldStatus:       "assets.status",
+	search.FieldAssetType:    "assets.type",
+	search.FieldLocation:     "assets.location",
+	search.FieldPositionCode: "assets.position_code",
+	search.FieldQuantity:     "assets.quantity",
+	search.FieldWarranty:     "assets.warranty_until",
+}
+
+var searchPurchaseColumns = map[string]string{
+	search.FieldPurchaseSupplier: "asset_purchases.supplier",
+	search.FieldPurchaseOrderNo:  "asset_purchases.order_no",
+	search.FieldPurchaseCurrency: "asset_purchases.currency",
+	search.FieldPurchaseAmount:   "asset_purchases.amount",
+	search.FieldPurchaseDate:     "asset_purchases.order_date",
+}
+
+func compareCondition(cmp *search.Compare) (bob.Expression, error) {
+	if cmp.Type == search.TypeAttr {
+		return customAttrCondition(cmp), nil
+	}
+
+	if column, ok := searchAssetColumns[cmp.Field]; ok {
+		return compareColumn(column, cmp), nil
+	}
+
+	if column, ok := searchPurchaseColumns[cmp.Field]; ok {
+		return psql.Raw("EXISTS (SELECT 1 FROM asset_purchases WHERE asset_purchases.asset_id = assets.id AND ?)", compareColumn(column, cmp)), nil
+	}
+
+	return nil, fmt.Errorf("unsupported search field %s", cmp.Field)
+}
+
+func compareColumn(column string, cmp *search.Compare) bob.Expression {
+	switch cmp.Type {
+	case search.TypeKeyword:
+		if cmp.Prefix {
+			return psql.Raw(column+` ILIKE ? ESCAPE '\'`, likePrefix(cmp.Text))
+		}
+		return psql.Raw("lower("+column+") = lower(?)", cmp.Text)
+	case search.TypeDate:
+		return psql.Raw(column+" "+string(cmp.Op)+" ?", cmp.Time)
+	default:
+		return psql.Raw(column+" "+string(cmp.Op)+" CAST(? AS DOUBLE PRECISION)", cmp.Number)
+	}
+}
+
+// leadingNumber matches custom attribute values that start with a number, so "16 GB" is compared as 16, like in SQLite.
+const leadingNumber = `^\s*(-?[0-9]+(\.[0-9]+)?)`
+
+// customAttrCondition compares the value of the custom attribute as text, or as a number for range comparisons.
+func customAttrCondition(cmp *search.Compare) bob.Expression {
+	var condition bob.Expression
+	switch {
+	case cmp.Op == search.OpEQ && cmp.Prefix:
+		condition = psql.Raw(`attrs.attr->>'value' ILIKE ? ESCAPE '\'`, likePrefix(cmp.Text))
+	case cmp.Op == search.OpEQ:
+		condition = psql.Raw("lower(attrs.attr->>'value') = lower(?)", cmp.Text)
+	default:
+		condition = psql.Raw(
+			"CAST(substring(attrs.attr->>'value' from CAST(? AS TEXT)) AS DOUBLE PRECISION) "+string(cmp.Op)+" CAST(? AS DOUBLE PRECISION)",
+			leadingNumber, cmp.Number,
+		)
+	}
+
+	return psql.Raw(
+		"EXISTS (SELECT 1 FROM jsonb_array_elements(assets.custom_attrs) AS attrs(attr) WHERE lower(attrs.attr->>'name') = lower(?) AND ?)",
+		cmp.Attr, condition,
+	)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func likePrefix(s string) string {
+	return likeEscaper.Replace(s) + "%"
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/alexedwards/scs/v2"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/scan"
+)
+
+const cleanupInterval = time.Minute * 30
+
+var ErrFindingSession = errors.New("error finding sessions")
+var ErrCommittingSession = errors.New("error committing sessions")
+
+type PostgresSessionStore struct {
+	db *database.Database
+}
+
+var _ scs.Store = (*PostgresSessionStore)(nil)
+
+func NewPostgresSessionStore(db *database.Database) *PostgresSessionStore {
+	s := &PostgresSessionStore{db: db}
+	go s.cleanupTask()
+	return s
+}
+
+func (s *PostgresSessionStore) Find(token string) ([]byte, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	return s.FindCtx(ctx, token)
+}
+
+func (s *PostgresSessionStore) Commit(token string, b []byte, expiry time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	return s.CommitCtx(ctx, token, b, expiry)
+}
+
+func (s *PostgresSessionStore) Delete(token string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	return s.DeleteCtx(ctx, token)
+}
+
+func (s *PostgresSessionStore) FindCtx(ctx context.Context, token string) ([]byte, bool, error) {
+	data, err := bob.One(ctx, s.db, psql.Select(
+		sm.Columns("data"),
+		sm.From("sessions"),
+		sm.Where(psql.Quote("token").EQ(psql.Arg(token))),
+	), scan.SingleColumnMapper[[]byte])
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+
+		slog.Error(ErrFindingSession.Error(), "error", err, "token", token)
+		return nil, false, err
+	}
+
+	return data, true, nil
+}
+
+func (s *PostgresSessionStore) CommitCtx(ctx context.Context, token string, b []byte, expiry time.Time) error {
+	err := s.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		_, err := bob.Exec(ctx, tx, psql.Insert(
+			im.Into("sessions", "token", "data", "expires_at"),
+			im.Values(psql.Arg(token, b, expiry)),
+			im.OnConflict("token").DoUpdate().SetExcluded("data", "expires_at"),
+		))
+		return err
+	})
+
+	if err != nil {
+		slog.Error(ErrCommittingSession.Error(), "error", err, "token", token)
+		return fmt.Errorf("%v: %w", ErrCommittingSession, err)
+	}
+
+	return nil
+}
+
+func (s *PostgresSessionStore) DeleteCtx(ctx context.Context, token string) error {
+	err := s.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		_, err := bob.Exec(ctx, tx, psql.Delete(dm.From("sessions"), dm.Where(psql.Quote("token").EQ(psql.Arg(token)))))
+		return err
+	})
+
+	if err != nil {
+		slog.Error("error deleting session", "error", err, "token", token)
+		return err
+	}
+
+	return nil
+}
+
+func (s *PostgresSessionStore) deleteExpired(ctx context.Context) error {
+	return s.db.InTransaction(ctx, func(ctx context.Context, tx database.Executor) error {
+		_, err := bob.Exec(ctx, tx, psql.Delete(dm.From("sessions"), dm.Where(psql.Quote("expires_at").LT(psql.Arg(time.Now())))))
+		return err
+	})
+}
+
+func (s *PostgresSessionStore) cleanupTask() {
+	ticker := time.NewTicker(cleanupInterval)
+	for range ticker.C {
+		func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			err := s.deleteExpired(ctx)
+			if err != nil {
+				slog.Error("error while running session cleanup task", "error", err)
+			}
+		}()
+	}
+}
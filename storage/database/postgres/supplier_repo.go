@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/scan"
+)
+
+type SupplierRepo struct{}
+
+func (cr *SupplierRepo) List(ctx context.Context, exec bob.Executor, query database.ListSuppliersQuery) (*entities.ListPage[*entities.Supplier], error) {
+	where := []bob.Expression{}
+
+	if query.Search != "" {
+		where = append(where, containsExpr("name", query.Search))
+	}
+
+	if query.WorkspaceID != 0 {
+		where = append(where, psql.Quote("workspace_id").EQ(psql.Arg(query.WorkspaceID)))
+	}
+
+	suppliers, count, err := listView(ctx, exec, "suppliers", []string{"name"}, where, query.Page, query.PageSize, scan.StructMapper[struct {
+		Name string `db:"name"`
+	}]())
+	if err != nil {
+		return nil, err
+	}
+
+	numPages, pageSize := calcNumPages(query.PageSize, count)
+	page := &entities.ListPage[*entities.Supplier]{
+		Items:    make([]*entities.Supplier, 0, len(suppliers)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: pageSize,
+		NumPages: numPages,
+	}
+
+	for _, c := range suppliers {
+		page.Items = append(page.Items, &entities.Supplier{Name: c.Name})
+	}
+
+	return page, nil
+}
@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+)
+
+type TagRepo struct{}
+
+type tagRow struct {
+	ID          int64     `db:"id"`
+	WorkspaceID int64     `db:"workspace_id"`
+	Tag         string    `db:"tag"`
+	InUse       bool      `db:"in_use"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+func (*TagRepo) List(ctx context.Context, exec bob.Executor, query database.ListTagsQuery) (*entities.ListPage[*entities.Tag], error) {
+	limit, offset := limitOffset(query.Page, query.PageSize)
+
+	qmods := []bob.Mod[*dialect.SelectQuery]{sm.From("tags")}
+	if query.Search != "" {
+		qmods = append(qmods, sm.Where(containsExpr("tag", query.Search)))
+	}
+
+	if query.InUse != nil {
+		qmods = append(qmods, sm.Where(psql.Quote("in_use").EQ(psql.Arg(*query.InUse))))
+	}
+
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, sm.Where(psql.Quote("workspace_id").EQ(psql.Arg(query.WorkspaceID))))
+	}
+
+	count, err := bob.One(ctx, exec, psql.Select(append(qmods, sm.Columns("count(*)"))...), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return nil, fmt.Errorf("error counting tags: %w", err)
+	}
+
+	if query.OrderBy != "" {
+		if query.OrderDir == "" {
+			query.OrderDir = "ASC"
+		}
+
+		qmods = append(qmods, orderByClause("tags", query.OrderBy, query.OrderDir))
+	}
+
+	qmods = append(qmods, sm.Limit(limit), sm.Offset(offset))
+
+	tags, err := bob.All(ctx, exec, psql.Select(qmods...), scan.StructMapper[*tagRow]())
+	if err != nil {
+		return nil, fmt.Errorf("error getting tags: %w", err)
+	}
+
+	numPages, pageSize := calcNumPages(query.PageSize, count)
+	page := &entities.ListPage[*entities.Tag]{
+		Items:    make([]*entities.Tag, 0, len(tags)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: pageSize,
+		NumPages: numPages,
+	}
+
+	for i := range tags {
+		page.Items = append(page.Items, mapDBRowToTag(tags[i]))
+	}
+
+	return page, nil
+}
+
+func (*TagRepo) GetUnused(ctx context.Context, exec bob.Executor, workspaceID int64) (*entities.Tag, error) {
+	qmods := []bob.Mod[*dialect.SelectQuery]{
+		sm.From("tags"),
+		sm.Where(psql.Quote("in_use").EQ(psql.Arg(false))),
+		sm.OrderBy("id"),
+		sm.Limit(1),
+	}
+	if workspaceID != 0 {
+		qmods = append(qmods, sm.Where(psql.Quote("workspace_id").EQ(psql.Arg(workspaceID))))
+	}
+
+	tag, err := bob.One(ctx, exec, psql.Select(qmods...), scan.StructMapper[*tagRow]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return mapDBRowToTag(tag), nil
+}
+
+func (*TagRepo) Get(ctx context.Context, exec bob.Executor, tag string) (*entities.Tag, error) {
+	row, err := bob.One(ctx, exec, psql.Select(
+		sm.From("tags"),
+		sm.Where(psql.Quote("tag").EQ(psql.Arg(tag))),
+	), scan.StructMapper[*tagRow]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entities.ErrTagNotFound
+		}
+		return nil, err
+	}
+
+	return mapDBRowToTag(row), nil
+}
+
+func (*TagRepo) Create(ctx context.Context, exec bob.Executor, tag *entities.Tag) error {
+	workspaceID := tag.WorkspaceID
+	if workspaceID == 0 {
+		workspaceID = entities.DefaultWorkspaceID
+	}
+
+	_, err := bob.Exec(ctx, exec, psql.Insert(
+		im.Into("tags", "tag", "in_use", "workspace_id"),
+		im.Values(psql.Arg(tag.Tag, tag.InUse, workspaceID)),
+	))
+	if err != nil {
+		return fmt.Errorf("error creating tag %s: %w", tag.Tag, err)
+	}
+
+	_, err = bob.Exec(ctx, exec, psql.Update(
+		um.Table("workspaces"),
+		um.Set("tag_counter").To(psql.Raw("tag_counter + 1")),
+		um.Where(psql.Quote("id").EQ(psql.Arg(workspaceID))),
+	))
+	if err != nil {
+		return fmt.Errorf("error incrementing tag counter of workspace %d: %w", workspaceID, err)
+	}
+
+	return nil
+}
+
+func (*TagRepo) MarkTagUsed(ctx context.Context, exec bob.Executor, tag string) error {
+	return setTagInUse(ctx, exec, tag, true)
+}
+
+func (*TagRepo) MarkTagUnused(ctx context.Context, exec bob.Executor, tag string) error {
+	return setTagInUse(ctx, exec, tag, false)
+}
+
+func setTagInUse(ctx context.Context, exec bob.Executor, tag string, inUse bool) error {
+	_, err := bob.Exec(ctx, exec, psql.Update(
+		um.Table("tags"),
+		um.Set("in_use").ToArg(inUse),
+		um.Set("updated_at").ToArg(time.Now()),
+		um.Where(psql.Quote("tag").EQ(psql.Arg(tag))),
+	))
+	return err
+}
+
+func (*TagRepo) Delete(ctx context.Context, exec bob.Executor, tag string) error {
+	_, err := bob.Exec(ctx, exec, psql.Delete(
+		dm.From("tags"),
+		dm.Where(psql.Quote("tag").EQ(psql.Arg(tag))),
+		dm.Where(psql.Quote("in_use").EQ(psql.Arg(false))),
+	))
+	return err
+}
+
+// NextSequential returns the next number for sequential tags. If workspaceID is not 0,
+// the workspace's own counter is used instead of the global one.
+func (*TagRepo) NextSequential(ctx context.Context, exec bob.Executor, workspaceID int64) (int64, error) {
+	if workspaceID != 0 {
+		counter, err := bob.One(ctx, exec, psql.Select(
+			sm.Columns("tag_counter"),
+			sm.From("workspaces"),
+			sm.Where(psql.Quote("id").EQ(psql.Arg(workspaceID))),
+		), scan.SingleColumnMapper[int64])
+		if err != nil {
+			return 0, fmt.Errorf("error getting tag counter of workspace %d: %w", workspaceID, err)
+		}
+
+		return counter + 1, nil
+	}
+
+	// the sequence reports its start value until the first tag was inserted
+	current, err := bob.One(ctx, exec, psql.Select(
+		sm.Columns("CASE WHEN is_called THEN last_value ELSE 0 END"),
+		sm.From("tags_id_seq"),
+	), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return 0, err
+	}
+
+	return current + 1, nil
+}
+
+func mapDBRowToTag(row *tagRow) *entities.Tag {
+	return &entities.Tag{
+		ID:          row.ID,
+		WorkspaceID: row.WorkspaceID,
+		Tag:         row.Tag,
+		InUse:       row.InUse,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
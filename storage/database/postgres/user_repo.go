@@ -0,0 +1,321 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+)
+
+type UserRepo struct{}
+
+type userRow struct {
+	ID          int64     `db:"id"`
+	Username    string    `db:"username"`
+	DisplayName string    `db:"display_name"`
+	AuthRef     string    `db:"auth_ref"`
+	Role        string    `db:"role"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+type userPreferenceRow struct {
+	Key   string `db:"key"`
+	Value []byte `db:"value"`
+}
+
+func (*UserRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*auth.User, error) {
+	user, err := getUser(ctx, exec, psql.Quote("id").EQ(psql.Arg(id)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: id %d", database.ErrUserNotFound, id)
+		}
+
+		return nil, fmt.Errorf("error getting user by id: id %d: %w", id, err)
+	}
+
+	return user, nil
+}
+
+func (*UserRepo) GetByUsername(ctx context.Context, exec bob.Executor, username string) (*auth.User, error) {
+	user, err := getUser(ctx, exec, psql.Quote("username").EQ(psql.Arg(username)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: username %s", database.ErrUserNotFound, username)
+		}
+
+		return nil, fmt.Errorf("error getting user by username: username %s: %w", username, err)
+	}
+
+	return user, nil
+}
+
+func (*UserRepo) GetByRef(ctx context.Context, exec bob.Executor, ref string) (*auth.User, error) {
+	user, err := getUser(ctx, exec, psql.Quote("auth_ref").EQ(psql.Arg(ref)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: ref %s", database.ErrUserNotFound, ref)
+		}
+
+		return nil, fmt.Errorf("error getting user by ref: ref %s: %w", ref, err)
+	}
+
+	return user, nil
+}
+
+func getUser(ctx context.Context, exec bob.Executor, where bob.Expression) (*auth.User, error) {
+	row, err := bob.One(ctx, exec, psql.Select(sm.From("users"), sm.Where(where)), scan.StructMapper[*userRow]())
+	if err != nil {
+		return nil, err
+	}
+
+	prefs, err := bob.All(ctx, exec, psql.Select(
+		sm.Columns("key", "value"),
+		sm.From("user_preferences"),
+		sm.Where(psql.Quote("user_id").EQ(psql.Arg(row.ID))),
+	), scan.StructMapper[*userPreferenceRow]())
+	if err != nil {
+		return nil, err
+	}
+
+	user := mapDBRowToUser(row)
+	for _, pref := range prefs {
+		err = mapUserPrefValueToEntity(ctx, &user.Preferences, pref)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+func (*UserRepo) List(ctx context.Context, exec bob.Executor, query database.ListUsersQuery) (*entities.ListPage[*auth.User], error) {
+	limit, offset := limitOffset(query.Page, query.PageSize)
+
+	mods := []bob.Mod[*dialect.SelectQuery]{sm.From("users")}
+
+	if query.Search != "" {
+		mods = append(mods, sm.Where(containsExpr("username", query.Search)))
+	}
+
+	count, err := bob.One(ctx, exec, psql.Select(append(mods, sm.Columns("count(*)"))...), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return nil, fmt.Errorf("error counting users: %w", err)
+	}
+
+	if query.OrderBy != "" {
+		if query.OrderDir == "" {
+			query.OrderDir = "ASC"
+		}
+
+		mods = append(mods, orderByClause("users", query.OrderBy, query.OrderDir))
+	}
+
+	mods = append(mods, sm.Limit(limit), sm.Offset(offset))
+
+	users, err := bob.All(ctx, exec, psql.Select(mods...), scan.StructMapper[*userRow]())
+	if err != nil {
+		return nil, fmt.Errorf("error getting users: %w", err)
+	}
+
+	pageSize := limit
+	page := &entities.ListPage[*auth.User]{
+		Items:    make([]*auth.User, 0, len(users)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		NumPages: int(count) / pageSize,
+	}
+
+	for i := range users {
+		user := mapDBRowToUser(users[i])
+		user.AuthRef = ""
+		page.Items = append(page.Items, user)
+	}
+
+	return page, nil
+}
+
+func (*UserRepo) CountAdmins(ctx context.Context, exec bob.Executor) (int64, error) {
+	return bob.One(ctx, exec, psql.Select(
+		sm.Columns("count(*)"),
+		sm.From("users"),
+		sm.Where(psql.Quote("role").EQ(psql.Arg(string(auth.RoleAdmin)))),
+	), scan.SingleColumnMapper[int64])
+}
+
+func (*UserRepo) Create(ctx context.Context, exec bob.Executor, toCreate *auth.User) error {
+	id, err := bob.One(ctx, exec, psql.Insert(
+		im.Into("users", "username", "display_name", "role", "auth_ref"),
+		im.Values(psql.Arg(toCreate.Username, toCreate.DisplayName, string(toCreate.Role), toCreate.AuthRef)),
+		im.Returning("id"),
+	), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return err
+	}
+
+	toCreate.ID = id
+
+	return nil
+}
+
+func (*UserRepo) Update(ctx context.Context, exec bob.Executor, toUpdate *auth.User) error {
+	updatedAt := time.Now()
+
+	_, err := bob.Exec(ctx, exec, psql.Update(
+		um.Table("users"),
+		um.Set("username").ToArg(toUpdate.Username),
+		um.Set("display_name").ToArg(toUpdate.DisplayName),
+		um.Set("role").ToArg(string(toUpdate.Role)),
+		um.Set("auth_ref").ToArg(toUpdate.AuthRef),
+		um.Set("updated_at").ToArg(updatedAt),
+		um.Where(psql.Quote("id").EQ(psql.Arg(toUpdate.ID))),
+	))
+	if err != nil {
+		return err
+	}
+
+	toUpdate.UpdatedAt = updatedAt
+
+	return nil
+}
+
+func (*UserRepo) Delete(ctx context.Context, exec bob.Executor, id int64) error {
+	_, err := bob.Exec(ctx, exec, psql.Delete(
+		dm.From("users"),
+		dm.Where(psql.Quote("id").EQ(psql.Arg(id))),
+	))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (*UserRepo) UpsertPreferences(ctx context.Context, exec bob.Executor, user *auth.User) error {
+	prefs, err := mapUserPrefsToRows(user.Preferences)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	rows := make([][]bob.Expression, 0, len(prefs))
+	for _, pref := range prefs {
+		rows = append(rows, []bob.Expression{psql.Arg(user.ID), psql.Arg(pref.Key), psql.Arg(pref.Value), psql.Arg(now), psql.Arg(now)})
+	}
+
+	_, err = bob.Exec(ctx, exec, psql.Insert(
+		im.Into("user_preferences", "user_id", "key", "value", "created_at", "updated_at"),
+		im.Rows(rows...),
+		im.OnConflict("user_id", "key").DoUpdate().SetExcluded("value", "updated_at"),
+	))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func mapDBRowToUser(row *userRow) *auth.User {
+	return &auth.User{
+		ID:          row.ID,
+		Username:    row.Username,
+		DisplayName: row.DisplayName,
+		Role:        auth.Role(row.Role),
+		AuthRef:     row.AuthRef,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+func mapUserPrefsToRows(prefs auth.UserPreferences) ([]*userPreferenceRow, error) {
+	rows := []*userPreferenceRow{
+		{Key: "sidebar_closed_desktop", Value: []byte(fmt.Sprint(prefs.SidebarClosedDesktop))},
+		{Key: "asset_list_compact", Value: []byte(fmt.Sprint(prefs.AssetListCompact))},
+		{Key: "user_list_compact", Value: []byte(fmt.Sprint(prefs.UserListCompact))},
+	}
+
+	if prefs.AssetListColumns != nil {
+		val, err := json.Marshal(prefs.AssetListColumns)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, &userPreferenceRow{Key: "asset_list_columns", Value: val})
+	}
+
+	if prefs.PinnedSavedSearches != nil {
+		val, err := json.Marshal(prefs.PinnedSavedSearches)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, &userPreferenceRow{Key: "pinned_saved_searches", Value: val})
+	}
+
+	if prefs.ThemeName != "" {
+		rows = append(rows, &userPreferenceRow{Key: "theme_name", Value: []byte(prefs.ThemeName)})
+	}
+
+	if prefs.ThemeMode != "" {
+		rows = append(rows, &userPreferenceRow{Key: "theme_mode", Value: []byte(prefs.ThemeMode)})
+	}
+
+	return rows, nil
+}
+
+func mapUserPrefValueToEntity(ctx context.Context, prefs *auth.UserPreferences, pref *userPreferenceRow) error {
+	switch pref.Key {
+	case "sidebar_closed_desktop":
+		val, err := strconv.ParseBool(string(pref.Value))
+		if err != nil {
+			return err
+		}
+		prefs.SidebarClosedDesktop = val
+		return nil
+	case "asset_list_compact":
+		val, err := strconv.ParseBool(string(pref.Value))
+		if err != nil {
+			return err
+		}
+		prefs.AssetListCompact = val
+		return nil
+	case "user_list_compact":
+		val, err := strconv.ParseBool(string(pref.Value))
+		if err != nil {
+			return err
+		}
+		prefs.UserListCompact = val
+		return nil
+	case "theme_name":
+		prefs.ThemeName = string(pref.Value)
+		return nil
+	case "theme_mode":
+		prefs.ThemeMode = string(pref.Value)
+		return nil
+	case "asset_list_columns":
+		err := json.Unmarshal(pref.Value, &prefs.AssetListColumns)
+		return err
+	case "pinned_saved_searches":
+		err := json.Unmarshal(pref.Value, &prefs.PinnedSavedSearches)
+		return err
+	}
+
+	slog.WarnContext(ctx, fmt.Sprintf("unknown preference key: %s", pref.Key))
+
+	return nil
+}
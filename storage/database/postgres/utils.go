@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/scan"
+)
+
+func calcNumPages(requestPageSize int, count int64) (numPages int, pageSize int) {
+	pageSize = (requestPageSize)
+	if pageSize <= 0 {
+		pageSize = int(count)
+	}
+
+	if pageSize <= 0 {
+		return 1, int(count)
+	}
+
+	return divCeil(int(count), pageSize), pageSize
+}
+
+func divCeil(a, b int) int {
+	mod := a % b
+	if mod == 0 {
+		return a / b
+	}
+
+	return a/b + 1
+}
+
+// listView returns a page of the distinct values of columns of one of the views that collect the values used by assets, e.g. categories.
+func listView[T any](ctx context.Context, exec bob.Executor, view string, columns []string, where []bob.Expression, page int, requestPageSize int, m scan.Mapper[T]) ([]T, int64, error) {
+	limit := requestPageSize
+	if limit == 0 {
+		limit = 25
+	}
+	offset := limit * page
+
+	qmods := []bob.Mod[*dialect.SelectQuery]{sm.From(view)}
+	for _, w := range where {
+		qmods = append(qmods, sm.Where(w))
+	}
+
+	quoted := make([]string, 0, len(columns))
+	for _, c := range columns {
+		quoted = append(quoted, psql.Quote(c).String())
+	}
+
+	count, err := bob.One(ctx, exec, psql.Select(append(qmods, sm.Columns("count(DISTINCT ("+strings.Join(quoted, ", ")+"))"))...), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return nil, 0, fmt.Errorf("error counting %s: %w", view, err)
+	}
+
+	rows, err := bob.All(ctx, exec, psql.Select(append(qmods, sm.Distinct(), sm.Columns(strings.Join(quoted, ", ")), sm.OrderBy("1"), sm.Limit(limit), sm.Offset(offset))...), m)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error listing %s: %w", view, err)
+	}
+
+	return rows, count, nil
+}
+
+func containsExpr(column string, search string) bob.Expression {
+	return psql.Quote(column).ILike(psql.Arg("%" + search + "%"))
+}
+
+func int64Args(ids []int64) []bob.Expression {
+	args := make([]bob.Expression, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, psql.Arg(id))
+	}
+	return args
+}
@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+)
+
+type WorkspaceRepo struct{}
+
+type workspaceRow struct {
+	ID         int64     `db:"id"`
+	Name       string    `db:"name"`
+	TagPrefix  string    `db:"tag_prefix"`
+	TagCounter int64     `db:"tag_counter"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+func (*WorkspaceRepo) List(ctx context.Context, exec bob.Executor) ([]*entities.Workspace, error) {
+	workspaces, err := bob.All(ctx, exec, psql.Select(sm.From("workspaces"), sm.OrderBy("name")), scan.StructMapper[*workspaceRow]())
+	if err != nil {
+		return nil, fmt.Errorf("error getting workspaces: %w", err)
+	}
+
+	return mapDBRowsToWorkspaces(workspaces), nil
+}
+
+func (*WorkspaceRepo) ListForUser(ctx context.Context, exec bob.Executor, userID int64) ([]*entities.Workspace, error) {
+	workspaces, err := bob.All(ctx, exec, psql.Select(
+		sm.From("workspaces"),
+		sm.Where(psql.Raw("workspaces.id IN (SELECT workspace_id FROM workspace_members WHERE user_id = ?)", userID)),
+		sm.OrderBy("name"),
+	), scan.StructMapper[*workspaceRow]())
+	if err != nil {
+		return nil, fmt.Errorf("error getting workspaces for user %d: %w", userID, err)
+	}
+
+	return mapDBRowsToWorkspaces(workspaces), nil
+}
+
+func (*WorkspaceRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*entities.Workspace, error) {
+	workspace, err := bob.One(ctx, exec, psql.Select(
+		sm.From("workspaces"),
+		sm.Where(psql.Quote("id").EQ(psql.Arg(id))),
+	), scan.StructMapper[*workspaceRow]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %d", database.ErrWorkspaceNotFound, id)
+		}
+		return nil, fmt.Errorf("error getting workspace %d: %w", id, err)
+	}
+
+	return mapDBRowToWorkspace(workspace), nil
+}
+
+func (*WorkspaceRepo) Create(ctx context.Context, exec bob.Executor, workspace *entities.Workspace) error {
+	inserted, err := bob.One(ctx, exec, psql.Insert(
+		im.Into("workspaces", "name", "tag_prefix"),
+		im.Values(psql.Arg(workspace.Name, workspace.TagPrefix)),
+		im.Returning("*"),
+	), scan.StructMapper[*workspaceRow]())
+	if err != nil {
+		return fmt.Errorf("error creating workspace: %w", err)
+	}
+
+	workspace.ID = inserted.ID
+	workspace.CreatedAt = inserted.CreatedAt
+	workspace.UpdatedAt = inserted.UpdatedAt
+
+	return nil
+}
+
+func (*WorkspaceRepo) Update(ctx context.Context, exec bob.Executor, workspace *entities.Workspace) error {
+	_, err := bob.Exec(ctx, exec, psql.Update(
+		um.Table("workspaces"),
+		um.Set("name").ToArg(workspace.Name),
+		um.Set("tag_prefix").ToArg(workspace.TagPrefix),
+		um.Set("updated_at").ToArg(time.Now()),
+		um.Where(psql.Quote("id").EQ(psql.Arg(workspace.ID))),
+	))
+	if err != nil {
+		return fmt.Errorf("error updating workspace %d: %w", workspace.ID, err)
+	}
+
+	return nil
+}
+
+func (*WorkspaceRepo) Delete(ctx context.Context, exec bob.Executor, id int64) error {
+	_, err := bob.Exec(ctx, exec, psql.Delete(
+		dm.From("workspaces"),
+		dm.Where(psql.Quote("id").EQ(psql.Arg(id))),
+	))
+	if err != nil {
+		return fmt.Errorf("error deleting workspace %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// CountAssets counts all assets of the workspace, including the ones in the trash.
+func (*WorkspaceRepo) CountAssets(ctx context.Context, exec bob.Executor, id int64) (int64, error) {
+	return bob.One(ctx, exec, psql.Select(
+		sm.Columns("count(*)"),
+		sm.From("assets"),
+		sm.Where(psql.Quote("workspace_id").EQ(psql.Arg(id))),
+	), scan.SingleColumnMapper[int64])
+}
+
+func (*WorkspaceRepo) ListMembers(ctx context.Context, exec bob.Executor, id int64) ([]*auth.User, error) {
+	users, err := bob.All(ctx, exec, psql.Select(
+		sm.From("users"),
+		sm.Where(psql.Raw("users.id IN (SELECT user_id FROM workspace_members WHERE workspace_id = ?)", id)),
+		sm.OrderBy("username"),
+	), scan.StructMapper[*userRow]())
+	if err != nil {
+		return nil, fmt.Errorf("error getting members of workspace %d: %w", id, err)
+	}
+
+	members := make([]*auth.User, 0, len(users))
+	for _, user := range users {
+		members = append(members, mapDBRowToUser(user))
+	}
+
+	return members, nil
+}
+
+func (*WorkspaceRepo) AddMember(ctx context.Context, exec bob.Executor, id int64, userID int64) error {
+	_, err := bob.Exec(ctx, exec, psql.Insert(
+		im.Into("workspace_members", "workspace_id", "user_id"),
+		im.Values(psql.Arg(id), psql.Arg(userID)),
+		im.OnConflict("workspace_id", "user_id").DoNothing(),
+	))
+	if err != nil {
+		return fmt.Errorf("error adding user %d to workspace %d: %w", userID, id, err)
+	}
+
+	return nil
+}
+
+func (*WorkspaceRepo) RemoveMember(ctx context.Context, exec bob.Executor, id int64, userID int64) error {
+	_, err := bob.Exec(ctx, exec, psql.Delete(
+		dm.From("workspace_members"),
+		dm.Where(psql.Quote("workspace_id").EQ(psql.Arg(id))),
+		dm.Where(psql.Quote("user_id").EQ(psql.Arg(userID))),
+	))
+	if err != nil {
+		return fmt.Errorf("error removing user %d from workspace %d: %w", userID, id, err)
+	}
+
+	return nil
+}
+
+func mapDBRowsToWorkspaces(list []*workspaceRow) []*entities.Workspace {
+	workspaces := make([]*entities.Workspace, 0, len(list))
+	for _, row := range list {
+		workspaces = append(workspaces, mapDBRowToWorkspace(row))
+	}
+
+	return workspaces
+}
+
+func mapDBRowToWorkspace(row *workspaceRow) *entities.Workspace {
+	return &entities.Workspace{
+		ID:         row.ID,
+		Name:       row.Name,
+		TagPrefix:  row.TagPrefix,
+		TagCounter: row.TagCounter,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+}
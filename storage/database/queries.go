@@ -1,17 +1,30 @@
 package database
 
+import (
+	"time"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/search"
+)
+
 type ListTagsQuery struct {
-	Search   string
-	InUse    *bool
-	Page     int
-	PageSize int
-	OrderBy  string
-	OrderDir string
+	WorkspaceID int64
+	Search      string
+	InUse       *bool
+	Page        int
+	PageSize    int
+	OrderBy     string
+	OrderDir    string
 }
 
 type ListAssetsQuery struct {
-	SearchRaw    string
-	SearchFields map[string]string
+	// WorkspaceID limits the assets to a single workspace, 0 includes all workspaces.
+	WorkspaceID int64
+
+	// Search is the parsed search query, nil lists all assets.
+	Search search.Node
+
+	Filters entities.AssetFilters
 
 	IDs []int64
 
@@ -23,6 +36,13 @@ type ListAssetsQuery struct {
 
 	AssetType string
 
+	// OnlyDeleted lists the assets in the trash instead of the regular ones.
+	OnlyDeleted   bool
+	DeletedBefore time.Time
+
+	// MissingImageVariants only lists assets with an image, but without a generated thumbnail and preview.
+	MissingImageVariants bool
+
 	IncludePurchases bool
 	IncludeParts     bool
 	IncludeFiles     bool
@@ -30,38 +50,55 @@ type ListAssetsQuery struct {
 	IncludeChildren  bool
 }
 
+type ListAssetFacetsQuery struct {
+	WorkspaceID int64
+	Search      search.Node
+	Filters     entities.AssetFilters
+	AssetType   string
+
+	// MaxValues limits the number of values per facet, the selected values are always included.
+	MaxValues int
+}
+
 type GetAssetQuery struct {
+	WorkspaceID      int64
 	ID               int64
 	Tag              string
 	IncludePurchases bool
 	IncludeParts     bool
 	IncludeFiles     bool
+	IncludePhotos    bool
 	IncludeParent    bool
 	IncludeChildren  bool
+	IncludeDeleted   bool
 }
 
 type ListCategoriesQuery struct {
-	Search   string
-	Page     int
-	PageSize int
+	WorkspaceID int64
+	Search      string
+	Page        int
+	PageSize    int
 }
 
 type ListLocationsQuery struct {
-	Search   string
-	Page     int
-	PageSize int
+	WorkspaceID int64
+	Search      string
+	Page        int
+	PageSize    int
 }
 
 type ListPositionCodesQuery struct {
-	Search   string
-	Page     int
-	PageSize int
+	WorkspaceID int64
+	Search      string
+	Page        int
+	PageSize    int
 }
 
 type ListCustomAttrsQuery struct {
-	Search   string
-	Page     int
-	PageSize int
+	WorkspaceID int64
+	Search      string
+	Page        int
+	PageSize    int
 }
 
 type ListFilesQuery struct {
@@ -69,24 +106,29 @@ type ListFilesQuery struct {
 	Page     int
 	PageSize int
 	Hashes   [][]byte
+
+	OnlyBroken bool
 }
 
 type ListManufacturersQuery struct {
-	Search   string
-	Page     int
-	PageSize int
+	WorkspaceID int64
+	Search      string
+	Page        int
+	PageSize    int
 }
 
 type ListModelsQuery struct {
-	Search   string
-	Page     int
-	PageSize int
+	WorkspaceID int64
+	Search      string
+	Page        int
+	PageSize    int
 }
 
 type ListSuppliersQuery struct {
-	Search   string
-	Page     int
-	PageSize int
+	WorkspaceID int64
+	Search      string
+	Page        int
+	PageSize    int
 }
 
 type ListUsersQuery struct {
@@ -96,3 +138,25 @@ type ListUsersQuery struct {
 	OrderBy  string
 	OrderDir string
 }
+
+type ListCheckoutsQuery struct {
+	WorkspaceID int64
+	AssetID     int64
+	OnlyOpen    bool
+	OnlyOverdue bool
+	DueBefore   time.Time
+	Page        int
+	PageSize    int
+}
+
+type ListAssetEventsQuery struct {
+	AssetID  int64
+	Page     int
+	PageSize int
+}
+
+// ListSavedSearchesQuery lists the searches of the user and the shared searches of the workspace.
+type ListSavedSearchesQuery struct {
+	WorkspaceID int64
+	UserID      int64
+}
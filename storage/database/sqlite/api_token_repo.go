@@ -0,0 +1,111 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/models"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/types"
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+)
+
+type APITokenRepo struct{}
+
+func (*APITokenRepo) ListForUser(ctx context.Context, exec bob.Executor, userID int64) ([]*auth.APIToken, error) {
+	tokens, err := models.APITokens.Query(
+		ctx, exec,
+		models.SelectWhere.APITokens.UserID.EQ(userID),
+		sm.OrderBy(models.APITokenColumns.ID).Desc(),
+	).All()
+	if err != nil {
+		return nil, fmt.Errorf("error getting API tokens for user %d: %w", userID, err)
+	}
+
+	list := make([]*auth.APIToken, 0, len(tokens))
+	for _, t := range tokens {
+		list = append(list, mapDBModelToAPIToken(t))
+	}
+
+	return list, nil
+}
+
+func (*APITokenRepo) GetByHash(ctx context.Context, exec bob.Executor, hash []byte) (*auth.APIToken, error) {
+	token, err := models.APITokens.Query(ctx, exec, models.SelectWhere.APITokens.TokenHash.EQ(hash)).One()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, database.ErrAPITokenNotFound
+		}
+		return nil, fmt.Errorf("error getting API token: %w", err)
+	}
+
+	return mapDBModelToAPIToken(token), nil
+}
+
+func (*APITokenRepo) Create(ctx context.Context, exec bob.Executor, token *auth.APIToken) error {
+	inserted, err := models.APITokens.Insert(ctx, exec, &models.APITokenSetter{
+		UserID:    omit.From(token.UserID),
+		Name:      omit.From(token.Name),
+		TokenHash: omit.From(token.Hash),
+		Scope:     omit.From(string(token.Scope)),
+		ExpiresAt: omitnullTime(token.ExpiresAt),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating API token: %w", err)
+	}
+
+	token.ID = inserted.ID
+	token.CreatedAt = inserted.CreatedAt.Time
+	token.UpdatedAt = inserted.UpdatedAt.Time
+
+	return nil
+}
+
+func (*APITokenRepo) SetLastUsedAt(ctx context.Context, exec bob.Executor, id int64, at time.Time) error {
+	_, err := models.APITokens.UpdateQ(ctx, exec, models.UpdateWhere.APITokens.ID.EQ(id), &models.APITokenSetter{
+		LastUsedAt: omitnullTime(at),
+		UpdatedAt:  omit.From(types.NewSQLiteDatetime(time.Now())),
+	}).Exec()
+	if err != nil {
+		return fmt.Errorf("error updating last use of API token %d: %w", id, err)
+	}
+
+	return nil
+}
+
+func (*APITokenRepo) Delete(ctx context.Context, exec bob.Executor, userID int64, id int64) error {
+	deleted, err := models.APITokens.DeleteQ(
+		ctx, exec,
+		models.DeleteWhere.APITokens.ID.EQ(id),
+		models.DeleteWhere.APITokens.UserID.EQ(userID),
+	).Exec()
+	if err != nil {
+		return fmt.Errorf("error deleting API token %d: %w", id, err)
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("%w: %d", database.ErrAPITokenNotFound, id)
+	}
+
+	return nil
+}
+
+func mapDBModelToAPIToken(model *models.APIToken) *auth.APIToken {
+	return &auth.APIToken{
+		ID:         model.ID,
+		UserID:     model.UserID,
+		Name:       model.Name,
+		Scope:      auth.APITokenScope(model.Scope),
+		Hash:       model.TokenHash,
+		ExpiresAt:  model.ExpiresAt.GetOrZero().Time,
+		LastUsedAt: model.LastUsedAt.GetOrZero().Time,
+		CreatedAt:  model.CreatedAt.Time,
+		UpdatedAt:  model.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,150 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+)
+
+type AssetEventRepo struct{}
+
+func (aer *AssetEventRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*entities.AssetEvent, error) {
+	event, err := models.AssetEvents.Query(
+		ctx, exec,
+		models.SelectWhere.AssetEvents.ID.EQ(id),
+		models.PreloadAssetEventUser(),
+	).One()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %d", database.ErrAssetEventNotFound, id)
+		}
+		return nil, fmt.Errorf("error getting asset event %d: %w", id, err)
+	}
+
+	return mapDBModelToAssetEvent(event)
+}
+
+func (aer *AssetEventRepo) List(ctx context.Context, exec bob.Executor, query database.ListAssetEventsQuery) (*entities.ListPage[*entities.AssetEvent], error) {
+	limit := query.PageSize
+
+	if limit == 0 {
+		limit = 50
+	}
+
+	if limit > 100 {
+		limit = 100
+	}
+
+	offset := limit * query.Page
+
+	count, err := models.AssetEvents.Query(ctx, exec, models.SelectWhere.AssetEvents.AssetID.EQ(query.AssetID)).Count()
+	if err != nil {
+		return nil, fmt.Errorf("error counting asset events: %w", err)
+	}
+
+	events, err := models.AssetEvents.Query(
+		ctx, exec,
+		models.SelectWhere.AssetEvents.AssetID.EQ(query.AssetID),
+		models.PreloadAssetEventUser(),
+		sm.OrderBy(models.AssetEventColumns.ID).Desc(),
+		sm.Limit(limit),
+		sm.Offset(offset),
+	).All()
+	if err != nil {
+		return nil, fmt.Errorf("error getting asset events: %w", err)
+	}
+
+	numPages, pageSize := calcNumPages(query.PageSize, count)
+	page := &entities.ListPage[*entities.AssetEvent]{
+		Items:    make([]*entities.AssetEvent, 0, len(events)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: pageSize,
+		NumPages: numPages,
+	}
+
+	for i := range events {
+		event, err := mapDBModelToAssetEvent(events[i])
+		if err != nil {
+			return nil, err
+		}
+		page.Items = append(page.Items, event)
+	}
+
+	return page, nil
+}
+
+func (aer *AssetEventRepo) Create(ctx context.Context, exec bob.Executor, event *entities.AssetEvent) error {
+	changes, err := json.Marshal(event.Changes)
+	if err != nil {
+		return fmt.Errorf("error encoding asset event changes: %w", err)
+	}
+
+	var snapshot omitnull.Val[string]
+	if event.Snapshot != nil {
+		encoded, err := json.Marshal(event.Snapshot)
+		if err != nil {
+			return fmt.Errorf("error encoding asset event snapshot: %w", err)
+		}
+		snapshot = omitnull.From(string(encoded))
+	}
+
+	inserted, err := models.AssetEvents.Insert(ctx, exec, &models.AssetEventSetter{
+		AssetID:   omit.From(event.AssetID),
+		Type:      omit.From(string(event.Type)),
+		Changes:   omit.From(string(changes)),
+		UserID:    omitnullInt64(event.UserID),
+		RequestID: omitnullStr(event.RequestID),
+		Snapshot:  snapshot,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating asset event: %w", err)
+	}
+
+	event.ID = inserted.ID
+	event.CreatedAt = inserted.CreatedAt.Time
+
+	return nil
+}
+
+func mapDBModelToAssetEvent(model *models.AssetEvent) (*entities.AssetEvent, error) {
+	event := &entities.AssetEvent{
+		ID:        model.ID,
+		AssetID:   model.AssetID,
+		Type:      entities.AssetEventType(model.Type),
+		UserID:    model.UserID.GetOrZero(),
+		RequestID: model.RequestID.GetOrZero(),
+		CreatedAt: model.CreatedAt.Time,
+	}
+
+	err := json.Unmarshal([]byte(model.Changes), &event.Changes)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding changes of asset event %d: %w", model.ID, err)
+	}
+
+	if snapshot, ok := model.Snapshot.Get(); ok {
+		err = json.Unmarshal([]byte(snapshot), &event.Snapshot)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding snapshot of asset event %d: %w", model.ID, err)
+		}
+	}
+
+	if model.R.User != nil {
+		event.UserName = model.R.User.DisplayName
+		if event.UserName == "" {
+			event.UserName = model.R.User.Username
+		}
+	}
+
+	return event, nil
+}
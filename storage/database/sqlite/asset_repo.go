@@ -5,8 +5,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"regexp"
-	"strconv"
 	"time"
 
 	"github.com/RobinThrift/stuff/entities"
@@ -22,9 +20,6 @@ import (
 	"github.com/stephenafamo/bob/dialect/sqlite/sm"
 )
 
-var ErrAssetNotFound = errors.New("asset not found")
-var ErrCreatingAsset = errors.New("error creating asset")
-
 type AssetRepo struct{}
 
 func (ar *AssetRepo) Get(ctx context.Context, exec bob.Executor, query database.GetAssetQuery) (*entities.Asset, error) {
@@ -43,7 +38,18 @@ func (ar *AssetRepo) Get(ctx context.Context, exec bob.Executor, query database.
 	}
 
 	if query.IncludeFiles {
-		qmods = append(qmods, models.ThenLoadAssetAssetFiles())
+		// thumbnails, previews and photos are part of the photo gallery and not listed as files
+		qmods = append(qmods, models.ThenLoadAssetAssetFiles(
+			models.SelectWhere.AssetFiles.Variant.EQ(string(entities.FileVariantOriginal)),
+			sm.Where(sqlite.Raw("asset_files.id NOT IN (SELECT file_id FROM asset_photos)")),
+		))
+	}
+
+	if query.IncludePhotos {
+		qmods = append(qmods, models.ThenLoadAssetAssetPhotos(
+			sm.OrderBy(models.AssetPhotoColumns.Position).Asc(),
+			sm.OrderBy(models.AssetPhotoColumns.ID).Asc(),
+		))
 	}
 
 	switch {
@@ -58,10 +64,18 @@ func (ar *AssetRepo) Get(ctx context.Context, exec bob.Executor, query database.
 		qmods = append(qmods, models.SelectWhere.Assets.ID.EQ(query.ID))
 	}
 
+	if !query.IncludeDeleted {
+		qmods = append(qmods, models.SelectWhere.Assets.DeletedAt.IsNull())
+	}
+
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, models.SelectWhere.Assets.WorkspaceID.EQ(query.WorkspaceID))
+	}
+
 	asset, err := models.Assets.Query(ctx, exec, qmods...).One()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrAssetNotFound
+			return nil, database.ErrAssetNotFound
 		}
 		return nil, fmt.Errorf("error getting assets: %w", err)
 	}
@@ -71,6 +85,7 @@ func (ar *AssetRepo) Get(ctx context.Context, exec bob.Executor, query database.
 		children, err = models.Assets.Query(ctx, exec,
 			sm.Columns(models.Assets.Columns().Only("id", "name", "tag")),
 			models.SelectWhere.Assets.ParentAssetID.EQ(asset.ID),
+			models.SelectWhere.Assets.DeletedAt.IsNull(),
 		).All()
 		if err != nil {
 			return nil, fmt.Errorf("error getting asset children: %w", err)
@@ -81,18 +96,19 @@ func (ar *AssetRepo) Get(ctx context.Context, exec bob.Executor, query database.
 }
 
 func (ar *AssetRepo) List(ctx context.Context, exec bob.Executor, query database.ListAssetsQuery) (*entities.ListPage[*entities.Asset], error) {
-	var err error
-	var assets models.AssetSlice
-	var count int64
-	if len(query.SearchFields) != 0 || query.SearchRaw != "" {
-		assets, count, err = searchAssets(ctx, exec, query)
-	} else {
-		assets, count, err = listAssets(ctx, exec, query)
-	}
+	assets, count, err := listAssets(ctx, exec, query)
 	if err != nil {
 		return nil, err
 	}
 
+	var snippets map[int64]string
+	if query.Search != nil && len(assets) != 0 {
+		snippets, err = searchSnippets(ctx, exec, query.Search, assets)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	numPages, pageSize := calcNumPages(query.PageSize, count)
 	page := &entities.ListPage[*entities.Asset]{
 		Items:    make([]*entities.Asset, 0, len(assets)),
@@ -103,7 +119,9 @@ func (ar *AssetRepo) List(ctx context.Context, exec bob.Executor, query database
 	}
 
 	for i := range assets {
-		page.Items = append(page.Items, mapDBModelToAsset(assets[i], nil))
+		asset := mapDBModelToAsset(assets[i], nil)
+		asset.Snippet = snippets[asset.ID]
+		page.Items = append(page.Items, asset)
 	}
 
 	return page, nil
@@ -114,19 +132,20 @@ func (ar *AssetRepo) Create(ctx context.Context, exec bob.Executor, asset *entit
 
 	inserted, err := models.Assets.Insert(ctx, exec, setter)
 	if err != nil {
-		return fmt.Errorf("%w: %w", ErrCreatingAsset, err)
+		return fmt.Errorf("%w: %w", database.ErrCreatingAsset, err)
 	}
 
 	err = createParts(ctx, exec, inserted.ID, asset.Parts)
 	if err != nil {
-		return fmt.Errorf("%w: error creating parts: %w", ErrCreatingAsset, err)
+		return fmt.Errorf("%w: error creating parts: %w", database.ErrCreatingAsset, err)
 	}
 
 	asset.ID = inserted.ID
+	asset.WorkspaceID = inserted.WorkspaceID
 
 	err = createPurchases(ctx, exec, asset, asset.Purchases)
 	if err != nil {
-		return fmt.Errorf("%w: error creating purchases: %w", ErrCreatingAsset, err)
+		return fmt.Errorf("%w: error creating purchases: %w", database.ErrCreatingAsset, err)
 	}
 	return nil
 }
@@ -151,17 +170,26 @@ func (ar *AssetRepo) Update(ctx context.Context, exec bob.Executor, asset *entit
 		return err
 	}
 
-	err = deletePurchases(ctx, exec, asset.ID)
-	if err != nil {
-		return err
-	}
+	return updatePurchases(ctx, exec, asset)
+}
 
-	err = createPurchases(ctx, exec, asset, asset.Purchases)
-	if err != nil {
-		return err
-	}
+// SetDeletedAt moves the asset to the trash, or restores it from there if at is the zero value.
+func (ar *AssetRepo) SetDeletedAt(ctx context.Context, exec bob.Executor, id int64, at time.Time) error {
+	_, err := models.Assets.UpdateQ(ctx, exec, models.UpdateWhere.Assets.ID.EQ(id), &models.AssetSetter{
+		DeletedAt: omitnullTime(at),
+		UpdatedAt: omit.From(types.NewSQLiteDatetime(time.Now())),
+	}).Exec()
+	return err
+}
 
-	return nil
+// SetImage sets the URLs of the asset's image, or removes the image if they are empty.
+func (ar *AssetRepo) SetImage(ctx context.Context, exec bob.Executor, id int64, imageURL string, thumbnailURL string, previewURL string) error {
+	_, err := models.Assets.UpdateQ(ctx, exec, models.UpdateWhere.Assets.ID.EQ(id), &models.AssetSetter{
+		ImageURL:     omitnullStr(imageURL),
+		ThumbnailURL: omitnullStr(thumbnailURL),
+		PreviewURL:   omitnullStr(previewURL),
+	}).Exec()
+	return err
 }
 
 func (ar *AssetRepo) Delete(ctx context.Context, exec bob.Executor, id int64) error {
@@ -182,14 +210,15 @@ func listAssets(ctx context.Context, exec bob.Executor, query database.ListAsset
 	limit := query.PageSize
 	offset := limit * query.Page
 
-	qmods := make([]bob.Mod[*dialect.SelectQuery], 0, 3)
-
-	if len(query.IDs) != 0 {
-		qmods = append(qmods, models.SelectWhere.Assets.ID.In(query.IDs...))
+	qmods, err := assetConditions(query)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	if query.AssetType != "" {
-		qmods = append(qmods, models.SelectWhere.Assets.Type.EQ(query.AssetType))
+	var rankQuery string
+	var ranked bool
+	if query.Search != nil {
+		rankQuery, ranked = searchRankQuery(query.Search)
 	}
 
 	count, err := models.Assets.Query(ctx, exec, qmods...).Count()
@@ -197,6 +226,13 @@ func listAssets(ctx context.Context, exec bob.Executor, query database.ListAsset
 		return nil, 0, fmt.Errorf("error counting assets: %w", err)
 	}
 
+	if ranked {
+		qmods = append(qmods, sm.LeftJoin(sqlite.Raw("(SELECT rowid, "+bm25Rank+" AS rank FROM assets_fts WHERE assets_fts MATCH ?)", rankQuery)).
+			As("search_rank").
+			On(sqlite.Raw("search_rank.rowid = assets.id")),
+		)
+	}
+
 	if query.IncludeParts {
 		qmods = append(qmods, models.ThenLoadAssetAssetParts())
 	}
@@ -219,8 +255,13 @@ func listAssets(ctx context.Context, exec bob.Executor, query database.ListAsset
 		}
 
 		qmods = append(qmods, orderByClause(models.TableNames.Assets, query.OrderBy, query.OrderDir))
+	} else if ranked {
+		// bm25 scores are negative, assets which only matched the non text conditions come last
+		qmods = append(qmods, sm.OrderBy("coalesce(search_rank.rank, 0)"))
 	}
 
+	qmods = append(qmods, sm.OrderBy(sqlite.Quote(models.TableNames.Assets, models.ColumnNames.Assets.ID)))
+
 	if limit > 0 {
 		qmods = append(qmods, sm.Limit(limit))
 	}
@@ -237,77 +278,48 @@ func listAssets(ctx context.Context, exec bob.Executor, query database.ListAsset
 	return assets, count, nil
 }
 
-var removeSpecialChars = regexp.MustCompile(`[^\w* ]`)
-
-func searchAssets(ctx context.Context, exec bob.Executor, query database.ListAssetsQuery) (models.AssetSlice, int64, error) {
-	limit := query.PageSize
-	offset := limit * query.Page
-
-	mods := make([]bob.Mod[*dialect.SelectQuery], 0, 2)
+// assetConditions returns the conditions on the assets table shared by the list of assets and the facets.
+func assetConditions(query database.ListAssetsQuery) ([]bob.Mod[*dialect.SelectQuery], error) {
+	qmods := make([]bob.Mod[*dialect.SelectQuery], 0, 3)
 
-	if limit > 0 {
-		mods = append(mods, sm.Limit(limit))
+	if query.OnlyDeleted {
+		qmods = append(qmods, models.SelectWhere.Assets.DeletedAt.IsNotNull())
+	} else {
+		qmods = append(qmods, models.SelectWhere.Assets.DeletedAt.IsNull())
 	}
 
-	if offset > 0 {
-		mods = append(mods, sm.Offset(offset))
+	if !query.DeletedBefore.IsZero() {
+		qmods = append(qmods, models.SelectWhere.Assets.DeletedAt.LT(types.NewSQLiteDatetime(query.DeletedBefore)))
 	}
 
-	if len(query.SearchFields) == 0 {
-		value := removeSpecialChars.ReplaceAllString(query.SearchRaw, "")
-		if value[len(value)-1] != '*' {
-			value += "*"
-		}
-
-		mods = append(mods,
-			sm.Where(sqlite.Quote(models.TableNames.AssetsFTS).EQ(sqlite.Quote(value))),
-		)
+	if len(query.IDs) != 0 {
+		qmods = append(qmods, models.SelectWhere.Assets.ID.In(query.IDs...))
 	}
 
-	for field, value := range query.SearchFields {
-		column, ok := isAssetsFTSColumn(field)
-		if !ok {
-			continue
-		}
-
-		value = removeSpecialChars.ReplaceAllString(value, "")
-
-		mods = append(mods, sm.Where(sqlite.Raw(column+" MATCH ?", value)))
+	if query.MissingImageVariants {
+		qmods = append(qmods, models.SelectWhere.Assets.ImageURL.IsNotNull(), models.SelectWhere.Assets.PreviewURL.IsNull())
 	}
 
-	entries, err := models.AssetsFTS.Query(ctx, exec, mods...).All()
-	if err != nil {
-		return nil, 0, fmt.Errorf("error searching assets: %w", err)
+	if query.AssetType != "" {
+		qmods = append(qmods, models.SelectWhere.Assets.Type.EQ(query.AssetType))
 	}
 
-	if len(entries) == 0 {
-		return nil, 0, nil
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, models.SelectWhere.Assets.WorkspaceID.EQ(query.WorkspaceID))
 	}
 
-	ids := make([]int64, 0, len(entries))
-
-	for _, entry := range entries {
-		id, err := strconv.ParseInt(entry.ID.GetOrZero(), 10, 64)
+	if query.Search != nil {
+		condition, err := searchCondition(query.Search)
 		if err != nil {
-			continue
+			return nil, err
 		}
 
-		ids = append(ids, id)
+		qmods = append(qmods, sm.Where(condition))
 	}
 
-	return listAssets(ctx, exec, database.ListAssetsQuery{
-		IDs:              ids,
-		Page:             query.Page,
-		PageSize:         query.PageSize,
-		OrderBy:          query.OrderBy,
-		OrderDir:         query.OrderDir,
-		AssetType:        query.AssetType,
-		IncludePurchases: query.IncludePurchases,
-		IncludeParts:     query.IncludeParts,
-		IncludeFiles:     query.IncludeFiles,
-		IncludeParent:    query.IncludeParent,
-		IncludeChildren:  query.IncludeChildren,
-	})
+	qmods = append(qmods, filterConditions(query.Filters)...)
+
+	return qmods, nil
 }
 
 func createPurchases(ctx context.Context, exec bob.Executor, asset *entities.Asset, purchases []*entities.Purchase) error {
@@ -347,6 +359,60 @@ func createPurchases(ctx context.Context, exec bob.Executor, asset *entities.Ass
 	return err
 }
 
+// updatePurchases updates the existing purchases in place, so they keep their IDs, adds the new ones and deletes
+// those that are no longer part of the asset.
+func updatePurchases(ctx context.Context, exec bob.Executor, asset *entities.Asset) error {
+	existing, err := models.AssetPurchases.Query(ctx, exec, models.SelectWhere.AssetPurchases.AssetID.EQ(asset.ID)).All()
+	if err != nil {
+		return err
+	}
+
+	removed := make(map[int64]struct{}, len(existing))
+	for _, p := range existing {
+		removed[p.ID] = struct{}{}
+	}
+
+	for _, p := range asset.Purchases {
+		setter := &models.AssetPurchaseSetter{
+			AssetID:   omit.From(asset.ID),
+			Supplier:  omitnullStr(p.Supplier),
+			OrderNo:   omitnullStr(p.OrderNo),
+			OrderDate: omitnullTime(p.Date),
+			Amount:    omitnullInt64(int64(p.Amount)),
+			Currency:  omitnullStr(p.Currency),
+			UpdatedAt: omit.From(types.NewSQLiteDatetime(time.Now())),
+		}
+
+		if _, ok := removed[p.ID]; ok {
+			delete(removed, p.ID)
+			_, err = models.AssetPurchases.UpdateQ(ctx, exec, models.UpdateWhere.AssetPurchases.ID.EQ(p.ID), setter).Exec()
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		setter.CreatedBy = omit.From(asset.MetaInfo.CreatedBy)
+		inserted, err := models.AssetPurchases.Insert(ctx, exec, setter)
+		if err != nil {
+			return err
+		}
+		p.ID = inserted.ID
+	}
+
+	if len(removed) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(removed))
+	for id := range removed {
+		ids = append(ids, id)
+	}
+
+	_, err = models.AssetPurchases.DeleteQ(ctx, exec, models.DeleteWhere.AssetPurchases.ID.In(ids...)).Exec()
+	return err
+}
+
 func deletePurchases(ctx context.Context, exec bob.Executor, assetID int64) error {
 	_, err := models.AssetPurchases.DeleteQ(ctx, exec, models.DeleteWhere.AssetPurchases.AssetID.EQ(assetID)).Exec()
 	return err
@@ -400,6 +466,7 @@ func mapDBModelToAsset(model *models.Asset, children []*models.Asset) *entities.
 	purchases := make([]*entities.Purchase, 0, len(model.R.AssetPurchases))
 	for _, p := range model.R.AssetPurchases {
 		purchases = append(purchases, &entities.Purchase{
+			ID:       p.ID,
 			Supplier: p.Supplier.GetOrZero(),
 			OrderNo:  p.OrderNo.GetOrZero(),
 			Date:     p.OrderDate.GetOrZero().Time,
@@ -418,6 +485,8 @@ func mapDBModelToAsset(model *models.Asset, children []*models.Asset) *entities.
 			Name:       f.Name,
 			Filetype:   f.Filetype,
 			Sha256:     f.Sha256,
+			Variant:    entities.FileVariant(f.Variant),
+			Broken:     entities.FileBrokenReason(f.BrokenReason),
 			SizeBytes:  f.SizeBytes,
 			CreatedBy:  f.CreatedBy,
 			CreatedAt:  f.CreatedAt.Time,
@@ -425,6 +494,8 @@ func mapDBModelToAsset(model *models.Asset, children []*models.Asset) *entities.
 		})
 	}
 
+	photos := mapDBModelsToPhotos(model.R.AssetPhotos)
+
 	parts := make([]*entities.Part, 0, len(model.R.AssetParts))
 	for _, p := range model.R.AssetParts {
 		parts = append(parts, &entities.Part{
@@ -448,6 +519,7 @@ func mapDBModelToAsset(model *models.Asset, children []*models.Asset) *entities.
 
 	return &entities.Asset{
 		ID:            model.ID,
+		WorkspaceID:   model.WorkspaceID,
 		Type:          entities.AssetType(model.Type),
 		ParentAssetID: model.ParentAssetID.GetOrZero(),
 		Parent:        mapDBModelToAsset(model.R.ParentAsset, nil),
@@ -462,6 +534,7 @@ func mapDBModelToAsset(model *models.Asset, children []*models.Asset) *entities.
 		Notes:         model.Notes.GetOrZero(),
 		ImageURL:      model.ImageURL.GetOrZero(),
 		ThumbnailURL:  model.ThumbnailURL.GetOrZero(),
+		PreviewURL:    model.PreviewURL.GetOrZero(),
 		WarrantyUntil: model.WarrantyUntil.GetOrZero().Time,
 		CustomAttrs:   unmarshalCustomAttrs(model.CustomAttrs.GetOrZero().JSON),
 		Quantity:      model.Quantity,
@@ -477,12 +550,15 @@ func mapDBModelToAsset(model *models.Asset, children []*models.Asset) *entities.
 
 		Files: files,
 
+		Photos: photos,
+
 		Children: mappedChildren,
 
 		MetaInfo: entities.MetaInfo{
 			CreatedBy: model.CreatedBy,
 			CreatedAt: model.CreatedAt.Time,
 			UpdatedAt: model.UpdatedAt.Time,
+			DeletedAt: model.DeletedAt.GetOrZero().Time,
 		},
 	}
 }
@@ -501,16 +577,18 @@ func mapAssetToSetter(asset *entities.Asset) *models.AssetSetter {
 		Notes:             omitnullStr(asset.Notes),
 		ImageURL:          omitnullStr(asset.ImageURL),
 		ThumbnailURL:      omitnullStr(asset.ThumbnailURL),
+		PreviewURL:        omitnullStr(asset.PreviewURL),
 		WarrantyUntil:     omitnullTime(asset.WarrantyUntil),
 		CustomAttrs:       omitnullCustomAttrs(asset.CustomAttrs),
 		CheckedOutTo:      omitnullInt64(asset.CheckedOutTo),
 		Location:          omitnullStr(asset.Location),
 		PositionCode:      omitnullStr(asset.PositionCode),
-		PartsTotalCounter: omit.From(int64(len(asset.Parts))),
+		PartsTotalCounter: omit.From(int64(max(asset.PartsTotalCounter, len(asset.Parts)))),
 		CreatedBy:         omit.From(asset.MetaInfo.CreatedBy),
 		Type:              omit.From(string(asset.Type)),
 		Quantity:          omit.From(asset.Quantity),
 		QuantityUnit:      omitnullStr(asset.QuantityUnit),
+		WorkspaceID:       omitInt64(asset.WorkspaceID),
 	}
 }
 
@@ -532,6 +610,15 @@ func omitnullInt64(i int64) omitnull.Val[int64] {
 	return v
 }
 
+// omitInt64 leaves the column unset for 0, so the column's default is used on insert and it is left unchanged on update.
+func omitInt64(i int64) omit.Val[int64] {
+	if i == 0 {
+		return omit.Val[int64]{}
+	}
+
+	return omit.From(i)
+}
+
 func omitnullTime(t time.Time) omitnull.Val[types.SQLiteDatetime] {
 	st := types.NewSQLiteDatetime(t)
 	v := omitnull.From(st)
@@ -568,25 +655,3 @@ func unmarshalCustomAttrs(encoded []map[string]any) []entities.CustomAttr {
 
 	return cas
 }
-
-func isAssetsFTSColumn(s string) (string, bool) {
-	switch s {
-	case models.ColumnNames.AssetsFTS.Tag:
-		return models.ColumnNames.AssetsFTS.Tag, true
-	case models.ColumnNames.AssetsFTS.Name:
-		return models.ColumnNames.AssetsFTS.Name, true
-	case models.ColumnNames.AssetsFTS.Category:
-		return models.ColumnNames.AssetsFTS.Category, true
-	case models.ColumnNames.AssetsFTS.Model:
-		return models.ColumnNames.AssetsFTS.Model, true
-	case models.ColumnNames.AssetsFTS.ModelNo, "modelno":
-		return models.ColumnNames.AssetsFTS.ModelNo, true
-	case models.ColumnNames.AssetsFTS.SerialNo, "serial", "serialno":
-		return models.ColumnNames.AssetsFTS.SerialNo, true
-	case models.ColumnNames.AssetsFTS.Manufacturer:
-		return models.ColumnNames.AssetsFTS.Manufacturer, true
-	case models.ColumnNames.AssetsFTS.Notes:
-		return models.ColumnNames.AssetsFTS.Notes, true
-	}
-	return "", false
-}
@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+
+	"github.com/pressly/goose/v3"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/scan"
+)
+
+// Snapshot writes a consistent copy of the database to file using `VACUUM INTO`, which is safe while the database is in use, including in WAL mode.
+// Must not be called inside a transaction.
+func Snapshot(ctx context.Context, exec bob.Executor, file string) error {
+	_, err := exec.ExecContext(ctx, "VACUUM INTO ?", file)
+	if err != nil {
+		return fmt.Errorf("error creating database snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// MigrationVersion returns the version of the last migration that was applied to the database.
+func MigrationVersion(ctx context.Context, exec bob.Executor) (int64, error) {
+	// SELECT COALESCE(MAX(version_id), 0) FROM migrations WHERE is_applied = 1;
+	version, err := bob.One(ctx, exec,
+		sqlite.Select(
+			sm.Columns(sqlite.F("COALESCE", sqlite.F("MAX", sqlite.Quote("version_id")), sqlite.Arg(0))),
+			sm.From(sqlite.Quote("migrations")),
+			sm.Where(sqlite.Quote("is_applied").EQ(sqlite.Arg(true))),
+		),
+		scan.SingleColumnMapper[int64],
+	)
+	if err != nil {
+		return 0, fmt.Errorf("error getting migration version: %w", err)
+	}
+
+	return version, nil
+}
+
+// LatestMigrationVersion returns the version of the newest migration included in this build.
+func LatestMigrationVersion() (int64, error) {
+	files, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return 0, err
+	}
+
+	var latest int64
+	for _, file := range files {
+		version, err := goose.NumericComponent(path.Base(file))
+		if err != nil {
+			return 0, err
+		}
+
+		latest = max(latest, version)
+	}
+
+	return latest, nil
+}
@@ -31,6 +31,10 @@ func (cr *CategoryRepo) List(ctx context.Context, exec bob.Executor, query datab
 		qmods = append(qmods, models.SelectWhere.Categories.CatName.Like("%"+query.Search+"%"))
 	}
 
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, models.SelectWhere.Categories.WorkspaceID.EQ(query.WorkspaceID))
+	}
+
 	count, err := models.Categories.Query(ctx, exec, qmods...).Count()
 	if err != nil {
 		return nil, fmt.Errorf("error counting categories: %w", err)
@@ -0,0 +1,190 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/models"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/types"
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+)
+
+type CheckoutRepo struct{}
+
+func (cr *CheckoutRepo) GetOpenForAsset(ctx context.Context, exec bob.Executor, assetID int64) (*entities.Checkout, error) {
+	checkout, err := models.AssetCheckouts.Query(
+		ctx, exec,
+		models.SelectWhere.AssetCheckouts.AssetID.EQ(assetID),
+		models.SelectWhere.AssetCheckouts.CheckedInAt.IsNull(),
+		models.PreloadAssetCheckoutCheckedOutToUser(),
+		models.PreloadAssetCheckoutAsset(),
+		sm.OrderBy(models.AssetCheckoutColumns.ID).Desc(),
+	).One()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: no open checkout for asset %d", database.ErrCheckoutNotFound, assetID)
+		}
+		return nil, fmt.Errorf("error getting checkout: %w", err)
+	}
+
+	return mapDBModelToCheckout(checkout), nil
+}
+
+func (cr *CheckoutRepo) List(ctx context.Context, exec bob.Executor, query database.ListCheckoutsQuery) (*entities.ListPage[*entities.Checkout], error) {
+	limit := query.PageSize
+
+	if limit == 0 {
+		limit = 50
+	}
+
+	if limit > 100 {
+		limit = 100
+	}
+
+	offset := limit * query.Page
+
+	mods := []bob.Mod[*dialect.SelectQuery]{}
+
+	if query.AssetID != 0 {
+		mods = append(mods, models.SelectWhere.AssetCheckouts.AssetID.EQ(query.AssetID))
+	}
+
+	if query.OnlyOpen {
+		mods = append(mods, models.SelectWhere.AssetCheckouts.CheckedInAt.IsNull())
+	}
+
+	if query.OnlyOverdue {
+		mods = append(mods, models.SelectWhere.AssetCheckouts.OverdueAt.IsNotNull())
+	}
+
+	if !query.DueBefore.IsZero() {
+		mods = append(mods, models.SelectWhere.AssetCheckouts.DueAt.LT(types.NewSQLiteDatetime(query.DueBefore)))
+	}
+
+	if query.WorkspaceID != 0 {
+		mods = append(mods, sm.Where(sqlite.Raw("asset_checkouts.asset_id IN (SELECT id FROM assets WHERE workspace_id = ?)", query.WorkspaceID)))
+	}
+
+	count, err := models.AssetCheckouts.Query(ctx, exec, mods...).Count()
+	if err != nil {
+		return nil, fmt.Errorf("error counting checkouts: %w", err)
+	}
+
+	mods = append(mods,
+		models.PreloadAssetCheckoutCheckedOutToUser(),
+		models.PreloadAssetCheckoutAsset(),
+		sm.OrderBy(models.AssetCheckoutColumns.CheckedOutAt).Desc(),
+		sm.OrderBy(models.AssetCheckoutColumns.ID).Desc(),
+		sm.Limit(limit),
+		sm.Offset(offset),
+	)
+
+	checkouts, err := models.AssetCheckouts.Query(ctx, exec, mods...).All()
+	if err != nil {
+		return nil, fmt.Errorf("error getting checkouts: %w", err)
+	}
+
+	numPages, pageSize := calcNumPages(query.PageSize, count)
+	page := &entities.ListPage[*entities.Checkout]{
+		Items:    make([]*entities.Checkout, 0, len(checkouts)),
+		Total:    int(count),
+		Page:     query.Page,
+		PageSize: pageSize,
+		NumPages: numPages,
+	}
+
+	for i := range checkouts {
+		page.Items = append(page.Items, mapDBModelToCheckout(checkouts[i]))
+	}
+
+	return page, nil
+}
+
+func (cr *CheckoutRepo) Create(ctx context.Context, exec bob.Executor, checkout *entities.Checkout) (int64, error) {
+	setter := &models.AssetCheckoutSetter{
+		AssetID:      omit.From(checkout.AssetID),
+		CheckedOutTo: omit.From(checkout.CheckedOutTo),
+		DueAt:        omitnullTime(checkout.DueAt),
+		Note:         omitnullStr(checkout.Note),
+		CreatedBy:    omit.From(checkout.CreatedBy),
+	}
+
+	if !checkout.CheckedOutAt.IsZero() {
+		setter.CheckedOutAt = omit.From(types.NewSQLiteDatetime(checkout.CheckedOutAt))
+	}
+
+	inserted, err := models.AssetCheckouts.Insert(ctx, exec, setter)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", database.ErrCreatingCheckout, err)
+	}
+
+	return inserted.ID, nil
+}
+
+func (cr *CheckoutRepo) Update(ctx context.Context, exec bob.Executor, checkout *entities.Checkout) error {
+	_, err := models.AssetCheckouts.UpdateQ(ctx, exec, models.UpdateWhere.AssetCheckouts.ID.EQ(checkout.ID), &models.AssetCheckoutSetter{
+		CheckedOutTo: omit.From(checkout.CheckedOutTo),
+		DueAt:        omitnullTime(checkout.DueAt),
+		Note:         omitnullStr(checkout.Note),
+		CheckedInAt:  omitnullTime(checkout.CheckedInAt),
+		CheckInNote:  omitnullStr(checkout.CheckInNote),
+		OverdueAt:    omitnullTime(checkout.OverdueAt),
+		RemindedAt:   omitnullTime(checkout.RemindedAt),
+		UpdatedAt:    omit.From(types.NewSQLiteDatetime(time.Now())),
+	}).Exec()
+	if err != nil {
+		return fmt.Errorf("error updating checkout %d: %w", checkout.ID, err)
+	}
+
+	return nil
+}
+
+func (cr *CheckoutRepo) DeleteAllForAsset(ctx context.Context, exec bob.Executor, assetID int64) error {
+	_, err := models.AssetCheckouts.DeleteQ(ctx, exec, models.DeleteWhere.AssetCheckouts.AssetID.EQ(assetID)).Exec()
+	if err != nil {
+		return fmt.Errorf("error deleting checkouts for asset %d: %w", assetID, err)
+	}
+
+	return nil
+}
+
+func mapDBModelToCheckout(model *models.AssetCheckout) *entities.Checkout {
+	checkout := &entities.Checkout{
+		ID:           model.ID,
+		AssetID:      model.AssetID,
+		CheckedOutTo: model.CheckedOutTo,
+		DueAt:        model.DueAt.GetOrZero().Time,
+		Note:         model.Note.GetOrZero(),
+		CheckedOutAt: model.CheckedOutAt.Time,
+		CheckedInAt:  model.CheckedInAt.GetOrZero().Time,
+		CheckInNote:  model.CheckInNote.GetOrZero(),
+		OverdueAt:    model.OverdueAt.GetOrZero().Time,
+		RemindedAt:   model.RemindedAt.GetOrZero().Time,
+		CreatedBy:    model.CreatedBy,
+		CreatedAt:    model.CreatedAt.Time,
+		UpdatedAt:    model.UpdatedAt.Time,
+	}
+
+	if model.R.CheckedOutToUser != nil {
+		checkout.CheckedOutToName = model.R.CheckedOutToUser.DisplayName
+		if checkout.CheckedOutToName == "" {
+			checkout.CheckedOutToName = model.R.CheckedOutToUser.Username
+		}
+	}
+
+	if model.R.Asset != nil {
+		checkout.AssetTag = model.R.Asset.Tag.GetOrZero()
+		checkout.AssetName = model.R.Asset.Name
+	}
+
+	return checkout
+}
@@ -31,6 +31,10 @@ func (cr *CustomAttrRepo) List(ctx context.Context, exec bob.Executor, query dat
 		qmods = append(qmods, models.SelectWhere.CustomAttrNames.AttrName.Like("%"+query.Search+"%"))
 	}
 
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, models.SelectWhere.CustomAttrNames.WorkspaceID.EQ(query.WorkspaceID))
+	}
+
 	count, err := models.CustomAttrNames.Query(ctx, exec, qmods...).Count()
 	if err != nil {
 		return nil, fmt.Errorf("error counting locations: %w", err)
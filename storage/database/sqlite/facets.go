@@ -0,0 +1,229 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/search"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/types"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/scan"
+)
+
+const customAttrValue = "CAST(json_extract(attrs.value, '$.value') AS TEXT)"
+
+// filterConditions returns the conditions for the selected facet values.
+func filterConditions(filters entities.AssetFilters) []bob.Mod[*dialect.SelectQuery] {
+	var qmods []bob.Mod[*dialect.SelectQuery]
+
+	if len(filters.Status) != 0 {
+		qmods = append(qmods, sm.Where(sqlite.Quote("assets", "status").In(stringArgs(filters.Status)...)))
+	}
+
+	if len(filters.Category) != 0 {
+		qmods = append(qmods, sm.Where(sqlite.Quote("assets", "category").In(stringArgs(filters.Category)...)))
+	}
+
+	if len(filters.Location) != 0 {
+		qmods = append(qmods, sm.Where(sqlite.Quote("assets", "location").In(stringArgs(filters.Location)...)))
+	}
+
+	if len(filters.Manufacturer) != 0 {
+		qmods = append(qmods, sm.Where(sqlite.Quote("assets", "manufacturer").In(stringArgs(filters.Manufacturer)...)))
+	}
+
+	if len(filters.Supplier) != 0 {
+		qmods = append(qmods, sm.Where(sqlite.Raw(
+			"EXISTS (SELECT 1 FROM asset_purchases WHERE asset_purchases.asset_id = assets.id AND ?)",
+			sqlite.Quote("asset_purchases", "supplier").In(stringArgs(filters.Supplier)...),
+		)))
+	}
+
+	if len(filters.Warranty) != 0 {
+		qmods = append(qmods, sm.Where(warrantyStateExpr().In(stringArgs(filters.Warranty)...)))
+	}
+
+	for name, values := range filters.CustomAttrs {
+		qmods = append(qmods, sm.Where(sqlite.Raw(
+			"EXISTS (SELECT 1 FROM json_each(assets.custom_attrs) AS attrs WHERE json_extract(attrs.value, '$.name') = ? AND ?)",
+			name, sqlite.Raw(customAttrValue).In(stringArgs(values)...),
+		)))
+	}
+
+	return qmods
+}
+
+const warrantyState = "CASE WHEN assets.warranty_until IS NULL THEN 'NONE' WHEN assets.warranty_until < ? THEN 'EXPIRED' ELSE 'ACTIVE' END"
+
+// warrantyStateExpr returns the warranty state of assets. Warranties ending today are still active,
+// like in a search for warranty>=today.
+func warrantyStateExpr() dialect.Expression {
+	return sqlite.Raw(warrantyState, types.NewSQLiteDatetime(search.StartOfDay(time.Now())))
+}
+
+func stringArgs[S ~string](values []S) []bob.Expression {
+	args := make([]bob.Expression, 0, len(values))
+	for _, v := range values {
+		args = append(args, sqlite.Arg(string(v)))
+	}
+	return args
+}
+
+type facetValueRow struct {
+	Attr  string `db:"attr"`
+	Value string `db:"value"`
+	Count int    `db:"count"`
+}
+
+// ListFacets counts the values of each facet for the assets matching the query.
+// The counts of each facet are computed with the selected values of all other facets, but not its own.
+func (ar *AssetRepo) ListFacets(ctx context.Context, exec bob.Executor, query database.ListAssetFacetsQuery) ([]*entities.Facet, error) {
+	columns := []struct {
+		facet string
+		value bob.Expression
+	}{
+		{facet: entities.FacetStatus, value: sqlite.Quote("assets", "status")},
+		{facet: entities.FacetCategory, value: sqlite.Quote("assets", "category")},
+		{facet: entities.FacetLocation, value: sqlite.Quote("assets", "location")},
+		{facet: entities.FacetManufacturer, value: sqlite.Quote("assets", "manufacturer")},
+		{facet: entities.FacetSupplier, value: sqlite.Quote("asset_purchases", "supplier")},
+		{facet: entities.FacetWarranty, value: warrantyStateExpr()},
+	}
+
+	facets := make([]*entities.Facet, 0, len(columns))
+	for _, c := range columns {
+		qmods, err := facetConditions(query, query.Filters.Without(c.facet, ""))
+		if err != nil {
+			return nil, err
+		}
+
+		if c.facet == entities.FacetSupplier {
+			qmods = append(qmods, sm.InnerJoin("asset_purchases").On(sqlite.Raw("asset_purchases.asset_id = assets.id")))
+		}
+
+		rows, err := bob.All(ctx, exec, sqlite.Select(append(qmods,
+			sm.Columns(sqlite.Raw("''").As("attr"), sqlite.Group(c.value).As("value"), sqlite.Raw("count(DISTINCT assets.id)").As("count")),
+			sm.Where(sqlite.Raw("? != ''", c.value)),
+			sm.GroupBy("value"),
+			sm.OrderBy("count").Desc(),
+			sm.OrderBy("value"),
+		)...), scan.StructMapper[*facetValueRow]())
+		if err != nil {
+			return nil, fmt.Errorf("error counting %s facet values: %w", c.facet, err)
+		}
+
+		facets = append(facets, newFacet(c.facet, "", rows, query))
+	}
+
+	attrFacets, err := listCustomAttrFacets(ctx, exec, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(facets, attrFacets...), nil
+}
+
+// listCustomAttrFacets returns a facet for each custom attribute. Attributes without selected values share a
+// single query, every attribute with selected values needs its own query without its selection.
+func listCustomAttrFacets(ctx context.Context, exec bob.Executor, query database.ListAssetFacetsQuery) ([]*entities.Facet, error) {
+	rows, err := listCustomAttrValues(ctx, exec, query, query.Filters, "")
+	if err != nil {
+		return nil, err
+	}
+
+	byAttr := map[string][]*facetValueRow{}
+	var names []string
+	for _, row := range rows {
+		if _, selected := query.Filters.CustomAttrs[row.Attr]; selected {
+			continue
+		}
+
+		if _, ok := byAttr[row.Attr]; !ok {
+			names = append(names, row.Attr)
+		}
+		byAttr[row.Attr] = append(byAttr[row.Attr], row)
+	}
+
+	for name := range query.Filters.CustomAttrs {
+		rows, err := listCustomAttrValues(ctx, exec, query, query.Filters.Without(entities.FacetCustomAttr, name), name)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, name)
+		byAttr[name] = rows
+	}
+
+	slices.Sort(names)
+
+	facets := make([]*entities.Facet, 0, len(names))
+	for _, name := range names {
+		facets = append(facets, newFacet(entities.FacetCustomAttr, name, byAttr[name], query))
+	}
+
+	return facets, nil
+}
+
+func listCustomAttrValues(ctx context.Context, exec bob.Executor, query database.ListAssetFacetsQuery, filters entities.AssetFilters, attr string) ([]*facetValueRow, error) {
+	qmods, err := facetConditions(query, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	qmods = append(qmods,
+		sm.Columns(
+			sqlite.Raw("json_extract(attrs.value, '$.name')").As("attr"),
+			sqlite.Raw(customAttrValue).As("value"),
+			sqlite.Raw("count(DISTINCT assets.id)").As("count"),
+		),
+		sm.InnerJoin(sqlite.F("json_each", sqlite.Quote("assets", "custom_attrs"))).As("attrs").On(sqlite.Raw("1")),
+		sm.Where(sqlite.Raw(customAttrValue+" != ''")),
+		// json_each has a value column too, so the expressions are used instead of the aliases
+		sm.GroupBy(sqlite.Raw("json_extract(attrs.value, '$.name')")),
+		sm.GroupBy(sqlite.Raw(customAttrValue)),
+		sm.OrderBy(sqlite.Raw("json_extract(attrs.value, '$.name')")),
+		sm.OrderBy(sqlite.Raw("count(DISTINCT assets.id)")).Desc(),
+		sm.OrderBy(sqlite.Raw(customAttrValue)),
+	)
+
+	if attr != "" {
+		qmods = append(qmods, sm.Where(sqlite.Raw("json_extract(attrs.value, '$.name') = ?", attr)))
+	}
+
+	rows, err := bob.All(ctx, exec, sqlite.Select(qmods...), scan.StructMapper[*facetValueRow]())
+	if err != nil {
+		return nil, fmt.Errorf("error counting custom attribute facet values: %w", err)
+	}
+
+	return rows, nil
+}
+
+func facetConditions(query database.ListAssetFacetsQuery, filters entities.AssetFilters) ([]bob.Mod[*dialect.SelectQuery], error) {
+	qmods, err := assetConditions(database.ListAssetsQuery{
+		WorkspaceID: query.WorkspaceID,
+		Search:      query.Search,
+		Filters:     filters,
+		AssetType:   query.AssetType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(qmods, sm.From("assets")), nil
+}
+
+func newFacet(name string, attr string, rows []*facetValueRow, query database.ListAssetFacetsQuery) *entities.Facet {
+	values := make([]*entities.FacetValue, 0, len(rows))
+	for _, row := range rows {
+		values = append(values, &entities.FacetValue{Value: row.Value, Count: row.Count})
+	}
+
+	return entities.NewFacet(name, attr, values, query.Filters, query.MaxValues)
+}
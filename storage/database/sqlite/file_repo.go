@@ -15,12 +15,13 @@ import (
 	"github.com/stephenafamo/bob/dialect/sqlite/sm"
 )
 
-var ErrFileNotFound = errors.New("file not found")
-var ErrCreatingFile = errors.New("error creating file")
-
 type FileRepo struct{}
 
 func (fr *FileRepo) Create(ctx context.Context, exec bob.Executor, file *entities.File) (int64, error) {
+	if file.Variant == "" {
+		file.Variant = entities.FileVariantOriginal
+	}
+
 	inserted, err := models.AssetFiles.Insert(ctx, exec, &models.AssetFileSetter{
 		AssetID:    omit.From(file.AssetID),
 		Name:       omit.From(file.Name),
@@ -30,9 +31,10 @@ func (fr *FileRepo) Create(ctx context.Context, exec bob.Executor, file *entitie
 		CreatedBy:  omit.From(file.CreatedBy),
 		FullPath:   omit.From(file.FullPath),
 		PublicPath: omit.From(file.PublicPath),
+		Variant:    omit.From(string(file.Variant)),
 	})
 	if err != nil {
-		return 0, fmt.Errorf("%w: %w", ErrCreatingFile, err)
+		return 0, fmt.Errorf("%w: %w", database.ErrCreatingFile, err)
 	}
 	return inserted.ID, nil
 }
@@ -41,7 +43,40 @@ func (fr *FileRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*enti
 	file, err := models.AssetFiles.Query(ctx, exec, models.SelectWhere.AssetFiles.ID.EQ(id)).One()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("%w: %d", ErrFileNotFound, id)
+			return nil, fmt.Errorf("%w: %d", database.ErrFileNotFound, id)
+		}
+		return nil, fmt.Errorf("error getting asset file: %w", err)
+	}
+
+	return &entities.File{
+		ID:         file.ID,
+		AssetID:    file.AssetID,
+		PublicPath: file.PublicPath,
+		FullPath:   file.FullPath,
+		Name:       file.Name,
+		Filetype:   file.Filetype,
+		Sha256:     file.Sha256,
+		Variant:    entities.FileVariant(file.Variant),
+		Broken:     entities.FileBrokenReason(file.BrokenReason),
+		SizeBytes:  file.SizeBytes,
+		CreatedBy:  file.CreatedBy,
+		CreatedAt:  file.CreatedAt.Time,
+		UpdatedAt:  file.UpdatedAt.Time,
+	}, nil
+}
+
+// GetByPublicPath returns the asset's file with the public path.
+// Identical files share their public path, so the lookup must be limited to a single asset.
+func (fr *FileRepo) GetByPublicPath(ctx context.Context, exec bob.Executor, assetID int64, publicPath string) (*entities.File, error) {
+	file, err := models.AssetFiles.Query(
+		ctx, exec,
+		models.SelectWhere.AssetFiles.AssetID.EQ(assetID),
+		models.SelectWhere.AssetFiles.PublicPath.EQ(publicPath),
+		sm.OrderBy(models.AssetFileColumns.ID).Asc(),
+	).One()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: public path: %s", database.ErrFileNotFound, publicPath)
 		}
 		return nil, fmt.Errorf("error getting asset file: %w", err)
 	}
@@ -54,6 +89,8 @@ func (fr *FileRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*enti
 		Name:       file.Name,
 		Filetype:   file.Filetype,
 		Sha256:     file.Sha256,
+		Variant:    entities.FileVariant(file.Variant),
+		Broken:     entities.FileBrokenReason(file.BrokenReason),
 		SizeBytes:  file.SizeBytes,
 		CreatedBy:  file.CreatedBy,
 		CreatedAt:  file.CreatedAt.Time,
@@ -61,11 +98,16 @@ func (fr *FileRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*enti
 	}, nil
 }
 
-func (fr *FileRepo) GetByPublicPath(ctx context.Context, exec bob.Executor, publicPath string) (*entities.File, error) {
-	file, err := models.AssetFiles.Query(ctx, exec, models.SelectWhere.AssetFiles.PublicPath.EQ(publicPath)).One()
+// GetByHash returns the oldest file with the checksum, which owns the blob all identical files share.
+func (fr *FileRepo) GetByHash(ctx context.Context, exec bob.Executor, sha256 []byte) (*entities.File, error) {
+	file, err := models.AssetFiles.Query(
+		ctx, exec,
+		models.SelectWhere.AssetFiles.Sha256.EQ(sha256),
+		sm.OrderBy(models.AssetFileColumns.ID).Asc(),
+	).One()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("%w: public path: %s", ErrFileNotFound, publicPath)
+			return nil, fmt.Errorf("%w: sha256: %x", database.ErrFileNotFound, sha256)
 		}
 		return nil, fmt.Errorf("error getting asset file: %w", err)
 	}
@@ -78,6 +120,8 @@ func (fr *FileRepo) GetByPublicPath(ctx context.Context, exec bob.Executor, publ
 		Name:       file.Name,
 		Filetype:   file.Filetype,
 		Sha256:     file.Sha256,
+		Variant:    entities.FileVariant(file.Variant),
+		Broken:     entities.FileBrokenReason(file.BrokenReason),
 		SizeBytes:  file.SizeBytes,
 		CreatedBy:  file.CreatedBy,
 		CreatedAt:  file.CreatedAt.Time,
@@ -85,6 +129,16 @@ func (fr *FileRepo) GetByPublicPath(ctx context.Context, exec bob.Executor, publ
 	}, nil
 }
 
+// CountReferences returns the number of files which are stored in the blob at the full path.
+func (fr *FileRepo) CountReferences(ctx context.Context, exec bob.Executor, fullPath string) (int64, error) {
+	count, err := models.AssetFiles.Query(ctx, exec, models.SelectWhere.AssetFiles.FullPath.EQ(fullPath)).Count()
+	if err != nil {
+		return 0, fmt.Errorf("error counting references to %s: %w", fullPath, err)
+	}
+
+	return count, nil
+}
+
 func (fr *FileRepo) List(ctx context.Context, exec bob.Executor, query database.ListFilesQuery) (*entities.ListPage[*entities.File], error) {
 	limit := query.PageSize
 
@@ -98,10 +152,7 @@ func (fr *FileRepo) List(ctx context.Context, exec bob.Executor, query database.
 
 	offset := limit * query.Page
 
-	mods := []bob.Mod[*dialect.SelectQuery]{
-		sm.Limit(limit),
-		sm.Offset(offset),
-	}
+	mods := []bob.Mod[*dialect.SelectQuery]{}
 
 	if query.AssetID != 0 {
 		mods = append(mods, models.SelectWhere.AssetFiles.AssetID.EQ(query.AssetID))
@@ -111,7 +162,11 @@ func (fr *FileRepo) List(ctx context.Context, exec bob.Executor, query database.
 		mods = append(mods, models.SelectWhere.AssetFiles.Sha256.In(query.Hashes...))
 	}
 
-	files, err := models.AssetFiles.Query(ctx, exec, mods...).All()
+	if query.OnlyBroken {
+		mods = append(mods, models.SelectWhere.AssetFiles.BrokenReason.NE(""))
+	}
+
+	files, err := models.AssetFiles.Query(ctx, exec, append(mods, sm.OrderBy(models.AssetFileColumns.ID).Asc(), sm.Limit(limit), sm.Offset(offset))...).All()
 	if err != nil {
 		return nil, fmt.Errorf("error getting files: %w", err)
 	}
@@ -144,6 +199,8 @@ func (fr *FileRepo) List(ctx context.Context, exec bob.Executor, query database.
 			PublicPath: files[i].PublicPath,
 			FullPath:   files[i].FullPath,
 			Sha256:     files[i].Sha256,
+			Variant:    entities.FileVariant(files[i].Variant),
+			Broken:     entities.FileBrokenReason(files[i].BrokenReason),
 			CreatedBy:  files[i].CreatedBy,
 			CreatedAt:  files[i].CreatedAt.Time,
 			UpdatedAt:  files[i].UpdatedAt.Time,
@@ -161,3 +218,31 @@ func (fr *FileRepo) Delete(ctx context.Context, exec bob.Executor, ids []int64)
 
 	return nil
 }
+
+// SetBroken marks the files as broken. An empty reason marks them as intact again.
+func (fr *FileRepo) SetBroken(ctx context.Context, exec bob.Executor, ids []int64, reason entities.FileBrokenReason) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := models.AssetFiles.UpdateQ(ctx, exec, models.UpdateWhere.AssetFiles.ID.In(ids...), &models.AssetFileSetter{
+		BrokenReason: omit.From(string(reason)),
+	}).Exec()
+	if err != nil {
+		return fmt.Errorf("error marking asset files as broken: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateFullPath moves all files stored in the blob at oldPath to newPath, e.g. after restoring a backup to a different file storage.
+func (fr *FileRepo) UpdateFullPath(ctx context.Context, exec bob.Executor, oldPath string, newPath string) error {
+	_, err := models.AssetFiles.UpdateQ(ctx, exec, models.UpdateWhere.AssetFiles.FullPath.EQ(oldPath), &models.AssetFileSetter{
+		FullPath: omit.From(newPath),
+	}).Exec()
+	if err != nil {
+		return fmt.Errorf("error updating full path of asset files stored at %s: %w", oldPath, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+)
+
+type GrantRepo struct{}
+
+func (*GrantRepo) ListForUser(ctx context.Context, exec bob.Executor, userID int64) ([]*auth.Grant, error) {
+	grants, err := models.UserGrants.Query(
+		ctx, exec,
+		models.SelectWhere.UserGrants.UserID.EQ(userID),
+		sm.OrderBy(models.UserGrantColumns.ID),
+	).All()
+	if err != nil {
+		return nil, fmt.Errorf("error getting grants for user %d: %w", userID, err)
+	}
+
+	list := make([]*auth.Grant, 0, len(grants))
+	for _, g := range grants {
+		list = append(list, mapDBModelToGrant(g))
+	}
+
+	return list, nil
+}
+
+func (*GrantRepo) Create(ctx context.Context, exec bob.Executor, grant *auth.Grant) error {
+	inserted, err := models.UserGrants.Insert(ctx, exec, &models.UserGrantSetter{
+		UserID:   omit.From(grant.UserID),
+		Role:     omit.From(string(grant.Role)),
+		Location: omit.From(grant.Location),
+		Category: omit.From(grant.Category),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating grant: %w", err)
+	}
+
+	grant.ID = inserted.ID
+	grant.CreatedAt = inserted.CreatedAt.Time
+	grant.UpdatedAt = inserted.UpdatedAt.Time
+
+	return nil
+}
+
+func (*GrantRepo) Delete(ctx context.Context, exec bob.Executor, userID int64, id int64) error {
+	_, err := models.UserGrants.DeleteQ(
+		ctx, exec,
+		models.DeleteWhere.UserGrants.ID.EQ(id),
+		models.DeleteWhere.UserGrants.UserID.EQ(userID),
+	).Exec()
+	if err != nil {
+		return fmt.Errorf("error deleting grant %d: %w", id, err)
+	}
+
+	return nil
+}
+
+func mapDBModelToGrant(model *models.UserGrant) *auth.Grant {
+	return &auth.Grant{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		Role:      auth.Role(model.Role),
+		Location:  model.Location,
+		Category:  model.Category,
+		CreatedAt: model.CreatedAt.Time,
+		UpdatedAt: model.UpdatedAt.Time,
+	}
+}
@@ -8,14 +8,13 @@ import (
 	"time"
 
 	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/storage/database"
 	"github.com/RobinThrift/stuff/storage/database/sqlite/models"
 	"github.com/RobinThrift/stuff/storage/database/sqlite/types"
 	"github.com/aarondl/opt/omit"
 	"github.com/stephenafamo/bob"
 )
 
-var ErrLocalAuthUserNotFound = errors.New("user for local auth not found")
-
 type LocalAuthRepo struct{}
 
 func (*LocalAuthRepo) Get(ctx context.Context, exec bob.Executor, username string) (*auth.LocalAuthUser, error) {
@@ -23,7 +22,7 @@ func (*LocalAuthRepo) Get(ctx context.Context, exec bob.Executor, username strin
 	user, err := query.One()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrLocalAuthUserNotFound
+			return nil, database.ErrLocalAuthUserNotFound
 		}
 
 		return nil, err
@@ -73,7 +72,7 @@ func (*LocalAuthRepo) Update(ctx context.Context, exec bob.Executor, user *auth.
 	}).Exec()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("error updating local auth user: %w", ErrLocalAuthUserNotFound)
+			return fmt.Errorf("error updating local auth user: %w", database.ErrLocalAuthUserNotFound)
 		}
 		return err
 	}
@@ -85,7 +84,7 @@ func (*LocalAuthRepo) DeleteByUsername(ctx context.Context, exec bob.Executor, u
 	_, err := models.LocalAuthUsers.DeleteQ(ctx, exec, models.DeleteWhere.LocalAuthUsers.Username.EQ(username)).Exec()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("error deleting local auth user: %w", ErrLocalAuthUserNotFound)
+			return fmt.Errorf("error deleting local auth user: %w", database.ErrLocalAuthUserNotFound)
 		}
 		return err
 	}
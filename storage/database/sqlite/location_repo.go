@@ -30,6 +30,10 @@ func (cr *LocationRepo) ListLocations(ctx context.Context, exec bob.Executor, qu
 		qmods = append(qmods, models.SelectWhere.Locations.LocName.Like("%"+query.Search+"%"))
 	}
 
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, models.SelectWhere.Locations.WorkspaceID.EQ(query.WorkspaceID))
+	}
+
 	count, err := models.Locations.Query(ctx, exec, qmods...).Count()
 	if err != nil {
 		return nil, fmt.Errorf("error counting locations: %w", err)
@@ -74,6 +78,10 @@ func (cr *LocationRepo) ListPositionCodes(ctx context.Context, exec bob.Executor
 		qmods = append(qmods, models.SelectWhere.PositionCodes.PosCode.Like("%"+query.Search+"%"))
 	}
 
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, models.SelectWhere.PositionCodes.WorkspaceID.EQ(query.WorkspaceID))
+	}
+
 	count, err := models.PositionCodes.Query(ctx, exec, qmods...).Count()
 	if err != nil {
 		return nil, fmt.Errorf("error counting position codes: %w", err)
@@ -30,6 +30,10 @@ func (cr *ManufacturerRepo) List(ctx context.Context, exec bob.Executor, query d
 		qmods = append(qmods, models.SelectWhere.Manufacturers.Manufacturer.Like("%"+query.Search+"%"))
 	}
 
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, models.SelectWhere.Manufacturers.WorkspaceID.EQ(query.WorkspaceID))
+	}
+
 	count, err := models.Manufacturers.Query(ctx, exec, qmods...).Count()
 	if err != nil {
 		return nil, fmt.Errorf("error counting manufacturers: %w", err)
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE asset_checkouts (
+    id             INTEGER PRIMARY KEY AUTOINCREMENT,
+    asset_id       INTEGER NOT NULL,
+    checked_out_to INTEGER NOT NULL,
+
+    due_at         TEXT DEFAULT NULL,
+    note           TEXT DEFAULT NULL,
+    checked_out_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP)),
+
+    checked_in_at  TEXT DEFAULT NULL,
+    check_in_note  TEXT DEFAULT NULL,
+
+    created_by INTEGER NOT NULL,
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP)),
+    updated_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP)),
+
+    FOREIGN KEY(asset_id) REFERENCES assets(id),
+    FOREIGN KEY(checked_out_to) REFERENCES users(id),
+    FOREIGN KEY(created_by) REFERENCES users(id)
+);
+CREATE INDEX asset_checkouts_asset_id_idx ON asset_checkouts(asset_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX asset_checkouts_asset_id_idx;
+DROP TABLE asset_checkouts;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE asset_checkouts ADD COLUMN overdue_at TEXT DEFAULT NULL;
+ALTER TABLE asset_checkouts ADD COLUMN reminded_at TEXT DEFAULT NULL;
+CREATE INDEX asset_checkouts_due_at_idx ON asset_checkouts(due_at) WHERE checked_in_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX asset_checkouts_due_at_idx;
+ALTER TABLE asset_checkouts DROP COLUMN overdue_at;
+ALTER TABLE asset_checkouts DROP COLUMN reminded_at;
+-- +goose StatementEnd
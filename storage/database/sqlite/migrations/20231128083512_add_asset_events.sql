@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE asset_events (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    -- no foreign key, so the history of deleted assets is kept
+    asset_id   INTEGER NOT NULL,
+    type       TEXT NOT NULL,
+    changes    TEXT NOT NULL,
+
+    user_id    INTEGER DEFAULT NULL,
+    request_id TEXT DEFAULT NULL,
+
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP)),
+
+    FOREIGN KEY(user_id) REFERENCES users(id)
+);
+CREATE INDEX asset_events_asset_id_idx ON asset_events(asset_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX asset_events_asset_id_idx;
+DROP TABLE asset_events;
+-- +goose StatementEnd
@@ -0,0 +1,185 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE assets ADD COLUMN deleted_at TEXT DEFAULT NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX assets_deleted_at_idx ON assets(deleted_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TRIGGER assets_after_insert;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TRIGGER assets_after_delete;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TRIGGER assets_after_update;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER assets_after_insert AFTER INSERT ON assets WHEN new.deleted_at IS NULL BEGIN
+	INSERT INTO assets_fts(rowid, id, name, tag, category, model, model_no, serial_no, manufacturer, notes, custom_attrs) VALUES (
+		new.id,
+		new.id,
+		coalesce(new.name, ""),
+		coalesce(new.tag, ""),
+		coalesce(new.category, ""),
+		coalesce(new.model, ""),
+		coalesce(new.model_no, ""),
+		coalesce(new.serial_no, ""),
+		coalesce(new.manufacturer, ""),
+		coalesce(new.notes, ""),
+		coalesce(new.custom_attrs, "")
+	);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER assets_after_delete AFTER DELETE ON assets WHEN old.deleted_at IS NULL BEGIN
+	INSERT INTO assets_fts(assets_fts, rowid, id, name, tag, category, model, model_no, serial_no, manufacturer, notes, custom_attrs) VALUES (
+		'delete',
+		old.id,
+		old.id,
+		coalesce(old.name, ""),
+		coalesce(old.tag, ""),
+		coalesce(old.category, ""),
+		coalesce(old.model, ""),
+		coalesce(old.model_no, ""),
+		coalesce(old.serial_no, ""),
+		coalesce(old.manufacturer, ""),
+		coalesce(old.notes, ""),
+		coalesce(old.custom_attrs, "")
+	);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER assets_after_update AFTER UPDATE ON assets BEGIN
+	INSERT INTO assets_fts(assets_fts, rowid, id, name, tag, category, model, model_no, serial_no, manufacturer, notes, custom_attrs) SELECT
+		'delete',
+		old.id,
+		old.id,
+		coalesce(old.name, ""),
+		coalesce(old.tag, ""),
+		coalesce(old.category, ""),
+		coalesce(old.model, ""),
+		coalesce(old.model_no, ""),
+		coalesce(old.serial_no, ""),
+		coalesce(old.manufacturer, ""),
+		coalesce(old.notes, ""),
+		coalesce(old.custom_attrs, "")
+	WHERE old.deleted_at IS NULL;
+
+	INSERT INTO assets_fts(rowid, id, name, tag, category, model, model_no, serial_no, manufacturer, notes, custom_attrs) SELECT
+		new.id,
+		new.id,
+		coalesce(new.name, ""),
+		coalesce(new.tag, ""),
+		coalesce(new.category, ""),
+		coalesce(new.model, ""),
+		coalesce(new.model_no, ""),
+		coalesce(new.serial_no, ""),
+		coalesce(new.manufacturer, ""),
+		coalesce(new.notes, ""),
+		coalesce(new.custom_attrs, "")
+	WHERE new.deleted_at IS NULL;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER assets_after_insert;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TRIGGER assets_after_delete;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TRIGGER assets_after_update;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER assets_after_insert AFTER INSERT ON assets BEGIN
+	INSERT INTO assets_fts(rowid, id, name, tag, category, model, model_no, serial_no, manufacturer, notes, custom_attrs) VALUES (
+		new.id,
+		new.id,
+		coalesce(new.name, ""),
+		coalesce(new.tag, ""),
+		coalesce(new.category, ""),
+		coalesce(new.model, ""),
+		coalesce(new.model_no, ""),
+		coalesce(new.serial_no, ""),
+		coalesce(new.manufacturer, ""),
+		coalesce(new.notes, ""),
+		coalesce(new.custom_attrs, "")
+	);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER assets_after_delete AFTER DELETE ON assets BEGIN
+	INSERT INTO assets_fts(assets_fts, rowid, id, name, tag, category, model, model_no, serial_no, manufacturer, notes, custom_attrs) VALUES (
+		'delete',
+		old.id,
+		old.id,
+		coalesce(old.name, ""),
+		coalesce(old.tag, ""),
+		coalesce(old.category, ""),
+		coalesce(old.model, ""),
+		coalesce(old.model_no, ""),
+		coalesce(old.serial_no, ""),
+		coalesce(old.manufacturer, ""),
+		coalesce(old.notes, ""),
+		coalesce(old.custom_attrs, "")
+	);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER assets_after_update AFTER UPDATE ON assets BEGIN
+	INSERT INTO assets_fts(assets_fts, rowid, id, name, tag, category, model, model_no, serial_no, manufacturer, notes, custom_attrs) VALUES (
+		'delete',
+		old.id,
+		old.id,
+		coalesce(old.name, ""),
+		coalesce(old.tag, ""),
+		coalesce(old.category, ""),
+		coalesce(old.model, ""),
+		coalesce(old.model_no, ""),
+		coalesce(old.serial_no, ""),
+		coalesce(old.manufacturer, ""),
+		coalesce(old.notes, ""),
+		coalesce(old.custom_attrs, "")
+	);
+
+	INSERT INTO assets_fts(rowid, id, name, tag, category, model, model_no, serial_no, manufacturer, notes, custom_attrs) VALUES (
+		new.id,
+		new.id,
+		coalesce(new.name, ""),
+		coalesce(new.tag, ""),
+		coalesce(new.category, ""),
+		coalesce(new.model, ""),
+		coalesce(new.model_no, ""),
+		coalesce(new.serial_no, ""),
+		coalesce(new.manufacturer, ""),
+		coalesce(new.notes, ""),
+		coalesce(new.custom_attrs, "")
+	);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO assets_fts(assets_fts) VALUES ('rebuild');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX assets_deleted_at_idx;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE assets DROP COLUMN deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE api_tokens (
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id      INTEGER NOT NULL,
+    name         TEXT NOT NULL,
+    token_hash   BLOB NOT NULL,
+    scope        TEXT NOT NULL,
+
+    expires_at   TEXT DEFAULT NULL,
+    last_used_at TEXT DEFAULT NULL,
+
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP)),
+    updated_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP)),
+
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX api_tokens_token_hash_idx ON api_tokens(token_hash);
+CREATE INDEX api_tokens_user_id_idx ON api_tokens(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX api_tokens_user_id_idx;
+DROP INDEX api_tokens_token_hash_idx;
+DROP TABLE api_tokens;
+-- +goose StatementEnd
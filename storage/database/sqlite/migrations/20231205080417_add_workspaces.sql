@@ -0,0 +1,95 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE workspaces (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    name        TEXT NOT NULL,
+    tag_prefix  TEXT NOT NULL DEFAULT '',
+    tag_counter INT  NOT NULL DEFAULT 0,
+
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP)),
+    updated_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP))
+);
+CREATE UNIQUE INDEX unique_workspace_name ON workspaces(name);
+
+INSERT INTO workspaces(id, name, tag_counter) VALUES (1, 'Default', coalesce((SELECT seq FROM sqlite_sequence WHERE name = 'tags'), 0));
+
+CREATE TABLE workspace_members (
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    workspace_id INTEGER NOT NULL,
+    user_id      INTEGER NOT NULL,
+
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP)),
+    updated_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP)),
+
+    FOREIGN KEY(workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX unique_workspace_member ON workspace_members(workspace_id, user_id);
+
+INSERT INTO workspace_members(workspace_id, user_id) SELECT 1, id FROM users;
+
+-- SQLite doesn't allow adding a column with a foreign key and a non NULL default,
+-- so the workspace references are only checked by the application.
+ALTER TABLE assets ADD COLUMN workspace_id INTEGER NOT NULL DEFAULT 1;
+CREATE INDEX assets_workspace_id_idx ON assets(workspace_id);
+
+ALTER TABLE tags ADD COLUMN workspace_id INTEGER NOT NULL DEFAULT 1;
+CREATE INDEX tags_workspace_id_idx ON tags(workspace_id);
+
+DROP VIEW categories;
+CREATE VIEW categories AS SELECT workspace_id, category as cat_name FROM assets GROUP BY workspace_id, cat_name;
+
+DROP VIEW manufacturers;
+CREATE VIEW manufacturers AS SELECT workspace_id, manufacturer FROM assets WHERE manufacturer IS NOT NULL GROUP BY workspace_id, manufacturer;
+
+DROP VIEW models;
+CREATE VIEW models AS SELECT workspace_id, model, model_no FROM assets WHERE model IS NOT NULL OR model_no IS NOT NULL GROUP BY workspace_id, model, model_no;
+
+DROP VIEW suppliers;
+CREATE VIEW suppliers AS SELECT a.workspace_id as workspace_id, p.supplier as name FROM asset_purchases p JOIN assets a ON a.id = p.asset_id WHERE p.supplier IS NOT NULL GROUP BY a.workspace_id, name;
+
+DROP VIEW locations;
+CREATE VIEW locations AS SELECT workspace_id, location as loc_name FROM assets WHERE location IS NOT NULL UNION SELECT a.workspace_id as workspace_id, p.location as loc_name FROM asset_parts p JOIN assets a ON a.id = p.asset_id WHERE p.location IS NOT NULL;
+
+DROP VIEW position_codes;
+CREATE VIEW position_codes AS SELECT workspace_id, position_code as pos_code FROM assets WHERE position_code IS NOT NULL UNION SELECT a.workspace_id as workspace_id, p.position_code as pos_code FROM asset_parts p JOIN assets a ON a.id = p.asset_id WHERE p.position_code IS NOT NULL;
+
+DROP VIEW custom_attr_names;
+CREATE VIEW custom_attr_names AS SELECT workspace_id, j.value->>'name' as attr_name FROM assets, json_each(custom_attrs) j WHERE custom_attrs IS NOT NULL GROUP BY workspace_id, attr_name;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW custom_attr_names;
+CREATE VIEW custom_attr_names AS SELECT j.value->>'name' as attr_name FROM assets, json_each(custom_attrs) j WHERE custom_attrs IS NOT NULL GROUP BY attr_name;
+
+DROP VIEW position_codes;
+CREATE VIEW position_codes AS SELECT position_code as pos_code FROM assets WHERE position_code IS NOT NULL UNION SELECT position_code as pos_code FROM asset_parts WHERE position_code IS NOT NULL GROUP BY pos_code;
+
+DROP VIEW locations;
+CREATE VIEW locations AS SELECT location as loc_name FROM assets WHERE location IS NOT NULL UNION SELECT location as loc_name FROM asset_parts WHERE location IS NOT NULL GROUP BY loc_name;
+
+DROP VIEW suppliers;
+CREATE VIEW suppliers AS SELECT DISTINCT supplier as name FROM asset_purchases WHERE supplier IS NOT NULL GROUP BY name;
+
+DROP VIEW models;
+CREATE VIEW models AS SELECT model, model_no FROM assets WHERE model IS NOT NULL OR model_no IS NOT NULL GROUP BY model, model_no;
+
+DROP VIEW manufacturers;
+CREATE VIEW manufacturers AS SELECT manufacturer FROM assets WHERE manufacturer IS NOT NULL GROUP BY manufacturer;
+
+DROP VIEW categories;
+CREATE VIEW categories AS SELECT category as cat_name FROM assets GROUP BY cat_name;
+
+DROP INDEX tags_workspace_id_idx;
+ALTER TABLE tags DROP COLUMN workspace_id;
+
+DROP INDEX assets_workspace_id_idx;
+ALTER TABLE assets DROP COLUMN workspace_id;
+
+DROP INDEX unique_workspace_member;
+DROP TABLE workspace_members;
+
+DROP INDEX unique_workspace_name;
+DROP TABLE workspaces;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE asset_files ADD COLUMN variant TEXT NOT NULL DEFAULT 'original';
+ALTER TABLE assets ADD COLUMN preview_url TEXT DEFAULT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE assets SET thumbnail_url = image_url;
+DELETE FROM asset_files WHERE variant != 'original';
+ALTER TABLE assets DROP COLUMN preview_url;
+ALTER TABLE asset_files DROP COLUMN variant;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE asset_photos (
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    asset_id      INTEGER NOT NULL,
+    file_id       INTEGER NOT NULL,
+
+    image_url     TEXT NOT NULL,
+    thumbnail_url TEXT NOT NULL,
+    preview_url   TEXT NOT NULL,
+    caption       TEXT NOT NULL DEFAULT '',
+    position      INT  NOT NULL DEFAULT 0,
+    is_primary    BOOLEAN NOT NULL DEFAULT FALSE,
+
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP)),
+    updated_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP)),
+
+    FOREIGN KEY(file_id) REFERENCES asset_files(id) ON DELETE CASCADE,
+    FOREIGN KEY(asset_id) REFERENCES assets(id) ON DELETE CASCADE
+);
+CREATE INDEX asset_photos_asset_id_idx ON asset_photos(asset_id);
+CREATE UNIQUE INDEX unique_asset_photo_file ON asset_photos(file_id);
+
+-- the existing asset images become the primary photos
+INSERT INTO asset_photos(asset_id, file_id, image_url, thumbnail_url, preview_url, is_primary)
+    SELECT a.id, min(f.id), a.image_url, coalesce(a.thumbnail_url, a.image_url), coalesce(a.preview_url, a.image_url), TRUE
+    FROM assets a
+    JOIN asset_files f ON f.asset_id = a.id AND f.public_path = a.image_url AND f.variant = 'original'
+    WHERE a.image_url IS NOT NULL AND a.image_url != ''
+    GROUP BY a.id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE asset_photos;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS asset_files_sha256_idx ON asset_files (sha256);
+CREATE INDEX IF NOT EXISTS asset_files_full_path_idx ON asset_files (full_path);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS asset_files_full_path_idx;
+DROP INDEX IF EXISTS asset_files_sha256_idx;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE saved_searches (
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    workspace_id INTEGER NOT NULL,
+    user_id      INTEGER NOT NULL,
+    name         TEXT NOT NULL,
+    query        TEXT NOT NULL DEFAULT '',
+    filters      TEXT NOT NULL DEFAULT '',
+    shared       BOOLEAN NOT NULL DEFAULT false,
+
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP)),
+    updated_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%SZ', CURRENT_TIMESTAMP)),
+
+    FOREIGN KEY(workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+CREATE INDEX saved_searches_workspace_id_user_id_idx ON saved_searches(workspace_id, user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX saved_searches_workspace_id_user_id_idx;
+DROP TABLE saved_searches;
+-- +goose StatementEnd
@@ -34,6 +34,10 @@ func (cr *ModelRepo) List(ctx context.Context, exec bob.Executor, query database
 		))
 	}
 
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, models.SelectWhere.Models.WorkspaceID.EQ(query.WorkspaceID))
+	}
+
 	count, err := models.Models.Query(ctx, exec, qmods...).Count()
 	if err != nil {
 		return nil, fmt.Errorf("error counting locations: %w", err)
@@ -24,17 +24,19 @@ import (
 
 // AssetFile is an object representing the database table.
 type AssetFile struct {
-	ID         int64                `db:"id,pk" `
-	AssetID    int64                `db:"asset_id" `
-	Name       string               `db:"name" `
-	Filetype   string               `db:"filetype" `
-	Sha256     []byte               `db:"sha256" `
-	SizeBytes  int64                `db:"size_bytes" `
-	CreatedBy  int64                `db:"created_by" `
-	CreatedAt  types.SQLiteDatetime `db:"created_at" `
-	UpdatedAt  types.SQLiteDatetime `db:"updated_at" `
-	FullPath   string               `db:"full_path" `
-	PublicPath string               `db:"public_path" `
+	ID           int64                `db:"id,pk" `
+	AssetID      int64                `db:"asset_id" `
+	Name         string               `db:"name" `
+	Filetype     string               `db:"filetype" `
+	Sha256       []byte               `db:"sha256" `
+	SizeBytes    int64                `db:"size_bytes" `
+	CreatedBy    int64                `db:"created_by" `
+	CreatedAt    types.SQLiteDatetime `db:"created_at" `
+	UpdatedAt    types.SQLiteDatetime `db:"updated_at" `
+	FullPath     string               `db:"full_path" `
+	PublicPath   string               `db:"public_path" `
+	Variant      string               `db:"variant" `
+	BrokenReason string               `db:"broken_reason" `
 
 	R assetFileR `db:"-" `
 }
@@ -54,25 +56,28 @@ type AssetFilesStmt = bob.QueryStmt[*AssetFile, AssetFileSlice]
 
 // assetFileR is where relationships are stored.
 type assetFileR struct {
-	CreatedByUser *User  // fk_asset_files_0
-	Asset         *Asset // fk_asset_files_1
+	CreatedByUser   *User           // fk_asset_files_0
+	Asset           *Asset          // fk_asset_files_1
+	FileAssetPhotos AssetPhotoSlice // fk_asset_photos_1
 }
 
 // AssetFileSetter is used for insert/upsert/update operations
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type AssetFileSetter struct {
-	ID         omit.Val[int64]                `db:"id,pk"`
-	AssetID    omit.Val[int64]                `db:"asset_id"`
-	Name       omit.Val[string]               `db:"name"`
-	Filetype   omit.Val[string]               `db:"filetype"`
-	Sha256     omit.Val[[]byte]               `db:"sha256"`
-	SizeBytes  omit.Val[int64]                `db:"size_bytes"`
-	CreatedBy  omit.Val[int64]                `db:"created_by"`
-	CreatedAt  omit.Val[types.SQLiteDatetime] `db:"created_at"`
-	UpdatedAt  omit.Val[types.SQLiteDatetime] `db:"updated_at"`
-	FullPath   omit.Val[string]               `db:"full_path"`
-	PublicPath omit.Val[string]               `db:"public_path"`
+	ID           omit.Val[int64]                `db:"id,pk"`
+	AssetID      omit.Val[int64]                `db:"asset_id"`
+	Name         omit.Val[string]               `db:"name"`
+	Filetype     omit.Val[string]               `db:"filetype"`
+	Sha256       omit.Val[[]byte]               `db:"sha256"`
+	SizeBytes    omit.Val[int64]                `db:"size_bytes"`
+	CreatedBy    omit.Val[int64]                `db:"created_by"`
+	CreatedAt    omit.Val[types.SQLiteDatetime] `db:"created_at"`
+	UpdatedAt    omit.Val[types.SQLiteDatetime] `db:"updated_at"`
+	FullPath     omit.Val[string]               `db:"full_path"`
+	PublicPath   omit.Val[string]               `db:"public_path"`
+	Variant      omit.Val[string]               `db:"variant"`
+	BrokenReason omit.Val[string]               `db:"broken_reason"`
 }
 
 func (s AssetFileSetter) SetColumns() []string {
@@ -120,6 +125,12 @@ func (s AssetFileSetter) SetColumns() []string {
 	if !s.PublicPath.IsUnset() {
 		vals = append(vals, "public_path")
 	}
+	if !s.Variant.IsUnset() {
+		vals = append(vals, "variant")
+	}
+	if !s.BrokenReason.IsUnset() {
+		vals = append(vals, "broken_reason")
+	}
 
 	return vals
 }
@@ -158,6 +169,12 @@ func (s AssetFileSetter) Overwrite(t *AssetFile) {
 	if !s.PublicPath.IsUnset() {
 		t.PublicPath, _ = s.PublicPath.Get()
 	}
+	if !s.Variant.IsUnset() {
+		t.Variant, _ = s.Variant.Get()
+	}
+	if !s.BrokenReason.IsUnset() {
+		t.BrokenReason, _ = s.BrokenReason.Get()
+	}
 }
 
 func (s AssetFileSetter) Apply(q *dialect.UpdateQuery) {
@@ -194,6 +211,12 @@ func (s AssetFileSetter) Apply(q *dialect.UpdateQuery) {
 	if !s.PublicPath.IsUnset() {
 		um.Set("public_path").ToArg(s.PublicPath).Apply(q)
 	}
+	if !s.Variant.IsUnset() {
+		um.Set("variant").ToArg(s.Variant).Apply(q)
+	}
+	if !s.BrokenReason.IsUnset() {
+		um.Set("broken_reason").ToArg(s.BrokenReason).Apply(q)
+	}
 }
 
 func (s AssetFileSetter) Insert() bob.Mod[*dialect.InsertQuery] {
@@ -241,33 +264,43 @@ func (s AssetFileSetter) Insert() bob.Mod[*dialect.InsertQuery] {
 	if !s.PublicPath.IsUnset() {
 		vals = append(vals, sqlite.Arg(s.PublicPath))
 	}
+	if !s.Variant.IsUnset() {
+		vals = append(vals, sqlite.Arg(s.Variant))
+	}
+	if !s.BrokenReason.IsUnset() {
+		vals = append(vals, sqlite.Arg(s.BrokenReason))
+	}
 
 	return im.Values(vals...)
 }
 
 type assetFileColumnNames struct {
-	ID         string
-	AssetID    string
-	Name       string
-	Filetype   string
-	Sha256     string
-	SizeBytes  string
-	CreatedBy  string
-	CreatedAt  string
-	UpdatedAt  string
-	FullPath   string
-	PublicPath string
+	ID           string
+	AssetID      string
+	Name         string
+	Filetype     string
+	Sha256       string
+	SizeBytes    string
+	CreatedBy    string
+	CreatedAt    string
+	UpdatedAt    string
+	FullPath     string
+	PublicPath   string
+	Variant      string
+	BrokenReason string
 }
 
 type assetFileRelationshipJoins[Q dialect.Joinable] struct {
-	CreatedByUser bob.Mod[Q]
-	Asset         bob.Mod[Q]
+	CreatedByUser   bob.Mod[Q]
+	Asset           bob.Mod[Q]
+	FileAssetPhotos bob.Mod[Q]
 }
 
 func buildassetFileRelationshipJoins[Q dialect.Joinable](ctx context.Context, typ string) assetFileRelationshipJoins[Q] {
 	return assetFileRelationshipJoins[Q]{
-		CreatedByUser: assetFilesJoinCreatedByUser[Q](ctx, typ),
-		Asset:         assetFilesJoinAsset[Q](ctx, typ),
+		CreatedByUser:   assetFilesJoinCreatedByUser[Q](ctx, typ),
+		Asset:           assetFilesJoinAsset[Q](ctx, typ),
+		FileAssetPhotos: assetFilesJoinFileAssetPhotos[Q](ctx, typ),
 	}
 }
 
@@ -280,58 +313,66 @@ func assetFilesJoin[Q dialect.Joinable](ctx context.Context) joinSet[assetFileRe
 }
 
 var AssetFileColumns = struct {
-	ID         sqlite.Expression
-	AssetID    sqlite.Expression
-	Name       sqlite.Expression
-	Filetype   sqlite.Expression
-	Sha256     sqlite.Expression
-	SizeBytes  sqlite.Expression
-	CreatedBy  sqlite.Expression
-	CreatedAt  sqlite.Expression
-	UpdatedAt  sqlite.Expression
-	FullPath   sqlite.Expression
-	PublicPath sqlite.Expression
+	ID           sqlite.Expression
+	AssetID      sqlite.Expression
+	Name         sqlite.Expression
+	Filetype     sqlite.Expression
+	Sha256       sqlite.Expression
+	SizeBytes    sqlite.Expression
+	CreatedBy    sqlite.Expression
+	CreatedAt    sqlite.Expression
+	UpdatedAt    sqlite.Expression
+	FullPath     sqlite.Expression
+	PublicPath   sqlite.Expression
+	Variant      sqlite.Expression
+	BrokenReason sqlite.Expression
 }{
-	ID:         sqlite.Quote("asset_files", "id"),
-	AssetID:    sqlite.Quote("asset_files", "asset_id"),
-	Name:       sqlite.Quote("asset_files", "name"),
-	Filetype:   sqlite.Quote("asset_files", "filetype"),
-	Sha256:     sqlite.Quote("asset_files", "sha256"),
-	SizeBytes:  sqlite.Quote("asset_files", "size_bytes"),
-	CreatedBy:  sqlite.Quote("asset_files", "created_by"),
-	CreatedAt:  sqlite.Quote("asset_files", "created_at"),
-	UpdatedAt:  sqlite.Quote("asset_files", "updated_at"),
-	FullPath:   sqlite.Quote("asset_files", "full_path"),
-	PublicPath: sqlite.Quote("asset_files", "public_path"),
+	ID:           sqlite.Quote("asset_files", "id"),
+	AssetID:      sqlite.Quote("asset_files", "asset_id"),
+	Name:         sqlite.Quote("asset_files", "name"),
+	Filetype:     sqlite.Quote("asset_files", "filetype"),
+	Sha256:       sqlite.Quote("asset_files", "sha256"),
+	SizeBytes:    sqlite.Quote("asset_files", "size_bytes"),
+	CreatedBy:    sqlite.Quote("asset_files", "created_by"),
+	CreatedAt:    sqlite.Quote("asset_files", "created_at"),
+	UpdatedAt:    sqlite.Quote("asset_files", "updated_at"),
+	FullPath:     sqlite.Quote("asset_files", "full_path"),
+	PublicPath:   sqlite.Quote("asset_files", "public_path"),
+	Variant:      sqlite.Quote("asset_files", "variant"),
+	BrokenReason: sqlite.Quote("asset_files", "broken_reason"),
 }
 
 type assetFileWhere[Q sqlite.Filterable] struct {
-	ID         sqlite.WhereMod[Q, int64]
-	AssetID    sqlite.WhereMod[Q, int64]
-	Name       sqlite.WhereMod[Q, string]
-	Filetype   sqlite.WhereMod[Q, string]
-	Sha256     sqlite.WhereMod[Q, []byte]
-	SizeBytes  sqlite.WhereMod[Q, int64]
-	CreatedBy  sqlite.WhereMod[Q, int64]
-	CreatedAt  sqlite.WhereMod[Q, types.SQLiteDatetime]
-	UpdatedAt  sqlite.WhereMod[Q, types.SQLiteDatetime]
-	FullPath   sqlite.WhereMod[Q, string]
-	PublicPath sqlite.WhereMod[Q, string]
+	ID           sqlite.WhereMod[Q, int64]
+	AssetID      sqlite.WhereMod[Q, int64]
+	Name         sqlite.WhereMod[Q, string]
+	Filetype     sqlite.WhereMod[Q, string]
+	Sha256       sqlite.WhereMod[Q, []byte]
+	SizeBytes    sqlite.WhereMod[Q, int64]
+	CreatedBy    sqlite.WhereMod[Q, int64]
+	CreatedAt    sqlite.WhereMod[Q, types.SQLiteDatetime]
+	UpdatedAt    sqlite.WhereMod[Q, types.SQLiteDatetime]
+	FullPath     sqlite.WhereMod[Q, string]
+	PublicPath   sqlite.WhereMod[Q, string]
+	Variant      sqlite.WhereMod[Q, string]
+	BrokenReason sqlite.WhereMod[Q, string]
 }
 
 func AssetFileWhere[Q sqlite.Filterable]() assetFileWhere[Q] {
 	return assetFileWhere[Q]{
-		ID:         sqlite.Where[Q, int64](AssetFileColumns.ID),
-		AssetID:    sqlite.Where[Q, int64](AssetFileColumns.AssetID),
-		Name:       sqlite.Where[Q, string](AssetFileColumns.Name),
-		Filetype:   sqlite.Where[Q, string](AssetFileColumns.Filetype),
-		Sha256:     sqlite.Where[Q, []byte](AssetFileColumns.Sha256),
-		SizeBytes:  sqlite.Where[Q, int64](AssetFileColumns.SizeBytes),
-		CreatedBy:  sqlite.Where[Q, int64](AssetFileColumns.CreatedBy),
-		CreatedAt:  sqlite.Where[Q, types.SQLiteDatetime](AssetFileColumns.CreatedAt),
-		UpdatedAt:  sqlite.Where[Q, types.SQLiteDatetime](AssetFileColumns.UpdatedAt),
-		FullPath:   sqlite.Where[Q, string](AssetFileColumns.FullPath),
-		PublicPath: sqlite.Where[Q, string](AssetFileColumns.PublicPath),
+		ID:           sqlite.Where[Q, int64](AssetFileColumns.ID),
+		AssetID:      sqlite.Where[Q, int64](AssetFileColumns.AssetID),
+		Name:         sqlite.Where[Q, string](AssetFileColumns.Name),
+		Filetype:     sqlite.Where[Q, string](AssetFileColumns.Filetype),
+		Sha256:       sqlite.Where[Q, []byte](AssetFileColumns.Sha256),
+		SizeBytes:    sqlite.Where[Q, int64](AssetFileColumns.SizeBytes),
+		CreatedBy:    sqlite.Where[Q, int64](AssetFileColumns.CreatedBy),
+		CreatedAt:    sqlite.Where[Q, types.SQLiteDatetime](AssetFileColumns.CreatedAt),
+		UpdatedAt:    sqlite.Where[Q, types.SQLiteDatetime](AssetFileColumns.UpdatedAt),
+		FullPath:     sqlite.Where[Q, string](AssetFileColumns.FullPath),
+		PublicPath:   sqlite.Where[Q, string](AssetFileColumns.PublicPath),
+		Variant:      sqlite.Where[Q, string](AssetFileColumns.Variant),
+		BrokenReason: sqlite.Where[Q, string](AssetFileColumns.BrokenReason),
 	}
 }
 
@@ -444,6 +485,13 @@ func assetFilesJoinAsset[Q dialect.Joinable](ctx context.Context, typ string) bo
 		),
 	}
 }
+func assetFilesJoinFileAssetPhotos[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
+	return mods.QueryMods[Q]{
+		dialect.Join[Q](typ, AssetPhotos.Name(ctx)).On(
+			AssetPhotoColumns.FileID.EQ(AssetFileColumns.ID),
+		),
+	}
+}
 
 // CreatedByUser starts a query for related objects on users
 func (o *AssetFile) CreatedByUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
@@ -481,6 +529,24 @@ func (os AssetFileSlice) Asset(ctx context.Context, exec bob.Executor, mods ...b
 	)...)
 }
 
+// FileAssetPhotos starts a query for related objects on asset_photos
+func (o *AssetFile) FileAssetPhotos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetPhotosQuery {
+	return AssetPhotos.Query(ctx, exec, append(mods,
+		sm.Where(AssetPhotoColumns.FileID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os AssetFileSlice) FileAssetPhotos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetPhotosQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = sqlite.ArgGroup(o.ID)
+	}
+
+	return AssetPhotos.Query(ctx, exec, append(mods,
+		sm.Where(sqlite.Group(AssetPhotoColumns.FileID).In(PKArgs...)),
+	)...)
+}
+
 func (o *AssetFile) Preload(name string, retrieved any) error {
 	if o == nil {
 		return nil
@@ -504,6 +570,15 @@ func (o *AssetFile) Preload(name string, retrieved any) error {
 
 		o.R.Asset = rel
 
+		return nil
+	case "FileAssetPhotos":
+		rels, ok := retrieved.(AssetPhotoSlice)
+		if !ok {
+			return fmt.Errorf("assetFile cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.FileAssetPhotos = rels
+
 		return nil
 	default:
 		return fmt.Errorf("assetFile has no relationship %q", name)
@@ -678,6 +753,72 @@ func (os AssetFileSlice) LoadAssetFileAsset(ctx context.Context, exec bob.Execut
 	return nil
 }
 
+func ThenLoadAssetFileFileAssetPhotos(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadAssetFileFileAssetPhotos(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load AssetFileFileAssetPhotos", retrieved)
+		}
+
+		err := loader.LoadAssetFileFileAssetPhotos(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadAssetFileFileAssetPhotos loads the assetFile's FileAssetPhotos into the .R struct
+func (o *AssetFile) LoadAssetFileFileAssetPhotos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.FileAssetPhotos = nil
+
+	related, err := o.FileAssetPhotos(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	o.R.FileAssetPhotos = related
+	return nil
+}
+
+// LoadAssetFileFileAssetPhotos loads the assetFile's FileAssetPhotos into the .R struct
+func (os AssetFileSlice) LoadAssetFileFileAssetPhotos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	assetPhotos, err := os.FileAssetPhotos(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.FileAssetPhotos = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range assetPhotos {
+			if o.ID != rel.FileID {
+				continue
+			}
+
+			o.R.FileAssetPhotos = append(o.R.FileAssetPhotos, rel)
+		}
+	}
+
+	return nil
+}
+
 func attachAssetFileCreatedByUser0(ctx context.Context, exec bob.Executor, assetFile0 *AssetFile, user1 *User) error {
 	setter := &AssetFileSetter{
 		CreatedBy: omit.From(user1.ID),
@@ -761,3 +902,62 @@ func (assetFile0 *AssetFile) AttachAsset(ctx context.Context, exec bob.Executor,
 
 	return nil
 }
+
+func insertAssetFileFileAssetPhotos0(ctx context.Context, exec bob.Executor, assetPhotos1 []*AssetPhotoSetter, assetFile0 *AssetFile) (AssetPhotoSlice, error) {
+	for _, assetPhoto1 := range assetPhotos1 {
+		assetPhoto1.FileID = omit.From(assetFile0.ID)
+	}
+
+	ret, err := AssetPhotos.InsertMany(ctx, exec, assetPhotos1...)
+	if err != nil {
+		return ret, fmt.Errorf("insertAssetFileFileAssetPhotos0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachAssetFileFileAssetPhotos0(ctx context.Context, exec bob.Executor, assetPhotos1 AssetPhotoSlice, assetFile0 *AssetFile) error {
+	setter := &AssetPhotoSetter{
+		FileID: omit.From(assetFile0.ID),
+	}
+
+	err := AssetPhotos.Update(ctx, exec, setter, assetPhotos1...)
+	if err != nil {
+		return fmt.Errorf("attachAssetFileFileAssetPhotos0: %w", err)
+	}
+
+	return nil
+}
+
+func (assetFile0 *AssetFile) InsertFileAssetPhotos(ctx context.Context, exec bob.Executor, related ...*AssetPhotoSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	assetPhoto1, err := insertAssetFileFileAssetPhotos0(ctx, exec, related, assetFile0)
+	if err != nil {
+		return err
+	}
+
+	assetFile0.R.FileAssetPhotos = append(assetFile0.R.FileAssetPhotos, assetPhoto1...)
+
+	return nil
+}
+
+func (assetFile0 *AssetFile) AttachFileAssetPhotos(ctx context.Context, exec bob.Executor, related ...*AssetPhoto) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	assetPhoto1 := AssetPhotoSlice(related)
+
+	err = attachAssetFileFileAssetPhotos0(ctx, exec, assetPhoto1, assetFile0)
+	if err != nil {
+		return err
+	}
+
+	assetFile0.R.FileAssetPhotos = append(assetFile0.R.FileAssetPhotos, assetPhoto1...)
+
+	return nil
+}
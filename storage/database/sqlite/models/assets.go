@@ -51,6 +51,9 @@ type Asset struct {
 	Type              string                                       `db:"type" `
 	Quantity          uint64                                       `db:"quantity" `
 	QuantityUnit      null.Val[string]                             `db:"quantity_unit" `
+	DeletedAt         null.Val[types.SQLiteDatetime]               `db:"deleted_at" `
+	WorkspaceID       int64                                        `db:"workspace_id" `
+	PreviewURL        null.Val[string]                             `db:"preview_url" `
 
 	R assetR `db:"-" `
 }
@@ -70,8 +73,10 @@ type AssetsStmt = bob.QueryStmt[*Asset, AssetSlice]
 
 // assetR is where relationships are stored.
 type assetR struct {
+	AssetCheckouts      AssetCheckoutSlice // fk_asset_checkouts_2
 	AssetFiles          AssetFileSlice     // fk_asset_files_1
 	AssetParts          AssetPartSlice     // fk_asset_parts_1
+	AssetPhotos         AssetPhotoSlice    // fk_asset_photos_0
 	AssetPurchases      AssetPurchaseSlice // fk_asset_purchases_1
 	CreatedByUser       *User              // fk_assets_0
 	CheckedOutToUser    *User              // fk_assets_1
@@ -109,10 +114,13 @@ type AssetSetter struct {
 	Type              omit.Val[string]                                 `db:"type"`
 	Quantity          omit.Val[uint64]                                 `db:"quantity"`
 	QuantityUnit      omitnull.Val[string]                             `db:"quantity_unit"`
+	DeletedAt         omitnull.Val[types.SQLiteDatetime]               `db:"deleted_at"`
+	WorkspaceID       omit.Val[int64]                                  `db:"workspace_id"`
+	PreviewURL        omitnull.Val[string]                             `db:"preview_url"`
 }
 
 func (s AssetSetter) SetColumns() []string {
-	vals := make([]string, 0, 25)
+	vals := make([]string, 0, 27)
 	if !s.ID.IsUnset() {
 		vals = append(vals, "id")
 	}
@@ -213,6 +221,17 @@ func (s AssetSetter) SetColumns() []string {
 		vals = append(vals, "quantity_unit")
 	}
 
+	if !s.DeletedAt.IsUnset() {
+		vals = append(vals, "deleted_at")
+	}
+
+	if !s.WorkspaceID.IsUnset() {
+		vals = append(vals, "workspace_id")
+	}
+	if !s.PreviewURL.IsUnset() {
+		vals = append(vals, "preview_url")
+	}
+
 	return vals
 }
 
@@ -292,6 +311,15 @@ func (s AssetSetter) Overwrite(t *Asset) {
 	if !s.QuantityUnit.IsUnset() {
 		t.QuantityUnit, _ = s.QuantityUnit.GetNull()
 	}
+	if !s.DeletedAt.IsUnset() {
+		t.DeletedAt, _ = s.DeletedAt.GetNull()
+	}
+	if !s.WorkspaceID.IsUnset() {
+		t.WorkspaceID, _ = s.WorkspaceID.Get()
+	}
+	if !s.PreviewURL.IsUnset() {
+		t.PreviewURL, _ = s.PreviewURL.GetNull()
+	}
 }
 
 func (s AssetSetter) Apply(q *dialect.UpdateQuery) {
@@ -370,10 +398,19 @@ func (s AssetSetter) Apply(q *dialect.UpdateQuery) {
 	if !s.QuantityUnit.IsUnset() {
 		um.Set("quantity_unit").ToArg(s.QuantityUnit).Apply(q)
 	}
+	if !s.DeletedAt.IsUnset() {
+		um.Set("deleted_at").ToArg(s.DeletedAt).Apply(q)
+	}
+	if !s.WorkspaceID.IsUnset() {
+		um.Set("workspace_id").ToArg(s.WorkspaceID).Apply(q)
+	}
+	if !s.PreviewURL.IsUnset() {
+		um.Set("preview_url").ToArg(s.PreviewURL).Apply(q)
+	}
 }
 
 func (s AssetSetter) Insert() bob.Mod[*dialect.InsertQuery] {
-	vals := make([]bob.Expression, 0, 25)
+	vals := make([]bob.Expression, 0, 27)
 	if !s.ID.IsUnset() {
 		vals = append(vals, sqlite.Arg(s.ID))
 	}
@@ -474,6 +511,17 @@ func (s AssetSetter) Insert() bob.Mod[*dialect.InsertQuery] {
 		vals = append(vals, sqlite.Arg(s.QuantityUnit))
 	}
 
+	if !s.DeletedAt.IsUnset() {
+		vals = append(vals, sqlite.Arg(s.DeletedAt))
+	}
+
+	if !s.WorkspaceID.IsUnset() {
+		vals = append(vals, sqlite.Arg(s.WorkspaceID))
+	}
+	if !s.PreviewURL.IsUnset() {
+		vals = append(vals, sqlite.Arg(s.PreviewURL))
+	}
+
 	return im.Values(vals...)
 }
 
@@ -503,11 +551,16 @@ type assetColumnNames struct {
 	Type              string
 	Quantity          string
 	QuantityUnit      string
+	DeletedAt         string
+	WorkspaceID       string
+	PreviewURL        string
 }
 
 type assetRelationshipJoins[Q dialect.Joinable] struct {
+	AssetCheckouts      bob.Mod[Q]
 	AssetFiles          bob.Mod[Q]
 	AssetParts          bob.Mod[Q]
+	AssetPhotos         bob.Mod[Q]
 	AssetPurchases      bob.Mod[Q]
 	CreatedByUser       bob.Mod[Q]
 	CheckedOutToUser    bob.Mod[Q]
@@ -518,8 +571,10 @@ type assetRelationshipJoins[Q dialect.Joinable] struct {
 
 func buildassetRelationshipJoins[Q dialect.Joinable](ctx context.Context, typ string) assetRelationshipJoins[Q] {
 	return assetRelationshipJoins[Q]{
+		AssetCheckouts:      assetsJoinAssetCheckouts[Q](ctx, typ),
 		AssetFiles:          assetsJoinAssetFiles[Q](ctx, typ),
 		AssetParts:          assetsJoinAssetParts[Q](ctx, typ),
+		AssetPhotos:         assetsJoinAssetPhotos[Q](ctx, typ),
 		AssetPurchases:      assetsJoinAssetPurchases[Q](ctx, typ),
 		CreatedByUser:       assetsJoinCreatedByUser[Q](ctx, typ),
 		CheckedOutToUser:    assetsJoinCheckedOutToUser[Q](ctx, typ),
@@ -563,6 +618,9 @@ var AssetColumns = struct {
 	Type              sqlite.Expression
 	Quantity          sqlite.Expression
 	QuantityUnit      sqlite.Expression
+	DeletedAt         sqlite.Expression
+	WorkspaceID       sqlite.Expression
+	PreviewURL        sqlite.Expression
 }{
 	ID:                sqlite.Quote("assets", "id"),
 	ParentAssetID:     sqlite.Quote("assets", "parent_asset_id"),
@@ -589,6 +647,9 @@ var AssetColumns = struct {
 	Type:              sqlite.Quote("assets", "type"),
 	Quantity:          sqlite.Quote("assets", "quantity"),
 	QuantityUnit:      sqlite.Quote("assets", "quantity_unit"),
+	DeletedAt:         sqlite.Quote("assets", "deleted_at"),
+	WorkspaceID:       sqlite.Quote("assets", "workspace_id"),
+	PreviewURL:        sqlite.Quote("assets", "preview_url"),
 }
 
 type assetWhere[Q sqlite.Filterable] struct {
@@ -617,6 +678,9 @@ type assetWhere[Q sqlite.Filterable] struct {
 	Type              sqlite.WhereMod[Q, string]
 	Quantity          sqlite.WhereMod[Q, uint64]
 	QuantityUnit      sqlite.WhereNullMod[Q, string]
+	DeletedAt         sqlite.WhereNullMod[Q, types.SQLiteDatetime]
+	WorkspaceID       sqlite.WhereMod[Q, int64]
+	PreviewURL        sqlite.WhereNullMod[Q, string]
 }
 
 func AssetWhere[Q sqlite.Filterable]() assetWhere[Q] {
@@ -646,6 +710,9 @@ func AssetWhere[Q sqlite.Filterable]() assetWhere[Q] {
 		Type:              sqlite.Where[Q, string](AssetColumns.Type),
 		Quantity:          sqlite.Where[Q, uint64](AssetColumns.Quantity),
 		QuantityUnit:      sqlite.WhereNull[Q, string](AssetColumns.QuantityUnit),
+		DeletedAt:         sqlite.WhereNull[Q, types.SQLiteDatetime](AssetColumns.DeletedAt),
+		WorkspaceID:       sqlite.Where[Q, int64](AssetColumns.WorkspaceID),
+		PreviewURL:        sqlite.WhereNull[Q, string](AssetColumns.PreviewURL),
 	}
 }
 
@@ -744,6 +811,13 @@ func (o AssetSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
+func assetsJoinAssetCheckouts[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
+	return mods.QueryMods[Q]{
+		dialect.Join[Q](typ, AssetCheckouts.Name(ctx)).On(
+			AssetCheckoutColumns.AssetID.EQ(AssetColumns.ID),
+		),
+	}
+}
 func assetsJoinAssetFiles[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
 	return mods.QueryMods[Q]{
 		dialect.Join[Q](typ, AssetFiles.Name(ctx)).On(
@@ -758,6 +832,13 @@ func assetsJoinAssetParts[Q dialect.Joinable](ctx context.Context, typ string) b
 		),
 	}
 }
+func assetsJoinAssetPhotos[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
+	return mods.QueryMods[Q]{
+		dialect.Join[Q](typ, AssetPhotos.Name(ctx)).On(
+			AssetPhotoColumns.AssetID.EQ(AssetColumns.ID),
+		),
+	}
+}
 func assetsJoinAssetPurchases[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
 	return mods.QueryMods[Q]{
 		dialect.Join[Q](typ, AssetPurchases.Name(ctx)).On(
@@ -801,6 +882,24 @@ func assetsJoinReverseParentAssets[Q dialect.Joinable](ctx context.Context, typ
 	}
 }
 
+// AssetCheckouts starts a query for related objects on asset_checkouts
+func (o *Asset) AssetCheckouts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetCheckoutsQuery {
+	return AssetCheckouts.Query(ctx, exec, append(mods,
+		sm.Where(AssetCheckoutColumns.AssetID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os AssetSlice) AssetCheckouts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetCheckoutsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = sqlite.ArgGroup(o.ID)
+	}
+
+	return AssetCheckouts.Query(ctx, exec, append(mods,
+		sm.Where(sqlite.Group(AssetCheckoutColumns.AssetID).In(PKArgs...)),
+	)...)
+}
+
 // AssetFiles starts a query for related objects on asset_files
 func (o *Asset) AssetFiles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetFilesQuery {
 	return AssetFiles.Query(ctx, exec, append(mods,
@@ -837,6 +936,24 @@ func (os AssetSlice) AssetParts(ctx context.Context, exec bob.Executor, mods ...
 	)...)
 }
 
+// AssetPhotos starts a query for related objects on asset_photos
+func (o *Asset) AssetPhotos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetPhotosQuery {
+	return AssetPhotos.Query(ctx, exec, append(mods,
+		sm.Where(AssetPhotoColumns.AssetID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os AssetSlice) AssetPhotos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetPhotosQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = sqlite.ArgGroup(o.ID)
+	}
+
+	return AssetPhotos.Query(ctx, exec, append(mods,
+		sm.Where(sqlite.Group(AssetPhotoColumns.AssetID).In(PKArgs...)),
+	)...)
+}
+
 // AssetPurchases starts a query for related objects on asset_purchases
 func (o *Asset) AssetPurchases(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetPurchasesQuery {
 	return AssetPurchases.Query(ctx, exec, append(mods,
@@ -951,6 +1068,15 @@ func (o *Asset) Preload(name string, retrieved any) error {
 	}
 
 	switch name {
+	case "AssetCheckouts":
+		rels, ok := retrieved.(AssetCheckoutSlice)
+		if !ok {
+			return fmt.Errorf("asset cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AssetCheckouts = rels
+
+		return nil
 	case "AssetFiles":
 		rels, ok := retrieved.(AssetFileSlice)
 		if !ok {
@@ -968,6 +1094,15 @@ func (o *Asset) Preload(name string, retrieved any) error {
 
 		o.R.AssetParts = rels
 
+		return nil
+	case "AssetPhotos":
+		rels, ok := retrieved.(AssetPhotoSlice)
+		if !ok {
+			return fmt.Errorf("asset cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AssetPhotos = rels
+
 		return nil
 	case "AssetPurchases":
 		rels, ok := retrieved.(AssetPurchaseSlice)
@@ -1028,6 +1163,72 @@ func (o *Asset) Preload(name string, retrieved any) error {
 	}
 }
 
+func ThenLoadAssetAssetCheckouts(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadAssetAssetCheckouts(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load AssetAssetCheckouts", retrieved)
+		}
+
+		err := loader.LoadAssetAssetCheckouts(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadAssetAssetCheckouts loads the asset's AssetCheckouts into the .R struct
+func (o *Asset) LoadAssetAssetCheckouts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AssetCheckouts = nil
+
+	related, err := o.AssetCheckouts(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	o.R.AssetCheckouts = related
+	return nil
+}
+
+// LoadAssetAssetCheckouts loads the asset's AssetCheckouts into the .R struct
+func (os AssetSlice) LoadAssetAssetCheckouts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	assetCheckouts, err := os.AssetCheckouts(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.AssetCheckouts = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range assetCheckouts {
+			if o.ID != rel.AssetID {
+				continue
+			}
+
+			o.R.AssetCheckouts = append(o.R.AssetCheckouts, rel)
+		}
+	}
+
+	return nil
+}
+
 func ThenLoadAssetAssetFiles(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
 	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
@@ -1160,6 +1361,72 @@ func (os AssetSlice) LoadAssetAssetParts(ctx context.Context, exec bob.Executor,
 	return nil
 }
 
+func ThenLoadAssetAssetPhotos(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadAssetAssetPhotos(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load AssetAssetPhotos", retrieved)
+		}
+
+		err := loader.LoadAssetAssetPhotos(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadAssetAssetPhotos loads the asset's AssetPhotos into the .R struct
+func (o *Asset) LoadAssetAssetPhotos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AssetPhotos = nil
+
+	related, err := o.AssetPhotos(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	o.R.AssetPhotos = related
+	return nil
+}
+
+// LoadAssetAssetPhotos loads the asset's AssetPhotos into the .R struct
+func (os AssetSlice) LoadAssetAssetPhotos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	assetPhotos, err := os.AssetPhotos(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.AssetPhotos = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range assetPhotos {
+			if o.ID != rel.AssetID {
+				continue
+			}
+
+			o.R.AssetPhotos = append(o.R.AssetPhotos, rel)
+		}
+	}
+
+	return nil
+}
+
 func ThenLoadAssetAssetPurchases(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
 	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
@@ -1628,6 +1895,65 @@ func (os AssetSlice) LoadAssetReverseParentAssets(ctx context.Context, exec bob.
 	return nil
 }
 
+func insertAssetAssetCheckouts0(ctx context.Context, exec bob.Executor, assetCheckouts1 []*AssetCheckoutSetter, asset0 *Asset) (AssetCheckoutSlice, error) {
+	for _, assetCheckout1 := range assetCheckouts1 {
+		assetCheckout1.AssetID = omit.From(asset0.ID)
+	}
+
+	ret, err := AssetCheckouts.InsertMany(ctx, exec, assetCheckouts1...)
+	if err != nil {
+		return ret, fmt.Errorf("insertAssetAssetCheckouts0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachAssetAssetCheckouts0(ctx context.Context, exec bob.Executor, assetCheckouts1 AssetCheckoutSlice, asset0 *Asset) error {
+	setter := &AssetCheckoutSetter{
+		AssetID: omit.From(asset0.ID),
+	}
+
+	err := AssetCheckouts.Update(ctx, exec, setter, assetCheckouts1...)
+	if err != nil {
+		return fmt.Errorf("attachAssetAssetCheckouts0: %w", err)
+	}
+
+	return nil
+}
+
+func (asset0 *Asset) InsertAssetCheckouts(ctx context.Context, exec bob.Executor, related ...*AssetCheckoutSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	assetCheckout1, err := insertAssetAssetCheckouts0(ctx, exec, related, asset0)
+	if err != nil {
+		return err
+	}
+
+	asset0.R.AssetCheckouts = append(asset0.R.AssetCheckouts, assetCheckout1...)
+
+	return nil
+}
+
+func (asset0 *Asset) AttachAssetCheckouts(ctx context.Context, exec bob.Executor, related ...*AssetCheckout) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	assetCheckout1 := AssetCheckoutSlice(related)
+
+	err = attachAssetAssetCheckouts0(ctx, exec, assetCheckout1, asset0)
+	if err != nil {
+		return err
+	}
+
+	asset0.R.AssetCheckouts = append(asset0.R.AssetCheckouts, assetCheckout1...)
+
+	return nil
+}
+
 func insertAssetAssetFiles0(ctx context.Context, exec bob.Executor, assetFiles1 []*AssetFileSetter, asset0 *Asset) (AssetFileSlice, error) {
 	for _, assetFile1 := range assetFiles1 {
 		assetFile1.AssetID = omit.From(asset0.ID)
@@ -1746,6 +2072,65 @@ func (asset0 *Asset) AttachAssetParts(ctx context.Context, exec bob.Executor, re
 	return nil
 }
 
+func insertAssetAssetPhotos0(ctx context.Context, exec bob.Executor, assetPhotos1 []*AssetPhotoSetter, asset0 *Asset) (AssetPhotoSlice, error) {
+	for _, assetPhoto1 := range assetPhotos1 {
+		assetPhoto1.AssetID = omit.From(asset0.ID)
+	}
+
+	ret, err := AssetPhotos.InsertMany(ctx, exec, assetPhotos1...)
+	if err != nil {
+		return ret, fmt.Errorf("insertAssetAssetPhotos0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachAssetAssetPhotos0(ctx context.Context, exec bob.Executor, assetPhotos1 AssetPhotoSlice, asset0 *Asset) error {
+	setter := &AssetPhotoSetter{
+		AssetID: omit.From(asset0.ID),
+	}
+
+	err := AssetPhotos.Update(ctx, exec, setter, assetPhotos1...)
+	if err != nil {
+		return fmt.Errorf("attachAssetAssetPhotos0: %w", err)
+	}
+
+	return nil
+}
+
+func (asset0 *Asset) InsertAssetPhotos(ctx context.Context, exec bob.Executor, related ...*AssetPhotoSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	assetPhoto1, err := insertAssetAssetPhotos0(ctx, exec, related, asset0)
+	if err != nil {
+		return err
+	}
+
+	asset0.R.AssetPhotos = append(asset0.R.AssetPhotos, assetPhoto1...)
+
+	return nil
+}
+
+func (asset0 *Asset) AttachAssetPhotos(ctx context.Context, exec bob.Executor, related ...*AssetPhoto) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	assetPhoto1 := AssetPhotoSlice(related)
+
+	err = attachAssetAssetPhotos0(ctx, exec, assetPhoto1, asset0)
+	if err != nil {
+		return err
+	}
+
+	asset0.R.AssetPhotos = append(asset0.R.AssetPhotos, assetPhoto1...)
+
+	return nil
+}
+
 func insertAssetAssetPurchases0(ctx context.Context, exec bob.Executor, assetPurchases1 []*AssetPurchaseSetter, asset0 *Asset) (AssetPurchaseSlice, error) {
 	for _, assetPurchase1 := range assetPurchases1 {
 		assetPurchase1.AssetID = omit.From(asset0.ID)
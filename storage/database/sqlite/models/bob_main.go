@@ -11,74 +11,136 @@ import (
 )
 
 var TableNames = struct {
-	AssetFiles      string
-	AssetParts      string
-	AssetPurchases  string
-	Assets          string
-	AssetsFTS       string
-	LocalAuthUsers  string
-	Sessions        string
-	Tags            string
-	UserPreferences string
-	Users           string
-	Categories      string
-	CustomAttrNames string
-	Locations       string
-	Manufacturers   string
-	Models          string
-	PositionCodes   string
-	Suppliers       string
+	APITokens        string
+	AssetCheckouts   string
+	AssetEvents      string
+	AssetFiles       string
+	AssetParts       string
+	AssetPhotos      string
+	AssetPurchases   string
+	Assets           string
+	AssetsFTS        string
+	LocalAuthUsers   string
+	SavedSearches    string
+	Sessions         string
+	Tags             string
+	UserGrants       string
+	UserPreferences  string
+	Users            string
+	WorkspaceMembers string
+	Workspaces       string
+	Categories       string
+	CustomAttrNames  string
+	Locations        string
+	Manufacturers    string
+	Models           string
+	PositionCodes    string
+	Suppliers        string
 }{
-	AssetFiles:      "asset_files",
-	AssetParts:      "asset_parts",
-	AssetPurchases:  "asset_purchases",
-	Assets:          "assets",
-	AssetsFTS:       "assets_fts",
-	LocalAuthUsers:  "local_auth_users",
-	Sessions:        "sessions",
-	Tags:            "tags",
-	UserPreferences: "user_preferences",
-	Users:           "users",
-	Categories:      "categories",
-	CustomAttrNames: "custom_attr_names",
-	Locations:       "locations",
-	Manufacturers:   "manufacturers",
-	Models:          "models",
-	PositionCodes:   "position_codes",
-	Suppliers:       "suppliers",
+	APITokens:        "api_tokens",
+	AssetCheckouts:   "asset_checkouts",
+	AssetEvents:      "asset_events",
+	AssetFiles:       "asset_files",
+	AssetParts:       "asset_parts",
+	AssetPhotos:      "asset_photos",
+	AssetPurchases:   "asset_purchases",
+	Assets:           "assets",
+	AssetsFTS:        "assets_fts",
+	LocalAuthUsers:   "local_auth_users",
+	SavedSearches:    "saved_searches",
+	Sessions:         "sessions",
+	Tags:             "tags",
+	UserGrants:       "user_grants",
+	UserPreferences:  "user_preferences",
+	Users:            "users",
+	WorkspaceMembers: "workspace_members",
+	Workspaces:       "workspaces",
+	Categories:       "categories",
+	CustomAttrNames:  "custom_attr_names",
+	Locations:        "locations",
+	Manufacturers:    "manufacturers",
+	Models:           "models",
+	PositionCodes:    "position_codes",
+	Suppliers:        "suppliers",
 }
 
 var ColumnNames = struct {
-	AssetFiles      assetFileColumnNames
-	AssetParts      assetPartColumnNames
-	AssetPurchases  assetPurchaseColumnNames
-	Assets          assetColumnNames
-	AssetsFTS       assetsFTColumnNames
-	LocalAuthUsers  localAuthUserColumnNames
-	Sessions        sessionColumnNames
-	Tags            tagColumnNames
-	UserPreferences userPreferenceColumnNames
-	Users           userColumnNames
-	Categories      categoryColumnNames
-	CustomAttrNames customAttrNameColumnNames
-	Locations       locationColumnNames
-	Manufacturers   manufacturerColumnNames
-	Models          modelColumnNames
-	PositionCodes   positionCodeColumnNames
-	Suppliers       supplierColumnNames
+	APITokens        apiTokenColumnNames
+	AssetCheckouts   assetCheckoutColumnNames
+	AssetEvents      assetEventColumnNames
+	AssetFiles       assetFileColumnNames
+	AssetParts       assetPartColumnNames
+	AssetPhotos      assetPhotoColumnNames
+	AssetPurchases   assetPurchaseColumnNames
+	Assets           assetColumnNames
+	AssetsFTS        assetsFTColumnNames
+	LocalAuthUsers   localAuthUserColumnNames
+	SavedSearches    savedSearchColumnNames
+	Sessions         sessionColumnNames
+	Tags             tagColumnNames
+	UserGrants       userGrantColumnNames
+	UserPreferences  userPreferenceColumnNames
+	Users            userColumnNames
+	WorkspaceMembers workspaceMemberColumnNames
+	Workspaces       workspaceColumnNames
+	Categories       categoryColumnNames
+	CustomAttrNames  customAttrNameColumnNames
+	Locations        locationColumnNames
+	Manufacturers    manufacturerColumnNames
+	Models           modelColumnNames
+	PositionCodes    positionCodeColumnNames
+	Suppliers        supplierColumnNames
 }{
-	AssetFiles: assetFileColumnNames{
+	APITokens: apiTokenColumnNames{
 		ID:         "id",
-		AssetID:    "asset_id",
+		UserID:     "user_id",
 		Name:       "name",
-		Filetype:   "filetype",
-		Sha256:     "sha256",
-		SizeBytes:  "size_bytes",
-		CreatedBy:  "created_by",
+		TokenHash:  "token_hash",
+		Scope:      "scope",
+		ExpiresAt:  "expires_at",
+		LastUsedAt: "last_used_at",
 		CreatedAt:  "created_at",
 		UpdatedAt:  "updated_at",
-		FullPath:   "full_path",
-		PublicPath: "public_path",
+	},
+	AssetCheckouts: assetCheckoutColumnNames{
+		ID:           "id",
+		AssetID:      "asset_id",
+		CheckedOutTo: "checked_out_to",
+		DueAt:        "due_at",
+		Note:         "note",
+		CheckedOutAt: "checked_out_at",
+		CheckedInAt:  "checked_in_at",
+		CheckInNote:  "check_in_note",
+		CreatedBy:    "created_by",
+		CreatedAt:    "created_at",
+		UpdatedAt:    "updated_at",
+		OverdueAt:    "overdue_at",
+		RemindedAt:   "reminded_at",
+	},
+	AssetEvents: assetEventColumnNames{
+		ID:        "id",
+		AssetID:   "asset_id",
+		Type:      "type",
+		Changes:   "changes",
+		UserID:    "user_id",
+		RequestID: "request_id",
+		CreatedAt: "created_at",
+		Snapshot:  "snapshot",
+	},
+	AssetFiles: assetFileColumnNames{
+		ID:           "id",
+		AssetID:      "asset_id",
+		Name:         "name",
+		Filetype:     "filetype",
+		Sha256:       "sha256",
+		SizeBytes:    "size_bytes",
+		CreatedBy:    "created_by",
+		CreatedAt:    "created_at",
+		UpdatedAt:    "updated_at",
+		FullPath:     "full_path",
+		PublicPath:   "public_path",
+		Variant:      "variant",
+		BrokenReason: "broken_reason",
 	},
 	AssetParts: assetPartColumnNames{
 		ID:           "id",
@@ -92,6 +154,19 @@ var ColumnNames = struct {
 		CreatedAt:    "created_at",
 		UpdatedAt:    "updated_at",
 	},
+	AssetPhotos: assetPhotoColumnNames{
+		ID:           "id",
+		AssetID:      "asset_id",
+		FileID:       "file_id",
+		ImageURL:     "image_url",
+		ThumbnailURL: "thumbnail_url",
+		PreviewURL:   "preview_url",
+		Caption:      "caption",
+		Position:     "position",
+		IsPrimary:    "is_primary",
+		CreatedAt:    "created_at",
+		UpdatedAt:    "updated_at",
+	},
 	AssetPurchases: assetPurchaseColumnNames{
 		ID:        "id",
 		AssetID:   "asset_id",
@@ -130,6 +205,9 @@ var ColumnNames = struct {
 		Type:              "type",
 		Quantity:          "quantity",
 		QuantityUnit:      "quantity_unit",
+		DeletedAt:         "deleted_at",
+		WorkspaceID:       "workspace_id",
+		PreviewURL:        "preview_url",
 	},
 	AssetsFTS: assetsFTColumnNames{
 		ID:           "id",
@@ -156,6 +234,17 @@ var ColumnNames = struct {
 		CreatedAt:              "created_at",
 		UpdatedAt:              "updated_at",
 	},
+	SavedSearches: savedSearchColumnNames{
+		ID:          "id",
+		WorkspaceID: "workspace_id",
+		UserID:      "user_id",
+		Name:        "name",
+		Query:       "query",
+		Filters:     "filters",
+		Shared:      "shared",
+		CreatedAt:   "created_at",
+		UpdatedAt:   "updated_at",
+	},
 	Sessions: sessionColumnNames{
 		ID:        "id",
 		Token:     "token",
@@ -163,9 +252,19 @@ var ColumnNames = struct {
 		ExpiresAt: "expires_at",
 	},
 	Tags: tagColumnNames{
+		ID:          "id",
+		Tag:         "tag",
+		InUse:       "in_use",
+		CreatedAt:   "created_at",
+		UpdatedAt:   "updated_at",
+		WorkspaceID: "workspace_id",
+	},
+	UserGrants: userGrantColumnNames{
 		ID:        "id",
-		Tag:       "tag",
-		InUse:     "in_use",
+		UserID:    "user_id",
+		Role:      "role",
+		Location:  "location",
+		Category:  "category",
 		CreatedAt: "created_at",
 		UpdatedAt: "updated_at",
 	},
@@ -181,32 +280,54 @@ var ColumnNames = struct {
 		ID:          "id",
 		Username:    "username",
 		DisplayName: "display_name",
-		IsAdmin:     "is_admin",
 		AuthRef:     "auth_ref",
 		CreatedAt:   "created_at",
 		UpdatedAt:   "updated_at",
+		Role:        "role",
+	},
+	WorkspaceMembers: workspaceMemberColumnNames{
+		ID:          "id",
+		WorkspaceID: "workspace_id",
+		UserID:      "user_id",
+		CreatedAt:   "created_at",
+		UpdatedAt:   "updated_at",
+	},
+	Workspaces: workspaceColumnNames{
+		ID:         "id",
+		Name:       "name",
+		TagPrefix:  "tag_prefix",
+		TagCounter: "tag_counter",
+		CreatedAt:  "created_at",
+		UpdatedAt:  "updated_at",
 	},
 	Categories: categoryColumnNames{
-		CatName: "cat_name",
+		WorkspaceID: "workspace_id",
+		CatName:     "cat_name",
 	},
 	CustomAttrNames: customAttrNameColumnNames{
-		AttrName: "attr_name",
+		WorkspaceID: "workspace_id",
+		AttrName:    "attr_name",
 	},
 	Locations: locationColumnNames{
-		LocName: "loc_name",
+		WorkspaceID: "workspace_id",
+		LocName:     "loc_name",
 	},
 	Manufacturers: manufacturerColumnNames{
+		WorkspaceID:  "workspace_id",
 		Manufacturer: "manufacturer",
 	},
 	Models: modelColumnNames{
-		Model:   "model",
-		ModelNo: "model_no",
+		WorkspaceID: "workspace_id",
+		Model:       "model",
+		ModelNo:     "model_no",
 	},
 	PositionCodes: positionCodeColumnNames{
-		PosCode: "pos_code",
+		WorkspaceID: "workspace_id",
+		PosCode:     "pos_code",
 	},
 	Suppliers: supplierColumnNames{
-		Name: "name",
+		WorkspaceID: "workspace_id",
+		Name:        "name",
 	},
 }
 
@@ -218,60 +339,84 @@ var (
 )
 
 func Where[Q sqlite.Filterable]() struct {
-	AssetFiles      assetFileWhere[Q]
-	AssetParts      assetPartWhere[Q]
-	AssetPurchases  assetPurchaseWhere[Q]
-	Assets          assetWhere[Q]
-	AssetsFTS       assetsFTWhere[Q]
-	LocalAuthUsers  localAuthUserWhere[Q]
-	Sessions        sessionWhere[Q]
-	Tags            tagWhere[Q]
-	UserPreferences userPreferenceWhere[Q]
-	Users           userWhere[Q]
-	Categories      categoryWhere[Q]
-	CustomAttrNames customAttrNameWhere[Q]
-	Locations       locationWhere[Q]
-	Manufacturers   manufacturerWhere[Q]
-	Models          modelWhere[Q]
-	PositionCodes   positionCodeWhere[Q]
-	Suppliers       supplierWhere[Q]
+	APITokens        apiTokenWhere[Q]
+	AssetCheckouts   assetCheckoutWhere[Q]
+	AssetEvents      assetEventWhere[Q]
+	AssetFiles       assetFileWhere[Q]
+	AssetParts       assetPartWhere[Q]
+	AssetPhotos      assetPhotoWhere[Q]
+	AssetPurchases   assetPurchaseWhere[Q]
+	Assets           assetWhere[Q]
+	AssetsFTS        assetsFTWhere[Q]
+	LocalAuthUsers   localAuthUserWhere[Q]
+	SavedSearches    savedSearchWhere[Q]
+	Sessions         sessionWhere[Q]
+	Tags             tagWhere[Q]
+	UserGrants       userGrantWhere[Q]
+	UserPreferences  userPreferenceWhere[Q]
+	Users            userWhere[Q]
+	WorkspaceMembers workspaceMemberWhere[Q]
+	Workspaces       workspaceWhere[Q]
+	Categories       categoryWhere[Q]
+	CustomAttrNames  customAttrNameWhere[Q]
+	Locations        locationWhere[Q]
+	Manufacturers    manufacturerWhere[Q]
+	Models           modelWhere[Q]
+	PositionCodes    positionCodeWhere[Q]
+	Suppliers        supplierWhere[Q]
 } {
 	return struct {
-		AssetFiles      assetFileWhere[Q]
-		AssetParts      assetPartWhere[Q]
-		AssetPurchases  assetPurchaseWhere[Q]
-		Assets          assetWhere[Q]
-		AssetsFTS       assetsFTWhere[Q]
-		LocalAuthUsers  localAuthUserWhere[Q]
-		Sessions        sessionWhere[Q]
-		Tags            tagWhere[Q]
-		UserPreferences userPreferenceWhere[Q]
-		Users           userWhere[Q]
-		Categories      categoryWhere[Q]
-		CustomAttrNames customAttrNameWhere[Q]
-		Locations       locationWhere[Q]
-		Manufacturers   manufacturerWhere[Q]
-		Models          modelWhere[Q]
-		PositionCodes   positionCodeWhere[Q]
-		Suppliers       supplierWhere[Q]
+		APITokens        apiTokenWhere[Q]
+		AssetCheckouts   assetCheckoutWhere[Q]
+		AssetEvents      assetEventWhere[Q]
+		AssetFiles       assetFileWhere[Q]
+		AssetParts       assetPartWhere[Q]
+		AssetPhotos      assetPhotoWhere[Q]
+		AssetPurchases   assetPurchaseWhere[Q]
+		Assets           assetWhere[Q]
+		AssetsFTS        assetsFTWhere[Q]
+		LocalAuthUsers   localAuthUserWhere[Q]
+		SavedSearches    savedSearchWhere[Q]
+		Sessions         sessionWhere[Q]
+		Tags             tagWhere[Q]
+		UserGrants       userGrantWhere[Q]
+		UserPreferences  userPreferenceWhere[Q]
+		Users            userWhere[Q]
+		WorkspaceMembers workspaceMemberWhere[Q]
+		Workspaces       workspaceWhere[Q]
+		Categories       categoryWhere[Q]
+		CustomAttrNames  customAttrNameWhere[Q]
+		Locations        locationWhere[Q]
+		Manufacturers    manufacturerWhere[Q]
+		Models           modelWhere[Q]
+		PositionCodes    positionCodeWhere[Q]
+		Suppliers        supplierWhere[Q]
 	}{
-		AssetFiles:      AssetFileWhere[Q](),
-		AssetParts:      AssetPartWhere[Q](),
-		AssetPurchases:  AssetPurchaseWhere[Q](),
-		Assets:          AssetWhere[Q](),
-		AssetsFTS:       AssetsFTWhere[Q](),
-		LocalAuthUsers:  LocalAuthUserWhere[Q](),
-		Sessions:        SessionWhere[Q](),
-		Tags:            TagWhere[Q](),
-		UserPreferences: UserPreferenceWhere[Q](),
-		Users:           UserWhere[Q](),
-		Categories:      CategoryWhere[Q](),
-		CustomAttrNames: CustomAttrNameWhere[Q](),
-		Locations:       LocationWhere[Q](),
-		Manufacturers:   ManufacturerWhere[Q](),
-		Models:          ModelWhere[Q](),
-		PositionCodes:   PositionCodeWhere[Q](),
-		Suppliers:       SupplierWhere[Q](),
+		APITokens:        APITokenWhere[Q](),
+		AssetCheckouts:   AssetCheckoutWhere[Q](),
+		AssetEvents:      AssetEventWhere[Q](),
+		AssetFiles:       AssetFileWhere[Q](),
+		AssetParts:       AssetPartWhere[Q](),
+		AssetPhotos:      AssetPhotoWhere[Q](),
+		AssetPurchases:   AssetPurchaseWhere[Q](),
+		Assets:           AssetWhere[Q](),
+		AssetsFTS:        AssetsFTWhere[Q](),
+		LocalAuthUsers:   LocalAuthUserWhere[Q](),
+		SavedSearches:    SavedSearchWhere[Q](),
+		Sessions:         SessionWhere[Q](),
+		Tags:             TagWhere[Q](),
+		UserGrants:       UserGrantWhere[Q](),
+		UserPreferences:  UserPreferenceWhere[Q](),
+		Users:            UserWhere[Q](),
+		WorkspaceMembers: WorkspaceMemberWhere[Q](),
+		Workspaces:       WorkspaceWhere[Q](),
+		Categories:       CategoryWhere[Q](),
+		CustomAttrNames:  CustomAttrNameWhere[Q](),
+		Locations:        LocationWhere[Q](),
+		Manufacturers:    ManufacturerWhere[Q](),
+		Models:           ModelWhere[Q](),
+		PositionCodes:    PositionCodeWhere[Q](),
+		Suppliers:        SupplierWhere[Q](),
 	}
 }
 
@@ -287,23 +432,39 @@ type joinSet[Q any] struct {
 }
 
 type joins[Q dialect.Joinable] struct {
-	AssetFiles      joinSet[assetFileRelationshipJoins[Q]]
-	AssetParts      joinSet[assetPartRelationshipJoins[Q]]
-	AssetPurchases  joinSet[assetPurchaseRelationshipJoins[Q]]
-	Assets          joinSet[assetRelationshipJoins[Q]]
-	Tags            joinSet[tagRelationshipJoins[Q]]
-	UserPreferences joinSet[userPreferenceRelationshipJoins[Q]]
-	Users           joinSet[userRelationshipJoins[Q]]
+	APITokens        joinSet[apiTokenRelationshipJoins[Q]]
+	AssetCheckouts   joinSet[assetCheckoutRelationshipJoins[Q]]
+	AssetEvents      joinSet[assetEventRelationshipJoins[Q]]
+	AssetFiles       joinSet[assetFileRelationshipJoins[Q]]
+	AssetParts       joinSet[assetPartRelationshipJoins[Q]]
+	AssetPhotos      joinSet[assetPhotoRelationshipJoins[Q]]
+	AssetPurchases   joinSet[assetPurchaseRelationshipJoins[Q]]
+	Assets           joinSet[assetRelationshipJoins[Q]]
+	SavedSearches    joinSet[savedSearchRelationshipJoins[Q]]
+	Tags             joinSet[tagRelationshipJoins[Q]]
+	UserGrants       joinSet[userGrantRelationshipJoins[Q]]
+	UserPreferences  joinSet[userPreferenceRelationshipJoins[Q]]
+	Users            joinSet[userRelationshipJoins[Q]]
+	WorkspaceMembers joinSet[workspaceMemberRelationshipJoins[Q]]
+	Workspaces       joinSet[workspaceRelationshipJoins[Q]]
 }
 
 func getJoins[Q dialect.Joinable](ctx context.Context) joins[Q] {
 	return joins[Q]{
-		AssetFiles:      assetFilesJoin[Q](ctx),
-		AssetParts:      assetPartsJoin[Q](ctx),
-		AssetPurchases:  assetPurchasesJoin[Q](ctx),
-		Assets:          assetsJoin[Q](ctx),
-		Tags:            tagsJoin[Q](ctx),
-		UserPreferences: userPreferencesJoin[Q](ctx),
-		Users:           usersJoin[Q](ctx),
+		APITokens:        apiTokensJoin[Q](ctx),
+		AssetCheckouts:   assetCheckoutsJoin[Q](ctx),
+		AssetEvents:      assetEventsJoin[Q](ctx),
+		AssetFiles:       assetFilesJoin[Q](ctx),
+		AssetParts:       assetPartsJoin[Q](ctx),
+		AssetPhotos:      assetPhotosJoin[Q](ctx),
+		AssetPurchases:   assetPurchasesJoin[Q](ctx),
+		Assets:           assetsJoin[Q](ctx),
+		SavedSearches:    savedSearchesJoin[Q](ctx),
+		Tags:             tagsJoin[Q](ctx),
+		UserGrants:       userGrantsJoin[Q](ctx),
+		UserPreferences:  userPreferencesJoin[Q](ctx),
+		Users:            usersJoin[Q](ctx),
+		WorkspaceMembers: workspaceMembersJoin[Q](ctx),
+		Workspaces:       workspacesJoin[Q](ctx),
 	}
 }
@@ -11,7 +11,8 @@ import (
 
 // Category is an object representing the database table.
 type Category struct {
-	CatName null.Val[string] `db:"cat_name" `
+	WorkspaceID null.Val[int64]  `db:"workspace_id" `
+	CatName     null.Val[string] `db:"cat_name" `
 }
 
 // CategorySlice is an alias for a slice of pointers to Category.
@@ -28,21 +29,26 @@ type CategoriesQuery = *sqlite.ViewQuery[*Category, CategorySlice]
 type CategoriesStmt = bob.QueryStmt[*Category, CategorySlice]
 
 type categoryColumnNames struct {
-	CatName string
+	WorkspaceID string
+	CatName     string
 }
 
 var CategoryColumns = struct {
-	CatName sqlite.Expression
+	WorkspaceID sqlite.Expression
+	CatName     sqlite.Expression
 }{
-	CatName: sqlite.Quote("categories", "cat_name"),
+	WorkspaceID: sqlite.Quote("categories", "workspace_id"),
+	CatName:     sqlite.Quote("categories", "cat_name"),
 }
 
 type categoryWhere[Q sqlite.Filterable] struct {
-	CatName sqlite.WhereNullMod[Q, string]
+	WorkspaceID sqlite.WhereNullMod[Q, int64]
+	CatName     sqlite.WhereNullMod[Q, string]
 }
 
 func CategoryWhere[Q sqlite.Filterable]() categoryWhere[Q] {
 	return categoryWhere[Q]{
-		CatName: sqlite.WhereNull[Q, string](CategoryColumns.CatName),
+		WorkspaceID: sqlite.WhereNull[Q, int64](CategoryColumns.WorkspaceID),
+		CatName:     sqlite.WhereNull[Q, string](CategoryColumns.CatName),
 	}
 }
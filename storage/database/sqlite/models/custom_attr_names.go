@@ -11,7 +11,8 @@ import (
 
 // CustomAttrName is an object representing the database table.
 type CustomAttrName struct {
-	AttrName null.Val[string] `db:"attr_name" `
+	WorkspaceID null.Val[int64]  `db:"workspace_id" `
+	AttrName    null.Val[string] `db:"attr_name" `
 }
 
 // CustomAttrNameSlice is an alias for a slice of pointers to CustomAttrName.
@@ -28,21 +29,26 @@ type CustomAttrNamesQuery = *sqlite.ViewQuery[*CustomAttrName, CustomAttrNameSli
 type CustomAttrNamesStmt = bob.QueryStmt[*CustomAttrName, CustomAttrNameSlice]
 
 type customAttrNameColumnNames struct {
-	AttrName string
+	WorkspaceID string
+	AttrName    string
 }
 
 var CustomAttrNameColumns = struct {
-	AttrName sqlite.Expression
+	WorkspaceID sqlite.Expression
+	AttrName    sqlite.Expression
 }{
-	AttrName: sqlite.Quote("custom_attr_names", "attr_name"),
+	WorkspaceID: sqlite.Quote("custom_attr_names", "workspace_id"),
+	AttrName:    sqlite.Quote("custom_attr_names", "attr_name"),
 }
 
 type customAttrNameWhere[Q sqlite.Filterable] struct {
-	AttrName sqlite.WhereNullMod[Q, string]
+	WorkspaceID sqlite.WhereNullMod[Q, int64]
+	AttrName    sqlite.WhereNullMod[Q, string]
 }
 
 func CustomAttrNameWhere[Q sqlite.Filterable]() customAttrNameWhere[Q] {
 	return customAttrNameWhere[Q]{
-		AttrName: sqlite.WhereNull[Q, string](CustomAttrNameColumns.AttrName),
+		WorkspaceID: sqlite.WhereNull[Q, int64](CustomAttrNameColumns.WorkspaceID),
+		AttrName:    sqlite.WhereNull[Q, string](CustomAttrNameColumns.AttrName),
 	}
 }
@@ -11,7 +11,8 @@ import (
 
 // Location is an object representing the database table.
 type Location struct {
-	LocName null.Val[string] `db:"loc_name" `
+	WorkspaceID null.Val[int64]  `db:"workspace_id" `
+	LocName     null.Val[string] `db:"loc_name" `
 }
 
 // LocationSlice is an alias for a slice of pointers to Location.
@@ -28,21 +29,26 @@ type LocationsQuery = *sqlite.ViewQuery[*Location, LocationSlice]
 type LocationsStmt = bob.QueryStmt[*Location, LocationSlice]
 
 type locationColumnNames struct {
-	LocName string
+	WorkspaceID string
+	LocName     string
 }
 
 var LocationColumns = struct {
-	LocName sqlite.Expression
+	WorkspaceID sqlite.Expression
+	LocName     sqlite.Expression
 }{
-	LocName: sqlite.Quote("locations", "loc_name"),
+	WorkspaceID: sqlite.Quote("locations", "workspace_id"),
+	LocName:     sqlite.Quote("locations", "loc_name"),
 }
 
 type locationWhere[Q sqlite.Filterable] struct {
-	LocName sqlite.WhereNullMod[Q, string]
+	WorkspaceID sqlite.WhereNullMod[Q, int64]
+	LocName     sqlite.WhereNullMod[Q, string]
 }
 
 func LocationWhere[Q sqlite.Filterable]() locationWhere[Q] {
 	return locationWhere[Q]{
-		LocName: sqlite.WhereNull[Q, string](LocationColumns.LocName),
+		WorkspaceID: sqlite.WhereNull[Q, int64](LocationColumns.WorkspaceID),
+		LocName:     sqlite.WhereNull[Q, string](LocationColumns.LocName),
 	}
 }
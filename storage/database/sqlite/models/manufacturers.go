@@ -11,6 +11,7 @@ import (
 
 // Manufacturer is an object representing the database table.
 type Manufacturer struct {
+	WorkspaceID  null.Val[int64]  `db:"workspace_id" `
 	Manufacturer null.Val[string] `db:"manufacturer" `
 }
 
@@ -28,21 +29,26 @@ type ManufacturersQuery = *sqlite.ViewQuery[*Manufacturer, ManufacturerSlice]
 type ManufacturersStmt = bob.QueryStmt[*Manufacturer, ManufacturerSlice]
 
 type manufacturerColumnNames struct {
+	WorkspaceID  string
 	Manufacturer string
 }
 
 var ManufacturerColumns = struct {
+	WorkspaceID  sqlite.Expression
 	Manufacturer sqlite.Expression
 }{
+	WorkspaceID:  sqlite.Quote("manufacturers", "workspace_id"),
 	Manufacturer: sqlite.Quote("manufacturers", "manufacturer"),
 }
 
 type manufacturerWhere[Q sqlite.Filterable] struct {
+	WorkspaceID  sqlite.WhereNullMod[Q, int64]
 	Manufacturer sqlite.WhereNullMod[Q, string]
 }
 
 func ManufacturerWhere[Q sqlite.Filterable]() manufacturerWhere[Q] {
 	return manufacturerWhere[Q]{
+		WorkspaceID:  sqlite.WhereNull[Q, int64](ManufacturerColumns.WorkspaceID),
 		Manufacturer: sqlite.WhereNull[Q, string](ManufacturerColumns.Manufacturer),
 	}
 }
@@ -11,8 +11,9 @@ import (
 
 // Model is an object representing the database table.
 type Model struct {
-	Model   null.Val[string] `db:"model" `
-	ModelNo null.Val[string] `db:"model_no" `
+	WorkspaceID null.Val[int64]  `db:"workspace_id" `
+	Model       null.Val[string] `db:"model" `
+	ModelNo     null.Val[string] `db:"model_no" `
 }
 
 // ModelSlice is an alias for a slice of pointers to Model.
@@ -29,26 +30,31 @@ type ModelsQuery = *sqlite.ViewQuery[*Model, ModelSlice]
 type ModelsStmt = bob.QueryStmt[*Model, ModelSlice]
 
 type modelColumnNames struct {
-	Model   string
-	ModelNo string
+	WorkspaceID string
+	Model       string
+	ModelNo     string
 }
 
 var ModelColumns = struct {
-	Model   sqlite.Expression
-	ModelNo sqlite.Expression
+	WorkspaceID sqlite.Expression
+	Model       sqlite.Expression
+	ModelNo     sqlite.Expression
 }{
-	Model:   sqlite.Quote("models", "model"),
-	ModelNo: sqlite.Quote("models", "model_no"),
+	WorkspaceID: sqlite.Quote("models", "workspace_id"),
+	Model:       sqlite.Quote("models", "model"),
+	ModelNo:     sqlite.Quote("models", "model_no"),
 }
 
 type modelWhere[Q sqlite.Filterable] struct {
-	Model   sqlite.WhereNullMod[Q, string]
-	ModelNo sqlite.WhereNullMod[Q, string]
+	WorkspaceID sqlite.WhereNullMod[Q, int64]
+	Model       sqlite.WhereNullMod[Q, string]
+	ModelNo     sqlite.WhereNullMod[Q, string]
 }
 
 func ModelWhere[Q sqlite.Filterable]() modelWhere[Q] {
 	return modelWhere[Q]{
-		Model:   sqlite.WhereNull[Q, string](ModelColumns.Model),
-		ModelNo: sqlite.WhereNull[Q, string](ModelColumns.ModelNo),
+		WorkspaceID: sqlite.WhereNull[Q, int64](ModelColumns.WorkspaceID),
+		Model:       sqlite.WhereNull[Q, string](ModelColumns.Model),
+		ModelNo:     sqlite.WhereNull[Q, string](ModelColumns.ModelNo),
 	}
 }
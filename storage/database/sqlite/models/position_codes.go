@@ -11,7 +11,8 @@ import (
 
 // PositionCode is an object representing the database table.
 type PositionCode struct {
-	PosCode null.Val[string] `db:"pos_code" `
+	WorkspaceID null.Val[int64]  `db:"workspace_id" `
+	PosCode     null.Val[string] `db:"pos_code" `
 }
 
 // PositionCodeSlice is an alias for a slice of pointers to PositionCode.
@@ -28,21 +29,26 @@ type PositionCodesQuery = *sqlite.ViewQuery[*PositionCode, PositionCodeSlice]
 type PositionCodesStmt = bob.QueryStmt[*PositionCode, PositionCodeSlice]
 
 type positionCodeColumnNames struct {
-	PosCode string
+	WorkspaceID string
+	PosCode     string
 }
 
 var PositionCodeColumns = struct {
-	PosCode sqlite.Expression
+	WorkspaceID sqlite.Expression
+	PosCode     sqlite.Expression
 }{
-	PosCode: sqlite.Quote("position_codes", "pos_code"),
+	WorkspaceID: sqlite.Quote("position_codes", "workspace_id"),
+	PosCode:     sqlite.Quote("position_codes", "pos_code"),
 }
 
 type positionCodeWhere[Q sqlite.Filterable] struct {
-	PosCode sqlite.WhereNullMod[Q, string]
+	WorkspaceID sqlite.WhereNullMod[Q, int64]
+	PosCode     sqlite.WhereNullMod[Q, string]
 }
 
 func PositionCodeWhere[Q sqlite.Filterable]() positionCodeWhere[Q] {
 	return positionCodeWhere[Q]{
-		PosCode: sqlite.WhereNull[Q, string](PositionCodeColumns.PosCode),
+		WorkspaceID: sqlite.WhereNull[Q, int64](PositionCodeColumns.WorkspaceID),
+		PosCode:     sqlite.WhereNull[Q, string](PositionCodeColumns.PosCode),
 	}
 }
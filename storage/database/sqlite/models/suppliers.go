@@ -11,7 +11,8 @@ import (
 
 // Supplier is an object representing the database table.
 type Supplier struct {
-	Name null.Val[string] `db:"name" `
+	WorkspaceID null.Val[int64]  `db:"workspace_id" `
+	Name        null.Val[string] `db:"name" `
 }
 
 // SupplierSlice is an alias for a slice of pointers to Supplier.
@@ -28,21 +29,26 @@ type SuppliersQuery = *sqlite.ViewQuery[*Supplier, SupplierSlice]
 type SuppliersStmt = bob.QueryStmt[*Supplier, SupplierSlice]
 
 type supplierColumnNames struct {
-	Name string
+	WorkspaceID string
+	Name        string
 }
 
 var SupplierColumns = struct {
-	Name sqlite.Expression
+	WorkspaceID sqlite.Expression
+	Name        sqlite.Expression
 }{
-	Name: sqlite.Quote("suppliers", "name"),
+	WorkspaceID: sqlite.Quote("suppliers", "workspace_id"),
+	Name:        sqlite.Quote("suppliers", "name"),
 }
 
 type supplierWhere[Q sqlite.Filterable] struct {
-	Name sqlite.WhereNullMod[Q, string]
+	WorkspaceID sqlite.WhereNullMod[Q, int64]
+	Name        sqlite.WhereNullMod[Q, string]
 }
 
 func SupplierWhere[Q sqlite.Filterable]() supplierWhere[Q] {
 	return supplierWhere[Q]{
-		Name: sqlite.WhereNull[Q, string](SupplierColumns.Name),
+		WorkspaceID: sqlite.WhereNull[Q, int64](SupplierColumns.WorkspaceID),
+		Name:        sqlite.WhereNull[Q, string](SupplierColumns.Name),
 	}
 }
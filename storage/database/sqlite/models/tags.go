@@ -24,11 +24,12 @@ import (
 
 // Tag is an object representing the database table.
 type Tag struct {
-	ID        int64                `db:"id,pk" `
-	Tag       string               `db:"tag" `
-	InUse     bool                 `db:"in_use" `
-	CreatedAt types.SQLiteDatetime `db:"created_at" `
-	UpdatedAt types.SQLiteDatetime `db:"updated_at" `
+	ID          int64                `db:"id,pk" `
+	Tag         string               `db:"tag" `
+	InUse       bool                 `db:"in_use" `
+	CreatedAt   types.SQLiteDatetime `db:"created_at" `
+	UpdatedAt   types.SQLiteDatetime `db:"updated_at" `
+	WorkspaceID int64                `db:"workspace_id" `
 
 	R tagR `db:"-" `
 }
@@ -55,15 +56,16 @@ type tagR struct {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type TagSetter struct {
-	ID        omit.Val[int64]                `db:"id,pk"`
-	Tag       omit.Val[string]               `db:"tag"`
-	InUse     omit.Val[bool]                 `db:"in_use"`
-	CreatedAt omit.Val[types.SQLiteDatetime] `db:"created_at"`
-	UpdatedAt omit.Val[types.SQLiteDatetime] `db:"updated_at"`
+	ID          omit.Val[int64]                `db:"id,pk"`
+	Tag         omit.Val[string]               `db:"tag"`
+	InUse       omit.Val[bool]                 `db:"in_use"`
+	CreatedAt   omit.Val[types.SQLiteDatetime] `db:"created_at"`
+	UpdatedAt   omit.Val[types.SQLiteDatetime] `db:"updated_at"`
+	WorkspaceID omit.Val[int64]                `db:"workspace_id"`
 }
 
 func (s TagSetter) SetColumns() []string {
-	vals := make([]string, 0, 5)
+	vals := make([]string, 0, 6)
 	if !s.ID.IsUnset() {
 		vals = append(vals, "id")
 	}
@@ -84,6 +86,10 @@ func (s TagSetter) SetColumns() []string {
 		vals = append(vals, "updated_at")
 	}
 
+	if !s.WorkspaceID.IsUnset() {
+		vals = append(vals, "workspace_id")
+	}
+
 	return vals
 }
 
@@ -103,6 +109,9 @@ func (s TagSetter) Overwrite(t *Tag) {
 	if !s.UpdatedAt.IsUnset() {
 		t.UpdatedAt, _ = s.UpdatedAt.Get()
 	}
+	if !s.WorkspaceID.IsUnset() {
+		t.WorkspaceID, _ = s.WorkspaceID.Get()
+	}
 }
 
 func (s TagSetter) Apply(q *dialect.UpdateQuery) {
@@ -121,10 +130,13 @@ func (s TagSetter) Apply(q *dialect.UpdateQuery) {
 	if !s.UpdatedAt.IsUnset() {
 		um.Set("updated_at").ToArg(s.UpdatedAt).Apply(q)
 	}
+	if !s.WorkspaceID.IsUnset() {
+		um.Set("workspace_id").ToArg(s.WorkspaceID).Apply(q)
+	}
 }
 
 func (s TagSetter) Insert() bob.Mod[*dialect.InsertQuery] {
-	vals := make([]bob.Expression, 0, 5)
+	vals := make([]bob.Expression, 0, 6)
 	if !s.ID.IsUnset() {
 		vals = append(vals, sqlite.Arg(s.ID))
 	}
@@ -145,15 +157,20 @@ func (s TagSetter) Insert() bob.Mod[*dialect.InsertQuery] {
 		vals = append(vals, sqlite.Arg(s.UpdatedAt))
 	}
 
+	if !s.WorkspaceID.IsUnset() {
+		vals = append(vals, sqlite.Arg(s.WorkspaceID))
+	}
+
 	return im.Values(vals...)
 }
 
 type tagColumnNames struct {
-	ID        string
-	Tag       string
-	InUse     string
-	CreatedAt string
-	UpdatedAt string
+	ID          string
+	Tag         string
+	InUse       string
+	CreatedAt   string
+	UpdatedAt   string
+	WorkspaceID string
 }
 
 type tagRelationshipJoins[Q dialect.Joinable] struct {
@@ -175,34 +192,38 @@ func tagsJoin[Q dialect.Joinable](ctx context.Context) joinSet[tagRelationshipJo
 }
 
 var TagColumns = struct {
-	ID        sqlite.Expression
-	Tag       sqlite.Expression
-	InUse     sqlite.Expression
-	CreatedAt sqlite.Expression
-	UpdatedAt sqlite.Expression
+	ID          sqlite.Expression
+	Tag         sqlite.Expression
+	InUse       sqlite.Expression
+	CreatedAt   sqlite.Expression
+	UpdatedAt   sqlite.Expression
+	WorkspaceID sqlite.Expression
 }{
-	ID:        sqlite.Quote("tags", "id"),
-	Tag:       sqlite.Quote("tags", "tag"),
-	InUse:     sqlite.Quote("tags", "in_use"),
-	CreatedAt: sqlite.Quote("tags", "created_at"),
-	UpdatedAt: sqlite.Quote("tags", "updated_at"),
+	ID:          sqlite.Quote("tags", "id"),
+	Tag:         sqlite.Quote("tags", "tag"),
+	InUse:       sqlite.Quote("tags", "in_use"),
+	CreatedAt:   sqlite.Quote("tags", "created_at"),
+	UpdatedAt:   sqlite.Quote("tags", "updated_at"),
+	WorkspaceID: sqlite.Quote("tags", "workspace_id"),
 }
 
 type tagWhere[Q sqlite.Filterable] struct {
-	ID        sqlite.WhereMod[Q, int64]
-	Tag       sqlite.WhereMod[Q, string]
-	InUse     sqlite.WhereMod[Q, bool]
-	CreatedAt sqlite.WhereMod[Q, types.SQLiteDatetime]
-	UpdatedAt sqlite.WhereMod[Q, types.SQLiteDatetime]
+	ID          sqlite.WhereMod[Q, int64]
+	Tag         sqlite.WhereMod[Q, string]
+	InUse       sqlite.WhereMod[Q, bool]
+	CreatedAt   sqlite.WhereMod[Q, types.SQLiteDatetime]
+	UpdatedAt   sqlite.WhereMod[Q, types.SQLiteDatetime]
+	WorkspaceID sqlite.WhereMod[Q, int64]
 }
 
 func TagWhere[Q sqlite.Filterable]() tagWhere[Q] {
 	return tagWhere[Q]{
-		ID:        sqlite.Where[Q, int64](TagColumns.ID),
-		Tag:       sqlite.Where[Q, string](TagColumns.Tag),
-		InUse:     sqlite.Where[Q, bool](TagColumns.InUse),
-		CreatedAt: sqlite.Where[Q, types.SQLiteDatetime](TagColumns.CreatedAt),
-		UpdatedAt: sqlite.Where[Q, types.SQLiteDatetime](TagColumns.UpdatedAt),
+		ID:          sqlite.Where[Q, int64](TagColumns.ID),
+		Tag:         sqlite.Where[Q, string](TagColumns.Tag),
+		InUse:       sqlite.Where[Q, bool](TagColumns.InUse),
+		CreatedAt:   sqlite.Where[Q, types.SQLiteDatetime](TagColumns.CreatedAt),
+		UpdatedAt:   sqlite.Where[Q, types.SQLiteDatetime](TagColumns.UpdatedAt),
+		WorkspaceID: sqlite.Where[Q, int64](TagColumns.WorkspaceID),
 	}
 }
 
@@ -27,10 +27,10 @@ type User struct {
 	ID          int64                `db:"id,pk" `
 	Username    string               `db:"username" `
 	DisplayName string               `db:"display_name" `
-	IsAdmin     bool                 `db:"is_admin" `
 	AuthRef     string               `db:"auth_ref" `
 	CreatedAt   types.SQLiteDatetime `db:"created_at" `
 	UpdatedAt   types.SQLiteDatetime `db:"updated_at" `
+	Role        string               `db:"role" `
 
 	R userR `db:"-" `
 }
@@ -50,12 +50,19 @@ type UsersStmt = bob.QueryStmt[*User, UserSlice]
 
 // userR is where relationships are stored.
 type userR struct {
-	CreatedByAssetFiles     AssetFileSlice      // fk_asset_files_0
-	CreatedByAssetParts     AssetPartSlice      // fk_asset_parts_0
-	CreatedByAssetPurchases AssetPurchaseSlice  // fk_asset_purchases_0
-	CreatedByAssets         AssetSlice          // fk_assets_0
-	CheckedOutToAssets      AssetSlice          // fk_assets_1
-	UserPreferences         UserPreferenceSlice // fk_user_preferences_0
+	APITokens                  APITokenSlice        // fk_api_tokens_0
+	CreatedByAssetCheckouts    AssetCheckoutSlice   // fk_asset_checkouts_0
+	CheckedOutToAssetCheckouts AssetCheckoutSlice   // fk_asset_checkouts_1
+	AssetEvents                AssetEventSlice      // fk_asset_events_0
+	CreatedByAssetFiles        AssetFileSlice       // fk_asset_files_0
+	CreatedByAssetParts        AssetPartSlice       // fk_asset_parts_0
+	CreatedByAssetPurchases    AssetPurchaseSlice   // fk_asset_purchases_0
+	CreatedByAssets            AssetSlice           // fk_assets_0
+	CheckedOutToAssets         AssetSlice           // fk_assets_1
+	UserGrants                 UserGrantSlice       // fk_user_grants_0
+	UserPreferences            UserPreferenceSlice  // fk_user_preferences_0
+	SavedSearches              SavedSearchSlice     // fk_saved_searches_0
+	WorkspaceMembers           WorkspaceMemberSlice // fk_workspace_members_0
 }
 
 // UserSetter is used for insert/upsert/update operations
@@ -65,10 +72,10 @@ type UserSetter struct {
 	ID          omit.Val[int64]                `db:"id,pk"`
 	Username    omit.Val[string]               `db:"username"`
 	DisplayName omit.Val[string]               `db:"display_name"`
-	IsAdmin     omit.Val[bool]                 `db:"is_admin"`
 	AuthRef     omit.Val[string]               `db:"auth_ref"`
 	CreatedAt   omit.Val[types.SQLiteDatetime] `db:"created_at"`
 	UpdatedAt   omit.Val[types.SQLiteDatetime] `db:"updated_at"`
+	Role        omit.Val[string]               `db:"role"`
 }
 
 func (s UserSetter) SetColumns() []string {
@@ -85,10 +92,6 @@ func (s UserSetter) SetColumns() []string {
 		vals = append(vals, "display_name")
 	}
 
-	if !s.IsAdmin.IsUnset() {
-		vals = append(vals, "is_admin")
-	}
-
 	if !s.AuthRef.IsUnset() {
 		vals = append(vals, "auth_ref")
 	}
@@ -101,6 +104,10 @@ func (s UserSetter) SetColumns() []string {
 		vals = append(vals, "updated_at")
 	}
 
+	if !s.Role.IsUnset() {
+		vals = append(vals, "role")
+	}
+
 	return vals
 }
 
@@ -114,9 +121,6 @@ func (s UserSetter) Overwrite(t *User) {
 	if !s.DisplayName.IsUnset() {
 		t.DisplayName, _ = s.DisplayName.Get()
 	}
-	if !s.IsAdmin.IsUnset() {
-		t.IsAdmin, _ = s.IsAdmin.Get()
-	}
 	if !s.AuthRef.IsUnset() {
 		t.AuthRef, _ = s.AuthRef.Get()
 	}
@@ -126,6 +130,9 @@ func (s UserSetter) Overwrite(t *User) {
 	if !s.UpdatedAt.IsUnset() {
 		t.UpdatedAt, _ = s.UpdatedAt.Get()
 	}
+	if !s.Role.IsUnset() {
+		t.Role, _ = s.Role.Get()
+	}
 }
 
 func (s UserSetter) Apply(q *dialect.UpdateQuery) {
@@ -138,9 +145,6 @@ func (s UserSetter) Apply(q *dialect.UpdateQuery) {
 	if !s.DisplayName.IsUnset() {
 		um.Set("display_name").ToArg(s.DisplayName).Apply(q)
 	}
-	if !s.IsAdmin.IsUnset() {
-		um.Set("is_admin").ToArg(s.IsAdmin).Apply(q)
-	}
 	if !s.AuthRef.IsUnset() {
 		um.Set("auth_ref").ToArg(s.AuthRef).Apply(q)
 	}
@@ -150,6 +154,9 @@ func (s UserSetter) Apply(q *dialect.UpdateQuery) {
 	if !s.UpdatedAt.IsUnset() {
 		um.Set("updated_at").ToArg(s.UpdatedAt).Apply(q)
 	}
+	if !s.Role.IsUnset() {
+		um.Set("role").ToArg(s.Role).Apply(q)
+	}
 }
 
 func (s UserSetter) Insert() bob.Mod[*dialect.InsertQuery] {
@@ -166,10 +173,6 @@ func (s UserSetter) Insert() bob.Mod[*dialect.InsertQuery] {
 		vals = append(vals, sqlite.Arg(s.DisplayName))
 	}
 
-	if !s.IsAdmin.IsUnset() {
-		vals = append(vals, sqlite.Arg(s.IsAdmin))
-	}
-
 	if !s.AuthRef.IsUnset() {
 		vals = append(vals, sqlite.Arg(s.AuthRef))
 	}
@@ -182,6 +185,10 @@ func (s UserSetter) Insert() bob.Mod[*dialect.InsertQuery] {
 		vals = append(vals, sqlite.Arg(s.UpdatedAt))
 	}
 
+	if !s.Role.IsUnset() {
+		vals = append(vals, sqlite.Arg(s.Role))
+	}
+
 	return im.Values(vals...)
 }
 
@@ -189,29 +196,43 @@ type userColumnNames struct {
 	ID          string
 	Username    string
 	DisplayName string
-	IsAdmin     string
 	AuthRef     string
 	CreatedAt   string
 	UpdatedAt   string
+	Role        string
 }
 
 type userRelationshipJoins[Q dialect.Joinable] struct {
-	CreatedByAssetFiles     bob.Mod[Q]
-	CreatedByAssetParts     bob.Mod[Q]
-	CreatedByAssetPurchases bob.Mod[Q]
-	CreatedByAssets         bob.Mod[Q]
-	CheckedOutToAssets      bob.Mod[Q]
-	UserPreferences         bob.Mod[Q]
+	APITokens                  bob.Mod[Q]
+	CreatedByAssetCheckouts    bob.Mod[Q]
+	CheckedOutToAssetCheckouts bob.Mod[Q]
+	AssetEvents                bob.Mod[Q]
+	CreatedByAssetFiles        bob.Mod[Q]
+	CreatedByAssetParts        bob.Mod[Q]
+	CreatedByAssetPurchases    bob.Mod[Q]
+	CreatedByAssets            bob.Mod[Q]
+	CheckedOutToAssets         bob.Mod[Q]
+	UserGrants                 bob.Mod[Q]
+	UserPreferences            bob.Mod[Q]
+	SavedSearches              bob.Mod[Q]
+	WorkspaceMembers           bob.Mod[Q]
 }
 
 func builduserRelationshipJoins[Q dialect.Joinable](ctx context.Context, typ string) userRelationshipJoins[Q] {
 	return userRelationshipJoins[Q]{
-		CreatedByAssetFiles:     usersJoinCreatedByAssetFiles[Q](ctx, typ),
-		CreatedByAssetParts:     usersJoinCreatedByAssetParts[Q](ctx, typ),
-		CreatedByAssetPurchases: usersJoinCreatedByAssetPurchases[Q](ctx, typ),
-		CreatedByAssets:         usersJoinCreatedByAssets[Q](ctx, typ),
-		CheckedOutToAssets:      usersJoinCheckedOutToAssets[Q](ctx, typ),
-		UserPreferences:         usersJoinUserPreferences[Q](ctx, typ),
+		APITokens:                  usersJoinAPITokens[Q](ctx, typ),
+		CreatedByAssetCheckouts:    usersJoinCreatedByAssetCheckouts[Q](ctx, typ),
+		CheckedOutToAssetCheckouts: usersJoinCheckedOutToAssetCheckouts[Q](ctx, typ),
+		AssetEvents:                usersJoinAssetEvents[Q](ctx, typ),
+		CreatedByAssetFiles:        usersJoinCreatedByAssetFiles[Q](ctx, typ),
+		CreatedByAssetParts:        usersJoinCreatedByAssetParts[Q](ctx, typ),
+		CreatedByAssetPurchases:    usersJoinCreatedByAssetPurchases[Q](ctx, typ),
+		CreatedByAssets:            usersJoinCreatedByAssets[Q](ctx, typ),
+		CheckedOutToAssets:         usersJoinCheckedOutToAssets[Q](ctx, typ),
+		UserGrants:                 usersJoinUserGrants[Q](ctx, typ),
+		UserPreferences:            usersJoinUserPreferences[Q](ctx, typ),
+		SavedSearches:              usersJoinSavedSearches[Q](ctx, typ),
+		WorkspaceMembers:           usersJoinWorkspaceMembers[Q](ctx, typ),
 	}
 }
 
@@ -227,28 +248,28 @@ var UserColumns = struct {
 	ID          sqlite.Expression
 	Username    sqlite.Expression
 	DisplayName sqlite.Expression
-	IsAdmin     sqlite.Expression
 	AuthRef     sqlite.Expression
 	CreatedAt   sqlite.Expression
 	UpdatedAt   sqlite.Expression
+	Role        sqlite.Expression
 }{
 	ID:          sqlite.Quote("users", "id"),
 	Username:    sqlite.Quote("users", "username"),
 	DisplayName: sqlite.Quote("users", "display_name"),
-	IsAdmin:     sqlite.Quote("users", "is_admin"),
 	AuthRef:     sqlite.Quote("users", "auth_ref"),
 	CreatedAt:   sqlite.Quote("users", "created_at"),
 	UpdatedAt:   sqlite.Quote("users", "updated_at"),
+	Role:        sqlite.Quote("users", "role"),
 }
 
 type userWhere[Q sqlite.Filterable] struct {
 	ID          sqlite.WhereMod[Q, int64]
 	Username    sqlite.WhereMod[Q, string]
 	DisplayName sqlite.WhereMod[Q, string]
-	IsAdmin     sqlite.WhereMod[Q, bool]
 	AuthRef     sqlite.WhereMod[Q, string]
 	CreatedAt   sqlite.WhereMod[Q, types.SQLiteDatetime]
 	UpdatedAt   sqlite.WhereMod[Q, types.SQLiteDatetime]
+	Role        sqlite.WhereMod[Q, string]
 }
 
 func UserWhere[Q sqlite.Filterable]() userWhere[Q] {
@@ -256,10 +277,10 @@ func UserWhere[Q sqlite.Filterable]() userWhere[Q] {
 		ID:          sqlite.Where[Q, int64](UserColumns.ID),
 		Username:    sqlite.Where[Q, string](UserColumns.Username),
 		DisplayName: sqlite.Where[Q, string](UserColumns.DisplayName),
-		IsAdmin:     sqlite.Where[Q, bool](UserColumns.IsAdmin),
 		AuthRef:     sqlite.Where[Q, string](UserColumns.AuthRef),
 		CreatedAt:   sqlite.Where[Q, types.SQLiteDatetime](UserColumns.CreatedAt),
 		UpdatedAt:   sqlite.Where[Q, types.SQLiteDatetime](UserColumns.UpdatedAt),
+		Role:        sqlite.Where[Q, string](UserColumns.Role),
 	}
 }
 
@@ -358,6 +379,34 @@ func (o UserSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
+func usersJoinAPITokens[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
+	return mods.QueryMods[Q]{
+		dialect.Join[Q](typ, APITokens.Name(ctx)).On(
+			APITokenColumns.UserID.EQ(UserColumns.ID),
+		),
+	}
+}
+func usersJoinCreatedByAssetCheckouts[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
+	return mods.QueryMods[Q]{
+		dialect.Join[Q](typ, AssetCheckouts.Name(ctx)).On(
+			AssetCheckoutColumns.CreatedBy.EQ(UserColumns.ID),
+		),
+	}
+}
+func usersJoinCheckedOutToAssetCheckouts[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
+	return mods.QueryMods[Q]{
+		dialect.Join[Q](typ, AssetCheckouts.Name(ctx)).On(
+			AssetCheckoutColumns.CheckedOutTo.EQ(UserColumns.ID),
+		),
+	}
+}
+func usersJoinAssetEvents[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
+	return mods.QueryMods[Q]{
+		dialect.Join[Q](typ, AssetEvents.Name(ctx)).On(
+			AssetEventColumns.UserID.EQ(UserColumns.ID),
+		),
+	}
+}
 func usersJoinCreatedByAssetFiles[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
 	return mods.QueryMods[Q]{
 		dialect.Join[Q](typ, AssetFiles.Name(ctx)).On(
@@ -393,6 +442,13 @@ func usersJoinCheckedOutToAssets[Q dialect.Joinable](ctx context.Context, typ st
 		),
 	}
 }
+func usersJoinUserGrants[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
+	return mods.QueryMods[Q]{
+		dialect.Join[Q](typ, UserGrants.Name(ctx)).On(
+			UserGrantColumns.UserID.EQ(UserColumns.ID),
+		),
+	}
+}
 func usersJoinUserPreferences[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
 	return mods.QueryMods[Q]{
 		dialect.Join[Q](typ, UserPreferences.Name(ctx)).On(
@@ -400,6 +456,93 @@ func usersJoinUserPreferences[Q dialect.Joinable](ctx context.Context, typ strin
 		),
 	}
 }
+func usersJoinSavedSearches[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
+	return mods.QueryMods[Q]{
+		dialect.Join[Q](typ, SavedSearches.Name(ctx)).On(
+			SavedSearchColumns.UserID.EQ(UserColumns.ID),
+		),
+	}
+}
+
+func usersJoinWorkspaceMembers[Q dialect.Joinable](ctx context.Context, typ string) bob.Mod[Q] {
+	return mods.QueryMods[Q]{
+		dialect.Join[Q](typ, WorkspaceMembers.Name(ctx)).On(
+			WorkspaceMemberColumns.UserID.EQ(UserColumns.ID),
+		),
+	}
+}
+
+// APITokens starts a query for related objects on api_tokens
+func (o *User) APITokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) APITokensQuery {
+	return APITokens.Query(ctx, exec, append(mods,
+		sm.Where(APITokenColumns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) APITokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) APITokensQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = sqlite.ArgGroup(o.ID)
+	}
+
+	return APITokens.Query(ctx, exec, append(mods,
+		sm.Where(sqlite.Group(APITokenColumns.UserID).In(PKArgs...)),
+	)...)
+}
+
+// CreatedByAssetCheckouts starts a query for related objects on asset_checkouts
+func (o *User) CreatedByAssetCheckouts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetCheckoutsQuery {
+	return AssetCheckouts.Query(ctx, exec, append(mods,
+		sm.Where(AssetCheckoutColumns.CreatedBy.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) CreatedByAssetCheckouts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetCheckoutsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = sqlite.ArgGroup(o.ID)
+	}
+
+	return AssetCheckouts.Query(ctx, exec, append(mods,
+		sm.Where(sqlite.Group(AssetCheckoutColumns.CreatedBy).In(PKArgs...)),
+	)...)
+}
+
+// CheckedOutToAssetCheckouts starts a query for related objects on asset_checkouts
+func (o *User) CheckedOutToAssetCheckouts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetCheckoutsQuery {
+	return AssetCheckouts.Query(ctx, exec, append(mods,
+		sm.Where(AssetCheckoutColumns.CheckedOutTo.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) CheckedOutToAssetCheckouts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetCheckoutsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = sqlite.ArgGroup(o.ID)
+	}
+
+	return AssetCheckouts.Query(ctx, exec, append(mods,
+		sm.Where(sqlite.Group(AssetCheckoutColumns.CheckedOutTo).In(PKArgs...)),
+	)...)
+}
+
+// AssetEvents starts a query for related objects on asset_events
+func (o *User) AssetEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetEventsQuery {
+	return AssetEvents.Query(ctx, exec, append(mods,
+		sm.Where(AssetEventColumns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) AssetEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetEventsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = sqlite.ArgGroup(o.ID)
+	}
+
+	return AssetEvents.Query(ctx, exec, append(mods,
+		sm.Where(sqlite.Group(AssetEventColumns.UserID).In(PKArgs...)),
+	)...)
+}
 
 // CreatedByAssetFiles starts a query for related objects on asset_files
 func (o *User) CreatedByAssetFiles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) AssetFilesQuery {
@@ -491,6 +634,24 @@ func (os UserSlice) CheckedOutToAssets(ctx context.Context, exec bob.Executor, m
 	)...)
 }
 
+// UserGrants starts a query for related objects on user_grants
+func (o *User) UserGrants(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) UserGrantsQuery {
+	return UserGrants.Query(ctx, exec, append(mods,
+		sm.Where(UserGrantColumns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) UserGrants(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) UserGrantsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = sqlite.ArgGroup(o.ID)
+	}
+
+	return UserGrants.Query(ctx, exec, append(mods,
+		sm.Where(sqlite.Group(UserGrantColumns.UserID).In(PKArgs...)),
+	)...)
+}
+
 // UserPreferences starts a query for related objects on user_preferences
 func (o *User) UserPreferences(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) UserPreferencesQuery {
 	return UserPreferences.Query(ctx, exec, append(mods,
@@ -509,12 +670,84 @@ func (os UserSlice) UserPreferences(ctx context.Context, exec bob.Executor, mods
 	)...)
 }
 
+// SavedSearches starts a query for related objects on saved_searches
+func (o *User) SavedSearches(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) SavedSearchesQuery {
+	return SavedSearches.Query(ctx, exec, append(mods,
+		sm.Where(SavedSearchColumns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+// WorkspaceMembers starts a query for related objects on workspace_members
+func (o *User) WorkspaceMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) WorkspaceMembersQuery {
+	return WorkspaceMembers.Query(ctx, exec, append(mods,
+		sm.Where(WorkspaceMemberColumns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) SavedSearches(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) SavedSearchesQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = sqlite.ArgGroup(o.ID)
+	}
+
+	return SavedSearches.Query(ctx, exec, append(mods,
+		sm.Where(sqlite.Group(SavedSearchColumns.UserID).In(PKArgs...)),
+	)...)
+}
+
+func (os UserSlice) WorkspaceMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) WorkspaceMembersQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = sqlite.ArgGroup(o.ID)
+	}
+
+	return WorkspaceMembers.Query(ctx, exec, append(mods,
+		sm.Where(sqlite.Group(WorkspaceMemberColumns.UserID).In(PKArgs...)),
+	)...)
+}
+
 func (o *User) Preload(name string, retrieved any) error {
 	if o == nil {
 		return nil
 	}
 
 	switch name {
+	case "APITokens":
+		rels, ok := retrieved.(APITokenSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.APITokens = rels
+
+		return nil
+	case "CreatedByAssetCheckouts":
+		rels, ok := retrieved.(AssetCheckoutSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.CreatedByAssetCheckouts = rels
+
+		return nil
+	case "CheckedOutToAssetCheckouts":
+		rels, ok := retrieved.(AssetCheckoutSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.CheckedOutToAssetCheckouts = rels
+
+		return nil
+	case "AssetEvents":
+		rels, ok := retrieved.(AssetEventSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AssetEvents = rels
+
+		return nil
 	case "CreatedByAssetFiles":
 		rels, ok := retrieved.(AssetFileSlice)
 		if !ok {
@@ -559,6 +792,15 @@ func (o *User) Preload(name string, retrieved any) error {
 
 		o.R.CheckedOutToAssets = rels
 
+		return nil
+	case "UserGrants":
+		rels, ok := retrieved.(UserGrantSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UserGrants = rels
+
 		return nil
 	case "UserPreferences":
 		rels, ok := retrieved.(UserPreferenceSlice)
@@ -568,22 +810,40 @@ func (o *User) Preload(name string, retrieved any) error {
 
 		o.R.UserPreferences = rels
 
+		return nil
+	case "SavedSearches":
+		rels, ok := retrieved.(SavedSearchSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.SavedSearches = rels
+
+		return nil
+	case "WorkspaceMembers":
+		rels, ok := retrieved.(WorkspaceMemberSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.WorkspaceMembers = rels
+
 		return nil
 	default:
 		return fmt.Errorf("user has no relationship %q", name)
 	}
 }
 
-func ThenLoadUserCreatedByAssetFiles(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+func ThenLoadUserAPITokens(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
 	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
-			LoadUserCreatedByAssetFiles(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+			LoadUserAPITokens(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 		})
 		if !isLoader {
-			return fmt.Errorf("object %T cannot load UserCreatedByAssetFiles", retrieved)
+			return fmt.Errorf("object %T cannot load UserAPITokens", retrieved)
 		}
 
-		err := loader.LoadUserCreatedByAssetFiles(ctx, exec, queryMods...)
+		err := loader.LoadUserAPITokens(ctx, exec, queryMods...)
 
 		// Don't cause an issue due to missing relationships
 		if errors.Is(err, sql.ErrNoRows) {
@@ -594,62 +854,62 @@ func ThenLoadUserCreatedByAssetFiles(queryMods ...bob.Mod[*dialect.SelectQuery])
 	})
 }
 
-// LoadUserCreatedByAssetFiles loads the user's CreatedByAssetFiles into the .R struct
-func (o *User) LoadUserCreatedByAssetFiles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserAPITokens loads the user's APITokens into the .R struct
+func (o *User) LoadUserAPITokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.CreatedByAssetFiles = nil
+	o.R.APITokens = nil
 
-	related, err := o.CreatedByAssetFiles(ctx, exec, mods...).All()
+	related, err := o.APITokens(ctx, exec, mods...).All()
 	if err != nil {
 		return err
 	}
 
-	o.R.CreatedByAssetFiles = related
+	o.R.APITokens = related
 	return nil
 }
 
-// LoadUserCreatedByAssetFiles loads the user's CreatedByAssetFiles into the .R struct
-func (os UserSlice) LoadUserCreatedByAssetFiles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserAPITokens loads the user's APITokens into the .R struct
+func (os UserSlice) LoadUserAPITokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	assetFiles, err := os.CreatedByAssetFiles(ctx, exec, mods...).All()
+	apiTokens, err := os.APITokens(ctx, exec, mods...).All()
 	if err != nil {
 		return err
 	}
 
 	for _, o := range os {
-		o.R.CreatedByAssetFiles = nil
+		o.R.APITokens = nil
 	}
 
 	for _, o := range os {
-		for _, rel := range assetFiles {
-			if o.ID != rel.CreatedBy {
+		for _, rel := range apiTokens {
+			if o.ID != rel.UserID {
 				continue
 			}
 
-			o.R.CreatedByAssetFiles = append(o.R.CreatedByAssetFiles, rel)
+			o.R.APITokens = append(o.R.APITokens, rel)
 		}
 	}
 
 	return nil
 }
 
-func ThenLoadUserCreatedByAssetParts(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+func ThenLoadUserCreatedByAssetCheckouts(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
 	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
-			LoadUserCreatedByAssetParts(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+			LoadUserCreatedByAssetCheckouts(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 		})
 		if !isLoader {
-			return fmt.Errorf("object %T cannot load UserCreatedByAssetParts", retrieved)
+			return fmt.Errorf("object %T cannot load UserCreatedByAssetCheckouts", retrieved)
 		}
 
-		err := loader.LoadUserCreatedByAssetParts(ctx, exec, queryMods...)
+		err := loader.LoadUserCreatedByAssetCheckouts(ctx, exec, queryMods...)
 
 		// Don't cause an issue due to missing relationships
 		if errors.Is(err, sql.ErrNoRows) {
@@ -660,62 +920,62 @@ func ThenLoadUserCreatedByAssetParts(queryMods ...bob.Mod[*dialect.SelectQuery])
 	})
 }
 
-// LoadUserCreatedByAssetParts loads the user's CreatedByAssetParts into the .R struct
-func (o *User) LoadUserCreatedByAssetParts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserCreatedByAssetCheckouts loads the user's CreatedByAssetCheckouts into the .R struct
+func (o *User) LoadUserCreatedByAssetCheckouts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.CreatedByAssetParts = nil
+	o.R.CreatedByAssetCheckouts = nil
 
-	related, err := o.CreatedByAssetParts(ctx, exec, mods...).All()
+	related, err := o.CreatedByAssetCheckouts(ctx, exec, mods...).All()
 	if err != nil {
 		return err
 	}
 
-	o.R.CreatedByAssetParts = related
+	o.R.CreatedByAssetCheckouts = related
 	return nil
 }
 
-// LoadUserCreatedByAssetParts loads the user's CreatedByAssetParts into the .R struct
-func (os UserSlice) LoadUserCreatedByAssetParts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserCreatedByAssetCheckouts loads the user's CreatedByAssetCheckouts into the .R struct
+func (os UserSlice) LoadUserCreatedByAssetCheckouts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	assetParts, err := os.CreatedByAssetParts(ctx, exec, mods...).All()
+	assetCheckouts, err := os.CreatedByAssetCheckouts(ctx, exec, mods...).All()
 	if err != nil {
 		return err
 	}
 
 	for _, o := range os {
-		o.R.CreatedByAssetParts = nil
+		o.R.CreatedByAssetCheckouts = nil
 	}
 
 	for _, o := range os {
-		for _, rel := range assetParts {
+		for _, rel := range assetCheckouts {
 			if o.ID != rel.CreatedBy {
 				continue
 			}
 
-			o.R.CreatedByAssetParts = append(o.R.CreatedByAssetParts, rel)
+			o.R.CreatedByAssetCheckouts = append(o.R.CreatedByAssetCheckouts, rel)
 		}
 	}
 
 	return nil
 }
 
-func ThenLoadUserCreatedByAssetPurchases(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+func ThenLoadUserCheckedOutToAssetCheckouts(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
 	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
-			LoadUserCreatedByAssetPurchases(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+			LoadUserCheckedOutToAssetCheckouts(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 		})
 		if !isLoader {
-			return fmt.Errorf("object %T cannot load UserCreatedByAssetPurchases", retrieved)
+			return fmt.Errorf("object %T cannot load UserCheckedOutToAssetCheckouts", retrieved)
 		}
 
-		err := loader.LoadUserCreatedByAssetPurchases(ctx, exec, queryMods...)
+		err := loader.LoadUserCheckedOutToAssetCheckouts(ctx, exec, queryMods...)
 
 		// Don't cause an issue due to missing relationships
 		if errors.Is(err, sql.ErrNoRows) {
@@ -726,62 +986,62 @@ func ThenLoadUserCreatedByAssetPurchases(queryMods ...bob.Mod[*dialect.SelectQue
 	})
 }
 
-// LoadUserCreatedByAssetPurchases loads the user's CreatedByAssetPurchases into the .R struct
-func (o *User) LoadUserCreatedByAssetPurchases(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserCheckedOutToAssetCheckouts loads the user's CheckedOutToAssetCheckouts into the .R struct
+func (o *User) LoadUserCheckedOutToAssetCheckouts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.CreatedByAssetPurchases = nil
+	o.R.CheckedOutToAssetCheckouts = nil
 
-	related, err := o.CreatedByAssetPurchases(ctx, exec, mods...).All()
+	related, err := o.CheckedOutToAssetCheckouts(ctx, exec, mods...).All()
 	if err != nil {
 		return err
 	}
 
-	o.R.CreatedByAssetPurchases = related
+	o.R.CheckedOutToAssetCheckouts = related
 	return nil
 }
 
-// LoadUserCreatedByAssetPurchases loads the user's CreatedByAssetPurchases into the .R struct
-func (os UserSlice) LoadUserCreatedByAssetPurchases(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserCheckedOutToAssetCheckouts loads the user's CheckedOutToAssetCheckouts into the .R struct
+func (os UserSlice) LoadUserCheckedOutToAssetCheckouts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	assetPurchases, err := os.CreatedByAssetPurchases(ctx, exec, mods...).All()
+	assetCheckouts, err := os.CheckedOutToAssetCheckouts(ctx, exec, mods...).All()
 	if err != nil {
 		return err
 	}
 
 	for _, o := range os {
-		o.R.CreatedByAssetPurchases = nil
+		o.R.CheckedOutToAssetCheckouts = nil
 	}
 
 	for _, o := range os {
-		for _, rel := range assetPurchases {
-			if o.ID != rel.CreatedBy {
+		for _, rel := range assetCheckouts {
+			if o.ID != rel.CheckedOutTo {
 				continue
 			}
 
-			o.R.CreatedByAssetPurchases = append(o.R.CreatedByAssetPurchases, rel)
+			o.R.CheckedOutToAssetCheckouts = append(o.R.CheckedOutToAssetCheckouts, rel)
 		}
 	}
 
 	return nil
 }
 
-func ThenLoadUserCreatedByAssets(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+func ThenLoadUserAssetEvents(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
 	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
-			LoadUserCreatedByAssets(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+			LoadUserAssetEvents(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 		})
 		if !isLoader {
-			return fmt.Errorf("object %T cannot load UserCreatedByAssets", retrieved)
+			return fmt.Errorf("object %T cannot load UserAssetEvents", retrieved)
 		}
 
-		err := loader.LoadUserCreatedByAssets(ctx, exec, queryMods...)
+		err := loader.LoadUserAssetEvents(ctx, exec, queryMods...)
 
 		// Don't cause an issue due to missing relationships
 		if errors.Is(err, sql.ErrNoRows) {
@@ -792,62 +1052,62 @@ func ThenLoadUserCreatedByAssets(queryMods ...bob.Mod[*dialect.SelectQuery]) sql
 	})
 }
 
-// LoadUserCreatedByAssets loads the user's CreatedByAssets into the .R struct
-func (o *User) LoadUserCreatedByAssets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserAssetEvents loads the user's AssetEvents into the .R struct
+func (o *User) LoadUserAssetEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.CreatedByAssets = nil
+	o.R.AssetEvents = nil
 
-	related, err := o.CreatedByAssets(ctx, exec, mods...).All()
+	related, err := o.AssetEvents(ctx, exec, mods...).All()
 	if err != nil {
 		return err
 	}
 
-	o.R.CreatedByAssets = related
+	o.R.AssetEvents = related
 	return nil
 }
 
-// LoadUserCreatedByAssets loads the user's CreatedByAssets into the .R struct
-func (os UserSlice) LoadUserCreatedByAssets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserAssetEvents loads the user's AssetEvents into the .R struct
+func (os UserSlice) LoadUserAssetEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	assets, err := os.CreatedByAssets(ctx, exec, mods...).All()
+	assetEvents, err := os.AssetEvents(ctx, exec, mods...).All()
 	if err != nil {
 		return err
 	}
 
 	for _, o := range os {
-		o.R.CreatedByAssets = nil
+		o.R.AssetEvents = nil
 	}
 
 	for _, o := range os {
-		for _, rel := range assets {
-			if o.ID != rel.CreatedBy {
+		for _, rel := range assetEvents {
+			if o.ID != rel.UserID.GetOrZero() {
 				continue
 			}
 
-			o.R.CreatedByAssets = append(o.R.CreatedByAssets, rel)
+			o.R.AssetEvents = append(o.R.AssetEvents, rel)
 		}
 	}
 
 	return nil
 }
 
-func ThenLoadUserCheckedOutToAssets(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+func ThenLoadUserCreatedByAssetFiles(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
 	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
-			LoadUserCheckedOutToAssets(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+			LoadUserCreatedByAssetFiles(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 		})
 		if !isLoader {
-			return fmt.Errorf("object %T cannot load UserCheckedOutToAssets", retrieved)
+			return fmt.Errorf("object %T cannot load UserCreatedByAssetFiles", retrieved)
 		}
 
-		err := loader.LoadUserCheckedOutToAssets(ctx, exec, queryMods...)
+		err := loader.LoadUserCreatedByAssetFiles(ctx, exec, queryMods...)
 
 		// Don't cause an issue due to missing relationships
 		if errors.Is(err, sql.ErrNoRows) {
@@ -858,46 +1118,376 @@ func ThenLoadUserCheckedOutToAssets(queryMods ...bob.Mod[*dialect.SelectQuery])
 	})
 }
 
-// LoadUserCheckedOutToAssets loads the user's CheckedOutToAssets into the .R struct
-func (o *User) LoadUserCheckedOutToAssets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserCreatedByAssetFiles loads the user's CreatedByAssetFiles into the .R struct
+func (o *User) LoadUserCreatedByAssetFiles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.CheckedOutToAssets = nil
+	o.R.CreatedByAssetFiles = nil
 
-	related, err := o.CheckedOutToAssets(ctx, exec, mods...).All()
+	related, err := o.CreatedByAssetFiles(ctx, exec, mods...).All()
 	if err != nil {
 		return err
 	}
 
-	o.R.CheckedOutToAssets = related
+	o.R.CreatedByAssetFiles = related
 	return nil
 }
 
-// LoadUserCheckedOutToAssets loads the user's CheckedOutToAssets into the .R struct
-func (os UserSlice) LoadUserCheckedOutToAssets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserCreatedByAssetFiles loads the user's CreatedByAssetFiles into the .R struct
+func (os UserSlice) LoadUserCreatedByAssetFiles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	assets, err := os.CheckedOutToAssets(ctx, exec, mods...).All()
+	assetFiles, err := os.CreatedByAssetFiles(ctx, exec, mods...).All()
 	if err != nil {
 		return err
 	}
 
 	for _, o := range os {
-		o.R.CheckedOutToAssets = nil
+		o.R.CreatedByAssetFiles = nil
 	}
 
 	for _, o := range os {
-		for _, rel := range assets {
-			if o.ID != rel.CheckedOutTo.GetOrZero() {
+		for _, rel := range assetFiles {
+			if o.ID != rel.CreatedBy {
 				continue
 			}
 
-			o.R.CheckedOutToAssets = append(o.R.CheckedOutToAssets, rel)
+			o.R.CreatedByAssetFiles = append(o.R.CreatedByAssetFiles, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserCreatedByAssetParts(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserCreatedByAssetParts(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserCreatedByAssetParts", retrieved)
+		}
+
+		err := loader.LoadUserCreatedByAssetParts(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserCreatedByAssetParts loads the user's CreatedByAssetParts into the .R struct
+func (o *User) LoadUserCreatedByAssetParts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.CreatedByAssetParts = nil
+
+	related, err := o.CreatedByAssetParts(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	o.R.CreatedByAssetParts = related
+	return nil
+}
+
+// LoadUserCreatedByAssetParts loads the user's CreatedByAssetParts into the .R struct
+func (os UserSlice) LoadUserCreatedByAssetParts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	assetParts, err := os.CreatedByAssetParts(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.CreatedByAssetParts = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range assetParts {
+			if o.ID != rel.CreatedBy {
+				continue
+			}
+
+			o.R.CreatedByAssetParts = append(o.R.CreatedByAssetParts, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserCreatedByAssetPurchases(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserCreatedByAssetPurchases(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserCreatedByAssetPurchases", retrieved)
+		}
+
+		err := loader.LoadUserCreatedByAssetPurchases(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserCreatedByAssetPurchases loads the user's CreatedByAssetPurchases into the .R struct
+func (o *User) LoadUserCreatedByAssetPurchases(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.CreatedByAssetPurchases = nil
+
+	related, err := o.CreatedByAssetPurchases(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	o.R.CreatedByAssetPurchases = related
+	return nil
+}
+
+// LoadUserCreatedByAssetPurchases loads the user's CreatedByAssetPurchases into the .R struct
+func (os UserSlice) LoadUserCreatedByAssetPurchases(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	assetPurchases, err := os.CreatedByAssetPurchases(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.CreatedByAssetPurchases = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range assetPurchases {
+			if o.ID != rel.CreatedBy {
+				continue
+			}
+
+			o.R.CreatedByAssetPurchases = append(o.R.CreatedByAssetPurchases, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserCreatedByAssets(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserCreatedByAssets(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserCreatedByAssets", retrieved)
+		}
+
+		err := loader.LoadUserCreatedByAssets(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserCreatedByAssets loads the user's CreatedByAssets into the .R struct
+func (o *User) LoadUserCreatedByAssets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.CreatedByAssets = nil
+
+	related, err := o.CreatedByAssets(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	o.R.CreatedByAssets = related
+	return nil
+}
+
+// LoadUserCreatedByAssets loads the user's CreatedByAssets into the .R struct
+func (os UserSlice) LoadUserCreatedByAssets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	assets, err := os.CreatedByAssets(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.CreatedByAssets = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range assets {
+			if o.ID != rel.CreatedBy {
+				continue
+			}
+
+			o.R.CreatedByAssets = append(o.R.CreatedByAssets, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserCheckedOutToAssets(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserCheckedOutToAssets(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserCheckedOutToAssets", retrieved)
+		}
+
+		err := loader.LoadUserCheckedOutToAssets(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserCheckedOutToAssets loads the user's CheckedOutToAssets into the .R struct
+func (o *User) LoadUserCheckedOutToAssets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.CheckedOutToAssets = nil
+
+	related, err := o.CheckedOutToAssets(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	o.R.CheckedOutToAssets = related
+	return nil
+}
+
+// LoadUserCheckedOutToAssets loads the user's CheckedOutToAssets into the .R struct
+func (os UserSlice) LoadUserCheckedOutToAssets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	assets, err := os.CheckedOutToAssets(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.CheckedOutToAssets = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range assets {
+			if o.ID != rel.CheckedOutTo.GetOrZero() {
+				continue
+			}
+
+			o.R.CheckedOutToAssets = append(o.R.CheckedOutToAssets, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserUserGrants(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserUserGrants(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserUserGrants", retrieved)
+		}
+
+		err := loader.LoadUserUserGrants(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserUserGrants loads the user's UserGrants into the .R struct
+func (o *User) LoadUserUserGrants(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UserGrants = nil
+
+	related, err := o.UserGrants(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	o.R.UserGrants = related
+	return nil
+}
+
+// LoadUserUserGrants loads the user's UserGrants into the .R struct
+func (os UserSlice) LoadUserUserGrants(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	userGrants, err := os.UserGrants(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.UserGrants = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range userGrants {
+			if o.ID != rel.UserID {
+				continue
+			}
+
+			o.R.UserGrants = append(o.R.UserGrants, rel)
 		}
 	}
 
@@ -913,60 +1503,428 @@ func ThenLoadUserUserPreferences(queryMods ...bob.Mod[*dialect.SelectQuery]) sql
 			return fmt.Errorf("object %T cannot load UserUserPreferences", retrieved)
 		}
 
-		err := loader.LoadUserUserPreferences(ctx, exec, queryMods...)
+		err := loader.LoadUserUserPreferences(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserUserPreferences loads the user's UserPreferences into the .R struct
+func (o *User) LoadUserUserPreferences(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UserPreferences = nil
+
+	related, err := o.UserPreferences(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	o.R.UserPreferences = related
+	return nil
+}
+
+// LoadUserUserPreferences loads the user's UserPreferences into the .R struct
+func (os UserSlice) LoadUserUserPreferences(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	userPreferences, err := os.UserPreferences(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.UserPreferences = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range userPreferences {
+			if o.ID != rel.UserID {
+				continue
+			}
+
+			o.R.UserPreferences = append(o.R.UserPreferences, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserSavedSearches(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserSavedSearches(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserSavedSearches", retrieved)
+		}
+
+		err := loader.LoadUserSavedSearches(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+func ThenLoadUserWorkspaceMembers(queryMods ...bob.Mod[*dialect.SelectQuery]) sqlite.Loader {
+	return sqlite.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserWorkspaceMembers(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserWorkspaceMembers", retrieved)
+		}
+
+		err := loader.LoadUserWorkspaceMembers(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserSavedSearches loads the user's SavedSearches into the .R struct
+func (o *User) LoadUserSavedSearches(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.SavedSearches = nil
+
+	related, err := o.SavedSearches(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	o.R.SavedSearches = related
+	return nil
+}
+
+// LoadUserWorkspaceMembers loads the user's WorkspaceMembers into the .R struct
+func (o *User) LoadUserWorkspaceMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.WorkspaceMembers = nil
+
+	related, err := o.WorkspaceMembers(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	o.R.WorkspaceMembers = related
+	return nil
+}
+
+// LoadUserSavedSearches loads the user's SavedSearches into the .R struct
+func (os UserSlice) LoadUserSavedSearches(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	savedSearches, err := os.SavedSearches(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.SavedSearches = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range savedSearches {
+			if o.ID != rel.UserID {
+				continue
+			}
+
+			o.R.SavedSearches = append(o.R.SavedSearches, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadUserWorkspaceMembers loads the user's WorkspaceMembers into the .R struct
+func (os UserSlice) LoadUserWorkspaceMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	workspaceMembers, err := os.WorkspaceMembers(ctx, exec, mods...).All()
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.WorkspaceMembers = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range workspaceMembers {
+			if o.ID != rel.UserID {
+				continue
+			}
+
+			o.R.WorkspaceMembers = append(o.R.WorkspaceMembers, rel)
+		}
+	}
+
+	return nil
+}
+
+func insertUserAPITokens0(ctx context.Context, exec bob.Executor, apiTokens1 []*APITokenSetter, user0 *User) (APITokenSlice, error) {
+	for _, apiToken1 := range apiTokens1 {
+		apiToken1.UserID = omit.From(user0.ID)
+	}
+
+	ret, err := APITokens.InsertMany(ctx, exec, apiTokens1...)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserAPITokens0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserAPITokens0(ctx context.Context, exec bob.Executor, apiTokens1 APITokenSlice, user0 *User) error {
+	setter := &APITokenSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := APITokens.Update(ctx, exec, setter, apiTokens1...)
+	if err != nil {
+		return fmt.Errorf("attachUserAPITokens0: %w", err)
+	}
+
+	return nil
+}
+
+func (user0 *User) InsertAPITokens(ctx context.Context, exec bob.Executor, related ...*APITokenSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	apiToken1, err := insertUserAPITokens0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.APITokens = append(user0.R.APITokens, apiToken1...)
+
+	return nil
+}
+
+func (user0 *User) AttachAPITokens(ctx context.Context, exec bob.Executor, related ...*APIToken) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	apiToken1 := APITokenSlice(related)
+
+	err = attachUserAPITokens0(ctx, exec, apiToken1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.APITokens = append(user0.R.APITokens, apiToken1...)
 
-		// Don't cause an issue due to missing relationships
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil
-		}
+	return nil
+}
+
+func insertUserCreatedByAssetCheckouts0(ctx context.Context, exec bob.Executor, assetCheckouts1 []*AssetCheckoutSetter, user0 *User) (AssetCheckoutSlice, error) {
+	for _, assetCheckout1 := range assetCheckouts1 {
+		assetCheckout1.CreatedBy = omit.From(user0.ID)
+	}
+
+	ret, err := AssetCheckouts.InsertMany(ctx, exec, assetCheckouts1...)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserCreatedByAssetCheckouts0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserCreatedByAssetCheckouts0(ctx context.Context, exec bob.Executor, assetCheckouts1 AssetCheckoutSlice, user0 *User) error {
+	setter := &AssetCheckoutSetter{
+		CreatedBy: omit.From(user0.ID),
+	}
+
+	err := AssetCheckouts.Update(ctx, exec, setter, assetCheckouts1...)
+	if err != nil {
+		return fmt.Errorf("attachUserCreatedByAssetCheckouts0: %w", err)
+	}
+
+	return nil
+}
+
+func (user0 *User) InsertCreatedByAssetCheckouts(ctx context.Context, exec bob.Executor, related ...*AssetCheckoutSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
 
+	assetCheckout1, err := insertUserCreatedByAssetCheckouts0(ctx, exec, related, user0)
+	if err != nil {
 		return err
-	})
+	}
+
+	user0.R.CreatedByAssetCheckouts = append(user0.R.CreatedByAssetCheckouts, assetCheckout1...)
+
+	return nil
 }
 
-// LoadUserUserPreferences loads the user's UserPreferences into the .R struct
-func (o *User) LoadUserUserPreferences(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
-	if o == nil {
+func (user0 *User) AttachCreatedByAssetCheckouts(ctx context.Context, exec bob.Executor, related ...*AssetCheckout) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	// Reset the relationship
-	o.R.UserPreferences = nil
+	var err error
+	assetCheckout1 := AssetCheckoutSlice(related)
 
-	related, err := o.UserPreferences(ctx, exec, mods...).All()
+	err = attachUserCreatedByAssetCheckouts0(ctx, exec, assetCheckout1, user0)
 	if err != nil {
 		return err
 	}
 
-	o.R.UserPreferences = related
+	user0.R.CreatedByAssetCheckouts = append(user0.R.CreatedByAssetCheckouts, assetCheckout1...)
+
 	return nil
 }
 
-// LoadUserUserPreferences loads the user's UserPreferences into the .R struct
-func (os UserSlice) LoadUserUserPreferences(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
-	if len(os) == 0 {
+func insertUserCheckedOutToAssetCheckouts0(ctx context.Context, exec bob.Executor, assetCheckouts1 []*AssetCheckoutSetter, user0 *User) (AssetCheckoutSlice, error) {
+	for _, assetCheckout1 := range assetCheckouts1 {
+		assetCheckout1.CheckedOutTo = omit.From(user0.ID)
+	}
+
+	ret, err := AssetCheckouts.InsertMany(ctx, exec, assetCheckouts1...)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserCheckedOutToAssetCheckouts0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserCheckedOutToAssetCheckouts0(ctx context.Context, exec bob.Executor, assetCheckouts1 AssetCheckoutSlice, user0 *User) error {
+	setter := &AssetCheckoutSetter{
+		CheckedOutTo: omit.From(user0.ID),
+	}
+
+	err := AssetCheckouts.Update(ctx, exec, setter, assetCheckouts1...)
+	if err != nil {
+		return fmt.Errorf("attachUserCheckedOutToAssetCheckouts0: %w", err)
+	}
+
+	return nil
+}
+
+func (user0 *User) InsertCheckedOutToAssetCheckouts(ctx context.Context, exec bob.Executor, related ...*AssetCheckoutSetter) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	userPreferences, err := os.UserPreferences(ctx, exec, mods...).All()
+	assetCheckout1, err := insertUserCheckedOutToAssetCheckouts0(ctx, exec, related, user0)
 	if err != nil {
 		return err
 	}
 
-	for _, o := range os {
-		o.R.UserPreferences = nil
+	user0.R.CheckedOutToAssetCheckouts = append(user0.R.CheckedOutToAssetCheckouts, assetCheckout1...)
+
+	return nil
+}
+
+func (user0 *User) AttachCheckedOutToAssetCheckouts(ctx context.Context, exec bob.Executor, related ...*AssetCheckout) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	for _, o := range os {
-		for _, rel := range userPreferences {
-			if o.ID != rel.UserID {
-				continue
-			}
+	var err error
+	assetCheckout1 := AssetCheckoutSlice(related)
 
-			o.R.UserPreferences = append(o.R.UserPreferences, rel)
-		}
+	err = attachUserCheckedOutToAssetCheckouts0(ctx, exec, assetCheckout1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.CheckedOutToAssetCheckouts = append(user0.R.CheckedOutToAssetCheckouts, assetCheckout1...)
+
+	return nil
+}
+
+func insertUserAssetEvents0(ctx context.Context, exec bob.Executor, assetEvents1 []*AssetEventSetter, user0 *User) (AssetEventSlice, error) {
+	for _, assetEvent1 := range assetEvents1 {
+		assetEvent1.UserID = omitnull.From(user0.ID)
+	}
+
+	ret, err := AssetEvents.InsertMany(ctx, exec, assetEvents1...)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserAssetEvents0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserAssetEvents0(ctx context.Context, exec bob.Executor, assetEvents1 AssetEventSlice, user0 *User) error {
+	setter := &AssetEventSetter{
+		UserID: omitnull.From(user0.ID),
+	}
+
+	err := AssetEvents.Update(ctx, exec, setter, assetEvents1...)
+	if err != nil {
+		return fmt.Errorf("attachUserAssetEvents0: %w", err)
+	}
+
+	return nil
+}
+
+func (user0 *User) InsertAssetEvents(ctx context.Context, exec bob.Executor, related ...*AssetEventSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	assetEvent1, err := insertUserAssetEvents0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AssetEvents = append(user0.R.AssetEvents, assetEvent1...)
+
+	return nil
+}
+
+func (user0 *User) AttachAssetEvents(ctx context.Context, exec bob.Executor, related ...*AssetEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	assetEvent1 := AssetEventSlice(related)
+
+	err = attachUserAssetEvents0(ctx, exec, assetEvent1, user0)
+	if err != nil {
+		return err
 	}
 
+	user0.R.AssetEvents = append(user0.R.AssetEvents, assetEvent1...)
+
 	return nil
 }
 
@@ -1265,6 +2223,65 @@ func (user0 *User) AttachCheckedOutToAssets(ctx context.Context, exec bob.Execut
 	return nil
 }
 
+func insertUserUserGrants0(ctx context.Context, exec bob.Executor, userGrants1 []*UserGrantSetter, user0 *User) (UserGrantSlice, error) {
+	for _, userGrant1 := range userGrants1 {
+		userGrant1.UserID = omit.From(user0.ID)
+	}
+
+	ret, err := UserGrants.InsertMany(ctx, exec, userGrants1...)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUserGrants0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUserGrants0(ctx context.Context, exec bob.Executor, userGrants1 UserGrantSlice, user0 *User) error {
+	setter := &UserGrantSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := UserGrants.Update(ctx, exec, setter, userGrants1...)
+	if err != nil {
+		return fmt.Errorf("attachUserUserGrants0: %w", err)
+	}
+
+	return nil
+}
+
+func (user0 *User) InsertUserGrants(ctx context.Context, exec bob.Executor, related ...*UserGrantSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	userGrant1, err := insertUserUserGrants0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UserGrants = append(user0.R.UserGrants, userGrant1...)
+
+	return nil
+}
+
+func (user0 *User) AttachUserGrants(ctx context.Context, exec bob.Executor, related ...*UserGrant) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	userGrant1 := UserGrantSlice(related)
+
+	err = attachUserUserGrants0(ctx, exec, userGrant1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UserGrants = append(user0.R.UserGrants, userGrant1...)
+
+	return nil
+}
+
 func insertUserUserPreferences0(ctx context.Context, exec bob.Executor, userPreferences1 []*UserPreferenceSetter, user0 *User) (UserPreferenceSlice, error) {
 	for _, userPreference1 := range userPreferences1 {
 		userPreference1.UserID = omit.From(user0.ID)
@@ -1323,3 +2340,120 @@ func (user0 *User) AttachUserPreferences(ctx context.Context, exec bob.Executor,
 
 	return nil
 }
+
+func insertUserSavedSearches0(ctx context.Context, exec bob.Executor, savedSearches1 []*SavedSearchSetter, user0 *User) (SavedSearchSlice, error) {
+	for _, savedSearch1 := range savedSearches1 {
+		savedSearch1.UserID = omit.From(user0.ID)
+	}
+
+	ret, err := SavedSearches.InsertMany(ctx, exec, savedSearches1...)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserSavedSearches0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func insertUserWorkspaceMembers0(ctx context.Context, exec bob.Executor, workspaceMembers1 []*WorkspaceMemberSetter, user0 *User) (WorkspaceMemberSlice, error) {
+	for _, workspaceMember1 := range workspaceMembers1 {
+		workspaceMember1.UserID = omit.From(user0.ID)
+	}
+
+	ret, err := WorkspaceMembers.InsertMany(ctx, exec, workspaceMembers1...)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserWorkspaceMembers0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserSavedSearches0(ctx context.Context, exec bob.Executor, savedSearches1 SavedSearchSlice, user0 *User) error {
+	setter := &SavedSearchSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := SavedSearches.Update(ctx, exec, setter, savedSearches1...)
+	if err != nil {
+		return fmt.Errorf("attachUserSavedSearches0: %w", err)
+	}
+
+	return nil
+}
+
+func attachUserWorkspaceMembers0(ctx context.Context, exec bob.Executor, workspaceMembers1 WorkspaceMemberSlice, user0 *User) error {
+	setter := &WorkspaceMemberSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := WorkspaceMembers.Update(ctx, exec, setter, workspaceMembers1...)
+	if err != nil {
+		return fmt.Errorf("attachUserWorkspaceMembers0: %w", err)
+	}
+
+	return nil
+}
+
+func (user0 *User) InsertSavedSearches(ctx context.Context, exec bob.Executor, related ...*SavedSearchSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	savedSearch1, err := insertUserSavedSearches0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.SavedSearches = append(user0.R.SavedSearches, savedSearch1...)
+
+	return nil
+}
+
+func (user0 *User) InsertWorkspaceMembers(ctx context.Context, exec bob.Executor, related ...*WorkspaceMemberSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	workspaceMember1, err := insertUserWorkspaceMembers0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.WorkspaceMembers = append(user0.R.WorkspaceMembers, workspaceMember1...)
+
+	return nil
+}
+
+func (user0 *User) AttachSavedSearches(ctx context.Context, exec bob.Executor, related ...*SavedSearch) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	savedSearch1 := SavedSearchSlice(related)
+
+	err = attachUserSavedSearches0(ctx, exec, savedSearch1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.SavedSearches = append(user0.R.SavedSearches, savedSearch1...)
+
+	return nil
+}
+func (user0 *User) AttachWorkspaceMembers(ctx context.Context, exec bob.Executor, related ...*WorkspaceMember) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	workspaceMember1 := WorkspaceMemberSlice(related)
+
+	err = attachUserWorkspaceMembers0(ctx, exec, workspaceMember1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.WorkspaceMembers = append(user0.R.WorkspaceMembers, workspaceMember1...)
+
+	return nil
+}
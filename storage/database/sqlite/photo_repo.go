@@ -0,0 +1,103 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/models"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/types"
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+)
+
+type PhotoRepo struct{}
+
+func (pr *PhotoRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*entities.Photo, error) {
+	photo, err := models.AssetPhotos.Query(ctx, exec, models.SelectWhere.AssetPhotos.ID.EQ(id)).One()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %d", database.ErrPhotoNotFound, id)
+		}
+		return nil, fmt.Errorf("error getting photo: %w", err)
+	}
+
+	return mapDBModelToPhoto(photo), nil
+}
+
+// ListForAsset returns all photos of the asset, ordered by their position.
+func (pr *PhotoRepo) ListForAsset(ctx context.Context, exec bob.Executor, assetID int64) ([]*entities.Photo, error) {
+	photos, err := models.AssetPhotos.Query(ctx, exec,
+		models.SelectWhere.AssetPhotos.AssetID.EQ(assetID),
+		sm.OrderBy(models.AssetPhotoColumns.Position).Asc(),
+		sm.OrderBy(models.AssetPhotoColumns.ID).Asc(),
+	).All()
+	if err != nil {
+		return nil, fmt.Errorf("error getting photos for asset %d: %w", assetID, err)
+	}
+
+	return mapDBModelsToPhotos(photos), nil
+}
+
+func (pr *PhotoRepo) Create(ctx context.Context, exec bob.Executor, photo *entities.Photo) (int64, error) {
+	inserted, err := models.AssetPhotos.Insert(ctx, exec, &models.AssetPhotoSetter{
+		AssetID:      omit.From(photo.AssetID),
+		FileID:       omit.From(photo.FileID),
+		ImageURL:     omit.From(photo.ImageURL),
+		ThumbnailURL: omit.From(photo.ThumbnailURL),
+		PreviewURL:   omit.From(photo.PreviewURL),
+		Caption:      omit.From(photo.Caption),
+		Position:     omit.From(int64(photo.Position)),
+		IsPrimary:    omit.From(photo.Primary),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", database.ErrCreatingPhoto, err)
+	}
+
+	return inserted.ID, nil
+}
+
+func (pr *PhotoRepo) Update(ctx context.Context, exec bob.Executor, photo *entities.Photo) error {
+	_, err := models.AssetPhotos.UpdateQ(ctx, exec, models.UpdateWhere.AssetPhotos.ID.EQ(photo.ID), &models.AssetPhotoSetter{
+		ThumbnailURL: omit.From(photo.ThumbnailURL),
+		PreviewURL:   omit.From(photo.PreviewURL),
+		Caption:      omit.From(photo.Caption),
+		Position:     omit.From(int64(photo.Position)),
+		IsPrimary:    omit.From(photo.Primary),
+		UpdatedAt:    omit.From(types.NewSQLiteDatetime(time.Now())),
+	}).Exec()
+	if err != nil {
+		return fmt.Errorf("error updating photo %d: %w", photo.ID, err)
+	}
+
+	return nil
+}
+
+func mapDBModelsToPhotos(photos models.AssetPhotoSlice) []*entities.Photo {
+	mapped := make([]*entities.Photo, 0, len(photos))
+	for _, p := range photos {
+		mapped = append(mapped, mapDBModelToPhoto(p))
+	}
+	return mapped
+}
+
+func mapDBModelToPhoto(model *models.AssetPhoto) *entities.Photo {
+	return &entities.Photo{
+		ID:           model.ID,
+		AssetID:      model.AssetID,
+		FileID:       model.FileID,
+		ImageURL:     model.ImageURL,
+		ThumbnailURL: model.ThumbnailURL,
+		PreviewURL:   model.PreviewURL,
+		Caption:      model.Caption,
+		Position:     int(model.Position),
+		Primary:      model.IsPrimary,
+		CreatedAt:    model.CreatedAt.Time,
+		UpdatedAt:    model.UpdatedAt.Time,
+	}
+}
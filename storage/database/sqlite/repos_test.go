@@ -0,0 +1,50 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RobinThrift/stuff/storage/database/databasetest"
+	"github.com/stephenafamo/bob"
+)
+
+func TestRepos(t *testing.T) {
+	databasetest.Run(t, newTestDB, databasetest.Repos{
+		Assets:     &AssetRepo{},
+		Files:      &FileRepo{},
+		Models:     &ModelRepo{},
+		Tags:       &TagRepo{},
+		Users:      &UserRepo{},
+		Workspaces: &WorkspaceRepo{},
+	})
+}
+
+// newTestDB migrates a new in-memory database without enforcing foreign keys.
+func newTestDB(t *testing.T) bob.Executor {
+	db, err := NewSQLiteDB(&Config{File: ":memory:", Timeout: time.Millisecond * 500})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err = db.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err = db.ExecContext(ctx, "PRAGMA foreign_keys = 0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = RunMigrations(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return bob.NewDB(db)
+}
@@ -0,0 +1,107 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+)
+
+type SavedSearchRepo struct{}
+
+func (*SavedSearchRepo) List(ctx context.Context, exec bob.Executor, query database.ListSavedSearchesQuery) ([]*entities.SavedSearch, error) {
+	qmods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.SavedSearchColumns.UserID.EQ(sqlite.Arg(query.UserID)).Or(models.SavedSearchColumns.Shared.EQ(sqlite.Arg(true)))),
+		sm.OrderBy(models.SavedSearchColumns.Name),
+		sm.OrderBy(models.SavedSearchColumns.ID),
+	}
+
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, models.SelectWhere.SavedSearches.WorkspaceID.EQ(query.WorkspaceID))
+	}
+
+	searches, err := models.SavedSearches.Query(ctx, exec, qmods...).All()
+	if err != nil {
+		return nil, fmt.Errorf("error getting saved searches for user %d: %w", query.UserID, err)
+	}
+
+	list := make([]*entities.SavedSearch, 0, len(searches))
+	for _, s := range searches {
+		search, err := mapDBModelToSavedSearch(s)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, search)
+	}
+
+	return list, nil
+}
+
+func (*SavedSearchRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*entities.SavedSearch, error) {
+	search, err := models.SavedSearches.Query(ctx, exec, models.SelectWhere.SavedSearches.ID.EQ(id)).One()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %d", database.ErrSavedSearchNotFound, id)
+		}
+		return nil, fmt.Errorf("error getting saved search %d: %w", id, err)
+	}
+
+	return mapDBModelToSavedSearch(search)
+}
+
+func (*SavedSearchRepo) Create(ctx context.Context, exec bob.Executor, search *entities.SavedSearch) error {
+	inserted, err := models.SavedSearches.Insert(ctx, exec, &models.SavedSearchSetter{
+		WorkspaceID: omit.From(search.WorkspaceID),
+		UserID:      omit.From(search.UserID),
+		Name:        omit.From(search.Name),
+		Query:       omit.From(search.Query),
+		Filters:     omit.From(search.Filters.Encode()),
+		Shared:      omit.From(search.Shared),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating saved search: %w", err)
+	}
+
+	search.ID = inserted.ID
+	search.CreatedAt = inserted.CreatedAt.Time
+	search.UpdatedAt = inserted.UpdatedAt.Time
+
+	return nil
+}
+
+func (*SavedSearchRepo) Delete(ctx context.Context, exec bob.Executor, id int64) error {
+	_, err := models.SavedSearches.DeleteQ(ctx, exec, models.DeleteWhere.SavedSearches.ID.EQ(id)).Exec()
+	if err != nil {
+		return fmt.Errorf("error deleting saved search %d: %w", id, err)
+	}
+
+	return nil
+}
+
+func mapDBModelToSavedSearch(model *models.SavedSearch) (*entities.SavedSearch, error) {
+	filters, err := entities.ParseAssetFilters(model.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing filters of saved search %d: %w", model.ID, err)
+	}
+
+	return &entities.SavedSearch{
+		ID:          model.ID,
+		WorkspaceID: model.WorkspaceID,
+		UserID:      model.UserID,
+		Name:        model.Name,
+		Query:       model.Query,
+		Filters:     filters,
+		Shared:      model.Shared,
+		CreatedAt:   model.CreatedAt.Time,
+		UpdatedAt:   model.UpdatedAt.Time,
+	}, nil
+}
@@ -0,0 +1,238 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/search"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/models"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/types"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/scan"
+)
+
+// searchCondition compiles the parsed search query into a condition on the assets table.
+// Text terms are looked up in the assets_fts table, everything else is compared with the columns directly.
+func searchCondition(node search.Node) (bob.Expression, error) {
+	switch n := node.(type) {
+	case *search.And:
+		conditions, err := searchConditions(n.Nodes)
+		if err != nil {
+			return nil, err
+		}
+		return sqlite.Group(sqlite.And(conditions...)), nil
+	case *search.Or:
+		conditions, err := searchConditions(n.Nodes)
+		if err != nil {
+			return nil, err
+		}
+		return sqlite.Group(sqlite.Or(conditions...)), nil
+	case *search.Not:
+		condition, err := searchCondition(n.Node)
+		if err != nil {
+			return nil, err
+		}
+		return sqlite.Not(sqlite.Group(condition)), nil
+	case *search.Term:
+		match, ok := ftsMatchQuery(n)
+		if !ok {
+			return sqlite.Raw("0"), nil
+		}
+		return sqlite.Raw("assets.id IN (SELECT rowid FROM assets_fts WHERE assets_fts MATCH ?)", match), nil
+	case *search.Compare:
+		return compareCondition(n)
+	}
+
+	return nil, fmt.Errorf("unsupported search query node %T", node)
+}
+
+func searchConditions(nodes []search.Node) ([]bob.Expression, error) {
+	conditions := make([]bob.Expression, 0, len(nodes))
+	for _, node := range nodes {
+		condition, err := searchCondition(node)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	return conditions, nil
+}
+
+// ftsMatchQuery builds an FTS5 query for the term. All words of a term are searched as a phrase, as FTS5
+// would do with any quoted string. Returns false if the term contains no words.
+func ftsMatchQuery(term *search.Term) (string, bool) {
+	words := term.Words()
+	if len(words) == 0 {
+		return "", false
+	}
+
+	match := `"` + strings.Join(words, " ") + `"`
+	if term.Prefix {
+		match += "*"
+	}
+
+	if term.Field != "" {
+		match = term.Field + " : " + match
+	}
+
+	return match, true
+}
+
+// bm25Rank weights the columns of assets_fts like the search vector in PostgreSQL:
+// name and tag count the most, the notes and custom attributes the least and the id not at all.
+const bm25Rank = "bm25(assets_fts, 0, 10, 10, 5, 5, 5, 5, 5, 1, 1)"
+
+// searchRankQuery combines all terms, which aren't negated, into a single FTS5 query, that is used to rank the
+// results and to build the snippets. Returns false if the search query contains no such terms.
+func searchRankQuery(node search.Node) (string, bool) {
+	var terms []string
+	collectRankTerms(node, &terms)
+
+	if len(terms) == 0 {
+		return "", false
+	}
+
+	return strings.Join(terms, " OR "), true
+}
+
+func collectRankTerms(node search.Node, terms *[]string) {
+	switch n := node.(type) {
+	case *search.And:
+		for _, node := range n.Nodes {
+			collectRankTerms(node, terms)
+		}
+	case *search.Or:
+		for _, node := range n.Nodes {
+			collectRankTerms(node, terms)
+		}
+	case *search.Term:
+		if match, ok := ftsMatchQuery(n); ok {
+			*terms = append(*terms, match)
+		}
+	}
+}
+
+type searchSnippet struct {
+	ID      int64  `db:"id"`
+	Snippet string `db:"snippet"`
+}
+
+// searchSnippets returns a short excerpt of the best matching column for each asset,
+// with the matching words wrapped in entities.SnippetMatchStart and entities.SnippetMatchEnd.
+func searchSnippets(ctx context.Context, exec bob.Executor, node search.Node, assets models.AssetSlice) (map[int64]string, error) {
+	rankQuery, ok := searchRankQuery(node)
+	if !ok {
+		return nil, nil
+	}
+
+	ids := make([]bob.Expression, 0, len(assets))
+	for _, a := range assets {
+		ids = append(ids, sqlite.Arg(a.ID))
+	}
+
+	rows, err := bob.All(ctx, exec,
+		sqlite.Select(
+			sm.Columns(
+				sqlite.Raw("rowid").As("id"),
+				sqlite.Raw("snippet(assets_fts, -1, ?, ?, '…', 12)", entities.SnippetMatchStart, entities.SnippetMatchEnd).As("snippet"),
+			),
+			sm.From(sqlite.Quote(models.TableNames.AssetsFTS)),
+			sm.Where(sqlite.Raw("assets_fts MATCH ?", rankQuery)),
+			sm.Where(sqlite.Raw("rowid").In(ids...)),
+		),
+		scan.StructMapper[*searchSnippet](),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting search snippets: %w", err)
+	}
+
+	snippets := make(map[int64]string, len(rows))
+	for _, row := range rows {
+		snippets[row.ID] = row.Snippet
+	}
+
+	return snippets, nil
+}
+
+var searchAssetColumns = map[string]string{
+	search.FieldStatus:       "assets.status",
+	search.FieldAssetType:    "assets.type",
+	search.FieldLocation:     "assets.location",
+	search.FieldPositionCode: "assets.position_code",
+	search.FieldQuantity:     "assets.quantity",
+	search.FieldWarranty:     "assets.warranty_until",
+}
+
+var searchPurchaseColumns = map[string]string{
+	search.FieldPurchaseSupplier: "asset_purchases.supplier",
+	search.FieldPurchaseOrderNo:  "asset_purchases.order_no",
+	search.FieldPurchaseCurrency: "asset_purchases.currency",
+	search.FieldPurchaseAmount:   "asset_purchases.amount",
+	search.FieldPurchaseDate:     "asset_purchases.order_date",
+}
+
+func compareCondition(cmp *search.Compare) (bob.Expression, error) {
+	if cmp.Type == search.TypeAttr {
+		return customAttrCondition(cmp), nil
+	}
+
+	if column, ok := searchAssetColumns[cmp.Field]; ok {
+		return compareColumn(column, cmp), nil
+	}
+
+	if column, ok := searchPurchaseColumns[cmp.Field]; ok {
+		return sqlite.Raw("EXISTS (SELECT 1 FROM asset_purchases WHERE asset_purchases.asset_id = assets.id AND ?)", compareColumn(column, cmp)), nil
+	}
+
+	return nil, fmt.Errorf("unsupported search field %s", cmp.Field)
+}
+
+func compareColumn(column string, cmp *search.Compare) bob.Expression {
+	switch cmp.Type {
+	case search.TypeKeyword:
+		if cmp.Prefix {
+			return sqlite.Raw(column+` LIKE ? ESCAPE '\'`, likePrefix(cmp.Text))
+		}
+		return sqlite.Raw("lower("+column+") = lower(?)", cmp.Text)
+	case search.TypeDate:
+		return sqlite.Raw(column+" "+string(cmp.Op)+" ?", types.NewSQLiteDatetime(cmp.Time))
+	default:
+		return sqlite.Raw(column+" "+string(cmp.Op)+" ?", cmp.Number)
+	}
+}
+
+// customAttrCondition compares the value of the custom attribute as text, or as a number for range comparisons.
+// Values only count as numbers if they are stored as one or start with a number, so "16 GB" is compared as 16.
+func customAttrCondition(cmp *search.Compare) bob.Expression {
+	value := "json_extract(attrs.value, '$.value')"
+
+	var condition bob.Expression
+	switch {
+	case cmp.Op == search.OpEQ && cmp.Prefix:
+		condition = sqlite.Raw("CAST("+value+` AS TEXT) LIKE ? ESCAPE '\'`, likePrefix(cmp.Text))
+	case cmp.Op == search.OpEQ:
+		condition = sqlite.Raw("lower(CAST("+value+" AS TEXT)) = lower(?)", cmp.Text)
+	default:
+		condition = sqlite.Raw(
+			"CASE WHEN json_type(attrs.value, '$.value') IN ('integer', 'real') OR ltrim("+value+") GLOB '[0-9]*' OR ltrim("+value+") GLOB '-[0-9]*' "+
+				"THEN CAST("+value+" AS REAL) END "+string(cmp.Op)+" ?",
+			cmp.Number,
+		)
+	}
+
+	return sqlite.Raw(
+		"EXISTS (SELECT 1 FROM json_each(assets.custom_attrs) AS attrs WHERE lower(json_extract(attrs.value, '$.name')) = lower(?) AND ?)",
+		cmp.Attr, condition,
+	)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func likePrefix(s string) string {
+	return likeEscaper.Replace(s) + "%"
+}
@@ -5,10 +5,12 @@ import (
 	"database/sql"
 	"embed"
 	"fmt"
+	"io/fs"
 	"log/slog"
 	"time"
 
 	"github.com/pressly/goose/v3"
+	goosedb "github.com/pressly/goose/v3/database"
 	bobsqlite "github.com/stephenafamo/bob/dialect/sqlite"
 	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
 	"github.com/stephenafamo/bob/mods"
@@ -58,6 +60,50 @@ func RunMigrations(ctx context.Context, db *sql.DB) error {
 	return nil
 }
 
+// RollbackMigration reverts the last applied migration.
+func RollbackMigration(ctx context.Context, db *sql.DB) (*goose.MigrationResult, error) {
+	provider, err := newMigrationProvider(db)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := provider.Down(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error rolling back migration: %w", err)
+	}
+
+	return result, nil
+}
+
+// MigrationStatus lists all migrations included in this build and whether they have been applied.
+func MigrationStatus(ctx context.Context, db *sql.DB) ([]*goose.MigrationStatus, error) {
+	provider, err := newMigrationProvider(db)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := provider.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting migration status: %w", err)
+	}
+
+	return status, nil
+}
+
+func newMigrationProvider(db *sql.DB) (*goose.Provider, error) {
+	store, err := goosedb.NewStore(goose.DialectSQLite3, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	fsys, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	return goose.NewProvider("", db, fsys, goose.WithStore(store))
+}
+
 func orderByClause(table string, column string, dir string) mods.OrderBy[*dialect.SelectQuery] {
 	return mods.OrderBy[*dialect.SelectQuery]{
 		Expression: bobsqlite.Quote(table, column).String() + " COLLATE NOCASE",
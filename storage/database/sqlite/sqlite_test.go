@@ -6,7 +6,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/pressly/goose/v3"
+	"github.com/stephenafamo/bob"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRunMigrations(t *testing.T) {
@@ -21,3 +25,77 @@ func TestRunMigrations(t *testing.T) {
 	err = RunMigrations(ctx, db)
 	assert.NoError(t, err)
 }
+
+func TestMigrationStatus(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db, err := NewSQLiteDB(&Config{File: path.Join(t.TempDir(), t.Name()+".db"), Timeout: time.Millisecond * 500})
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, db.Close()) })
+
+	status, err := MigrationStatus(ctx, db)
+	require.NoError(t, err)
+	require.NotEmpty(t, status)
+	for _, s := range status {
+		assert.Equal(t, goose.StatePending, s.State)
+	}
+
+	err = RunMigrations(ctx, db)
+	require.NoError(t, err)
+
+	status, err = MigrationStatus(ctx, db)
+	require.NoError(t, err)
+	for _, s := range status {
+		assert.Equal(t, goose.StateApplied, s.State)
+	}
+
+	result, err := RollbackMigration(ctx, db)
+	require.NoError(t, err)
+
+	latest, err := LatestMigrationVersion()
+	require.NoError(t, err)
+	assert.Equal(t, latest, result.Source.Version)
+
+	version, err := MigrationVersion(ctx, bob.NewDB(db))
+	require.NoError(t, err)
+	assert.Equal(t, status[len(status)-2].Source.Version, version)
+}
+
+func TestSnapshot(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+
+	db, err := NewSQLiteDB(&Config{File: path.Join(dir, "stuff.db"), Timeout: time.Millisecond * 500, EnableWAL: true})
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, db.Close()) })
+
+	err = RunMigrations(ctx, db)
+	require.NoError(t, err)
+
+	exec := bob.NewDB(db)
+
+	err = (&UserRepo{}).Create(ctx, exec, &auth.User{Username: "snapshot_test_user"})
+	require.NoError(t, err)
+
+	err = Snapshot(ctx, exec, path.Join(dir, "snapshot.db"))
+	require.NoError(t, err)
+
+	snapshot, err := NewSQLiteDB(&Config{File: path.Join(dir, "snapshot.db"), Timeout: time.Millisecond * 500})
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, snapshot.Close()) })
+
+	user, err := (&UserRepo{}).GetByUsername(ctx, bob.NewDB(snapshot), "snapshot_test_user")
+	require.NoError(t, err)
+	assert.Equal(t, "snapshot_test_user", user.Username)
+
+	latest, err := LatestMigrationVersion()
+	require.NoError(t, err)
+	assert.NotZero(t, latest)
+
+	version, err := MigrationVersion(ctx, bob.NewDB(snapshot))
+	require.NoError(t, err)
+	assert.Equal(t, latest, version)
+}
@@ -30,6 +30,10 @@ func (cr *SupplierRepo) List(ctx context.Context, exec bob.Executor, query datab
 		qmods = append(qmods, models.SelectWhere.Suppliers.Name.Like("%"+query.Search+"%"))
 	}
 
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, models.SelectWhere.Suppliers.WorkspaceID.EQ(query.WorkspaceID))
+	}
+
 	count, err := models.Suppliers.Query(ctx, exec, qmods...).Count()
 	if err != nil {
 		return nil, fmt.Errorf("error counting locations: %w", err)
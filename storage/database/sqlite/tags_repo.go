@@ -16,6 +16,7 @@ import (
 	"github.com/stephenafamo/bob/dialect/sqlite"
 	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
 	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
 	"github.com/stephenafamo/scan"
 )
 
@@ -45,6 +46,10 @@ func (*TagRepo) List(ctx context.Context, exec bob.Executor, query database.List
 		qmods = append(qmods, models.SelectWhere.Tags.InUse.EQ(*query.InUse))
 	}
 
+	if query.WorkspaceID != 0 {
+		qmods = append(qmods, models.SelectWhere.Tags.WorkspaceID.EQ(query.WorkspaceID))
+	}
+
 	count, err := models.Tags.Query(ctx, exec, qmods...).Count()
 	if err != nil {
 		return nil, fmt.Errorf("error counting tags: %w", err)
@@ -76,19 +81,25 @@ func (*TagRepo) List(ctx context.Context, exec bob.Executor, query database.List
 
 	for i := range tags {
 		page.Items = append(page.Items, &entities.Tag{
-			ID:        tags[i].ID,
-			Tag:       tags[i].Tag,
-			InUse:     tags[i].InUse,
-			CreatedAt: tags[i].CreatedAt.Time,
-			UpdatedAt: tags[i].UpdatedAt.Time,
+			ID:          tags[i].ID,
+			WorkspaceID: tags[i].WorkspaceID,
+			Tag:         tags[i].Tag,
+			InUse:       tags[i].InUse,
+			CreatedAt:   tags[i].CreatedAt.Time,
+			UpdatedAt:   tags[i].UpdatedAt.Time,
 		})
 	}
 
 	return page, nil
 }
 
-func (*TagRepo) GetUnused(ctx context.Context, exec bob.Executor) (*entities.Tag, error) {
-	model, err := models.Tags.Query(ctx, exec, models.SelectWhere.Tags.InUse.EQ(false)).One()
+func (*TagRepo) GetUnused(ctx context.Context, exec bob.Executor, workspaceID int64) (*entities.Tag, error) {
+	qmods := []bob.Mod[*dialect.SelectQuery]{models.SelectWhere.Tags.InUse.EQ(false)}
+	if workspaceID != 0 {
+		qmods = append(qmods, models.SelectWhere.Tags.WorkspaceID.EQ(workspaceID))
+	}
+
+	model, err := models.Tags.Query(ctx, exec, qmods...).One()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -97,10 +108,12 @@ func (*TagRepo) GetUnused(ctx context.Context, exec bob.Executor) (*entities.Tag
 	}
 
 	return &entities.Tag{
-		ID:        model.ID,
-		Tag:       model.Tag,
-		CreatedAt: model.CreatedAt.Time,
-		UpdatedAt: model.UpdatedAt.Time,
+		ID:          model.ID,
+		WorkspaceID: model.WorkspaceID,
+		Tag:         model.Tag,
+		InUse:       model.InUse,
+		CreatedAt:   model.CreatedAt.Time,
+		UpdatedAt:   model.UpdatedAt.Time,
 	}, nil
 }
 
@@ -114,17 +127,25 @@ func (*TagRepo) Get(ctx context.Context, exec bob.Executor, tag string) (*entiti
 	}
 
 	return &entities.Tag{
-		ID:        model.ID,
-		Tag:       model.Tag,
-		CreatedAt: model.CreatedAt.Time,
-		UpdatedAt: model.UpdatedAt.Time,
+		ID:          model.ID,
+		WorkspaceID: model.WorkspaceID,
+		Tag:         model.Tag,
+		InUse:       model.InUse,
+		CreatedAt:   model.CreatedAt.Time,
+		UpdatedAt:   model.UpdatedAt.Time,
 	}, nil
 }
 
 func (*TagRepo) Create(ctx context.Context, exec bob.Executor, tag *entities.Tag) error {
+	workspaceID := tag.WorkspaceID
+	if workspaceID == 0 {
+		workspaceID = entities.DefaultWorkspaceID
+	}
+
 	model := &models.TagSetter{
-		Tag:   omit.From(tag.Tag),
-		InUse: omit.From(tag.InUse),
+		Tag:         omit.From(tag.Tag),
+		InUse:       omit.From(tag.InUse),
+		WorkspaceID: omit.From(workspaceID),
 	}
 
 	_, err := models.Tags.Insert(ctx, exec, model)
@@ -132,6 +153,15 @@ func (*TagRepo) Create(ctx context.Context, exec bob.Executor, tag *entities.Tag
 		return nil
 	}
 
+	_, err = models.Workspaces.UpdateQ(
+		ctx, exec,
+		um.Set(models.ColumnNames.Workspaces.TagCounter).To(sqlite.Raw(models.ColumnNames.Workspaces.TagCounter+" + 1")),
+		models.UpdateWhere.Workspaces.ID.EQ(workspaceID),
+	).Exec()
+	if err != nil {
+		return fmt.Errorf("error incrementing tag counter of workspace %d: %w", workspaceID, err)
+	}
+
 	return nil
 }
 
@@ -174,7 +204,18 @@ func (*TagRepo) Delete(ctx context.Context, exec bob.Executor, tag string) error
 	return err
 }
 
-func (*TagRepo) NextSequential(ctx context.Context, exec bob.Executor) (int64, error) {
+// NextSequential returns the next number for sequential tags. If workspaceID is not 0,
+// the workspace's own counter is used instead of the global one.
+func (*TagRepo) NextSequential(ctx context.Context, exec bob.Executor, workspaceID int64) (int64, error) {
+	if workspaceID != 0 {
+		workspace, err := models.FindWorkspace(ctx, exec, workspaceID)
+		if err != nil {
+			return 0, fmt.Errorf("error getting tag counter of workspace %d: %w", workspaceID, err)
+		}
+
+		return workspace.TagCounter + 1, nil
+	}
+
 	// SELECT seq FROM sqlite_sequence WHERE name = 'tags' LIMIT 1;
 	next, err := bob.One(ctx, exec,
 		sqlite.Select(
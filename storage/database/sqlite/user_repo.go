@@ -22,8 +22,6 @@ import (
 	"github.com/stephenafamo/bob/dialect/sqlite/sm"
 )
 
-var ErrUserNotFound = errors.New("user not found")
-
 type UserRepo struct{}
 
 func (*UserRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*auth.User, error) {
@@ -31,7 +29,7 @@ func (*UserRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*auth.Us
 	user, err := query.One()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("%w: id %d", ErrUserNotFound, id)
+			return nil, fmt.Errorf("%w: id %d", database.ErrUserNotFound, id)
 		}
 
 		return nil, fmt.Errorf("error getting user by id: id %d: %w", id, err)
@@ -45,7 +43,7 @@ func (*UserRepo) GetByUsername(ctx context.Context, exec bob.Executor, username
 	user, err := query.One()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("%w: username %s", ErrUserNotFound, username)
+			return nil, fmt.Errorf("%w: username %s", database.ErrUserNotFound, username)
 		}
 
 		return nil, fmt.Errorf("error getting user by username: username %s: %w", username, err)
@@ -59,7 +57,7 @@ func (*UserRepo) GetByRef(ctx context.Context, exec bob.Executor, ref string) (*
 	user, err := query.One()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("%w: ref %s", ErrUserNotFound, ref)
+			return nil, fmt.Errorf("%w: ref %s", database.ErrUserNotFound, ref)
 		}
 
 		return nil, fmt.Errorf("error getting user by ref: ref %s: %w", ref, err)
@@ -122,7 +120,7 @@ func (*UserRepo) List(ctx context.Context, exec bob.Executor, query database.Lis
 			ID:          users[i].ID,
 			Username:    users[i].Username,
 			DisplayName: users[i].DisplayName,
-			IsAdmin:     users[i].IsAdmin,
+			Role:        auth.Role(users[i].Role),
 			CreatedAt:   users[i].CreatedAt.Time,
 			UpdatedAt:   users[i].UpdatedAt.Time,
 		})
@@ -132,7 +130,7 @@ func (*UserRepo) List(ctx context.Context, exec bob.Executor, query database.Lis
 }
 
 func (*UserRepo) CountAdmins(ctx context.Context, exec bob.Executor) (int64, error) {
-	query := models.Users.Query(ctx, exec, models.SelectWhere.Users.IsAdmin.EQ(true))
+	query := models.Users.Query(ctx, exec, models.SelectWhere.Users.Role.EQ(string(auth.RoleAdmin)))
 	return query.Count()
 }
 
@@ -140,7 +138,7 @@ func (*UserRepo) Create(ctx context.Context, exec bob.Executor, toCreate *auth.U
 	inserted, err := models.Users.Insert(ctx, exec, &models.UserSetter{
 		Username:    omit.From[string](toCreate.Username),
 		DisplayName: omit.From[string](toCreate.DisplayName),
-		IsAdmin:     omit.From[bool](toCreate.IsAdmin),
+		Role:        omit.From(string(toCreate.Role)),
 		AuthRef:     omit.From[string](toCreate.AuthRef),
 	})
 	if err != nil {
@@ -157,14 +155,14 @@ func (*UserRepo) Update(ctx context.Context, exec bob.Executor, toUpdate *auth.U
 		ID:          toUpdate.ID,
 		Username:    toUpdate.Username,
 		DisplayName: toUpdate.DisplayName,
-		IsAdmin:     toUpdate.IsAdmin,
+		Role:        string(toUpdate.Role),
 		AuthRef:     toUpdate.AuthRef,
 	}
 
 	err := models.Users.Update(ctx, exec, &models.UserSetter{
 		Username:    omit.From[string](toUpdate.Username),
 		DisplayName: omit.From[string](toUpdate.DisplayName),
-		IsAdmin:     omit.From[bool](toUpdate.IsAdmin),
+		Role:        omit.From(string(toUpdate.Role)),
 		AuthRef:     omit.From[string](toUpdate.AuthRef),
 		UpdatedAt:   omit.From(types.NewSQLiteDatetime(time.Now())),
 	}, model)
@@ -181,7 +179,7 @@ func (*UserRepo) Delete(ctx context.Context, exec bob.Executor, id int64) error
 	_, err := models.Users.DeleteQ(ctx, exec, models.DeleteWhere.Users.ID.EQ(id)).Exec()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("error deleting user: %w", ErrUserNotFound)
+			return fmt.Errorf("error deleting user: %w", database.ErrUserNotFound)
 		}
 		return err
 	}
@@ -238,7 +236,7 @@ func mapUserModelToEntity(ctx context.Context, user *models.User) (*auth.User, e
 		ID:          user.ID,
 		Username:    user.Username,
 		DisplayName: user.DisplayName,
-		IsAdmin:     user.IsAdmin,
+		Role:        auth.Role(user.Role),
 		AuthRef:     user.AuthRef,
 		Preferences: prefs,
 		CreatedAt:   user.CreatedAt.Time,
@@ -290,6 +288,22 @@ func mapUserPrefsToInsert(userID int64, prefs auth.UserPreferences) ([]bob.Mod[*
 		)
 	}
 
+	if prefs.PinnedSavedSearches != nil {
+		val, err := json.Marshal(prefs.PinnedSavedSearches)
+		if err != nil {
+			return nil, err
+		}
+		inserts = append(inserts,
+			models.UserPreferenceSetter{
+				UserID:    omit.From(userID),
+				Key:       omit.From("pinned_saved_searches"),
+				Value:     omit.From(val),
+				CreatedAt: omit.From(types.NewSQLiteDatetime(time.Now())),
+				UpdatedAt: omit.From(types.NewSQLiteDatetime(time.Now())),
+			}.Insert(),
+		)
+	}
+
 	if prefs.ThemeName != "" {
 		inserts = append(inserts,
 			models.UserPreferenceSetter{
@@ -349,6 +363,9 @@ func mapUserPrefValueToEntity(ctx context.Context, prefs *auth.UserPreferences,
 	case "asset_list_columns":
 		err := json.Unmarshal(pref.Value, &prefs.AssetListColumns)
 		return err
+	case "pinned_saved_searches":
+		err := json.Unmarshal(pref.Value, &prefs.PinnedSavedSearches)
+		return err
 	}
 
 	slog.WarnContext(ctx, fmt.Sprintf("unknown preference key: %s", pref.Key))
@@ -0,0 +1,174 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/storage/database"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/models"
+	"github.com/RobinThrift/stuff/storage/database/sqlite/types"
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/im"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+)
+
+type WorkspaceRepo struct{}
+
+func (*WorkspaceRepo) List(ctx context.Context, exec bob.Executor) ([]*entities.Workspace, error) {
+	workspaces, err := models.Workspaces.Query(ctx, exec, sm.OrderBy(models.WorkspaceColumns.Name)).All()
+	if err != nil {
+		return nil, fmt.Errorf("error getting workspaces: %w", err)
+	}
+
+	return mapDBModelsToWorkspaces(workspaces), nil
+}
+
+func (*WorkspaceRepo) ListForUser(ctx context.Context, exec bob.Executor, userID int64) ([]*entities.Workspace, error) {
+	workspaces, err := models.Workspaces.Query(
+		ctx, exec,
+		sm.Where(sqlite.Raw("workspaces.id IN (SELECT workspace_id FROM workspace_members WHERE user_id = ?)", userID)),
+		sm.OrderBy(models.WorkspaceColumns.Name),
+	).All()
+	if err != nil {
+		return nil, fmt.Errorf("error getting workspaces for user %d: %w", userID, err)
+	}
+
+	return mapDBModelsToWorkspaces(workspaces), nil
+}
+
+func (*WorkspaceRepo) Get(ctx context.Context, exec bob.Executor, id int64) (*entities.Workspace, error) {
+	workspace, err := models.Workspaces.Query(ctx, exec, models.SelectWhere.Workspaces.ID.EQ(id)).One()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %d", database.ErrWorkspaceNotFound, id)
+		}
+		return nil, fmt.Errorf("error getting workspace %d: %w", id, err)
+	}
+
+	return mapDBModelToWorkspace(workspace), nil
+}
+
+func (*WorkspaceRepo) Create(ctx context.Context, exec bob.Executor, workspace *entities.Workspace) error {
+	inserted, err := models.Workspaces.Insert(ctx, exec, &models.WorkspaceSetter{
+		Name:      omit.From(workspace.Name),
+		TagPrefix: omit.From(workspace.TagPrefix),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating workspace: %w", err)
+	}
+
+	workspace.ID = inserted.ID
+	workspace.CreatedAt = inserted.CreatedAt.Time
+	workspace.UpdatedAt = inserted.UpdatedAt.Time
+
+	return nil
+}
+
+func (*WorkspaceRepo) Update(ctx context.Context, exec bob.Executor, workspace *entities.Workspace) error {
+	_, err := models.Workspaces.UpdateQ(ctx, exec, models.UpdateWhere.Workspaces.ID.EQ(workspace.ID), &models.WorkspaceSetter{
+		Name:      omit.From(workspace.Name),
+		TagPrefix: omit.From(workspace.TagPrefix),
+		UpdatedAt: omit.From(types.NewSQLiteDatetime(time.Now())),
+	}).Exec()
+	if err != nil {
+		return fmt.Errorf("error updating workspace %d: %w", workspace.ID, err)
+	}
+
+	return nil
+}
+
+func (*WorkspaceRepo) Delete(ctx context.Context, exec bob.Executor, id int64) error {
+	_, err := models.Workspaces.DeleteQ(ctx, exec, models.DeleteWhere.Workspaces.ID.EQ(id)).Exec()
+	if err != nil {
+		return fmt.Errorf("error deleting workspace %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// CountAssets counts all assets of the workspace, including the ones in the trash.
+func (*WorkspaceRepo) CountAssets(ctx context.Context, exec bob.Executor, id int64) (int64, error) {
+	return models.Assets.Query(ctx, exec, models.SelectWhere.Assets.WorkspaceID.EQ(id)).Count()
+}
+
+func (*WorkspaceRepo) ListMembers(ctx context.Context, exec bob.Executor, id int64) ([]*auth.User, error) {
+	users, err := models.Users.Query(
+		ctx, exec,
+		sm.Where(sqlite.Raw("users.id IN (SELECT user_id FROM workspace_members WHERE workspace_id = ?)", id)),
+		sm.OrderBy(models.UserColumns.Username),
+	).All()
+	if err != nil {
+		return nil, fmt.Errorf("error getting members of workspace %d: %w", id, err)
+	}
+
+	members := make([]*auth.User, 0, len(users))
+	for _, user := range users {
+		member, err := mapUserModelToEntity(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+func (*WorkspaceRepo) AddMember(ctx context.Context, exec bob.Executor, id int64, userID int64) error {
+	_, err := models.WorkspaceMembers.InsertQ(
+		ctx, exec,
+		im.IntoAs(models.TableNames.WorkspaceMembers, models.TableNames.WorkspaceMembers,
+			models.ColumnNames.WorkspaceMembers.WorkspaceID,
+			models.ColumnNames.WorkspaceMembers.UserID,
+		),
+		im.Values(sqlite.Arg(id), sqlite.Arg(userID)),
+		im.OnConflict(
+			models.ColumnNames.WorkspaceMembers.WorkspaceID,
+			models.ColumnNames.WorkspaceMembers.UserID,
+		).DoNothing(),
+	).Exec()
+	if err != nil {
+		return fmt.Errorf("error adding user %d to workspace %d: %w", userID, id, err)
+	}
+
+	return nil
+}
+
+func (*WorkspaceRepo) RemoveMember(ctx context.Context, exec bob.Executor, id int64, userID int64) error {
+	_, err := models.WorkspaceMembers.DeleteQ(
+		ctx, exec,
+		models.DeleteWhere.WorkspaceMembers.WorkspaceID.EQ(id),
+		models.DeleteWhere.WorkspaceMembers.UserID.EQ(userID),
+	).Exec()
+	if err != nil {
+		return fmt.Errorf("error removing user %d from workspace %d: %w", userID, id, err)
+	}
+
+	return nil
+}
+
+func mapDBModelsToWorkspaces(list models.WorkspaceSlice) []*entities.Workspace {
+	workspaces := make([]*entities.Workspace, 0, len(list))
+	for _, model := range list {
+		workspaces = append(workspaces, mapDBModelToWorkspace(model))
+	}
+
+	return workspaces
+}
+
+func mapDBModelToWorkspace(model *models.Workspace) *entities.Workspace {
+	return &entities.Workspace{
+		ID:         model.ID,
+		Name:       model.Name,
+		TagPrefix:  model.TagPrefix,
+		TagCounter: model.TagCounter,
+		CreatedAt:  model.CreatedAt.Time,
+		UpdatedAt:  model.UpdatedAt.Time,
+	}
+}
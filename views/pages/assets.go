@@ -2,6 +2,7 @@ package pages
 
 import (
 	"net/http"
+	"slices"
 
 	"github.com/RobinThrift/stuff/auth"
 	"github.com/RobinThrift/stuff/entities"
@@ -10,9 +11,18 @@ import (
 )
 
 type AssetListPage struct {
-	Assets  *views.Pagination[*entities.Asset]
-	Search  string
-	Columns map[string]bool
+	Assets    *views.Pagination[*entities.Asset]
+	Search    string
+	SearchErr string
+	Filters   entities.AssetFilters
+	Facets    []*entities.Facet
+	Columns   map[string]bool
+	// SavedSearch is set, if the list is showing the results of a saved search.
+	SavedSearch *entities.SavedSearch
+	// CanShareSearch is true for editors, who may share saved searches with the workspace.
+	CanShareSearch bool
+	// IsPinned reports whether the current user pinned SavedSearch to the sidebar.
+	IsPinned bool
 }
 
 var defaultAssetListColumns = map[string]bool{
@@ -20,10 +30,20 @@ var defaultAssetListColumns = map[string]bool{
 }
 
 func (m *AssetListPage) Render(w http.ResponseWriter, r *http.Request) error {
-	global := views.NewGlobal("Assets", r)
+	title := "Assets"
+	if m.SavedSearch != nil {
+		title = m.SavedSearch.Name
+	}
+
+	global := views.NewGlobal(title, r)
 
 	user, _ := session.Get[*auth.User](r.Context(), "user")
 
+	m.CanShareSearch = user.Role.Includes(auth.RoleEditor)
+	if m.SavedSearch != nil {
+		m.IsPinned = slices.Contains(user.Preferences.PinnedSavedSearches, m.SavedSearch.ID)
+	}
+
 	m.Columns = defaultAssetListColumns
 
 	if len(user.Preferences.AssetListColumns) != 0 {
@@ -71,6 +91,7 @@ func (m *AssetEditPage) Render(w http.ResponseWriter, r *http.Request) error {
 
 type AssetViewPage struct {
 	Asset            *entities.Asset
+	Checkouts        []*entities.Checkout
 	DecimalSeparator string
 }
 
@@ -81,6 +102,18 @@ func (m *AssetViewPage) Render(w http.ResponseWriter, r *http.Request) error {
 	})
 }
 
+type AssetHistoryPage struct {
+	Asset  *entities.Asset
+	Events *views.Pagination[*entities.AssetEvent]
+}
+
+func (m *AssetHistoryPage) Render(w http.ResponseWriter, r *http.Request) error {
+	return views.Render(w, "assets_history_page", views.Model[*AssetHistoryPage]{
+		Global: views.NewGlobal(m.Asset.Name+" History", r),
+		Data:   m,
+	})
+}
+
 type AssetDeletePage struct {
 	Asset   *entities.Asset
 	Message string
@@ -100,3 +133,62 @@ func (m *AssetDeletePage) Render(w http.ResponseWriter, r *http.Request) error {
 		Data:   m,
 	})
 }
+
+type AssetCheckOutPage struct {
+	Asset          *entities.Asset
+	Checkout       *entities.Checkout
+	Users          [][]string
+	ValidationErrs map[string]string
+}
+
+func (m *AssetCheckOutPage) Render(w http.ResponseWriter, r *http.Request) error {
+	csrfErr, ok := session.Pop[string](r.Context(), "csrf_error")
+	if ok {
+		m.ValidationErrs["general"] = csrfErr
+	}
+
+	return views.Render(w, "assets_checkout_page", views.Model[*AssetCheckOutPage]{
+		Global: views.NewGlobal("Check out "+m.Asset.Name, r),
+		Data:   m,
+	})
+}
+
+type AssetCheckInPage struct {
+	Asset          *entities.Asset
+	Checkout       *entities.Checkout
+	ValidationErrs map[string]string
+}
+
+func (m *AssetCheckInPage) Render(w http.ResponseWriter, r *http.Request) error {
+	csrfErr, ok := session.Pop[string](r.Context(), "csrf_error")
+	if ok {
+		m.ValidationErrs["general"] = csrfErr
+	}
+
+	return views.Render(w, "assets_checkin_page", views.Model[*AssetCheckInPage]{
+		Global: views.NewGlobal("Check in "+m.Asset.Name, r),
+		Data:   m,
+	})
+}
+
+type AssetOverduePage struct {
+	Checkouts *views.Pagination[*entities.Checkout]
+}
+
+func (m *AssetOverduePage) Render(w http.ResponseWriter, r *http.Request) error {
+	return views.Render(w, "assets_overdue_page", views.Model[*AssetOverduePage]{
+		Global: views.NewGlobal("Overdue", r),
+		Data:   m,
+	})
+}
+
+type AssetTrashPage struct {
+	Assets *views.Pagination[*entities.Asset]
+}
+
+func (m *AssetTrashPage) Render(w http.ResponseWriter, r *http.Request) error {
+	return views.Render(w, "assets_trash_page", views.Model[*AssetTrashPage]{
+		Global: views.NewGlobal("Trash", r),
+		Data:   m,
+	})
+}
@@ -9,6 +9,8 @@ import (
 
 type LoginPage struct {
 	ValidationErrs map[string]string
+	// OIDCProviderName is empty if OIDC login is disabled.
+	OIDCProviderName string
 }
 
 func (p *LoginPage) Render(w http.ResponseWriter, r *http.Request) error {
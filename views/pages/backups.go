@@ -0,0 +1,19 @@
+package pages
+
+import (
+	"net/http"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/views"
+)
+
+type BackupsPage struct {
+	Status *entities.BackupStatus
+}
+
+func (p *BackupsPage) Render(w http.ResponseWriter, r *http.Request) error {
+	return views.Render(w, "backups_page", views.Model[*BackupsPage]{
+		Global: views.NewGlobal("Backups", r),
+		Data:   p,
+	})
+}
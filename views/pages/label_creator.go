@@ -12,6 +12,8 @@ type LabelSheetCreatorPage struct {
 	Template string `form:"template"`
 
 	SelectedAssetIDs []int64 `form:"selected_asset_ids"`
+	// SavedSearchID limits the labels to the assets matching the saved search.
+	SavedSearchID int64 `form:"saved_search"`
 
 	PageSize   string `form:"page_size"`
 	SkipLabels int    `form:"skip_labels"`
@@ -32,7 +34,8 @@ type LabelSheetCreatorPage struct {
 	VerticalSpacing   float64 `form:"label_vertical_spacing"`
 	HorizontalSpacing float64 `form:"label_horizontal_spacing"`
 
-	Assets []*entities.Asset `form:"-"`
+	Assets        []*entities.Asset       `form:"-"`
+	SavedSearches []*entities.SavedSearch `form:"-"`
 
 	ValidationErrs map[string]string `form:"-"`
 }
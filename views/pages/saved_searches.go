@@ -0,0 +1,32 @@
+package pages
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/views"
+)
+
+type SavedSearchesListPage struct {
+	Searches []*entities.SavedSearch
+	User     *auth.User
+}
+
+// IsPinned reports whether the current user pinned the search to the sidebar.
+func (p *SavedSearchesListPage) IsPinned(search *entities.SavedSearch) bool {
+	return slices.Contains(p.User.Preferences.PinnedSavedSearches, search.ID)
+}
+
+// CanDelete reports whether the current user may delete the search.
+func (p *SavedSearchesListPage) CanDelete(search *entities.SavedSearch) bool {
+	return search.UserID == p.User.ID || p.User.IsAdmin()
+}
+
+func (p *SavedSearchesListPage) Render(w http.ResponseWriter, r *http.Request) error {
+	return views.Render(w, "saved_searches_list_page", views.Model[*SavedSearchesListPage]{
+		Global: views.NewGlobal("Saved Searches", r),
+		Data:   p,
+	})
+}
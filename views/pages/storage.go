@@ -0,0 +1,21 @@
+package pages
+
+import (
+	"net/http"
+
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/views"
+)
+
+type StorageCheckPage struct {
+	Report          *entities.FileCheckReport
+	VerifyChecksums bool
+	Repair          bool
+}
+
+func (p *StorageCheckPage) Render(w http.ResponseWriter, r *http.Request) error {
+	return views.Render(w, "storage_check_page", views.Model[*StorageCheckPage]{
+		Global: views.NewGlobal("Storage", r),
+		Data:   p,
+	})
+}
@@ -39,6 +39,11 @@ func (p *UsersNewPage) Render(w http.ResponseWriter, r *http.Request) error {
 type UsersCurrentPage struct {
 	User           *auth.User
 	ValidationErrs map[string]string
+
+	APITokens              []*auth.APIToken
+	APITokenForm           *auth.APIToken
+	NewAPIToken            string
+	APITokenValidationErrs map[string]string
 }
 
 func (p *UsersCurrentPage) Render(w http.ResponseWriter, r *http.Request) error {
@@ -69,3 +74,22 @@ func (p *UserDeletePage) Render(w http.ResponseWriter, r *http.Request) error {
 		Data:   p,
 	})
 }
+
+type UsersPermissionsPage struct {
+	User           *auth.User
+	Grants         []*auth.Grant
+	GrantForm      *auth.Grant
+	ValidationErrs map[string]string
+}
+
+func (p *UsersPermissionsPage) Render(w http.ResponseWriter, r *http.Request) error {
+	csrfErr, ok := session.Pop[string](r.Context(), "csrf_error")
+	if ok {
+		p.ValidationErrs["general"] = csrfErr
+	}
+
+	return views.Render(w, "users_permissions_page", views.Model[*UsersPermissionsPage]{
+		Global: views.NewGlobal(p.User.DisplayName+" Permissions", r),
+		Data:   p,
+	})
+}
@@ -0,0 +1,49 @@
+package pages
+
+import (
+	"net/http"
+
+	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
+	"github.com/RobinThrift/stuff/internal/server/session"
+	"github.com/RobinThrift/stuff/views"
+)
+
+type WorkspacesListPage struct {
+	Workspaces     []*entities.Workspace
+	Form           *entities.Workspace
+	ValidationErrs map[string]string
+}
+
+func (p *WorkspacesListPage) Render(w http.ResponseWriter, r *http.Request) error {
+	csrfErr, ok := session.Pop[string](r.Context(), "csrf_error")
+	if ok {
+		p.ValidationErrs["general"] = csrfErr
+	}
+
+	return views.Render(w, "workspaces_list_page", views.Model[*WorkspacesListPage]{
+		Global: views.NewGlobal("Workspaces", r),
+		Data:   p,
+	})
+}
+
+type WorkspacesEditPage struct {
+	Workspace      *entities.Workspace
+	Members        []*auth.User
+	ValidationErrs map[string]string
+
+	MemberUsername       string
+	MemberValidationErrs map[string]string
+}
+
+func (p *WorkspacesEditPage) Render(w http.ResponseWriter, r *http.Request) error {
+	csrfErr, ok := session.Pop[string](r.Context(), "csrf_error")
+	if ok {
+		p.ValidationErrs["general"] = csrfErr
+	}
+
+	return views.Render(w, "workspaces_edit_page", views.Model[*WorkspacesEditPage]{
+		Global: views.NewGlobal("Edit "+p.Workspace.Name, r),
+		Data:   p,
+	})
+}
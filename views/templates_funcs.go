@@ -9,8 +9,10 @@ import (
 	"html/template"
 	"net/url"
 	"reflect"
+	"slices"
 	"strings"
 
+	"github.com/RobinThrift/stuff/entities"
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/extension"
 	"github.com/yuin/goldmark/renderer/html"
@@ -18,6 +20,8 @@ import (
 
 var markdown = goldmark.New(goldmark.WithExtensions(extension.GFM), goldmark.WithRendererOptions(html.WithHardWraps(), html.WithXHTML()))
 
+var snippetMarkers = strings.NewReplacer(entities.SnippetMatchStart, "<mark>", entities.SnippetMatchEnd, "</mark>")
+
 var templateFuncs = template.FuncMap{
 	"list": func(items ...any) []any {
 		return items
@@ -193,6 +197,41 @@ var templateFuncs = template.FuncMap{
 		return clone.String()
 	},
 
+	"highlight": func(snippet string) template.HTML {
+		return template.HTML(snippetMarkers.Replace(template.HTMLEscapeString(snippet))) //nolint: gosec // the snippet has been escaped
+	},
+
+	// toggleQueryParamURL adds the value to the values of the query parameter or removes it, if it is already set.
+	// The page is reset, as the number of pages may change.
+	"toggleQueryParamURL": func(u *url.URL, name string, value string) string {
+		clone := *u
+		q := clone.Query()
+
+		values := q[name]
+		if i := slices.Index(values, value); i != -1 {
+			q[name] = slices.Delete(slices.Clone(values), i, i+1)
+		} else {
+			q.Add(name, value)
+		}
+
+		q.Del("page")
+
+		clone.RawQuery = q.Encode()
+		return clone.String()
+	},
+
+	"urlWithoutParams": func(u *url.URL, names ...string) string {
+		clone := *u
+		q := clone.Query()
+		for _, name := range names {
+			q.Del(name)
+		}
+		clone.RawQuery = q.Encode()
+		return clone.String()
+	},
+
+	"savedSearchURL": SavedSearchURL,
+
 	"markdown": func(source string) (template.HTML, error) {
 		var out bytes.Buffer
 
@@ -9,10 +9,13 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/RobinThrift/stuff"
 	"github.com/RobinThrift/stuff/auth"
+	"github.com/RobinThrift/stuff/entities"
 	"github.com/RobinThrift/stuff/internal/server/session"
+	"github.com/RobinThrift/stuff/internal/workspace"
 	"github.com/gorilla/csrf"
 )
 
@@ -29,6 +32,10 @@ type Global struct {
 	Version      string
 	Referer      string
 	User         *auth.User
+	Workspace    *entities.Workspace
+	Workspaces   []*entities.Workspace
+	// PinnedSavedSearches are shown in the sidebar.
+	PinnedSavedSearches []*entities.SavedSearch
 }
 
 type FlashMessage struct {
@@ -56,7 +63,7 @@ func NewGlobal(title string, r *http.Request) Global {
 		}
 	}
 
-	return Global{
+	global := Global{
 		Title:        title,
 		CSRFToken:    csrf.Token(r),
 		FlashMessage: flashMessage,
@@ -65,6 +72,31 @@ func NewGlobal(title string, r *http.Request) Global {
 		Referer:      referer,
 		User:         user,
 	}
+
+	if scope, ok := workspace.FromCtx(r.Context()); ok {
+		global.Workspace = scope.Current
+		global.Workspaces = scope.Available
+	}
+
+	global.PinnedSavedSearches, _ = r.Context().Value(ctxPinnedSavedSearchesKey).([]*entities.SavedSearch)
+
+	return global
+}
+
+type ctxPinnedSavedSearchesKeyType string
+
+const ctxPinnedSavedSearchesKey = ctxPinnedSavedSearchesKeyType("ctxPinnedSavedSearchesKey")
+
+// WithPinnedSavedSearches adds the saved searches to be shown in the sidebar to the context.
+func WithPinnedSavedSearches(ctx context.Context, searches []*entities.SavedSearch) context.Context {
+	return context.WithValue(ctx, ctxPinnedSavedSearchesKey, searches)
+}
+
+// SavedSearchURL returns the URL of the asset list showing the results of the saved search.
+func SavedSearchURL(search *entities.SavedSearch) string {
+	q := search.Values()
+	q.Set("saved_search", strconv.FormatInt(search.ID, 10))
+	return (&url.URL{Path: "/assets", RawQuery: q.Encode()}).String()
 }
 
 func SetFlashMessage(ctx context.Context, typ FlashMessageType, text string) {
@@ -91,12 +123,32 @@ func RenderErrorPage(w http.ResponseWriter, r *http.Request, err error) {
 			Title:   "Unknown Error",
 			Message: err.Error(),
 		}
+
+		if errors.Is(err, auth.ErrForbidden) {
+			errPageErr = ErrorPageErr{
+				Code:    http.StatusForbidden,
+				Title:   "Forbidden",
+				Message: "You don't have permission to do this.",
+			}
+		}
+
+		if errors.Is(err, auth.ErrUnauthorized) {
+			errPageErr = ErrorPageErr{
+				Code:    http.StatusUnauthorized,
+				Title:   "Unauthorized",
+				Message: "You need to be logged in to do this.",
+			}
+		}
 	}
 
 	if errPageErr.Title == "" && errPageErr.Err != nil {
 		errPageErr.Title = errPageErr.Err.Error()
 	}
 
+	if errPageErr.Code != 0 {
+		w.WriteHeader(errPageErr.Code)
+	}
+
 	renderErr := Render(w, "error_page", Model[ErrorPageErr]{
 		Global: Global{
 			Title: errPageErr.Title,